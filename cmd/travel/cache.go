@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"travel-api/internal/cache"
+
+	"go.uber.org/zap"
+)
+
+// defaultCacheTimeout keeps a slow Redis from slowing the requests down much,
+// the reads falling back to the database past it.
+const defaultCacheTimeout = 50 * time.Millisecond
+
+// newCache returns the cache of the trips in the Redis at CACHE_REDIS_URL, or
+// nil when it is not set. CACHE_TRIP_TTL, CACHE_ACTIVITIES_TTL and
+// CACHE_PARTICIPANTS_TTL set how long each kind of entry is kept, and
+// CACHE_TIMEOUT bounds the Redis commands.
+func newCache(logger *zap.Logger) (*cache.Cache, error) {
+	redisURL := os.Getenv("CACHE_REDIS_URL")
+	if redisURL == "" {
+		return nil, nil
+	}
+
+	ttls := cache.TTLs{
+		Trip:         time.Minute,
+		Activities:   30 * time.Second,
+		Participants: 30 * time.Second,
+	}
+	timeout := defaultCacheTimeout
+
+	for name, d := range map[string]*time.Duration{
+		"CACHE_TRIP_TTL":         &ttls.Trip,
+		"CACHE_ACTIVITIES_TTL":   &ttls.Activities,
+		"CACHE_PARTICIPANTS_TTL": &ttls.Participants,
+		"CACHE_TIMEOUT":          &timeout,
+	} {
+		if v := os.Getenv(name); v != "" {
+			var err error
+			if *d, err = time.ParseDuration(v); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			if *d <= 0 {
+				return nil, fmt.Errorf("invalid %s: must be positive", name)
+			}
+		}
+	}
+
+	redis, err := cache.NewRedis(redisURL, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CACHE_REDIS_URL: %w", err)
+	}
+
+	// Redis being down at startup is reported rather than failing the
+	// server, the reads falling back to the database until it is up.
+	if err := redis.Ping(context.Background()); err != nil {
+		logger.Error("failed to reach cache", zap.Error(err))
+	}
+
+	return cache.New(redis, ttls, logger), nil
+}
+
+// logCacheStats logs the hits and misses of the cache, by kind of entry,
+// every interval until ctx is done.
+func logCacheStats(ctx context.Context, logger *zap.Logger, interval time.Duration, cached *cache.Cache) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for kind, stats := range cached.Stats() {
+				logger.Info(
+					"cache stats",
+					zap.String("kind", kind),
+					zap.Int64("hits", stats.Hits),
+					zap.Int64("misses", stats.Misses),
+					zap.Int64("errors", stats.Errors),
+				)
+			}
+		}
+	}
+}
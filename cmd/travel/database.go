@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// poolOptions tunes the connection pools, the zero value of each option
+// keeping the pgxpool default.
+type poolOptions struct {
+	maxConns          int32
+	minConns          int32
+	maxConnLifetime   time.Duration
+	healthCheckPeriod time.Duration
+	execMode          pgx.QueryExecMode
+	// queryTimeout is the statement_timeout of the connections, zero letting
+	// queries run.
+	queryTimeout time.Duration
+	// slowQueryThreshold is how long the queries of the API run before being
+	// logged, zero logging none.
+	slowQueryThreshold time.Duration
+	// retries is how the queries of the API failing on a transient error are
+	// retried.
+	retries pgstore.Retries
+}
+
+// defaultQueryTimeout is below the write timeout of the server, for a stuck
+// query to fail the request while its response can still be written.
+const defaultQueryTimeout = 3 * time.Second
+
+const defaultSlowQueryThreshold = 500 * time.Millisecond
+
+// defaultRetries rides out a connection reset or a failover without holding a
+// request for long.
+var defaultRetries = pgstore.Retries{Attempts: 3, Backoff: 50 * time.Millisecond}
+
+// execModes are the values of DATABASE_STATEMENT_CACHE_MODE, named like the
+// default_query_exec_mode connection parameter of pgx.
+var execModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
+
+// parsePoolOptions reads the pool options from DATABASE_MAX_CONNS,
+// DATABASE_MIN_CONNS, DATABASE_MAX_CONN_LIFETIME,
+// DATABASE_HEALTH_CHECK_PERIOD, DATABASE_STATEMENT_CACHE_MODE,
+// DATABASE_QUERY_TIMEOUT, which defaults to defaultQueryTimeout,
+// DATABASE_SLOW_QUERY_THRESHOLD, which defaults to defaultSlowQueryThreshold,
+// and DATABASE_RETRY_ATTEMPTS and DATABASE_RETRY_BACKOFF, which default to
+// defaultRetries.
+func parsePoolOptions() (poolOptions, error) {
+	opts := poolOptions{
+		queryTimeout:       defaultQueryTimeout,
+		slowQueryThreshold: defaultSlowQueryThreshold,
+		retries:            defaultRetries,
+	}
+
+	for name, conns := range map[string]*int32{
+		"DATABASE_MAX_CONNS": &opts.maxConns,
+		"DATABASE_MIN_CONNS": &opts.minConns,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || n < 0 {
+				return poolOptions{}, fmt.Errorf("invalid %s: %q", name, v)
+			}
+			*conns = int32(n)
+		}
+	}
+
+	if opts.maxConns > 0 && opts.minConns > opts.maxConns {
+		return poolOptions{}, fmt.Errorf("DATABASE_MIN_CONNS must not be greater than DATABASE_MAX_CONNS")
+	}
+
+	for name, d := range map[string]*time.Duration{
+		"DATABASE_MAX_CONN_LIFETIME":    &opts.maxConnLifetime,
+		"DATABASE_HEALTH_CHECK_PERIOD":  &opts.healthCheckPeriod,
+		"DATABASE_QUERY_TIMEOUT":        &opts.queryTimeout,
+		"DATABASE_SLOW_QUERY_THRESHOLD": &opts.slowQueryThreshold,
+		"DATABASE_RETRY_BACKOFF":        &opts.retries.Backoff,
+	} {
+		if v := os.Getenv(name); v != "" {
+			var err error
+			if *d, err = time.ParseDuration(v); err != nil {
+				return poolOptions{}, fmt.Errorf("invalid %s: %w", name, err)
+			}
+		}
+	}
+
+	if v := os.Getenv("DATABASE_RETRY_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return poolOptions{}, fmt.Errorf("invalid DATABASE_RETRY_ATTEMPTS: %q", v)
+		}
+		opts.retries.Attempts = n
+	}
+
+	if v := os.Getenv("DATABASE_STATEMENT_CACHE_MODE"); v != "" {
+		mode, ok := execModes[v]
+		if !ok {
+			return poolOptions{}, fmt.Errorf("invalid DATABASE_STATEMENT_CACHE_MODE: %q", v)
+		}
+		opts.execMode = mode
+	}
+
+	return opts, nil
+}
+
+// newPool connects to the database at host and port, with the credentials of
+// the primary database.
+func newPool(ctx context.Context, host, port string, opts poolOptions) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(fmt.Sprintf(
+		"user=%s password=%s host=%s port=%s dbname=%s",
+		os.Getenv("DATABASE_USER"),
+		os.Getenv("DATABASE_PASSWORD"),
+		host,
+		port,
+		os.Getenv("DATABASE_NAME"),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.maxConns > 0 {
+		cfg.MaxConns = opts.maxConns
+	}
+	if opts.minConns > 0 {
+		cfg.MinConns = opts.minConns
+	}
+	if opts.maxConnLifetime > 0 {
+		cfg.MaxConnLifetime = opts.maxConnLifetime
+	}
+	if opts.healthCheckPeriod > 0 {
+		cfg.HealthCheckPeriod = opts.healthCheckPeriod
+	}
+	if opts.execMode != 0 {
+		cfg.ConnConfig.DefaultQueryExecMode = opts.execMode
+	}
+	if opts.queryTimeout > 0 {
+		cfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(opts.queryTimeout.Milliseconds(), 10)
+	}
+
+	cfg.ConnConfig.Tracer = pgstore.Tracer{}
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// logPoolStats logs the stats of the pools, by name, every interval until ctx
+// is done.
+func logPoolStats(ctx context.Context, logger *zap.Logger, interval time.Duration, pools map[string]*pgxpool.Pool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for name, pool := range pools {
+				stat := pool.Stat()
+				logger.Info(
+					"database pool stats",
+					zap.String("pool", name),
+					zap.Int32("total_conns", stat.TotalConns()),
+					zap.Int32("acquired_conns", stat.AcquiredConns()),
+					zap.Int32("idle_conns", stat.IdleConns()),
+					zap.Int32("max_conns", stat.MaxConns()),
+					zap.Int64("acquire_count", stat.AcquireCount()),
+					zap.Duration("acquire_duration", stat.AcquireDuration()),
+					zap.Int64("empty_acquire_count", stat.EmptyAcquireCount()),
+					zap.Int64("canceled_acquire_count", stat.CanceledAcquireCount()),
+				)
+			}
+		}
+	}
+}
+
+// logQueryStats logs the stats of the queries of the API, by name, every
+// interval until ctx is done. The buckets are counted as described by
+// pgstore.QueryStats.
+func logQueryStats(ctx context.Context, logger *zap.Logger, interval time.Duration, metrics *pgstore.QueryMetrics) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for name, stats := range metrics.Stats() {
+				logger.Info(
+					"database query stats",
+					zap.String("query", name),
+					zap.Int64("count", stats.Count),
+					zap.Int64("errors", stats.Errors),
+					zap.Duration("total_duration", stats.Total),
+					zap.Int64s("buckets", stats.Buckets),
+				)
+			}
+		}
+	}
+}
@@ -3,15 +3,44 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
+	// Trip timezones are loaded by name, embed the database for hosts without
+	// one.
+	_ "time/tzdata"
+	"travel-api/internal/actionlink"
 	"travel-api/internal/api"
 	"travel-api/internal/api/spec"
+	"travel-api/internal/emailevents"
+	"travel-api/internal/events"
+	"travel-api/internal/flightstatus"
+	"travel-api/internal/geocoding"
+	"travel-api/internal/linkpreview"
+	"travel-api/internal/live"
+	"travel-api/internal/mailer"
+	"travel-api/internal/mailer/logmailer"
 	"travel-api/internal/mailer/mailpit"
+	"travel-api/internal/mailer/resend"
+	"travel-api/internal/mailer/ses"
+	"travel-api/internal/memstore"
+	"travel-api/internal/pgstore"
+	"travel-api/internal/places"
+	"travel-api/internal/reminder"
+	"travel-api/internal/seed"
+	"travel-api/internal/sqlitestore"
+	"travel-api/internal/storage/disk"
+	"travel-api/internal/storage/s3"
+	"travel-api/internal/thumbnail"
+	"travel-api/internal/unsubscribe"
+	"travel-api/internal/weather"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -26,7 +55,7 @@ func main() {
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGKILL)
 	defer cancel()
 
-	if err := run(ctx); err != nil {
+	if err := run(ctx, os.Args[1:]); err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
@@ -34,7 +63,27 @@ func main() {
 	fmt.Println("Bye!")
 }
 
-func run(ctx context.Context) error {
+// run serves the API. With the migrate command it applies the pending
+// database migrations and exits, and with the seed command it fills the
+// database with made up trips and exits.
+func run(ctx context.Context, args []string) error {
+	var command string
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	var seedCfg seed.Config
+	switch command {
+	case "", "migrate":
+	case "seed":
+		var err error
+		if seedCfg, err = parseSeedFlags(args[1:]); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown command: %q", command)
+	}
+
 	cfg := zap.NewDevelopmentConfig()
 	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 
@@ -46,33 +95,434 @@ func run(ctx context.Context) error {
 	logger = logger.Named("travel_app")
 	defer func() { _ = logger.Sync() }()
 
-	pool, err := pgxpool.New(ctx, fmt.Sprintf(
-		"user=%s password=%s host=%s port=%s dbname=%s",
-		os.Getenv("DATABASE_USER"),
-		os.Getenv("DATABASE_PASSWORD"),
-		os.Getenv("DATABASE_HOST"),
-		os.Getenv("DATABASE_PORT"),
-		os.Getenv("DATABASE_NAME"),
-	))
+	migrateOnStartup := false
+	if migrate := os.Getenv("MIGRATE_ON_STARTUP"); migrate != "" {
+		if migrateOnStartup, err = strconv.ParseBool(migrate); err != nil {
+			return fmt.Errorf("invalid MIGRATE_ON_STARTUP: %w", err)
+		}
+	}
+
+	switch driver := os.Getenv("DATABASE_DRIVER"); driver {
+	case "", "postgres":
+	case "sqlite":
+		if command == "seed" {
+			return fmt.Errorf("the seed command requires the postgres DATABASE_DRIVER")
+		}
+
+		return runSQLite(ctx, logger, command == "migrate" || migrateOnStartup, command == "migrate")
+	case "memory":
+		if command != "" {
+			return fmt.Errorf("the %s command requires the postgres DATABASE_DRIVER", command)
+		}
+
+		return runMemory(ctx, logger)
+	default:
+		return fmt.Errorf("invalid DATABASE_DRIVER: %q", driver)
+	}
+
+	poolOpts, err := parsePoolOptions()
+	if err != nil {
+		return err
+	}
+
+	// The migrations, the seed and the background jobs run on a pool of
+	// their own, without the statement_timeout meant for the requests, since
+	// they go through whole tables.
+	jobOpts := poolOpts
+	jobOpts.queryTimeout = 0
+
+	pool, err := newPool(ctx, os.Getenv("DATABASE_HOST"), os.Getenv("DATABASE_PORT"), jobOpts)
 	if err != nil {
 		return err
 	}
 
 	defer pool.Close()
-	if err := pool.Ping(ctx); err != nil {
+
+	if command == "migrate" || migrateOnStartup {
+		applied, err := pgstore.Migrate(ctx, pool)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range applied {
+			logger.Info("applied migration", zap.String("migration", m.Name))
+		}
+		if len(applied) == 0 {
+			logger.Info("database is up to date")
+		}
+
+		if command == "migrate" {
+			return nil
+		}
+	}
+
+	if command == "seed" {
+		counts, err := seed.Run(ctx, pool, seedCfg)
+		if err != nil {
+			return err
+		}
+
+		logger.Info(
+			"seeded database",
+			zap.Int("trips", counts.Trips),
+			zap.Int("participants", counts.Participants),
+			zap.Int("activities", counts.Activities),
+			zap.Int("links", counts.Links),
+		)
+
+		return nil
+	}
+
+	driver, err := newMailDriver(logger)
+	if err != nil {
+		return err
+	}
+
+	from := os.Getenv("MAILER_FROM")
+	if from == "" {
+		from = "mailpit@travel.com"
+	}
+
+	unsubscribeLinks, err := unsubscribe.NewLinks(
+		pool,
+		logger,
+		os.Getenv("PUBLIC_URL")+"/unsubscribe",
+		[]byte(os.Getenv("UNSUBSCRIBE_SIGNING_KEY")),
+	)
+	if err != nil {
+		return err
+	}
+
+	actionTokens, err := actionlink.NewTokens([]byte(os.Getenv("ACTION_LINK_SIGNING_KEY")))
+	if err != nil {
+		return err
+	}
+
+	forecasts, err := newForecaster()
+	if err != nil {
+		return err
+	}
+
+	locations := newGeocoder()
+	finder := newPlaceFinder()
+
+	emails := mailer.New(pool, driver, mailer.Config{
+		From:        from,
+		PublicURL:   os.Getenv("PUBLIC_URL"),
+		FrontendURL: os.Getenv("FRONTEND_URL"),
+		Actions:     actionTokens,
+		Unsubscribe: unsubscribeLinks,
+		Weather:     forecasts,
+	})
+
+	blobs, files, err := newBlobStore()
+	if err != nil {
+		return err
+	}
+
+	reminderLead := time.Hour
+	if lead := os.Getenv("REMINDER_LEAD"); lead != "" {
+		if reminderLead, err = time.ParseDuration(lead); err != nil {
+			return fmt.Errorf("invalid REMINDER_LEAD: %w", err)
+		}
+	}
+
+	rsvpReminderDays := 2
+	if days := os.Getenv("RSVP_REMINDER_DAYS"); days != "" {
+		if rsvpReminderDays, err = strconv.Atoi(days); err != nil {
+			return fmt.Errorf("invalid RSVP_REMINDER_DAYS: %w", err)
+		}
+	}
+
+	go mailer.NewOutbox(pool, logger, emails, 10*time.Second).Run(ctx)
+	go thumbnail.NewGenerator(pool, logger, blobs, 10*time.Second).Run(ctx)
+
+	notifyChannel := os.Getenv("EVENTS_NOTIFY_CHANNEL")
+	if notifyChannel == "" {
+		notifyChannel = "domain_events"
+	}
+
+	webhooks := events.NewWebhooks(pool, logger, 5*time.Second, 10*time.Second)
+	go webhooks.Run(ctx)
+
+	publishers := []events.Publisher{events.NewNotify(pool, notifyChannel), webhooks}
+	if url := os.Getenv("EVENTS_WEBHOOK_URL"); url != "" {
+		webhook, err := events.NewWebhook(url, []byte(os.Getenv("EVENTS_WEBHOOK_SIGNING_KEY")), 10*time.Second)
+		if err != nil {
+			return err
+		}
+		publishers = append(publishers, webhook)
+	}
+
+	go events.NewDispatcher(pool, logger, 5*time.Second, publishers...).Run(ctx)
+
+	changes := live.NewHub(pool, logger)
+	go changes.Run(ctx)
+
+	go reminder.NewScheduler(
+		pool,
+		logger,
+		reminderLead,
+		time.Duration(rsvpReminderDays)*24*time.Hour,
+		time.Minute,
+	).Run(ctx)
+
+	if url := os.Getenv("FLIGHT_STATUS_URL"); url != "" {
+		flightLead := 24 * time.Hour
+		if lead := os.Getenv("FLIGHT_STATUS_LEAD"); lead != "" {
+			if flightLead, err = time.ParseDuration(lead); err != nil {
+				return fmt.Errorf("invalid FLIGHT_STATUS_LEAD: %w", err)
+			}
+		}
+
+		flightInterval := 5 * time.Minute
+		if interval := os.Getenv("FLIGHT_STATUS_INTERVAL"); interval != "" {
+			if flightInterval, err = time.ParseDuration(interval); err != nil {
+				return fmt.Errorf("invalid FLIGHT_STATUS_INTERVAL: %w", err)
+			}
+		}
+
+		go flightstatus.NewTracker(
+			pool,
+			logger,
+			flightstatus.NewHTTP(url, os.Getenv("FLIGHT_STATUS_API_KEY"), 10*time.Second),
+			flightLead,
+			flightInterval,
+		).Run(ctx)
+	}
+
+	serverPool, err := newPool(ctx, os.Getenv("DATABASE_HOST"), os.Getenv("DATABASE_PORT"), poolOpts)
+	if err != nil {
+		return err
+	}
+
+	defer serverPool.Close()
+
+	// The replicas are read by the API only, the background jobs reading what
+	// they just wrote.
+	var replicas []*pgxpool.Pool
+	if hosts := os.Getenv("DATABASE_REPLICA_HOSTS"); hosts != "" {
+		for _, host := range strings.Split(hosts, ",") {
+			host, port, ok := strings.Cut(strings.TrimSpace(host), ":")
+			if !ok {
+				port = os.Getenv("DATABASE_PORT")
+			}
+
+			replica, err := newPool(ctx, host, port, poolOpts)
+			if err != nil {
+				return fmt.Errorf("invalid DATABASE_REPLICA_HOSTS: %w", err)
+			}
+
+			defer replica.Close()
+			replicas = append(replicas, replica)
+		}
+	}
+
+	cached, err := newCache(logger)
+	if err != nil {
 		return err
 	}
 
-	mailer := mailpit.NewMailpit(pool)
+	if cached != nil {
+		go cached.Run(ctx, changes)
+	}
+
+	queryMetrics := pgstore.NewQueryMetrics(logger, poolOpts.slowQueryThreshold)
+
+	statsInterval := time.Minute
+	if interval := os.Getenv("DATABASE_STATS_INTERVAL"); interval != "" {
+		if statsInterval, err = time.ParseDuration(interval); err != nil {
+			return fmt.Errorf("invalid DATABASE_STATS_INTERVAL: %w", err)
+		}
+	}
+
+	if statsInterval > 0 {
+		pools := map[string]*pgxpool.Pool{"primary": serverPool, "jobs": pool}
+		for i, replica := range replicas {
+			pools[fmt.Sprintf("replica_%d", i+1)] = replica
+		}
+
+		go logPoolStats(ctx, logger, statsInterval, pools)
+		go logQueryStats(ctx, logger, statsInterval, queryMetrics)
+
+		if cached != nil {
+			go logCacheStats(ctx, logger, statsInterval, cached)
+		}
+	}
 
-	si := api.NewAPI(pool, logger, mailer)
+	si := api.NewAPI(serverPool, replicas, poolOpts.queryTimeout, queryMetrics, poolOpts.retries, cached, logger, blobs, linkpreview.NewFetcher(10*time.Second), forecasts, locations, finder, emails, actionTokens, changes)
 	router := chi.NewMux()
-	router.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
+	router.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger), api.ServiceUnavailable)
+	if files != nil {
+		router.Mount("/files", http.StripPrefix("/files", files))
+	}
+	router.Handle("/unsubscribe", unsubscribeLinks.Handler())
+	router.Method(http.MethodPost, "/webhooks/email-events", emailevents.NewHandler(serverPool, logger, os.Getenv("EMAIL_WEBHOOK_TOKEN")))
 	router.Mount("/", spec.Handler(&si))
 
+	return serve(ctx, logger, router)
+}
+
+// runMemory serves the API over the in-memory store, without Postgres. The
+// data is lost on exit and, but for the thumbnails of the photos, the
+// background jobs, which read Postgres, do not run: no reminder nor
+// invitation is sent.
+func runMemory(ctx context.Context, logger *zap.Logger) error {
+	logger.Warn("serving from memory, the data is lost on exit")
+
+	deps, err := newEmbeddedDeps(logger)
+	if err != nil {
+		return err
+	}
+
+	store := memstore.New()
+	emails := mailer.NewWithStore(store, deps.mailDriver, deps.mailerCfg)
+
+	go thumbnail.NewGeneratorWithStore(store, logger, deps.blobs, 10*time.Second).Run(ctx)
+
+	si := api.NewAPIWithStore(store, logger, deps.blobs, linkpreview.NewFetcher(10*time.Second), deps.forecasts, deps.locations, deps.places, emails, deps.actions, deps.changes)
+	return serve(ctx, logger, deps.router(logger, &si))
+}
+
+// runSQLite serves the API over the SQLite database at DATABASE_PATH, for
+// hosting it on a single machine without Postgres. The emails of the outbox
+// are sent and the thumbnails of the photos made, but the other background
+// jobs only run against Postgres: no reminder, digest nor domain event is sent
+// and no change is streamed. With migrate it applies the pending migrations,
+// and with migrateOnly it exits afterwards.
+func runSQLite(ctx context.Context, logger *zap.Logger, migrate bool, migrateOnly bool) error {
+	path := os.Getenv("DATABASE_PATH")
+	if path == "" {
+		path = "travel.db"
+	}
+
+	store, err := sqlitestore.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = store.Close() }()
+
+	if migrate {
+		applied, err := store.Migrate(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range applied {
+			logger.Info("applied migration", zap.String("migration", m.Name))
+		}
+		if len(applied) == 0 {
+			logger.Info("database is up to date")
+		}
+
+		if migrateOnly {
+			return nil
+		}
+	}
+
+	deps, err := newEmbeddedDeps(logger)
+	if err != nil {
+		return err
+	}
+
+	emails := mailer.NewWithStore(store, deps.mailDriver, deps.mailerCfg)
+
+	go mailer.NewOutboxWithStore(store, logger, emails, 10*time.Second).Run(ctx)
+	go thumbnail.NewGeneratorWithStore(store, logger, deps.blobs, 10*time.Second).Run(ctx)
+
+	si := api.NewAPIWithStore(store, logger, deps.blobs, linkpreview.NewFetcher(10*time.Second), deps.forecasts, deps.locations, deps.places, emails, deps.actions, deps.changes)
+	return serve(ctx, logger, deps.router(logger, &si))
+}
+
+// embeddedDeps are the dependencies of the API served over a store other
+// than Postgres.
+type embeddedDeps struct {
+	mailDriver mailer.Driver
+	mailerCfg  mailer.Config
+	blobs      blobStore
+	files      http.Handler
+	forecasts  weather.Provider
+	locations  geocoding.Provider
+	places     places.Provider
+	actions    actionlink.Tokens
+	changes    *live.Hub
+}
+
+func newEmbeddedDeps(logger *zap.Logger) (embeddedDeps, error) {
+	driver, err := newMailDriver(logger)
+	if err != nil {
+		return embeddedDeps{}, err
+	}
+
+	from := os.Getenv("MAILER_FROM")
+	if from == "" {
+		from = "mailpit@travel.com"
+	}
+
+	// The links are only signed for the emails, /unsubscribe is not served.
+	unsubscribeLinks, err := unsubscribe.NewLinks(
+		nil,
+		logger,
+		os.Getenv("PUBLIC_URL")+"/unsubscribe",
+		[]byte(os.Getenv("UNSUBSCRIBE_SIGNING_KEY")),
+	)
+	if err != nil {
+		return embeddedDeps{}, err
+	}
+
+	actionTokens, err := actionlink.NewTokens([]byte(os.Getenv("ACTION_LINK_SIGNING_KEY")))
+	if err != nil {
+		return embeddedDeps{}, err
+	}
+
+	blobs, files, err := newBlobStore()
+	if err != nil {
+		return embeddedDeps{}, err
+	}
+
+	forecasts, err := newForecaster()
+	if err != nil {
+		return embeddedDeps{}, err
+	}
+
+	return embeddedDeps{
+		mailDriver: driver,
+		mailerCfg: mailer.Config{
+			From:        from,
+			PublicURL:   os.Getenv("PUBLIC_URL"),
+			FrontendURL: os.Getenv("FRONTEND_URL"),
+			Actions:     actionTokens,
+			Unsubscribe: unsubscribeLinks,
+			Weather:     forecasts,
+		},
+		blobs:     blobs,
+		files:     files,
+		forecasts: forecasts,
+		locations: newGeocoder(),
+		places:    newPlaceFinder(),
+		actions:   actionTokens,
+		// Without Postgres to notify them, no change is streamed.
+		changes: live.NewHub(nil, logger),
+	}, nil
+}
+
+func (d embeddedDeps) router(logger *zap.Logger, si *api.API) http.Handler {
+	router := chi.NewMux()
+	router.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
+	if d.files != nil {
+		router.Mount("/files", http.StripPrefix("/files", d.files))
+	}
+	router.Mount("/", spec.Handler(si))
+
+	return router
+}
+
+// serve serves handler on :8080 until ctx is done.
+func serve(ctx context.Context, logger *zap.Logger, handler http.Handler) error {
 	server := &http.Server{
 		Addr:         ":8080",
-		Handler:      router,
+		Handler:      handler,
 		IdleTimeout:  time.Minute,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 5 * time.Second,
@@ -106,3 +556,175 @@ func run(ctx context.Context) error {
 
 	return nil
 }
+
+// parseSeedFlags parses the flags of the seed command.
+func parseSeedFlags(args []string) (seed.Config, error) {
+	var cfg seed.Config
+
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	flags.IntVar(&cfg.Trips, "trips", 10, "number of trips")
+	flags.IntVar(&cfg.ParticipantsPerTrip, "participants", 5, "number of participants per trip")
+	flags.IntVar(&cfg.ActivitiesPerTrip, "activities", 8, "number of activities per trip")
+	flags.IntVar(&cfg.LinksPerTrip, "links", 3, "number of links per trip")
+	flags.Uint64Var(&cfg.Seed, "seed", uint64(time.Now().UnixNano()), "seed of the generated data, the same seed making up the same trips")
+
+	if err := flags.Parse(args); err != nil {
+		return seed.Config{}, err
+	}
+
+	if cfg.Trips < 0 || cfg.ParticipantsPerTrip < 0 || cfg.ActivitiesPerTrip < 0 || cfg.LinksPerTrip < 0 {
+		return seed.Config{}, errors.New("seed counts must not be negative")
+	}
+
+	return cfg, nil
+}
+
+// newForecaster returns the weather provider of WEATHER_URL, its forecasts
+// kept for WEATHER_CACHE_TTL, or nil when it is not set.
+func newForecaster() (weather.Provider, error) {
+	url := os.Getenv("WEATHER_URL")
+	if url == "" {
+		return nil, nil
+	}
+
+	ttl := 3 * time.Hour
+	if value := os.Getenv("WEATHER_CACHE_TTL"); value != "" {
+		var err error
+		if ttl, err = time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid WEATHER_CACHE_TTL: %w", err)
+		}
+	}
+
+	return weather.NewCache(weather.NewHTTP(url, os.Getenv("WEATHER_API_KEY"), 10*time.Second), ttl), nil
+}
+
+// newGeocoder returns the geocoding provider of GEOCODING_URL, or nil when it
+// is not set.
+func newGeocoder() geocoding.Provider {
+	url := os.Getenv("GEOCODING_URL")
+	if url == "" {
+		return nil
+	}
+
+	return geocoding.NewHTTP(url, os.Getenv("GEOCODING_API_KEY"), 5*time.Second)
+}
+
+// newPlaceFinder returns the places provider of PLACES_URL, or nil when it is
+// not set.
+func newPlaceFinder() places.Provider {
+	url := os.Getenv("PLACES_URL")
+	if url == "" {
+		return nil
+	}
+
+	return places.NewHTTP(url, os.Getenv("PLACES_API_KEY"), 10*time.Second)
+}
+
+// blobStore keeps the uploaded files, see api.NewAPI.
+type blobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	SignedURL(key string, ttl time.Duration) (string, time.Time, error)
+}
+
+// newBlobStore returns the file storage selected by STORAGE_DRIVER, the local
+// disk being the default. files serves the downloads of the disk storage
+// under /files, and is nil for S3, whose URLs point at the bucket.
+func newBlobStore() (blobs blobStore, files http.Handler, err error) {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "disk":
+		d, err := disk.NewDisk(
+			os.Getenv("STORAGE_DIR"),
+			os.Getenv("PUBLIC_URL")+"/files",
+			[]byte(os.Getenv("STORAGE_SIGNING_KEY")),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return d, d.Handler(), nil
+	case "s3":
+		cfg := s3.Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("S3_SESSION_TOKEN"),
+		}
+		if cfg.Region == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+			return nil, nil, errors.New("s3 storage requires S3_REGION, S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY")
+		}
+		if cfg.Endpoint == "" {
+			cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+		}
+
+		b, err := s3.NewS3(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return b, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid STORAGE_DRIVER: %q", driver)
+	}
+}
+
+// newMailDriver returns the email provider selected by MAILER_DRIVER, SMTP
+// being the default. The "log" driver sends nothing, for running without an
+// email server.
+func newMailDriver(logger *zap.Logger) (mailer.Driver, error) {
+	switch driver := os.Getenv("MAILER_DRIVER"); driver {
+	case "", "smtp":
+		cfg := mailpit.Config{
+			Host:     os.Getenv("MAILER_HOST"),
+			Port:     1025,
+			Username: os.Getenv("MAILER_USERNAME"),
+			Password: os.Getenv("MAILER_PASSWORD"),
+		}
+		if cfg.Host == "" {
+			cfg.Host = "mailpit"
+		}
+
+		var err error
+		if port := os.Getenv("MAILER_PORT"); port != "" {
+			if cfg.Port, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("invalid MAILER_PORT: %w", err)
+			}
+		}
+
+		tlsPolicy := os.Getenv("MAILER_TLS_POLICY")
+		if tlsPolicy == "" {
+			tlsPolicy = "none"
+		}
+		if cfg.TLSPolicy, err = mailpit.ParseTLSPolicy(tlsPolicy); err != nil {
+			return nil, fmt.Errorf("invalid MAILER_TLS_POLICY: %w", err)
+		}
+
+		return mailpit.NewMailpit(cfg), nil
+	case "ses":
+		cfg := ses.Config{
+			Region:          os.Getenv("AWS_REGION"),
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}
+		if cfg.Region == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+			return nil, errors.New("ses mailer requires AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+		}
+
+		return ses.NewSES(cfg), nil
+	case "resend":
+		apiKey := os.Getenv("RESEND_API_KEY")
+		if apiKey == "" {
+			return nil, errors.New("resend mailer requires RESEND_API_KEY")
+		}
+
+		return resend.NewResend(apiKey), nil
+	case "log":
+		return logmailer.NewLogMailer(logger, os.Getenv("MAILER_LOG_DIR"))
+	default:
+		return nil, fmt.Errorf("invalid MAILER_DRIVER: %q", driver)
+	}
+}
@@ -0,0 +1,90 @@
+package actionlink
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action is what a link lets its holder do.
+type Action string
+
+const (
+	// ActionConfirmTrip confirms a trip, it is sent to the trip owners.
+	ActionConfirmTrip Action = "confirm_trip"
+	// ActionConfirmParticipant confirms the attendance of an invited
+	// participant.
+	ActionConfirmParticipant Action = "confirm_participant"
+	// ActionDeclineParticipant declines the invitation of a participant.
+	ActionDeclineParticipant Action = "decline_participant"
+	// ActionVotePoll votes on a poll of the trip as the participant.
+	ActionVotePoll Action = "vote_poll"
+	// ActionManageWebhooks manages the webhooks of a trip, it is sent to the
+	// trip owners.
+	ActionManageWebhooks Action = "manage_webhooks"
+)
+
+var (
+	ErrInvalidToken = errors.New("actionlink: invalid token")
+	ErrExpiredToken = errors.New("actionlink: expired token")
+)
+
+// Tokens signs the tokens of the buttons in the emails, so the frontend can
+// act on a trip or participant for whoever holds the email. A token is the
+// unix time it expires at followed by an HMAC of the action, the id and that
+// time.
+type Tokens struct {
+	secret []byte
+}
+
+func NewTokens(secret []byte) (Tokens, error) {
+	if len(secret) == 0 {
+		return Tokens{}, fmt.Errorf("actionlink: signing secret must not be empty")
+	}
+
+	return Tokens{secret}, nil
+}
+
+// Token returns the token allowing action on the trip or participant id until
+// expiresAt.
+func (t Tokens) Token(action Action, id uuid.UUID, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	return expires + "." + t.sign(action, id, expires)
+}
+
+// Verify checks that token was returned by Token for action on id and has
+// not expired.
+func (t Tokens) Verify(token string, action Action, id uuid.UUID) error {
+	expires, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidToken
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(t.sign(action, id, expires))) {
+		return ErrInvalidToken
+	}
+
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if time.Now().After(time.Unix(unix, 0)) {
+		return ErrExpiredToken
+	}
+
+	return nil
+}
+
+func (t Tokens) sign(action Action, id uuid.UUID, expires string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(string(action) + "\n" + id.String() + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,13 +1,26 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"travel-api/internal/actionlink"
 	"travel-api/internal/api/spec"
+	"travel-api/internal/cache"
+	"travel-api/internal/domain"
+	"travel-api/internal/geocoding"
+	"travel-api/internal/linkpreview"
+	"travel-api/internal/live"
 	"travel-api/internal/pgstore"
+	"travel-api/internal/places"
+	"travel-api/internal/weather"
 
 	openapi_types "github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-playground/validator/v10"
@@ -22,22 +35,188 @@ import (
 
 type store interface {
 	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
-	ConfirmParticipant(context.Context, uuid.UUID) error
+	ConfirmParticipantTx(context.Context, *pgxpool.Pool, pgstore.ConfirmParticipantParams) error
+	UnconfirmParticipantTx(context.Context, *pgxpool.Pool, uuid.UUID, string) error
+	GetParticipantStatusChanges(context.Context, uuid.UUID) ([]pgstore.ParticipantStatusChange, error)
+	DeclineParticipantTx(context.Context, *pgxpool.Pool, uuid.UUID, pgstore.DeclineParticipantParams) error
+	UpdateParticipantProfile(context.Context, pgstore.UpdateParticipantProfileParams) error
+	ReinviteParticipantTx(context.Context, *pgxpool.Pool, pgstore.MarkParticipantReinvitedParams, pgstore.InvitationEmail) (int64, error)
+	GetNotificationOptOuts(context.Context, uuid.UUID) ([]pgstore.NotificationKind, error)
+	UpdateNotificationPreferencesTx(context.Context, *pgxpool.Pool, uuid.UUID, map[pgstore.NotificationKind]bool) error
 	CreateTripTx(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
+	CreateActivitiesTx(context.Context, *pgxpool.Pool, []pgstore.CreateActivityParams) ([]uuid.UUID, error)
+	ReorderActivitiesTx(context.Context, *pgxpool.Pool, uuid.UUID, []uuid.UUID) error
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
-	UpdateTrip(context.Context, pgstore.UpdateTripParams) error
-	GetTripActivities(context.Context, uuid.UUID) ([]pgstore.Activity, error)
+	GetTripWithRelations(context.Context, uuid.UUID) (pgstore.TripWithRelations, error)
+	UpdateTrip(context.Context, pgstore.UpdateTripParams) (int64, error)
+	UpdateTripPartialTx(context.Context, *pgxpool.Pool, pgstore.UpdateTripPartialParams) (int64, error)
+	GetTripActivities(context.Context, pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error)
 	CreateActivity(context.Context, pgstore.CreateActivityParams) (uuid.UUID, error)
-	InviteParticipantsToTrip(context.Context, []pgstore.InviteParticipantsToTripParams) (int64, error)
+	GetActivity(context.Context, pgstore.GetActivityParams) (pgstore.Activity, error)
+	UpdateActivity(context.Context, pgstore.UpdateActivityParams) error
+	GetActivityTripID(context.Context, uuid.UUID) (uuid.UUID, error)
+	UpsertActivityRSVP(context.Context, pgstore.UpsertActivityRSVPParams) error
+	GetTripActivityAttendeeCounts(context.Context, uuid.UUID) ([]pgstore.GetTripActivityAttendeeCountsRow, error)
+	VoteActivity(context.Context, pgstore.VoteActivityParams) error
+	UnvoteActivity(context.Context, pgstore.UnvoteActivityParams) (int64, error)
+	GetTripActivityVoteCounts(context.Context, uuid.UUID) ([]pgstore.GetTripActivityVoteCountsRow, error)
+	DeleteActivity(context.Context, pgstore.DeleteActivityParams) (int64, error)
+	RestoreActivity(context.Context, pgstore.RestoreActivityParams) (int64, error)
+	CreateActivityComment(context.Context, pgstore.CreateActivityCommentParams) (uuid.UUID, error)
+	GetActivityComments(context.Context, uuid.UUID) ([]pgstore.GetActivityCommentsRow, error)
+	DeleteActivityComment(context.Context, pgstore.DeleteActivityCommentParams) (int64, error)
+	RestoreActivityComment(context.Context, pgstore.RestoreActivityCommentParams) (int64, error)
+	CreateActivityAttachment(context.Context, pgstore.CreateActivityAttachmentParams) (uuid.UUID, error)
+	GetActivityAttachments(context.Context, uuid.UUID) ([]pgstore.ActivityAttachment, error)
+	CreateDocument(context.Context, pgstore.CreateDocumentParams) (uuid.UUID, error)
+	GetTripDocuments(context.Context, pgstore.GetTripDocumentsParams) ([]pgstore.Document, error)
+	GetDocument(context.Context, pgstore.GetDocumentParams) (pgstore.Document, error)
+	DeleteDocument(context.Context, pgstore.DeleteDocumentParams) (int64, error)
+	CreatePhoto(context.Context, pgstore.CreatePhotoParams) (uuid.UUID, error)
+	TripPhotosPage(context.Context, uuid.UUID, pgstore.Cursor, int32) (pgstore.Page[pgstore.GetTripPhotosPageRow], error)
+	GetPhoto(context.Context, pgstore.GetPhotoParams) (pgstore.Photo, error)
+	DeletePhoto(context.Context, pgstore.DeletePhotoParams) (pgstore.DeletePhotoRow, error)
+	CreatePhotoComment(context.Context, pgstore.CreatePhotoCommentParams) (uuid.UUID, error)
+	GetPhotoComments(context.Context, uuid.UUID) ([]pgstore.GetPhotoCommentsRow, error)
+	DeletePhotoComment(context.Context, pgstore.DeletePhotoCommentParams) (int64, error)
+	CreateJournalEntry(context.Context, pgstore.CreateJournalEntryParams) (uuid.UUID, error)
+	GetTripJournalEntries(context.Context, pgstore.GetTripJournalEntriesParams) ([]pgstore.GetTripJournalEntriesRow, error)
+	UpdateJournalEntry(context.Context, pgstore.UpdateJournalEntryParams) (int64, error)
+	DeleteJournalEntry(context.Context, pgstore.DeleteJournalEntryParams) (int64, error)
+	InviteParticipantsTx(context.Context, *pgxpool.Pool, uuid.UUID, []pgstore.InviteParticipantsToTripParams, []pgstore.CreateEmailVerificationParams) ([]string, error)
+	GetEmailVerification(context.Context, uuid.UUID) (pgstore.EmailVerification, error)
+	ClaimEmailVerificationAttempt(context.Context, pgstore.ClaimEmailVerificationAttemptParams) (pgstore.ClaimEmailVerificationAttemptRow, error)
+	VerifyParticipantEmailTx(context.Context, *pgxpool.Pool, uuid.UUID, pgstore.InvitationEmail) error
+	ResendEmailVerificationTx(context.Context, *pgxpool.Pool, pgstore.MarkParticipantReinvitedParams, pgstore.CreateEmailVerificationParams) (int64, error)
 	GetParticipants(context.Context, uuid.UUID) ([]pgstore.Participant, error)
-	ConfirmTrip(context.Context, pgstore.ConfirmTripParams) error
+	GetTripUndeliverableEmails(context.Context, uuid.UUID) ([]pgstore.GetTripUndeliverableEmailsRow, error)
+	GetTripParticipantStats(context.Context, uuid.UUID) (pgstore.GetTripParticipantStatsRow, error)
+	AddToTripWaitlist(context.Context, pgstore.AddToTripWaitlistParams) error
+	GetTripWaitlist(context.Context, uuid.UUID) ([]pgstore.TripWaitlist, error)
+	CountActiveTripParticipants(context.Context, uuid.UUID) (int64, error)
+	JoinTripTx(context.Context, *pgxpool.Pool, pgstore.InsertParticipantParams) (bool, error)
+	GetParticipantByEmail(context.Context, pgstore.GetParticipantByEmailParams) (pgstore.Participant, error)
+	UpdateTripStatus(context.Context, pgstore.UpdateTripStatusParams) error
+	UpdateTripPlace(context.Context, pgstore.UpdateTripPlaceParams) error
+	ConfirmTripTx(context.Context, *pgxpool.Pool, uuid.UUID, pgstore.TripStatus, pgstore.TripStatus) (bool, error)
 	CreateTripLink(context.Context, pgstore.CreateTripLinkParams) (uuid.UUID, error)
 	GetTripLinks(context.Context, uuid.UUID) ([]pgstore.Link, error)
+	UpdateTripLink(context.Context, pgstore.UpdateTripLinkParams) (int64, error)
+	UpdateTripLinkPartial(context.Context, pgstore.UpdateTripLinkPartialParams) (int64, error)
+	DeleteTripLink(context.Context, pgstore.DeleteTripLinkParams) (int64, error)
+	RestoreTripLink(context.Context, pgstore.RestoreTripLinkParams) (int64, error)
+	GetLinkURL(context.Context, uuid.UUID) (string, error)
+	RecordLinkClick(context.Context, uuid.UUID) error
+	GetTripLinkClickCounts(context.Context, uuid.UUID) ([]pgstore.GetTripLinkClickCountsRow, error)
+	SetLinkPreview(context.Context, pgstore.SetLinkPreviewParams) error
+	ReorderLinksTx(context.Context, *pgxpool.Pool, uuid.UUID, []uuid.UUID) error
+	CreateTripExpense(context.Context, pgstore.CreateTripExpenseParams) (uuid.UUID, error)
+	GetTripExpenses(context.Context, uuid.UUID) ([]pgstore.Expense, error)
+	UpdateTripExpense(context.Context, pgstore.UpdateTripExpenseParams) (int64, error)
+	DeleteTripExpense(context.Context, pgstore.DeleteTripExpenseParams) (int64, error)
+	GetTripExpenseTotals(context.Context, uuid.UUID) ([]pgstore.GetTripExpenseTotalsRow, error)
+	CreateSettlementPayment(context.Context, pgstore.CreateSettlementPaymentParams) (uuid.UUID, error)
+	GetTripSettlementPayments(context.Context, uuid.UUID) ([]pgstore.SettlementPayment, error)
+	DeleteSettlementPayment(context.Context, pgstore.DeleteSettlementPaymentParams) (int64, error)
+	CreatePollTx(context.Context, *pgxpool.Pool, pgstore.CreatePollParams, []pgstore.CreatePollOptionParams) (uuid.UUID, error)
+	GetPoll(context.Context, uuid.UUID) (pgstore.Poll, error)
+	GetTripPolls(context.Context, uuid.UUID) ([]pgstore.Poll, error)
+	GetTripPollOptions(context.Context, uuid.UUID) ([]pgstore.GetTripPollOptionsRow, error)
+	VotePoll(context.Context, pgstore.VotePollParams) error
+	ApplyPollTx(context.Context, *pgxpool.Pool, uuid.UUID, pgstore.UpdateTripPartialParams) (bool, error)
+	CreateMessage(context.Context, pgstore.CreateMessageParams) (uuid.UUID, error)
+	TripMessagesPage(context.Context, uuid.UUID, pgstore.Cursor, int32) (pgstore.Page[pgstore.GetTripMessagesPageRow], error)
+	MarkMessagesRead(context.Context, pgstore.MarkMessagesReadParams) error
+	CountUnreadMessages(context.Context, pgstore.CountUnreadMessagesParams) (int64, error)
+	GetParticipantNotifications(context.Context, pgstore.GetParticipantNotificationsParams) ([]pgstore.Notification, error)
+	MarkNotificationRead(context.Context, pgstore.MarkNotificationReadParams) (int64, error)
+	MarkAllNotificationsRead(context.Context, uuid.UUID) error
+	CreateWebhook(context.Context, pgstore.CreateWebhookParams) (pgstore.Webhook, error)
+	GetWebhook(context.Context, uuid.UUID) (pgstore.Webhook, error)
+	GetTripWebhooks(context.Context, uuid.UUID) ([]pgstore.Webhook, error)
+	DeleteWebhook(context.Context, uuid.UUID) (int64, error)
+	GetWebhookDeliveries(context.Context, pgstore.GetWebhookDeliveriesParams) ([]pgstore.GetWebhookDeliveriesRow, error)
+	CreatePackingItem(context.Context, pgstore.CreatePackingItemParams) (uuid.UUID, error)
+	GetTripPackingItems(context.Context, uuid.UUID) ([]pgstore.PackingItem, error)
+	UpdatePackingItem(context.Context, pgstore.UpdatePackingItemParams) (int64, error)
+	DeletePackingItem(context.Context, pgstore.DeletePackingItemParams) (int64, error)
+	CreateTask(context.Context, pgstore.CreateTaskParams) (uuid.UUID, error)
+	GetTripTasks(context.Context, uuid.UUID) ([]pgstore.Task, error)
+	UpdateTask(context.Context, pgstore.UpdateTaskParams) (int64, error)
+	DeleteTask(context.Context, pgstore.DeleteTaskParams) (int64, error)
+	CreateBooking(context.Context, pgstore.CreateBookingParams) (uuid.UUID, error)
+	GetTripBookings(context.Context, uuid.UUID) ([]pgstore.Booking, error)
+	UpdateBooking(context.Context, pgstore.UpdateBookingParams) (int64, error)
+	DeleteBooking(context.Context, pgstore.DeleteBookingParams) (int64, error)
+	CreateTransportSegmentTx(context.Context, *pgxpool.Pool, pgstore.CreateTransportSegmentParams, []uuid.UUID) (uuid.UUID, error)
+	GetTripTransportSegments(context.Context, uuid.UUID) ([]pgstore.TransportSegment, error)
+	GetTripTransportSegmentParticipants(context.Context, uuid.UUID) ([]pgstore.TransportSegmentParticipant, error)
+	UpdateTransportSegmentTx(context.Context, *pgxpool.Pool, pgstore.UpdateTransportSegmentParams, []uuid.UUID) (int64, error)
+	DeleteTransportSegment(context.Context, pgstore.DeleteTransportSegmentParams) (int64, error)
+	SearchTrip(context.Context, pgstore.SearchTripParams) ([]pgstore.SearchTripRow, error)
+	ListTrips(context.Context, pgstore.ListTripsParams) ([]pgstore.Trip, error)
+	CreateTag(context.Context, string) (uuid.UUID, error)
+	GetTag(context.Context, uuid.UUID) (pgstore.Tag, error)
+	GetTags(context.Context) ([]pgstore.Tag, error)
+	UpdateTag(context.Context, pgstore.UpdateTagParams) error
+	DeleteTag(context.Context, uuid.UUID) error
+	AddTagToTrip(context.Context, pgstore.AddTagToTripParams) error
+	RemoveTagFromTrip(context.Context, pgstore.RemoveTagFromTripParams) error
+	GetTripTags(context.Context, uuid.UUID) ([]pgstore.Tag, error)
+	CreateTripShare(context.Context, pgstore.CreateTripShareParams) error
+	GetTripIDByShareSlug(context.Context, string) (uuid.UUID, error)
+	RevokeTripShares(context.Context, uuid.UUID) error
+	SetTripJoinCode(context.Context, pgstore.SetTripJoinCodeParams) error
+	GetTripIDByJoinCode(context.Context, string) (uuid.UUID, error)
+	AddTripOwner(context.Context, pgstore.AddTripOwnerParams) error
+	GetTripOwners(context.Context, uuid.UUID) ([]pgstore.TripOwner, error)
+	IsTripOwner(context.Context, pgstore.IsTripOwnerParams) (bool, error)
+	RemoveTripOwner(context.Context, pgstore.RemoveTripOwnerParams) error
 }
 
-type mailer interface {
-	SendConfirmTripEmailToTripOwner(uuid.UUID) error
-	SendInvitationToParticipant(string, uuid.UUID) error
+// blobStore keeps uploaded files and hands out temporary download URLs.
+type blobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Delete(ctx context.Context, key string) error
+	SignedURL(key string, ttl time.Duration) (string, time.Time, error)
+}
+
+// linkPreviewer fetches the metadata shown on link cards.
+type linkPreviewer interface {
+	Fetch(ctx context.Context, url string) (linkpreview.Preview, error)
+}
+
+// forecaster forecasts the weather at the destination of the trips.
+type forecaster interface {
+	Forecast(ctx context.Context, place string, from, to time.Time) ([]weather.Day, error)
+}
+
+// geocoder resolves the destinations of the trips and the addresses of the
+// activities into places on the map.
+type geocoder interface {
+	Geocode(ctx context.Context, query string) (geocoding.Place, error)
+}
+
+// placeFinder finds the points of interest suggested at the destination of
+// the trips.
+type placeFinder interface {
+	Nearby(ctx context.Context, latitude, longitude float64, kind places.Kind) ([]places.Place, error)
+}
+
+// actionTokens verifies the tokens of the buttons in the emails.
+type actionTokens interface {
+	Verify(token string, action actionlink.Action, id uuid.UUID) error
+}
+
+// tripChanges streams the changes of the trips.
+type tripChanges interface {
+	Subscribe(tripID uuid.UUID) (<-chan live.Change, func())
+	Resume(tripID uuid.UUID, lastEventID string) (<-chan live.Change, func())
+}
+
+// emailPreviewer renders the emails of a trip as participants receive them.
+type emailPreviewer interface {
+	Preview(ctx context.Context, tripID uuid.UUID, name string, locale pgstore.Locale) (string, error)
 }
 
 type API struct {
@@ -45,22 +224,149 @@ type API struct {
 	logger    *zap.Logger
 	validator *validator.Validate
 	pool      *pgxpool.Pool
-	mailer    mailer
+	blobs     blobStore
+	previews  linkPreviewer
+	forecasts forecaster
+	locations geocoder
+	places    placeFinder
+	emails    emailPreviewer
+	actions   actionTokens
+	changes   tripChanges
+}
+
+// NewAPI returns the API writing to the primary pool, the trips, participants,
+// activities and links it shows being read from the replicas when there are
+// any. Its queries outside transactions are canceled after queryTimeout,
+// retried on transient errors as told by retries and recorded in metrics,
+// unless it is nil. When cached is not nil, the trips, activities and
+// participants are read through it. Without forecasts, the weather of the
+// trips is unavailable, without locations they are not put on the map and
+// without places nothing is suggested at their destination.
+func NewAPI(pool *pgxpool.Pool, replicas []*pgxpool.Pool, queryTimeout time.Duration, metrics *pgstore.QueryMetrics, retries pgstore.Retries, cached *cache.Cache, logger *zap.Logger, blobs blobStore, previews linkPreviewer, forecasts forecaster, locations geocoder, places placeFinder, emails emailPreviewer, actions actionTokens, changes tripChanges) API {
+	validator := validator.New(validator.WithRequiredStructEnabled())
+	registerValidations(validator)
+
+	readers := make([]pgstore.DBTX, 0, len(replicas))
+	for _, replica := range replicas {
+		readers = append(readers, replica)
+	}
+
+	primary := pgstore.NewStore(queryTimeout, metrics, retries, pool, readers...)
+
+	var s store = primary
+	if cached != nil {
+		s = cached.Store(primary)
+	}
+
+	return API{s, logger, validator, pool, blobs, previews, forecasts, locations, places, emails, actions, changes}
 }
 
-func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer) API {
+// NewAPIWithStore returns the API over a store other than Postgres, such as
+// the in-memory or the SQLite one.
+func NewAPIWithStore(s store, logger *zap.Logger, blobs blobStore, previews linkPreviewer, forecasts forecaster, locations geocoder, places placeFinder, emails emailPreviewer, actions actionTokens, changes tripChanges) API {
 	validator := validator.New(validator.WithRequiredStructEnabled())
-	return API{pgstore.New(pool), logger, validator, pool, mailer}
+	registerValidations(validator)
+
+	return API{s, logger, validator, nil, blobs, previews, forecasts, locations, places, emails, actions, changes}
+}
+
+// Get a participant details.
+// (GET /participants/{participantId})
+func (api *API) GetParticipantsParticipantID(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.GetParticipantsParticipantIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetParticipantsParticipantIDJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	return spec.GetParticipantsParticipantIDJSON200Response(spec.GetParticipantResponse{
+		Participant: participantResponse(participant),
+		Trip:        tripResponse(trip),
+	})
+}
+
+// Update a participant profile.
+// (PATCH /participants/{participantId})
+func (api *API) PatchParticipantsParticipantID(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateParticipantRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if body.Name != nil {
+		name := strings.TrimSpace(*body.Name)
+		body.Name = &name
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	if err := api.store.UpdateParticipantProfile(r.Context(), pgstore.UpdateParticipantProfileParams{
+		Name:      optionalText(body.Name),
+		Phone:     optionalText(body.Phone),
+		AvatarUrl: optionalText(body.AvatarURL),
+		Locale:    optionalLocale(body.Locale),
+		ID:        id,
+	}); err != nil {
+		api.logger.Error("failed to update participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	return spec.PatchParticipantsParticipantIDJSON204Response(nil)
 }
 
 // Confirms a participant on a trip.
 
-func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDConfirmParams) *spec.Response {
 	id, err := uuid.Parse(string(participantID))
 	if err != nil {
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionConfirmParticipant, id, false); !ok {
+		return spec.PatchParticipantsParticipantIDConfirmJSON403Response(spec.Error{Message: msg})
+	}
+
+	var body spec.ConfirmParticipantRequest
+
+	// The body is optional, participants without guests can omit it.
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
 	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -74,8 +380,35 @@ func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "Participante já confirmado."})
 	}
 
-	if err := api.store.ConfirmParticipant(r.Context(), id); err != nil {
+	pending, err := api.hasPendingEmailVerification(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get email verification", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+	if pending {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "confirme seu e-mail antes de confirmar presença"})
+	}
+
+	var guests int32
+	if body.Guests != nil {
+		trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+		if err != nil {
+			api.logger.Error("failed to get trip", zap.Error(err), zap.String("participant_id", participantID))
+			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+		}
+
+		if *body.Guests > int(trip.MaxGuests) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+				Message: fmt.Sprintf("esta viagem permite no máximo %d convidado(s) por participante", trip.MaxGuests),
+			})
+		}
+		guests = int32(*body.Guests)
+	}
 
+	if err := api.store.ConfirmParticipantTx(r.Context(), api.pool, pgstore.ConfirmParticipantParams{
+		Guests: guests,
+		ID:     id,
+	}); err != nil {
 		api.logger.Error("failed to confirm participant", zap.Error(err), zap.String("participant_id", participantID))
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
 	}
@@ -83,6 +416,101 @@ func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *
 	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
 }
 
+// Declines a trip invitation.
+// (PATCH /participants/{participantId}/decline)
+func (api *API) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDDeclineParams) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionDeclineParticipant, id, false); !ok {
+		return spec.PatchParticipantsParticipantIDDeclineJSON403Response(spec.Error{Message: msg})
+	}
+
+	var body spec.DeclineInvitationRequest
+
+	// The body is optional, as the reason is.
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	if participant.DeclinedAt.Valid {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "Participante já recusou o convite."})
+	}
+
+	// The declined seat goes to the first person on the waitlist, if any.
+	if err := api.store.DeclineParticipantTx(r.Context(), api.pool, participant.TripID, pgstore.DeclineParticipantParams{
+		DeclineReason: optionalText(body.Reason),
+		ID:            id,
+	}); err != nil {
+		api.logger.Error("failed to decline participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	return spec.PatchParticipantsParticipantIDDeclineJSON204Response(nil)
+}
+
+// List trips.
+// (GET /trips)
+func (api *API) GetTrips(w http.ResponseWriter, r *http.Request, params spec.GetTripsParams) *spec.Response {
+	var filter pgstore.ListTripsParams
+
+	if params.Tag != nil {
+		filter.Tag = pgtype.Text{Valid: true, String: normalizeTagName(*params.Tag)}
+	}
+
+	if params.Q != nil {
+		if q := strings.TrimSpace(*params.Q); q != "" {
+			filter.Query = pgtype.Text{Valid: true, String: q}
+		}
+	}
+
+	if params.Status != nil {
+		status, err := domain.ParseTripStatus(*params.Status)
+		if err != nil {
+			return spec.GetTripsJSON400Response(spec.Error{Message: "status inválido"})
+		}
+		filter.Status = pgstore.NullTripStatus{Valid: true, TripStatus: pgstore.TripStatus(status)}
+	}
+
+	trips, err := api.store.ListTrips(r.Context(), filter)
+	if err != nil {
+		api.logger.Error("failed to list trips", zap.Error(err))
+		return spec.GetTripsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	tripsRes := make([]spec.GetTripDetailsResponseTripObj, len(trips))
+
+	for i, trip := range trips {
+		tripsRes[i] = tripResponse(trip)
+	}
+
+	return spec.GetTripsJSON200Response(spec.GetTripsResponse{
+		Trips: tripsRes,
+	})
+}
+
+const errRSVPDeadlineAfterStart = "o prazo de confirmação deve ser antes do início da viagem"
+
+const errTripChanged = "a viagem foi alterada por outra pessoa, recarregue e tente novamente"
+
+// defaultPreTripReminderDays matches the default of the trips table.
+const defaultPreTripReminderDays = 2
+
 // Create a new trip
 // (POST /trips)
 func (api *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
@@ -96,18 +524,21 @@ func (api *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response
 		return spec.PostTripsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
 	}
 
+	if body.RsvpDeadline != nil && body.RsvpDeadline.After(body.StartsAt) {
+		return spec.PostTripsJSON400Response(spec.Error{Message: errRSVPDeadlineAfterStart})
+	}
+
+	if body.Description != nil {
+		description := sanitizeMarkdown(*body.Description)
+		body.Description = &description
+	}
+
 	tripID, err := api.store.CreateTripTx(r.Context(), api.pool, body)
 	if err != nil {
 		return spec.PostTripsJSON400Response(spec.Error{Message: "Falha ao criar a viagem, tente novamente."})
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			api.logger.Error("failed to send confirmation email on PostTrips: %w",
-				zap.Error(err),
-				zap.String("trip_id", tripID.String()))
-		}
-	}()
+	api.locateTrip(r.Context(), tripID, body.Destination)
 
 	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
 }
@@ -128,20 +559,16 @@ func (api *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID st
 		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(int(trip.Version))))
+
 	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
-		Trip: spec.GetTripDetailsResponseTripObj{
-			ID:          trip.ID.String(),
-			Destination: trip.Destination,
-			StartsAt:    trip.StartsAt.Time,
-			EndsAt:      trip.EndsAt.Time,
-			IsConfirmed: trip.IsConfirmed,
-		},
+		Trip: tripResponse(trip),
 	})
 }
 
 // Update a trip.
 // (PUT /trips/{tripId})
-func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
 		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "uuid inválido"})
@@ -155,6 +582,23 @@ func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID st
 		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PutTripsTripIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "If-Match inválido"})
+	}
+	if version != trip.Version {
+		return spec.PutTripsTripIDJSON409Response(tripChangedResponse())
+	}
+
 	var body spec.UpdateTripRequest
 
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -165,231 +609,1376 @@ func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID st
 		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
 	}
 
-	if err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if outside := activitiesOutsideRange(activities, body.StartsAt, body.EndsAt); len(outside) > 0 {
+		return spec.PutTripsTripIDJSON409Response(spec.TripRangeConflictResponse{
+			Message:    "o novo período deixa atividades fora da viagem",
+			Activities: outside,
+		})
+	}
+
+	description := trip.Description
+	if body.Description != nil {
+		description = sanitizeMarkdown(*body.Description)
+	}
+
+	updated, err := api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
 		Destination: body.Destination,
 		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
 		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
 		ID:          id,
-		IsConfirmed: trip.IsConfirmed,
-	}); err != nil {
+		Description: description,
+		Version:     version,
+	})
+	if err != nil {
 		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
+	if updated == 0 {
+		return spec.PutTripsTripIDJSON409Response(tripChangedResponse())
+	}
+
+	if body.Destination != trip.Destination {
+		api.locateTrip(r.Context(), id, body.Destination)
+	}
 
 	return spec.PutTripsTripIDJSON204Response(nil)
 }
 
-// Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// Partially update a trip.
+// (PATCH /trips/{tripId})
+func (api *API) PatchTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "uuid inválido"})
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
-	activities, err := api.store.GetTripActivities(r.Context(), id)
+	trip, err := api.store.GetTrip(r.Context(), id)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
-	}
-
-	activityMap := make(map[time.Time][]spec.GetTripActivitiesResponseInnerArray)
-
-	for _, activity := range activities {
-		occursAt := activity.OccursAt.Time
-		date := time.Date(
-			occursAt.Year(),
-			occursAt.Month(),
-			occursAt.Day(),
-			0, 0, 0, 0,
-			occursAt.Location(),
-		)
-
-		innerActivity := spec.GetTripActivitiesResponseInnerArray{
-			ID:       activity.ID.String(),
-			OccursAt: occursAt,
-			Title:    activity.Title,
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
 		}
-
-		activityMap[date] = append(activityMap[date], innerActivity)
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
-	var outerActivities []spec.GetTripActivitiesResponseOuterArray
-	for date, innerActivities := range activityMap {
-		outerActivities = append(outerActivities, spec.GetTripActivitiesResponseOuterArray{
-			Activities: innerActivities,
-			Date:       date,
-		})
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PatchTripsTripIDJSON403Response(spec.Error{Message: errNotTripOwner})
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
-		Activities: outerActivities,
-	})
-}
+	version, err := ifMatchVersion(params.IfMatch)
+	if err != nil {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "If-Match inválido"})
+	}
+	if version != trip.Version {
+		return spec.PatchTripsTripIDJSON409Response(tripChangedResponse())
+	}
 
-// Create a trip activity.
-// (POST /trips/{tripId}/activities)
-func (api *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+	data, err := io.ReadAll(r.Body)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "uuid inválido"})
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "JSON inválido"})
 	}
 
-	var body spec.PostTripsTripIDActivitiesJSONRequestBody
+	var body spec.PatchTripRequest
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "JSON inválido"})
+	if err := json.Unmarshal(data, &body); err != nil {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	// Decoding leaves the fields set to null as nil, like the ones left out,
+	// but null removes the field.
+	null, err := nullFields(data)
+	if err != nil {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "JSON inválido"})
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
 	}
 
-	activityID, err := api.store.CreateActivity(r.Context(), pgstore.CreateActivityParams{
-		TripID:   id,
-		Title:    body.Title,
-		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
-	})
-	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	if null["destination"] || null["starts_at"] || null["ends_at"] {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "o destino e as datas da viagem não podem ser removidos"})
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityID.String()})
-}
+	var update pgstore.UpdateTripPartialParams
+	update.ID = id
+	update.Version = version
 
-// Confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (api *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "uuid inválido"})
+	startsAt, endsAt := trip.StartsAt.Time, trip.EndsAt.Time
+	if body.StartsAt != nil {
+		startsAt = *body.StartsAt
+		update.StartsAt = pgtype.Timestamp{Valid: true, Time: startsAt}
+	}
+	if body.EndsAt != nil {
+		endsAt = *body.EndsAt
+		update.EndsAt = pgtype.Timestamp{Valid: true, Time: endsAt}
 	}
 
-	participants, err := api.store.GetParticipants(r.Context(), id)
-	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	if !endsAt.After(startsAt) {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "a data final deve ser depois da data inicial"})
 	}
 
-	if err := api.store.ConfirmTrip(r.Context(), pgstore.ConfirmTripParams{
-		IsConfirmed: true,
-	}); err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	rsvpDeadline := trip.RsvpDeadline
+	if null["rsvp_deadline"] {
+		rsvpDeadline = pgtype.Timestamp{}
+		update.ClearRsvpDeadline = true
+	} else if body.RsvpDeadline != nil {
+		rsvpDeadline = pgtype.Timestamp{Valid: true, Time: *body.RsvpDeadline}
+		// Setting the deadline resets the RSVP reminders, so only a new one
+		// is set.
+		if !trip.RsvpDeadline.Valid || !trip.RsvpDeadline.Time.Equal(rsvpDeadline.Time) {
+			update.RsvpDeadline = rsvpDeadline
+		}
+	}
+	if rsvpDeadline.Valid && rsvpDeadline.Time.After(startsAt) {
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: errRSVPDeadlineAfterStart})
 	}
 
-	for _, p := range participants {
-		go func() {
-			if err := api.mailer.SendInvitationToParticipant(string(p.Email), id); err != nil {
-				api.logger.Error("Failed to send invitation to Participant on PostTripsTripIDInvites: %w",
-					zap.Error(err),
-					zap.String("participand_id", p.TripID.String()),
-				)
-			}
-		}()
+	// The fields that cannot be null go back to their default instead.
+	if null["max_guests"] {
+		update.MaxGuests = pgtype.Int4{Valid: true, Int32: 0}
+	} else if body.MaxGuests != nil {
+		update.MaxGuests = pgtype.Int4{Valid: true, Int32: int32(*body.MaxGuests)}
 	}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(nil)
-}
+	if null["max_participants"] {
+		update.ClearMaxParticipants = true
+	} else if body.MaxParticipants != nil {
+		update.MaxParticipants = pgtype.Int4{Valid: true, Int32: int32(*body.MaxParticipants)}
+	}
 
-// Invite someone to the trip.
-// (POST /trips/{tripId}/invites)
-func (api *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "uuid inválido"})
+	if null["pre_trip_reminder_days"] {
+		update.PreTripReminderDays = pgtype.Int4{Valid: true, Int32: defaultPreTripReminderDays}
+	} else if body.PreTripReminderDays != nil {
+		update.PreTripReminderDays = pgtype.Int4{Valid: true, Int32: int32(*body.PreTripReminderDays)}
 	}
 
-	var body spec.InviteParticipantRequest
+	if body.StartsAt != nil || body.EndsAt != nil {
+		activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+		if err != nil {
+			return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "JSON inválido"})
+		if outside := activitiesOutsideRange(activities, startsAt, endsAt); len(outside) > 0 {
+			return spec.PatchTripsTripIDJSON409Response(spec.TripRangeConflictResponse{
+				Message:    "o novo período deixa atividades fora da viagem",
+				Activities: outside,
+			})
+		}
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	if body.Destination != nil {
+		update.Destination = pgtype.Text{Valid: true, String: *body.Destination}
 	}
-
-	participant := make([]pgstore.InviteParticipantsToTripParams, 1)
-
-	participant[0] = pgstore.InviteParticipantsToTripParams{
-		TripID: id,
-		Email:  string(body.Email),
+	if null["description"] {
+		update.Description = pgtype.Text{Valid: true, String: ""}
+	} else if body.Description != nil {
+		update.Description = pgtype.Text{Valid: true, String: sanitizeMarkdown(*body.Description)}
+	}
+	if null["timezone"] {
+		update.Timezone = pgtype.Text{Valid: true, String: "UTC"}
+	} else if body.Timezone != nil {
+		update.Timezone = pgtype.Text{Valid: true, String: *body.Timezone}
 	}
 
-	participantID, err := api.store.InviteParticipantsToTrip(r.Context(), participant)
+	updated, err := api.store.UpdateTripPartialTx(r.Context(), api.pool, update)
 	if err != nil {
-		api.logger.Error("Failed to send invitation to Participant on PostTripsTripIDInvites: %w",
-			zap.Error(err),
-			zap.String("trip_id", id.String()))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		api.logger.Error("failed to partially update trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if updated == 0 {
+		return spec.PatchTripsTripIDJSON409Response(tripChangedResponse())
 	}
 
-	go func() {
-		if err := api.mailer.SendInvitationToParticipant(string(body.Email), id); err != nil {
-			api.logger.Error("Failed to send invitation to Participant on PostTripsTripIDInvites: %w",
-				zap.Error(err),
-				zap.String("participand_id", fmt.Sprintf("%d", participantID)))
-		}
-	}()
+	if body.Destination != nil && *body.Destination != trip.Destination {
+		api.locateTrip(r.Context(), id, *body.Destination)
+	}
 
-	return spec.PostTripsTripIDInvitesJSON201Response(nil)
+	return spec.PatchTripsTripIDJSON204Response(nil)
 }
 
-// Get a trip links.
-// (GET /trips/{tripId}/links)
-func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// Move a trip to another lifecycle status.
+// (PATCH /trips/{tripId}/status)
+func (api *API) PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDStatusParams) *spec.Response {
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "uuid inválido"})
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
-	links, err := api.store.GetTripLinks(r.Context(), id)
+	trip, err := api.store.GetTrip(r.Context(), id)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
-	linksRes := make([]spec.GetLinksResponseArray, len(links))
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PatchTripsTripIDStatusJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
 
-	for i, link := range links {
-		linksRes[i] = spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		}
+	var body spec.UpdateTripStatusRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "JSON inválido"})
 	}
 
-	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
-		Links: linksRes,
-	})
-}
+	status, err := domain.Transition(domain.TripStatus(trip.Status), domain.TripStatus(body.Status.ToValue()))
+	if err != nil {
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "mudança de status inválida"})
+	}
 
-// Create a trip link.
+	if err := api.store.UpdateTripStatus(r.Context(), pgstore.UpdateTripStatusParams{
+		Status: pgstore.TripStatus(status),
+		ID:     id,
+	}); err != nil {
+		api.logger.Error("failed to update trip status", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDStatusJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchTripsTripIDStatusJSON204Response(nil)
+}
+
+// Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	// goapi-gen binds the time parameters left out to the zero time rather
+	// than to nil.
+	from, to := params.From, params.To
+	if from != nil && from.IsZero() {
+		from = nil
+	}
+	if to != nil && to.IsZero() {
+		to = nil
+	}
+
+	if from != nil && to != nil && to.Before(*from) {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "o parâmetro to deve ser posterior ao from"})
+	}
+
+	filter := pgstore.GetTripActivitiesParams{
+		TripID:   id,
+		FromTime: optionalTimestamp(from),
+		ToTime:   optionalTimestamp(to),
+	}
+
+	if params.Category != nil {
+		category, err := domain.ParseActivityCategory(*params.Category)
+		if err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "categoria inválida"})
+		}
+		filter.Category = pgstore.NullActivityCategory{Valid: true, ActivityCategory: pgstore.ActivityCategory(category)}
+	}
+
+	var loc *time.Location
+	if params.Tz != nil {
+		loc, err = time.LoadLocation(*params.Tz)
+		if err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "fuso horário inválido"})
+		}
+	} else {
+		trip, err := api.store.GetTrip(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+			}
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+		}
+		loc = tripLocation(trip)
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), filter)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	}
+
+	counts, err := api.activityCounts(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	}
+
+	// The bookings are lodging, left out when filtering by another category.
+	var bookings []bookingEvent
+	if !filter.Category.Valid || filter.Category.ActivityCategory == pgstore.ActivityCategoryLodging {
+		tripBookings, err := api.store.GetTripBookings(r.Context(), id)
+		if err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+		}
+		bookings = bookingEvents(tripBookings, from, to)
+	}
+
+	// And the transport segments are transport.
+	var segments []transportSegment
+	if !filter.Category.Valid || filter.Category.ActivityCategory == pgstore.ActivityCategoryTransport {
+		segments, err = api.transportSegments(r.Context(), id, from, to)
+		if err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+		}
+	}
+
+	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
+		Activities: activitiesResponse(activities, bookings, segments, counts, loc),
+	})
+}
+
+// Create a trip activity.
+// (POST /trips/{tripId}/activities)
+func (api *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDActivitiesParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.PostTripsTripIDActivitiesJSONRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if !occursDuringTrip(trip, body.OccursAt, body.EndsAt) {
+		return spec.PostTripsTripIDActivitiesJSON422Response(spec.Error{Message: outsideTripMessage(trip)})
+	}
+
+	activity := pgstore.CreateActivityParams{
+		TripID:    id,
+		Title:     body.Title,
+		OccursAt:  utcTimestamp(body.OccursAt),
+		EndsAt:    optionalTimestamp(body.EndsAt),
+		Address:   optionalText(body.Address),
+		Latitude:  optionalFloat8(body.Latitude),
+		Longitude: optionalFloat8(body.Longitude),
+		Category:  activityCategory(body.Category),
+	}
+	activity.Latitude, activity.Longitude, activity.PlaceName = api.locateActivity(r.Context(), activity.Address, activity.Latitude, activity.Longitude)
+
+	occurrences := []pgstore.CreateActivityParams{activity}
+
+	if body.Recurrence != nil {
+		until := trip.EndsAt.Time
+		if body.Recurrence.Until != nil && body.Recurrence.Until.Before(until) {
+			until = *body.Recurrence.Until
+		}
+
+		occurrences = expandRecurrence(activity, body.Recurrence.EveryDays, until)
+		if len(occurrences) > maxActivityOccurrences {
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "a recorrência gera atividades demais"})
+		}
+	}
+
+	overlaps, err := api.activityOverlaps(r.Context(), id, params.Force)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var conflicts []string
+	for _, occurrence := range occurrences {
+		var endsAt *time.Time
+		if occurrence.EndsAt.Valid {
+			endsAt = &occurrence.EndsAt.Time
+		}
+		conflicts = append(conflicts, overlaps(uuid.Nil, occurrence.OccursAt.Time, endsAt)...)
+	}
+
+	if len(conflicts) > 0 {
+		return spec.PostTripsTripIDActivitiesJSON409Response(spec.ActivityConflictResponse{
+			Message:     activityConflictMessage,
+			ActivityIds: conflicts,
+		})
+	}
+
+	if len(occurrences) == 1 {
+		activityID, err := api.store.CreateActivity(r.Context(), activity)
+		if err != nil {
+			if e, ok := constraintError(err); ok {
+				return spec.PostTripsTripIDActivitiesJSON422Response(e)
+			}
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+
+		return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityID.String()})
+	}
+
+	activityIDs, err := api.store.CreateActivitiesTx(r.Context(), api.pool, occurrences)
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDActivitiesJSON422Response(e)
+		}
+		api.logger.Error("failed to create recurring activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	ids := make([]string, len(activityIDs))
+	for i, activityID := range activityIDs {
+		ids[i] = activityID.String()
+	}
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
+		ActivityID:  ids[0],
+		ActivityIds: ids,
+	})
+}
+
+// Get a trip activity.
+// (GET /trips/{tripId}/activities/{activityId})
+func (api *API) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	aID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: aID, TripID: id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	counts, err := api.activityCounts(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	attachments, err := api.store.GetActivityAttachments(r.Context(), aID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	attachmentsRes, err := api.attachmentsResponse(attachments)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDActivitiesActivityIDJSON200Response(spec.GetActivityResponse{
+		Activity:    activityResponse(activity, counts[activity.ID]),
+		Attachments: attachmentsRes,
+	})
+}
+
+// Update a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api *API) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.PutTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	aID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if _, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: aID, TripID: id}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var body spec.UpdateActivityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if !occursDuringTrip(trip, body.OccursAt, body.EndsAt) {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON422Response(spec.Error{Message: outsideTripMessage(trip)})
+	}
+
+	overlaps, err := api.activityOverlaps(r.Context(), id, params.Force)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if conflicts := overlaps(aID, body.OccursAt, body.EndsAt); len(conflicts) > 0 {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON409Response(spec.ActivityConflictResponse{
+			Message:     activityConflictMessage,
+			ActivityIds: conflicts,
+		})
+	}
+
+	address := optionalText(body.Address)
+	latitude, longitude, placeName := api.locateActivity(r.Context(), address, optionalFloat8(body.Latitude), optionalFloat8(body.Longitude))
+
+	if err := api.store.UpdateActivity(r.Context(), pgstore.UpdateActivityParams{
+		Title:     body.Title,
+		OccursAt:  utcTimestamp(body.OccursAt),
+		EndsAt:    optionalTimestamp(body.EndsAt),
+		Address:   address,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Category:  activityCategory(body.Category),
+		PlaceName: placeName,
+		ID:        aID,
+	}); err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDActivitiesActivityIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Partially update a trip activity.
+// (PATCH /trips/{tripId}/activities/{activityId})
+func (api *API) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.PatchTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	aID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{ID: aID, TripID: id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var body spec.PatchActivityRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	title, occursAt := activity.Title, activity.OccursAt.Time
+	if body.Title != nil {
+		if *body.Title == "" {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "o título não pode ser vazio"})
+		}
+		title = *body.Title
+	}
+	if body.OccursAt != nil {
+		occursAt = *body.OccursAt
+	}
+
+	var endsAt *time.Time
+	if activity.EndsAt.Valid {
+		endsAt = &activity.EndsAt.Time
+	}
+	if body.EndsAt != nil {
+		endsAt = body.EndsAt
+	}
+
+	if endsAt != nil && !endsAt.After(occursAt) {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "a atividade deve terminar depois de começar"})
+	}
+
+	if !occursDuringTrip(trip, occursAt, endsAt) {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON422Response(spec.Error{Message: outsideTripMessage(trip)})
+	}
+
+	overlaps, err := api.activityOverlaps(r.Context(), id, params.Force)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if conflicts := overlaps(aID, occursAt, endsAt); len(conflicts) > 0 {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON409Response(spec.ActivityConflictResponse{
+			Message:     activityConflictMessage,
+			ActivityIds: conflicts,
+		})
+	}
+
+	address, latitude, longitude, placeName := activity.Address, activity.Latitude, activity.Longitude, activity.PlaceName
+	if body.Latitude != nil {
+		latitude, longitude = optionalFloat8(body.Latitude), optionalFloat8(body.Longitude)
+	}
+	if body.Address != nil {
+		address = optionalText(body.Address)
+
+		// Without coordinates of its own, the new address is put where it is
+		// geocoded to, the coordinates of the previous one being kept only
+		// when it is not found.
+		if body.Latitude != nil {
+			latitude, longitude, placeName = api.locateActivity(r.Context(), address, latitude, longitude)
+		} else if lat, lng, name := api.locateActivity(r.Context(), address, pgtype.Float8{}, pgtype.Float8{}); name.Valid {
+			latitude, longitude, placeName = lat, lng, name
+		} else {
+			placeName = pgtype.Text{}
+		}
+	}
+
+	category := activity.Category
+	if body.Category != nil {
+		category = activityCategory(body.Category)
+	}
+
+	if err := api.store.UpdateActivity(r.Context(), pgstore.UpdateActivityParams{
+		Title:     title,
+		OccursAt:  utcTimestamp(occursAt),
+		EndsAt:    optionalTimestamp(endsAt),
+		Address:   address,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Category:  category,
+		PlaceName: placeName,
+		ID:        aID,
+	}); err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api *API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.DeleteTripsTripIDActivitiesActivityIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	aID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	deleted, err := api.store.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{ID: aID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Restore a deleted trip activity.
+// (POST /trips/{tripId}/activities/{activityId}/restore)
+func (api *API) PostTripsTripIDActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params spec.PostTripsTripIDActivitiesActivityIDRestoreParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	aID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	restored, err := api.store.RestoreActivity(r.Context(), pgstore.RestoreActivityParams{ID: aID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to restore activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if restored == 0 {
+		return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON404Response(spec.Error{Message: "atividade excluída não encontrada"})
+	}
+
+	return spec.PostTripsTripIDActivitiesActivityIDRestoreJSON204Response(nil)
+}
+
+// Reorder a trip activities.
+// (PATCH /trips/{tripId}/activities/reorder)
+func (api *API) PatchTripsTripIDActivitiesReorder(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesReorderJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.ReorderActivitiesRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDActivitiesReorderJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDActivitiesReorderJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	activityIDs := make([]uuid.UUID, len(body.ActivityIds))
+	for i, activityID := range body.ActivityIds {
+		activityIDs[i] = uuid.MustParse(activityID)
+	}
+
+	if err := api.store.ReorderActivitiesTx(r.Context(), api.pool, id, activityIDs); err != nil {
+		if errors.Is(err, pgstore.ErrActivityNotInTrip) {
+			return spec.PatchTripsTripIDActivitiesReorderJSON400Response(spec.Error{Message: "atividade não encontrada nesta viagem"})
+		}
+		api.logger.Error("failed to reorder activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDActivitiesReorderJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchTripsTripIDActivitiesReorderJSON204Response(nil)
+}
+
+// Confirm a trip and send e-mail invitations.
+// (POST /trips/{tripId}/confirm)
+func (api *API) PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDConfirmParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var isOwner bool
+	if params.XOwnerEmail != nil {
+		isOwner, err = api.isTripOwner(r.Context(), id, *params.XOwnerEmail)
+		if err != nil {
+			api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+		}
+	}
+
+	if msg, ok := api.checkActionToken(params.Token, actionlink.ActionConfirmTrip, id, isOwner); !ok {
+		return spec.PostTripsTripIDConfirmJSON403Response(spec.Error{Message: msg})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	}
+
+	status, err := domain.Transition(domain.TripStatus(trip.Status), domain.TripStatusConfirmed)
+	if err != nil {
+		return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "viagem já confirmada ou encerrada"})
+	}
+
+	// The trip may have been confirmed by another request since it was read.
+	confirmed, err := api.store.ConfirmTripTx(r.Context(), api.pool, id, trip.Status, pgstore.TripStatus(status))
+	if err != nil {
+		api.logger.Error("failed to confirm trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "algo deu errado, tente novamente"})
+	}
+	if !confirmed {
+		return spec.PostTripsTripIDConfirmJSON400Response(spec.Error{Message: "viagem já confirmada ou encerrada"})
+	}
+
+	return spec.PostTripsTripIDConfirmJSON204Response(nil)
+}
+
+// Invite people to the trip.
+// (POST /trips/{tripId}/invites)
+func (api *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.InviteParticipantsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	existing, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	invited := make(map[string]bool, len(existing))
+	for _, participant := range existing {
+		invited[participant.Email] = true
+	}
+
+	results := make([]spec.InviteParticipantsResponseArray, len(body.Emails))
+	participants := make([]pgstore.InviteParticipantsToTripParams, 0, len(body.Emails))
+	var verifications []pgstore.CreateEmailVerificationParams
+	// seen maps the e-mails being invited to their result.
+	seen := make(map[string]*spec.InviteParticipantsResponseArray, len(body.Emails))
+
+	for i, email := range body.Emails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		results[i].Email = email
+
+		var reason string
+		switch {
+		case api.validator.Var(email, "required,email") != nil:
+			reason = "e-mail inválido"
+		case invited[email]:
+			reason = "e-mail já convidado para esta viagem"
+			results[i].AlreadyInvited = true
+		case seen[email] != nil:
+			reason = "e-mail repetido na requisição"
+		}
+		if reason != "" {
+			results[i].Error = &reason
+			continue
+		}
+
+		seen[email] = &results[i]
+
+		participants = append(participants, pgstore.InviteParticipantsToTripParams{
+			TripID: id,
+			Email:  email,
+			Locale: locale(body.Locale),
+		})
+
+		if body.VerifyEmails != nil && *body.VerifyEmails {
+			verification, err := newEmailVerification(id, email)
+			if err != nil {
+				api.logger.Error("failed to generate verification code", zap.Error(err), zap.String("trip_id", tripID))
+				return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+			}
+			verifications = append(verifications, verification)
+		}
+	}
+
+	if len(participants) > 0 {
+		waitlisted, err := api.store.InviteParticipantsTx(r.Context(), api.pool, id, participants, verifications)
+		if err != nil {
+			if e, ok := constraintError(err); ok && isUniqueViolation(err) {
+				e.Message = "algum dos e-mails já foi convidado para esta viagem"
+				return spec.PostTripsTripIDInvitesJSON409Response(e)
+			}
+			api.logger.Error("Failed to send invitation to Participant on PostTripsTripIDInvites: %w",
+				zap.Error(err),
+				zap.String("trip_id", id.String()))
+			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+
+		for _, p := range participants {
+			seen[p.Email].Invited = true
+		}
+		for _, email := range waitlisted {
+			seen[email].Invited = false
+			seen[email].Waitlisted = true
+		}
+	}
+
+	return spec.PostTripsTripIDInvitesJSON201Response(spec.InviteParticipantsResponse{Results: results})
+}
+
+// resendInviteInterval is how long a participant must wait between two
+// invitation e-mails.
+const resendInviteInterval = time.Hour
+
+// Resend the invitation e-mail to a participant.
+// (POST /trips/{tripId}/participants/{participantId}/resend-invite)
+func (api *API) PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params spec.PostTripsTripIDParticipantsParticipantIDResendInviteParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response(spec.Error{Message: "participante não encontrado"})
+		}
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if participant.TripID != id {
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response(spec.Error{Message: "participante não encontrado"})
+	}
+
+	if participant.IsConfirmed {
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Participante já confirmado."})
+	}
+
+	tooSoon := func() *spec.Response {
+		retryAfter := time.Until(participant.InvitedAt.Time.Add(resendInviteInterval))
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", max(int(retryAfter.Seconds()), 1)))
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON429Response(spec.Error{Message: "o convite foi enviado recentemente, tente novamente mais tarde"})
+	}
+
+	cutoff := time.Now().UTC().Add(-resendInviteInterval)
+	if participant.InvitedAt.Time.After(cutoff) {
+		return tooSoon()
+	}
+
+	pending, err := api.hasPendingEmailVerification(r.Context(), pID)
+	if err != nil {
+		api.logger.Error("failed to get email verification", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The cutoff is checked again in the update so concurrent requests
+	// cannot both send the e-mail.
+	reinvited := pgstore.MarkParticipantReinvitedParams{
+		ID:        pID,
+		InvitedAt: pgtype.Timestamp{Valid: true, Time: cutoff},
+	}
+
+	var updated int64
+	if pending {
+		// The invitation is held back until the address is verified, a new
+		// code is sent instead.
+		var verification pgstore.CreateEmailVerificationParams
+		verification, err = newEmailVerification(id, participant.Email)
+		if err != nil {
+			api.logger.Error("failed to generate verification code", zap.Error(err), zap.String("participant_id", participantID))
+			return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+		updated, err = api.store.ResendEmailVerificationTx(r.Context(), api.pool, reinvited, verification)
+	} else {
+		updated, err = api.store.ReinviteParticipantTx(r.Context(), api.pool, reinvited, pgstore.InvitationEmail{TripID: id, Email: participant.Email})
+	}
+	if err != nil {
+		api.logger.Error("failed to mark participant as reinvited", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if updated == 0 {
+		return tooSoon()
+	}
+
+	return spec.PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response(nil)
+}
+
+// Get a trip links.
+// (GET /trips/{tripId}/links)
+func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	links, err := api.store.GetTripLinks(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	clicks, err := api.linkClicks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get link clicks", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var pinned, unpinned []pgstore.Link
+	for _, link := range links {
+		if link.Pinned {
+			pinned = append(pinned, link)
+		} else {
+			unpinned = append(unpinned, link)
+		}
+	}
+
+	// Pinned links leave their category, so only the position orders them.
+	sort.SliceStable(pinned, func(i, j int) bool {
+		return pinned[i].Position < pinned[j].Position
+	})
+
+	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
+		Pinned: linksResponse(pinned, clicks),
+		Links:  linkGroupsResponse(unpinned, clicks),
+	})
+}
+
+// Create a trip link.
 // (POST /trips/{tripId}/links)
 func (api *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	var body spec.CreateLinkRequest
 
 	id, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "uuid inválido"})
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	linkURL, err := normalizeLinkURL(body.URL)
+	if err != nil {
+		return spec.PostTripsTripIDLinksJSON422Response(spec.Error{Message: err.Error()})
+	}
+
+	linkID, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
+		TripID:   id,
+		Title:    body.Title,
+		Url:      linkURL,
+		Category: linkCategory(body.Category),
+		Pinned:   body.Pinned != nil && *body.Pinned,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			if isUniqueViolation(err) {
+				return spec.PostTripsTripIDLinksJSON409Response(e)
+			}
+			return spec.PostTripsTripIDLinksJSON422Response(e)
+		}
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	go api.fetchLinkPreview(linkID, linkURL)
+
+	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
+		LinkID: linkID.String(),
+	})
+}
+
+// Update a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api *API) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.PutTripsTripIDLinksLinkIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	lID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PutTripsTripIDLinksLinkIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.UpdateLinkRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	linkURL, err := normalizeLinkURL(body.URL)
+	if err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON422Response(spec.Error{Message: err.Error()})
+	}
+
+	updated, err := api.store.UpdateTripLink(r.Context(), pgstore.UpdateTripLinkParams{
+		Title:    body.Title,
+		Url:      linkURL,
+		Category: linkCategory(body.Category),
+		ID:       lID,
+		TripID:   id,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			if isUniqueViolation(err) {
+				return spec.PutTripsTripIDLinksLinkIDJSON409Response(e)
+			}
+			return spec.PutTripsTripIDLinksLinkIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDLinksLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
+	}
+
+	go api.fetchLinkPreview(lID, linkURL)
+
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// Partially update a trip link.
+// (PATCH /trips/{tripId}/links/{linkId})
+func (api *API) PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.PatchTripsTripIDLinksLinkIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	lID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PatchTripsTripIDLinksLinkIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.PatchLinkRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if body.URL != nil {
+		linkURL, err := normalizeLinkURL(*body.URL)
+		if err != nil {
+			return spec.PatchTripsTripIDLinksLinkIDJSON422Response(spec.Error{Message: err.Error()})
+		}
+		body.URL = &linkURL
+	}
+
+	update := pgstore.UpdateTripLinkPartialParams{
+		Title:  optionalText(body.Title),
+		Url:    optionalText(body.URL),
+		ID:     lID,
+		TripID: id,
+	}
+
+	if body.Category != nil {
+		update.Category = pgstore.NullLinkCategory{Valid: true, LinkCategory: linkCategory(body.Category)}
+	}
+
+	if body.Pinned != nil {
+		update.Pinned = pgtype.Bool{Valid: true, Bool: *body.Pinned}
+	}
+
+	updated, err := api.store.UpdateTripLinkPartial(r.Context(), update)
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			if isUniqueViolation(err) {
+				return spec.PatchTripsTripIDLinksLinkIDJSON409Response(e)
+			}
+			return spec.PatchTripsTripIDLinksLinkIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PatchTripsTripIDLinksLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
+	}
+
+	if body.URL != nil {
+		go api.fetchLinkPreview(lID, *body.URL)
+	}
+
+	return spec.PatchTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// Reorder a trip links.
+// (PATCH /trips/{tripId}/links/reorder)
+func (api *API) PatchTripsTripIDLinksReorder(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDLinksReorderParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PatchTripsTripIDLinksReorderJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.ReorderLinksRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	linkIDs := make([]uuid.UUID, len(body.LinkIds))
+	for i, linkID := range body.LinkIds {
+		linkIDs[i] = uuid.MustParse(linkID)
+	}
+
+	if err := api.store.ReorderLinksTx(r.Context(), api.pool, id, linkIDs); err != nil {
+		if errors.Is(err, pgstore.ErrLinkNotInTrip) {
+			return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "link não encontrado nesta viagem"})
+		}
+		api.logger.Error("failed to reorder links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDLinksReorderJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchTripsTripIDLinksReorderJSON204Response(nil)
+}
+
+// Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api *API) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.DeleteTripsTripIDLinksLinkIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "JSON inválido"})
+	lID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON403Response(spec.Error{Message: errNotTripOwner})
 	}
 
-	linkID, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
-		TripID: id,
-		Title:  body.Title,
-		Url:    body.URL,
-	})
+	deleted, err := api.store.DeleteTripLink(r.Context(), pgstore.DeleteTripLinkParams{ID: lID, TripID: id})
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		api.logger.Error("failed to delete link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
-	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
-		LinkID: linkID.String(),
-	})
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// Restore a deleted trip link.
+// (POST /trips/{tripId}/links/{linkId}/restore)
+func (api *API) PostTripsTripIDLinksLinkIDRestore(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params spec.PostTripsTripIDLinksLinkIDRestoreParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	lID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	restored, err := api.store.RestoreTripLink(r.Context(), pgstore.RestoreTripLinkParams{ID: lID, TripID: id})
+	if err != nil {
+		// A link with the same URL was added after this one was deleted.
+		if e, ok := constraintError(err); ok && isUniqueViolation(err) {
+			e.Message = "o link não pode ser restaurado, outro link com a mesma URL foi adicionado à viagem"
+			return spec.PostTripsTripIDLinksLinkIDRestoreJSON409Response(e)
+		}
+		api.logger.Error("failed to restore link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if restored == 0 {
+		return spec.PostTripsTripIDLinksLinkIDRestoreJSON404Response(spec.Error{Message: "link excluído não encontrado"})
+	}
+
+	return spec.PostTripsTripIDLinksLinkIDRestoreJSON204Response(nil)
 }
 
 // Get a trip participants.
@@ -405,13 +1994,24 @@ func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Reques
 		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
 	}
 
+	undeliverable, err := api.store.GetTripUndeliverableEmails(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get undeliverable emails", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// Owners see which invitations never arrived.
+	issues := make(map[string]spec.EmailIssue, len(undeliverable))
+	for _, u := range undeliverable {
+		issues[u.Email] = emailIssueResponse(u.Issue)
+	}
+
 	participantsRes := make([]spec.GetTripParticipantsResponseArray, len(participants))
 
 	for i, participant := range participants {
-		participantsRes[i] = spec.GetTripParticipantsResponseArray{
-			ID:          participant.ID.String(),
-			Email:       openapi_types.Email(participant.Email),
-			IsConfirmed: participant.IsConfirmed,
+		participantsRes[i] = participantResponse(participant)
+		if issue, ok := issues[participant.Email]; ok {
+			participantsRes[i].EmailIssue = &issue
 		}
 	}
 
@@ -419,3 +2019,589 @@ func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Reques
 		Participants: participantsRes,
 	})
 }
+
+func participantResponse(participant pgstore.Participant) spec.GetTripParticipantsResponseArray {
+	res := spec.GetTripParticipantsResponseArray{
+		ID:            participant.ID.String(),
+		Email:         openapi_types.Email(participant.Email),
+		IsConfirmed:   participant.IsConfirmed,
+		IsDeclined:    participant.DeclinedAt.Valid,
+		NoResponse:    participant.NoResponseAt.Valid,
+		Guests:        int(participant.Guests),
+		Locale:        localeResponse(participant.Locale),
+		EmailVerified: participant.EmailVerifiedAt.Valid,
+	}
+
+	if participant.Name.Valid {
+		res.Name = &participant.Name.String
+	}
+
+	if participant.Phone.Valid {
+		res.Phone = &participant.Phone.String
+	}
+
+	if participant.AvatarUrl.Valid {
+		res.AvatarURL = &participant.AvatarUrl.String
+	}
+
+	if participant.DeclinedAt.Valid {
+		res.DeclinedAt = &participant.DeclinedAt.Time
+	}
+
+	if participant.DeclineReason.Valid {
+		res.DeclineReason = &participant.DeclineReason.String
+	}
+
+	return res
+}
+
+func tripResponse(trip pgstore.Trip) spec.GetTripDetailsResponseTripObj {
+	res := spec.GetTripDetailsResponseTripObj{
+		ID:                  trip.ID.String(),
+		Destination:         trip.Destination,
+		StartsAt:            trip.StartsAt.Time,
+		EndsAt:              trip.EndsAt.Time,
+		IsConfirmed:         domain.TripStatus(trip.Status).IsConfirmed(),
+		Description:         trip.Description,
+		Status:              tripStatusResponse(trip.Status),
+		MaxGuests:           int(trip.MaxGuests),
+		PreTripReminderDays: int(trip.PreTripReminderDays),
+		Timezone:            trip.Timezone,
+		Version:             int(trip.Version),
+	}
+
+	if trip.RsvpDeadline.Valid {
+		res.RsvpDeadline = &trip.RsvpDeadline.Time
+	}
+
+	if trip.MaxParticipants.Valid {
+		maxParticipants := int(trip.MaxParticipants.Int32)
+		res.MaxParticipants = &maxParticipants
+	}
+
+	if trip.Latitude.Valid && trip.Longitude.Valid {
+		res.Latitude = &trip.Latitude.Float64
+		res.Longitude = &trip.Longitude.Float64
+	}
+
+	if trip.PlaceName.Valid {
+		res.PlaceName = &trip.PlaceName.String
+	}
+
+	return res
+}
+
+// nullFields returns the fields of the JSON object data set to null, which a
+// JSON Merge Patch uses to remove them.
+func nullFields(data []byte) (map[string]bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	null := make(map[string]bool)
+	for name, value := range fields {
+		if string(bytes.TrimSpace(value)) == "null" {
+			null[name] = true
+		}
+	}
+
+	return null, nil
+}
+
+// ifMatchVersion parses the trip version of an If-Match header, quoted like
+// the ETag of the trip details or not.
+func ifMatchVersion(header string) (int32, error) {
+	v := strings.Trim(strings.TrimPrefix(strings.TrimSpace(header), "W/"), `"`)
+	n, err := strconv.ParseInt(v, 10, 32)
+	return int32(n), err
+}
+
+// tripChangedResponse answers an update based on an outdated version of the
+// trip.
+func tripChangedResponse() spec.TripRangeConflictResponse {
+	return spec.TripRangeConflictResponse{
+		Message:    errTripChanged,
+		Activities: []spec.GetTripActivitiesResponseInnerArray{},
+	}
+}
+
+func tripStatusResponse(status pgstore.TripStatus) spec.TripStatus {
+	switch status {
+	case pgstore.TripStatusDraft:
+		return spec.TripStatusDraft
+	case pgstore.TripStatusConfirmed:
+		return spec.TripStatusConfirmed
+	case pgstore.TripStatusOngoing:
+		return spec.TripStatusOngoing
+	case pgstore.TripStatusCompleted:
+		return spec.TripStatusCompleted
+	case pgstore.TripStatusCancelled:
+		return spec.TripStatusCancelled
+	}
+	return spec.UnknownTripStatus
+}
+
+// activityCounts holds how many participants attend and upvoted an activity.
+type activityCounts struct {
+	attendees int
+	votes     int
+}
+
+// activityCounts returns the attendee and vote counts of every activity of a
+// trip, keyed by activity ID. Activities nobody attends or voted for are
+// missing from the map.
+func (api *API) activityCounts(ctx context.Context, tripID uuid.UUID) (map[uuid.UUID]activityCounts, error) {
+	attendees, err := api.store.GetTripActivityAttendeeCounts(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := api.store.GetTripActivityVoteCounts(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]activityCounts, len(attendees))
+	for _, a := range attendees {
+		c := counts[a.ActivityID]
+		c.attendees = int(a.Attendees)
+		counts[a.ActivityID] = c
+	}
+	for _, v := range votes {
+		c := counts[v.ActivityID]
+		c.votes = int(v.Votes)
+		counts[v.ActivityID] = c
+	}
+
+	return counts, nil
+}
+
+// tripLocation returns the time zone of trip, falling back to UTC only when it
+// has none the server knows.
+func tripLocation(trip pgstore.Trip) *time.Location {
+	if trip.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// activitiesResponse groups the activities, the booking check-ins and
+// check-outs and the transport segment departures, all sorted by time, by
+// their day in loc.
+func activitiesResponse(activities []pgstore.Activity, bookings []bookingEvent, segments []transportSegment, counts map[uuid.UUID]activityCounts, loc *time.Location) []spec.GetTripActivitiesResponseOuterArray {
+	var outerActivities []spec.GetTripActivitiesResponseOuterArray
+
+	// day returns the index of the day of t, adding the day when missing.
+	day := func(t time.Time) int {
+		t = t.In(loc)
+		date := time.Date(
+			t.Year(),
+			t.Month(),
+			t.Day(),
+			0, 0, 0, 0,
+			time.UTC,
+		)
+
+		for i := len(outerActivities) - 1; i >= 0; i-- {
+			if outerActivities[i].Date.Time.Equal(date) {
+				return i
+			}
+		}
+
+		outerActivities = append(outerActivities, spec.GetTripActivitiesResponseOuterArray{
+			Date:       openapi_types.Date{Time: date},
+			Activities: []spec.GetTripActivitiesResponseInnerArray{},
+			Bookings:   []spec.GetTripActivitiesResponseBooking{},
+			Segments:   []spec.GetTripActivitiesResponseSegment{},
+		})
+		return len(outerActivities) - 1
+	}
+
+	for _, activity := range activities {
+		i := day(activity.OccursAt.Time)
+		outerActivities[i].Activities = append(outerActivities[i].Activities, activityResponse(activity, counts[activity.ID]))
+	}
+
+	for _, booking := range bookings {
+		i := day(booking.at)
+		outerActivities[i].Bookings = append(outerActivities[i].Bookings, bookingEventResponse(booking))
+	}
+
+	for _, segment := range segments {
+		i := day(segment.segment.DepartureAt.Time)
+		outerActivities[i].Segments = append(outerActivities[i].Segments, transportSegmentResponse(segment))
+	}
+
+	sort.SliceStable(outerActivities, func(i, j int) bool {
+		return outerActivities[i].Date.Time.Before(outerActivities[j].Date.Time)
+	})
+
+	return outerActivities
+}
+
+func activityResponse(activity pgstore.Activity, counts activityCounts) spec.GetTripActivitiesResponseInnerArray {
+	res := spec.GetTripActivitiesResponseInnerArray{
+		ID:             activity.ID.String(),
+		OccursAt:       activity.OccursAt.Time,
+		Title:          activity.Title,
+		Category:       activityCategoryResponse(activity.Category),
+		AttendeesCount: counts.attendees,
+		VotesCount:     counts.votes,
+		Position:       int(activity.Position),
+	}
+
+	if activity.EndsAt.Valid {
+		endsAt := activity.EndsAt.Time
+		duration := int(endsAt.Sub(activity.OccursAt.Time).Minutes())
+		res.EndsAt = &endsAt
+		res.DurationMinutes = &duration
+	}
+
+	if activity.Address.Valid {
+		res.Address = &activity.Address.String
+	}
+
+	if activity.Latitude.Valid && activity.Longitude.Valid {
+		res.Latitude = &activity.Latitude.Float64
+		res.Longitude = &activity.Longitude.Float64
+	}
+
+	if activity.PlaceName.Valid {
+		res.PlaceName = &activity.PlaceName.String
+	}
+
+	return res
+}
+
+func activityCategoryResponse(category pgstore.ActivityCategory) spec.ActivityCategory {
+	switch category {
+	case pgstore.ActivityCategoryFood:
+		return spec.ActivityCategoryFood
+	case pgstore.ActivityCategoryTransport:
+		return spec.ActivityCategoryTransport
+	case pgstore.ActivityCategorySightseeing:
+		return spec.ActivityCategorySightseeing
+	case pgstore.ActivityCategoryLodging:
+		return spec.ActivityCategoryLodging
+	case pgstore.ActivityCategoryOther:
+		return spec.ActivityCategoryOther
+	}
+	return spec.UnknownActivityCategory
+}
+
+// activityCategory converts an optional request category into the stored
+// one, defaulting to other.
+func activityCategory(category *spec.ActivityCategory) pgstore.ActivityCategory {
+	if category == nil || *category == spec.UnknownActivityCategory {
+		return pgstore.ActivityCategoryOther
+	}
+	return pgstore.ActivityCategory(category.ToValue())
+}
+
+// occursDuringTrip reports whether an activity starting at occursAt and
+// optionally ending at endsAt falls within the trip window.
+func occursDuringTrip(trip pgstore.Trip, occursAt time.Time, endsAt *time.Time) bool {
+	if occursAt.Before(trip.StartsAt.Time) || occursAt.After(trip.EndsAt.Time) {
+		return false
+	}
+	return endsAt == nil || !endsAt.After(trip.EndsAt.Time)
+}
+
+// maxActivityOccurrences caps how many activities a single recurrence can
+// expand into.
+const maxActivityOccurrences = 366
+
+// expandRecurrence repeats activity every everyDays days, keeping its
+// duration, for as long as the occurrence starts before or at until.
+func expandRecurrence(activity pgstore.CreateActivityParams, everyDays int, until time.Time) []pgstore.CreateActivityParams {
+	var occurrences []pgstore.CreateActivityParams
+
+	for i := 0; ; i++ {
+		occurrence := activity
+		occurrence.OccursAt.Time = activity.OccursAt.Time.AddDate(0, 0, i*everyDays)
+		if occurrence.OccursAt.Time.After(until) || len(occurrences) > maxActivityOccurrences {
+			break
+		}
+		if activity.EndsAt.Valid {
+			occurrence.EndsAt.Time = activity.EndsAt.Time.AddDate(0, 0, i*everyDays)
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences
+}
+
+// activityConflictMessage is why an activity overlapping other activities of
+// its trip is refused without force.
+const activityConflictMessage = "a atividade coincide com outras atividades da viagem"
+
+// activityOverlaps loads the activities of the trip once, for the returned
+// function to list the IDs of the ones, other than excludeID, an activity
+// starting at occursAt and optionally ending at endsAt would overlap. With
+// force, activities may overlap and nothing is loaded.
+func (api *API) activityOverlaps(ctx context.Context, tripID uuid.UUID, force *bool) (func(excludeID uuid.UUID, occursAt time.Time, endsAt *time.Time) []string, error) {
+	if force != nil && *force {
+		return func(uuid.UUID, time.Time, *time.Time) []string { return nil }, nil
+	}
+
+	activities, err := api.store.GetTripActivities(ctx, pgstore.GetTripActivitiesParams{TripID: tripID})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(excludeID uuid.UUID, occursAt time.Time, endsAt *time.Time) []string {
+		return overlappingActivities(activities, excludeID, occursAt, endsAt)
+	}, nil
+}
+
+// overlappingActivities returns the IDs of the activities, other than
+// excludeID, whose time span overlaps the one starting at occursAt and
+// optionally ending at endsAt. Activities without an end are instants.
+func overlappingActivities(activities []pgstore.Activity, excludeID uuid.UUID, occursAt time.Time, endsAt *time.Time) []string {
+	end := occursAt
+	if endsAt != nil {
+		end = *endsAt
+	}
+
+	var conflicts []string
+
+	for _, activity := range activities {
+		if activity.ID == excludeID {
+			continue
+		}
+
+		aStart, aEnd := activity.OccursAt.Time, activity.OccursAt.Time
+		if activity.EndsAt.Valid {
+			aEnd = activity.EndsAt.Time
+		}
+
+		if aStart.Equal(occursAt) || (aStart.Before(end) && occursAt.Before(aEnd)) {
+			conflicts = append(conflicts, activity.ID.String())
+		}
+	}
+
+	return conflicts
+}
+
+// outsideTripMessage explains which window an activity of trip must fall in.
+func outsideTripMessage(trip pgstore.Trip) string {
+	return fmt.Sprintf(
+		"a atividade deve acontecer durante a viagem, entre %s e %s",
+		trip.StartsAt.Time.Format(time.RFC3339),
+		trip.EndsAt.Time.Format(time.RFC3339),
+	)
+}
+
+// utcTimestamp converts t into a timestamp in UTC. The timestamp columns keep
+// the wall clock they are given, whatever its offset, so times are converted
+// before being stored.
+func utcTimestamp(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{Valid: true, Time: t.UTC()}
+}
+
+// optionalTimestamp converts an optional time into a nullable timestamp in UTC.
+func optionalTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return utcTimestamp(*t)
+}
+
+// optionalText converts an optional string into a nullable text.
+func optionalText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{Valid: true, String: *s}
+}
+
+// optionalFloat8 converts an optional float into a nullable float8.
+func optionalFloat8(f *float64) pgtype.Float8 {
+	if f == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Valid: true, Float64: *f}
+}
+
+// optionalInt8 converts an optional integer into a nullable int8.
+func optionalInt8(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Valid: true, Int64: *i}
+}
+
+// activitiesOutsideRange returns the activities that do not occur between
+// startsAt and endsAt.
+func activitiesOutsideRange(activities []pgstore.Activity, startsAt, endsAt time.Time) []spec.GetTripActivitiesResponseInnerArray {
+	var outside []spec.GetTripActivitiesResponseInnerArray
+
+	for _, activity := range activities {
+		end := activity.OccursAt.Time
+		if activity.EndsAt.Valid {
+			end = activity.EndsAt.Time
+		}
+		if activity.OccursAt.Time.Before(startsAt) || end.After(endsAt) {
+			outside = append(outside, activityResponse(activity, activityCounts{}))
+		}
+	}
+
+	return outside
+}
+
+func linksResponse(links []pgstore.Link, clicks map[uuid.UUID]int) []spec.GetLinksResponseArray {
+	linksRes := make([]spec.GetLinksResponseArray, len(links))
+
+	for i, link := range links {
+		linksRes[i] = linkResponse(link, clicks[link.ID])
+	}
+
+	return linksRes
+}
+
+func linkResponse(link pgstore.Link, clicks int) spec.GetLinksResponseArray {
+	res := spec.GetLinksResponseArray{
+		ID:       link.ID.String(),
+		Title:    link.Title,
+		URL:      link.Url,
+		Category: linkCategoryResponse(link.Category),
+		Pinned:   link.Pinned,
+		Clicks:   clicks,
+	}
+
+	if link.PreviewFetchedAt.Valid {
+		res.Preview = &spec.LinkPreview{}
+
+		if link.PreviewTitle.Valid {
+			res.Preview.Title = &link.PreviewTitle.String
+		}
+
+		if link.PreviewDescription.Valid {
+			res.Preview.Description = &link.PreviewDescription.String
+		}
+
+		if link.PreviewFaviconUrl.Valid {
+			res.Preview.FaviconURL = &link.PreviewFaviconUrl.String
+		}
+
+		if link.PreviewImageUrl.Valid {
+			res.Preview.ImageURL = &link.PreviewImageUrl.String
+		}
+	}
+
+	return res
+}
+
+// linkGroupsResponse groups links by category. links must be sorted by
+// category, as returned by GetTripLinks.
+func linkGroupsResponse(links []pgstore.Link, clicks map[uuid.UUID]int) []spec.GetLinksResponseOuterArray {
+	groups := []spec.GetLinksResponseOuterArray{}
+
+	for i, link := range links {
+		if i == 0 || link.Category != links[i-1].Category {
+			groups = append(groups, spec.GetLinksResponseOuterArray{
+				Category: linkCategoryResponse(link.Category),
+				Links:    []spec.GetLinksResponseArray{},
+			})
+		}
+
+		group := &groups[len(groups)-1]
+		group.Links = append(group.Links, linkResponse(link, clicks[link.ID]))
+	}
+
+	return groups
+}
+
+func linkCategoryResponse(category pgstore.LinkCategory) spec.LinkCategory {
+	switch category {
+	case pgstore.LinkCategoryLodging:
+		return spec.LinkCategoryLodging
+	case pgstore.LinkCategoryTransport:
+		return spec.LinkCategoryTransport
+	case pgstore.LinkCategoryTickets:
+		return spec.LinkCategoryTickets
+	case pgstore.LinkCategoryDocs:
+		return spec.LinkCategoryDocs
+	case pgstore.LinkCategoryOther:
+		return spec.LinkCategoryOther
+	}
+	return spec.UnknownLinkCategory
+}
+
+func emailIssueResponse(issue pgstore.EmailIssue) spec.EmailIssue {
+	switch issue {
+	case pgstore.EmailIssueBounce:
+		return spec.EmailIssueBounce
+	case pgstore.EmailIssueComplaint:
+		return spec.EmailIssueComplaint
+	}
+	return spec.UnknownEmailIssue
+}
+
+func localeResponse(locale pgstore.Locale) spec.Locale {
+	switch locale {
+	case pgstore.LocalePtBR:
+		return spec.LocalePtBR
+	case pgstore.LocaleEn:
+		return spec.LocaleEn
+	case pgstore.LocaleEs:
+		return spec.LocaleEs
+	}
+	return spec.UnknownLocale
+}
+
+// checkActionToken verifies the token of the email link a request comes from.
+// A request without one only goes through when authorized, that is when its
+// owner header allows the action. It returns the message to answer with when
+// the request is rejected.
+func (api *API) checkActionToken(token *string, action actionlink.Action, id uuid.UUID, authorized bool) (string, bool) {
+	if token == nil {
+		if !authorized {
+			return "link do e-mail ausente", false
+		}
+		return "", true
+	}
+
+	if err := api.actions.Verify(*token, action, id); err != nil {
+		if errors.Is(err, actionlink.ErrExpiredToken) {
+			return "link expirado", false
+		}
+		return "link inválido", false
+	}
+
+	return "", true
+}
+
+// locale converts an optional request locale into the stored one, defaulting
+// to pt-BR.
+func locale(locale *spec.Locale) pgstore.Locale {
+	if locale == nil || *locale == spec.UnknownLocale {
+		return pgstore.LocalePtBR
+	}
+	return pgstore.Locale(locale.ToValue())
+}
+
+// optionalLocale maps an omitted locale to NULL so COALESCE keeps the stored
+// value.
+func optionalLocale(locale *spec.Locale) pgstore.NullLocale {
+	if locale == nil || *locale == spec.UnknownLocale {
+		return pgstore.NullLocale{}
+	}
+	return pgstore.NullLocale{Valid: true, Locale: pgstore.Locale(locale.ToValue())}
+}
+
+// linkCategory converts an optional request category into the stored one,
+// defaulting to other.
+func linkCategory(category *spec.LinkCategory) pgstore.LinkCategory {
+	if category == nil || *category == spec.UnknownLinkCategory {
+		return pgstore.LinkCategoryOther
+	}
+	return pgstore.LinkCategory(category.ToValue())
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"travel-api/internal/pgstore"
+)
+
+func TestUTCTimestamp(t *testing.T) {
+	occursAt, err := time.Parse(time.RFC3339, "2025-01-10T23:30:00-03:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := utcTimestamp(occursAt)
+
+	want := time.Date(2025, time.January, 11, 2, 30, 0, 0, time.UTC)
+	if !got.Valid || got.Time != want {
+		t.Errorf("utcTimestamp() = %v, want %v", got.Time, want)
+	}
+
+	endsAt := occursAt.Add(time.Hour)
+	if got := optionalTimestamp(&endsAt); !got.Valid || got.Time != want.Add(time.Hour) {
+		t.Errorf("optionalTimestamp() = %v, want %v", got.Time, want.Add(time.Hour))
+	}
+
+	if got := optionalTimestamp(nil); got.Valid {
+		t.Errorf("optionalTimestamp(nil) = %v, want null", got.Time)
+	}
+}
+
+func TestActivitiesResponseDay(t *testing.T) {
+	occursAt, err := time.Parse(time.RFC3339, "2025-01-10T23:30:00-03:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stored as written by the handlers, so as it is read back.
+	activities := []pgstore.Activity{{OccursAt: utcTimestamp(occursAt)}}
+
+	tests := []struct {
+		name string
+		loc  *time.Location
+		want time.Time
+	}{
+		{name: "trip time zone", loc: time.FixedZone("-03", -3*60*60), want: time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)},
+		{name: "utc", loc: time.UTC, want: time.Date(2025, time.January, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := activitiesResponse(activities, nil, nil, nil, tt.loc)
+			if len(days) != 1 || days[0].Date.Time != tt.want {
+				t.Fatalf("activitiesResponse() = %+v, want a single day on %v", days, tt.want)
+			}
+			if got := days[0].Activities[0].OccursAt; !got.Equal(occursAt) {
+				t.Errorf("OccursAt = %v, want %v", got, occursAt)
+			}
+		})
+	}
+}
+
+func TestExpandRecurrenceUTC(t *testing.T) {
+	occursAt, err := time.Parse(time.RFC3339, "2025-01-10T23:30:00-03:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activity := pgstore.CreateActivityParams{OccursAt: utcTimestamp(occursAt)}
+
+	occurrences := expandRecurrence(activity, 1, occursAt.AddDate(0, 0, 2))
+	if len(occurrences) != 3 {
+		t.Fatalf("expandRecurrence() = %d occurrences, want 3", len(occurrences))
+	}
+
+	for i, occurrence := range occurrences {
+		want := time.Date(2025, time.January, 11+i, 2, 30, 0, 0, time.UTC)
+		if occurrence.OccursAt.Time != want {
+			t.Errorf("occurrence %d = %v, want %v", i, occurrence.OccursAt.Time, want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxAttachmentSize caps the size of a single uploaded file.
+	maxAttachmentSize = 10 << 20
+
+	// attachmentURLTTL is how long a signed download URL stays valid.
+	attachmentURLTTL = 15 * time.Minute
+)
+
+// attachmentContentTypes are the types an attachment may have, as sniffed by
+// http.DetectContentType.
+var attachmentContentTypes = map[string]bool{
+	"application/pdf":           true,
+	"image/gif":                 true,
+	"image/jpeg":                true,
+	"image/png":                 true,
+	"image/webp":                true,
+	"text/plain; charset=utf-8": true,
+}
+
+// Get an activity attachments.
+// (GET /activities/{activityId}/attachments)
+func (api *API) GetActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, activityID string) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	attachments, err := api.store.GetActivityAttachments(r.Context(), id)
+	if err != nil {
+		return spec.GetActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	attachmentsRes, err := api.attachmentsResponse(attachments)
+	if err != nil {
+		return spec.GetActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetActivitiesActivityIDAttachmentsJSON200Response(spec.GetActivityAttachmentsResponse{
+		Attachments: attachmentsRes,
+	})
+}
+
+// attachmentsResponse converts attachments into their response, signing a
+// fresh download URL for each of them.
+func (api *API) attachmentsResponse(attachments []pgstore.ActivityAttachment) ([]spec.GetActivityAttachmentsResponseArray, error) {
+	attachmentsRes := make([]spec.GetActivityAttachmentsResponseArray, len(attachments))
+
+	for i, attachment := range attachments {
+		url, expiresAt, err := api.blobs.SignedURL(attachment.StorageKey, attachmentURLTTL)
+		if err != nil {
+			api.logger.Error("failed to sign attachment url", zap.Error(err), zap.String("attachment_id", attachment.ID.String()))
+			return nil, err
+		}
+
+		attachmentsRes[i] = spec.GetActivityAttachmentsResponseArray{
+			ID:           attachment.ID.String(),
+			FileName:     attachment.FileName,
+			ContentType:  attachment.ContentType,
+			Size:         attachment.Size,
+			URL:          url,
+			URLExpiresAt: expiresAt,
+			CreatedAt:    attachment.CreatedAt.Time,
+		}
+	}
+
+	return attachmentsRes, nil
+}
+
+// Upload an attachment to an activity.
+// (POST /activities/{activityId}/attachments)
+func (api *API) PostActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, activityID string) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetActivityTripID(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentSize+1<<20)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	defer file.Close()
+
+	if header.Size > maxAttachmentSize {
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "arquivo muito grande"})
+	}
+
+	contentType, err := sniffContentType(file)
+	if err != nil {
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	if !attachmentContentTypes[contentType] {
+		return spec.PostActivitiesActivityIDAttachmentsJSON415Response(spec.Error{Message: "o anexo deve ser um PDF, um texto ou uma imagem GIF, JPEG, PNG ou WebP"})
+	}
+
+	key := path.Join("activities", id.String(), uuid.NewString())
+
+	if err := api.blobs.Put(r.Context(), key, file); err != nil {
+		api.logger.Error("failed to store attachment", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	attachmentID, err := api.store.CreateActivityAttachment(r.Context(), pgstore.CreateActivityAttachmentParams{
+		ActivityID:  id,
+		FileName:    path.Base(header.Filename),
+		ContentType: contentType,
+		Size:        header.Size,
+		StorageKey:  key,
+	})
+	if err != nil {
+		api.logger.Error("failed to create attachment", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostActivitiesActivityIDAttachmentsJSON201Response(spec.CreateActivityAttachmentResponse{
+		AttachmentID: attachmentID.String(),
+	})
+}
@@ -0,0 +1,328 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Get the bookings of a trip.
+// (GET /trips/{tripId}/bookings)
+func (api *API) GetTripsTripIDBookings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDBookingsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	bookings, err := api.store.GetTripBookings(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get bookings", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBookingsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetBookingsResponse{
+		Bookings: make([]spec.GetBookingsResponseArray, len(bookings)),
+	}
+
+	for i, booking := range bookings {
+		response.Bookings[i] = spec.GetBookingsResponseArray{
+			ID:         booking.ID.String(),
+			Type:       bookingTypeResponse(booking.Type),
+			Name:       booking.Name,
+			CheckInAt:  booking.CheckInAt.Time,
+			CheckOutAt: booking.CheckOutAt.Time,
+			CreatedAt:  booking.CreatedAt.Time,
+		}
+		if booking.Address.Valid {
+			response.Bookings[i].Address = &booking.Address.String
+		}
+		if booking.ConfirmationCode.Valid {
+			response.Bookings[i].ConfirmationCode = &booking.ConfirmationCode.String
+		}
+		if booking.Price.Valid {
+			response.Bookings[i].Price = &booking.Price.Int64
+			response.Bookings[i].Currency = &booking.Currency.String
+		}
+		if booking.Url.Valid {
+			response.Bookings[i].URL = &booking.Url.String
+		}
+	}
+
+	return spec.GetTripsTripIDBookingsJSON200Response(response)
+}
+
+// Add a booking to a trip.
+// (POST /trips/{tripId}/bookings)
+func (api *API) PostTripsTripIDBookings(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDBookingsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDBookingsJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreateBookingRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDBookingsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if !occursDuringTrip(trip, body.CheckInAt, &body.CheckOutAt) {
+		return spec.PostTripsTripIDBookingsJSON422Response(spec.Error{Message: outsideTripBookingMessage(trip)})
+	}
+
+	bookingID, err := api.store.CreateBooking(r.Context(), pgstore.CreateBookingParams{
+		TripID:           id,
+		Type:             bookingType(body.Type),
+		Name:             body.Name,
+		CheckInAt:        pgtype.Timestamp{Valid: true, Time: body.CheckInAt},
+		CheckOutAt:       pgtype.Timestamp{Valid: true, Time: body.CheckOutAt},
+		Address:          optionalText(body.Address),
+		ConfirmationCode: optionalText(body.ConfirmationCode),
+		Price:            optionalInt8(body.Price),
+		Currency:         optionalText(body.Currency),
+		Url:              optionalText(body.URL),
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDBookingsJSON422Response(e)
+		}
+		api.logger.Error("failed to create booking", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDBookingsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDBookingsJSON201Response(spec.CreateBookingResponse{BookingID: bookingID.String()})
+}
+
+// Update a booking of a trip.
+// (PUT /trips/{tripId}/bookings/{bookingId})
+func (api *API) PutTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request, tripID string, bookingID string, params spec.PutTripsTripIDBookingsBookingIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	bID, err := uuid.Parse(bookingID)
+	if err != nil {
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PutTripsTripIDBookingsBookingIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.UpdateBookingRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDBookingsBookingIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if !occursDuringTrip(trip, body.CheckInAt, &body.CheckOutAt) {
+		return spec.PutTripsTripIDBookingsBookingIDJSON422Response(spec.Error{Message: outsideTripBookingMessage(trip)})
+	}
+
+	updated, err := api.store.UpdateBooking(r.Context(), pgstore.UpdateBookingParams{
+		Type:             bookingType(body.Type),
+		Name:             body.Name,
+		CheckInAt:        pgtype.Timestamp{Valid: true, Time: body.CheckInAt},
+		CheckOutAt:       pgtype.Timestamp{Valid: true, Time: body.CheckOutAt},
+		Address:          optionalText(body.Address),
+		ConfirmationCode: optionalText(body.ConfirmationCode),
+		Price:            optionalInt8(body.Price),
+		Currency:         optionalText(body.Currency),
+		Url:              optionalText(body.URL),
+		ID:               bID,
+		TripID:           id,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDBookingsBookingIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update booking", zap.Error(err), zap.String("booking_id", bookingID))
+		return spec.PutTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDBookingsBookingIDJSON404Response(spec.Error{Message: "reserva não encontrada"})
+	}
+
+	return spec.PutTripsTripIDBookingsBookingIDJSON204Response(nil)
+}
+
+// Delete a booking of a trip.
+// (DELETE /trips/{tripId}/bookings/{bookingId})
+func (api *API) DeleteTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request, tripID string, bookingID string, params spec.DeleteTripsTripIDBookingsBookingIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	bID, err := uuid.Parse(bookingID)
+	if err != nil {
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	deleted, err := api.store.DeleteBooking(r.Context(), pgstore.DeleteBookingParams{ID: bID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete booking", zap.Error(err), zap.String("booking_id", bookingID))
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDBookingsBookingIDJSON404Response(spec.Error{Message: "reserva não encontrada"})
+	}
+
+	return spec.DeleteTripsTripIDBookingsBookingIDJSON204Response(nil)
+}
+
+// outsideTripBookingMessage explains which window a booking of trip must
+// fall in.
+func outsideTripBookingMessage(trip pgstore.Trip) string {
+	return fmt.Sprintf(
+		"a reserva deve acontecer durante a viagem, entre %s e %s",
+		trip.StartsAt.Time.Format(time.RFC3339),
+		trip.EndsAt.Time.Format(time.RFC3339),
+	)
+}
+
+func bookingType(t *spec.BookingType) pgstore.BookingType {
+	if t == nil || *t == spec.UnknownBookingType {
+		return pgstore.BookingTypeLodging
+	}
+	return pgstore.BookingType(t.ToValue())
+}
+
+func bookingTypeResponse(t pgstore.BookingType) spec.BookingType {
+	switch t {
+	case pgstore.BookingTypeLodging:
+		return spec.BookingTypeLodging
+	}
+	return spec.UnknownBookingType
+}
+
+// bookingEvent is the check-in or the check-out of a booking, listed in the
+// itinerary on its day.
+type bookingEvent struct {
+	booking pgstore.Booking
+	event   spec.BookingEvent
+	at      time.Time
+}
+
+// bookingEvents lists the check-ins and check-outs of bookings happening
+// between from and to, when given, the earliest first.
+func bookingEvents(bookings []pgstore.Booking, from, to *time.Time) []bookingEvent {
+	var events []bookingEvent
+
+	for _, booking := range bookings {
+		for _, e := range []bookingEvent{
+			{booking: booking, event: spec.BookingEventCheckIn, at: booking.CheckInAt.Time},
+			{booking: booking, event: spec.BookingEventCheckOut, at: booking.CheckOutAt.Time},
+		} {
+			if (from != nil && e.at.Before(*from)) || (to != nil && e.at.After(*to)) {
+				continue
+			}
+			events = append(events, e)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].at.Before(events[j].at)
+	})
+
+	return events
+}
+
+func bookingEventResponse(e bookingEvent) spec.GetTripActivitiesResponseBooking {
+	res := spec.GetTripActivitiesResponseBooking{
+		ID:    e.booking.ID.String(),
+		Type:  bookingTypeResponse(e.booking.Type),
+		Name:  e.booking.Name,
+		Event: e.event,
+		At:    e.at,
+	}
+
+	if e.booking.Address.Valid {
+		res.Address = &e.booking.Address.String
+	}
+
+	if e.booking.ConfirmationCode.Valid {
+		res.ConfirmationCode = &e.booking.ConfirmationCode.String
+	}
+
+	return res
+}
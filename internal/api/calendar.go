@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/ics"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// calendarRefresh is how often the calendars subscribed to a trip are asked
+// to fetch it again.
+const calendarRefresh = time.Hour
+
+// Get the calendar feed of a trip.
+// (GET /trips/{tripId}/calendar.ics)
+func (api *API) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDCalendarIcsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	bookings, err := api.store.GetTripBookings(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get bookings", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	segments, err := api.store.GetTripTransportSegments(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get transport segments", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	w.Header().Set("Content-Type", ics.ContentType)
+	w.Header().Set("Content-Disposition", `inline; filename="viagem.ics"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(tripFeed(trip, activities, bookings, segments, time.Now()).Encode())
+
+	return nil
+}
+
+// tripFeed is the calendar subscribed to by the participants of trip. The
+// UIDs of its events are derived from the IDs of what they are about, the
+// transport segments keeping the ones of the .ics attached to the trip
+// emails, so calendars update the events instead of adding copies. Feeds are
+// fetched and stored by third party calendar services, so they leave out the
+// booking confirmation codes.
+func tripFeed(trip pgstore.Trip, activities []pgstore.Activity, bookings []pgstore.Booking, segments []pgstore.TransportSegment, now time.Time) ics.Calendar {
+	calendar := ics.Calendar{
+		Name:    trip.Destination,
+		Refresh: calendarRefresh,
+		Events:  make([]ics.Event, 0, len(activities)+len(bookings)+len(segments)),
+	}
+
+	for _, activity := range activities {
+		event := ics.Event{
+			UID:     fmt.Sprintf("activity-%s@plann.er", activity.ID),
+			Start:   activity.OccursAt.Time,
+			Summary: activity.Title,
+			Updated: now,
+		}
+		if activity.EndsAt.Valid {
+			event.End = activity.EndsAt.Time
+		}
+		if activity.Address.Valid {
+			event.Location = activity.Address.String
+		} else if activity.PlaceName.Valid {
+			event.Location = activity.PlaceName.String
+		}
+		calendar.Events = append(calendar.Events, event)
+	}
+
+	for _, booking := range bookings {
+		event := ics.Event{
+			UID:     fmt.Sprintf("booking-%s@plann.er", booking.ID),
+			Start:   booking.CheckInAt.Time,
+			End:     booking.CheckOutAt.Time,
+			Summary: booking.Name,
+			Updated: now,
+		}
+		if booking.Address.Valid {
+			event.Location = booking.Address.String
+		}
+		if booking.Url.Valid {
+			event.URL = booking.Url.String
+		}
+		calendar.Events = append(calendar.Events, event)
+	}
+
+	for _, segment := range segments {
+		carrier := segment.Carrier
+		if segment.Number.Valid {
+			carrier += " " + segment.Number.String
+		}
+
+		calendar.Events = append(calendar.Events, ics.Event{
+			UID:      fmt.Sprintf("segment-%s@plann.er", segment.ID),
+			Start:    segment.DepartureAt.Time,
+			End:      segment.ArrivalAt.Time,
+			Summary:  fmt.Sprintf("%s: %s → %s", carrier, segment.DeparturePlace, segment.ArrivalPlace),
+			Location: segment.DeparturePlace,
+			Updated:  now,
+		})
+	}
+
+	return calendar
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Get an activity comments.
+// (GET /activities/{activityId}/comments)
+func (api *API) GetActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request, activityID string) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	comments, err := api.store.GetActivityComments(r.Context(), id)
+	if err != nil {
+		return spec.GetActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	commentsRes := make([]spec.GetActivityCommentsResponseArray, len(comments))
+
+	for i, comment := range comments {
+		commentsRes[i] = spec.GetActivityCommentsResponseArray{
+			ID:            comment.ID.String(),
+			ParticipantID: comment.ParticipantID.String(),
+			AuthorEmail:   openapi_types.Email(comment.Email),
+			Body:          comment.Body,
+			CreatedAt:     comment.CreatedAt.Time,
+		}
+	}
+
+	return spec.GetActivitiesActivityIDCommentsJSON200Response(spec.GetActivityCommentsResponse{
+		Comments: commentsRes,
+	})
+}
+
+// Comment on an activity.
+// (POST /activities/{activityId}/comments)
+func (api *API) PostActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request, activityID string) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.CreateActivityCommentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Body = strings.TrimSpace(body.Body)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	tripID, err := api.store.GetActivityTripID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participantID := uuid.MustParse(body.ParticipantID)
+
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if participant.TripID != tripID {
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "participante não faz parte desta viagem"})
+	}
+
+	commentID, err := api.store.CreateActivityComment(r.Context(), pgstore.CreateActivityCommentParams{
+		ActivityID:    id,
+		ParticipantID: participantID,
+		Body:          body.Body,
+	})
+	if err != nil {
+		api.logger.Error("failed to create activity comment", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostActivitiesActivityIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostActivitiesActivityIDCommentsJSON201Response(spec.CreateActivityCommentResponse{CommentID: commentID.String()})
+}
+
+// Delete a comment written by the participant.
+// (DELETE /activities/{activityId}/comments/{commentId})
+func (api *API) DeleteActivitiesActivityIDCommentsCommentID(w http.ResponseWriter, r *http.Request, activityID string, commentID string, params spec.DeleteActivitiesActivityIDCommentsCommentIDParams) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	cID, err := uuid.Parse(commentID)
+	if err != nil {
+		return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	deleted, err := api.store.DeleteActivityComment(r.Context(), pgstore.DeleteActivityCommentParams{
+		ID:            cID,
+		ActivityID:    id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete activity comment", zap.Error(err), zap.String("comment_id", commentID))
+		return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON404Response(spec.Error{Message: "comentário não encontrado"})
+	}
+
+	return spec.DeleteActivitiesActivityIDCommentsCommentIDJSON204Response(nil)
+}
+
+// Restore a comment deleted by the participant.
+// (POST /activities/{activityId}/comments/{commentId}/restore)
+func (api *API) PostActivitiesActivityIDCommentsCommentIDRestore(w http.ResponseWriter, r *http.Request, activityID string, commentID string, params spec.PostActivitiesActivityIDCommentsCommentIDRestoreParams) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	cID, err := uuid.Parse(commentID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	restored, err := api.store.RestoreActivityComment(r.Context(), pgstore.RestoreActivityCommentParams{
+		ID:            cID,
+		ActivityID:    id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to restore activity comment", zap.Error(err), zap.String("comment_id", commentID))
+		return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if restored == 0 {
+		return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON404Response(spec.Error{Message: "comentário excluído não encontrado"})
+	}
+
+	return spec.PostActivitiesActivityIDCommentsCommentIDRestoreJSON204Response(nil)
+}
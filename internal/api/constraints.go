@@ -0,0 +1,120 @@
+package api
+
+import (
+	"errors"
+	"strings"
+	"travel-api/internal/api/spec"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// The SQLSTATEs returned by postgres when a unique, a foreign key or a check
+// constraint is violated.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+	pgCheckViolation      = "23514"
+)
+
+// constraintErrors are the errors shown for the constraints the API expects
+// to be violated, by constraint name.
+var constraintErrors = map[string]spec.Error{
+	"participants_trip_id_email_key": {
+		Code:    &spec.ErrorCodeParticipantAlreadyInvited,
+		Message: "e-mail já convidado para esta viagem",
+	},
+	"links_trip_id_url_key": {
+		Code:    &spec.ErrorCodeLinkAlreadyAdded,
+		Message: "este link já foi adicionado à viagem",
+	},
+	// The payer and the activity of an expense are referenced along with
+	// its trip.
+	"expenses_payer_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	"expenses_activity_id_fkey": {
+		Code:    &spec.ErrorCodeActivityNotFound,
+		Message: "atividade não encontrada nesta viagem",
+	},
+	"settlement_payments_from_participant_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	"settlement_payments_to_participant_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	// Only the participants of a trip read and write on its board.
+	"messages_participant_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	"message_reads_participant_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	// The assignee of a packing item is referenced along with its trip.
+	"packing_items_assignee_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	"tasks_assignee_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	// So are the participants taking a transport segment.
+	"transport_segment_participants_participant_id_fkey": {
+		Code:    &spec.ErrorCodeParticipantNotFound,
+		Message: "participante não encontrado nesta viagem",
+	},
+	// The option voted for is referenced along with its poll.
+	"poll_votes_option_id_fkey": {
+		Code:    &spec.ErrorCodePollOptionNotFound,
+		Message: "opção não encontrada nesta enquete",
+	},
+	"tags_name_key": {
+		Code:    &spec.ErrorCodeTagNameTaken,
+		Message: "já existe uma tag com esse nome",
+	},
+}
+
+// constraintError translates a constraint violation reported by the store
+// into the error shown to the client, its code telling the constraint apart.
+// It returns false for the other errors, which are unexpected.
+func constraintError(err error) (spec.Error, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return spec.Error{}, false
+	}
+
+	if e, ok := constraintErrors[pgErr.ConstraintName]; ok {
+		return e, true
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return spec.Error{Code: &spec.ErrorCodeConflict, Message: "já existe um registro com esses dados"}, true
+	case pgForeignKeyViolation:
+		// Foreign keys are named after their column, such as
+		// links_trip_id_fkey.
+		switch {
+		case strings.HasSuffix(pgErr.ConstraintName, "_trip_id_fkey"):
+			return spec.Error{Code: &spec.ErrorCodeTripNotFound, Message: "viagem não encontrada"}, true
+		case strings.HasSuffix(pgErr.ConstraintName, "_activity_id_fkey"):
+			return spec.Error{Code: &spec.ErrorCodeActivityNotFound, Message: "atividade não encontrada"}, true
+		}
+		return spec.Error{Code: &spec.ErrorCodeReferenceNotFound, Message: "registro relacionado não encontrado"}, true
+	case pgCheckViolation:
+		return spec.Error{Code: &spec.ErrorCodeInvalidValue, Message: "valor inválido"}, true
+	}
+
+	return spec.Error{}, false
+}
+
+// isUniqueViolation tells whether err is a unique constraint violation, the
+// data conflicting with the data already stored.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
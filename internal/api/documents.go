@@ -0,0 +1,338 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxDocumentSize caps the size of a single uploaded document.
+	maxDocumentSize = 20 << 20
+
+	// documentURLTTL is how long a signed document download URL stays valid.
+	documentURLTTL = 15 * time.Minute
+)
+
+// documentContentTypes are the types a document may have, as sniffed by
+// http.DetectContentType.
+var documentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+}
+
+// Get the documents of a trip.
+// (GET /trips/{tripId}/documents)
+func (api *API) GetTripsTripIDDocuments(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDDocumentsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.GetTripsTripIDDocumentsJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	documents, err := api.store.GetTripDocuments(r.Context(), pgstore.GetTripDocumentsParams{
+		TripID:        id,
+		ParticipantID: pgtype.UUID{Valid: true, Bytes: participantID},
+	})
+	if err != nil {
+		api.logger.Error("failed to get documents", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetTripDocumentsResponse{
+		Documents: make([]spec.GetTripDocumentsResponseArray, len(documents)),
+	}
+	for i, document := range documents {
+		if response.Documents[i], err = api.documentResponse(document); err != nil {
+			return spec.GetTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+	}
+
+	return spec.GetTripsTripIDDocumentsJSON200Response(response)
+}
+
+// Upload a document to a trip.
+// (POST /trips/{tripId}/documents)
+func (api *API) PostTripsTripIDDocuments(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDDocumentsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDDocumentsJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentSize+1<<20)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return spec.PostTripsTripIDDocumentsJSON413Response(spec.Error{Message: "arquivo muito grande"})
+		}
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	defer file.Close()
+
+	if header.Size > maxDocumentSize {
+		return spec.PostTripsTripIDDocumentsJSON413Response(spec.Error{Message: "arquivo muito grande"})
+	}
+
+	kind := pgstore.DocumentKindOther
+	if value := r.FormValue("kind"); value != "" {
+		var k spec.DocumentKind
+		if err := k.FromValue(value); err != nil {
+			return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "tipo de documento inválido"})
+		}
+		kind = pgstore.DocumentKind(k.ToValue())
+	}
+
+	var owner pgtype.UUID
+	switch r.FormValue("scope") {
+	case "", spec.DocumentScopeTrip.ToValue():
+	case spec.DocumentScopeParticipant.ToValue():
+		owner = pgtype.UUID{Valid: true, Bytes: participantID}
+	default:
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "escopo do documento inválido"})
+	}
+
+	contentType, err := sniffContentType(file)
+	if err != nil {
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	if !documentContentTypes[contentType] {
+		return spec.PostTripsTripIDDocumentsJSON415Response(spec.Error{Message: "o documento deve ser um PDF ou uma imagem JPEG, PNG ou WebP"})
+	}
+
+	key := path.Join("trips", id.String(), "documents", uuid.NewString())
+
+	if err := api.blobs.Put(r.Context(), key, file); err != nil {
+		api.logger.Error("failed to store document", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	documentID, err := api.store.CreateDocument(r.Context(), pgstore.CreateDocumentParams{
+		TripID:        id,
+		ParticipantID: owner,
+		Kind:          kind,
+		FileName:      path.Base(header.Filename),
+		ContentType:   contentType,
+		Size:          header.Size,
+		StorageKey:    key,
+	})
+	if err != nil {
+		api.logger.Error("failed to create document", zap.Error(err), zap.String("trip_id", tripID))
+		api.deleteBlob(key)
+		return spec.PostTripsTripIDDocumentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDDocumentsJSON201Response(spec.CreateDocumentResponse{DocumentID: documentID.String()})
+}
+
+// Get a document of a trip.
+// (GET /trips/{tripId}/documents/{documentId})
+func (api *API) GetTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request, tripID string, documentID string, params spec.GetTripsTripIDDocumentsDocumentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	dID, err := uuid.Parse(documentID)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	document, err := api.store.GetDocument(r.Context(), pgstore.GetDocumentParams{ID: dID, TripID: id})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get document", zap.Error(err), zap.String("document_id", documentID))
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if err != nil || !canReadDocument(document, participantID) {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON404Response(spec.Error{Message: "documento não encontrado"})
+	}
+
+	response, err := api.documentResponse(document)
+	if err != nil {
+		return spec.GetTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDDocumentsDocumentIDJSON200Response(response)
+}
+
+// Delete a document of a trip.
+// (DELETE /trips/{tripId}/documents/{documentId})
+func (api *API) DeleteTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request, tripID string, documentID string, params spec.DeleteTripsTripIDDocumentsDocumentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	dID, err := uuid.Parse(documentID)
+	if err != nil {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	document, err := api.store.GetDocument(r.Context(), pgstore.GetDocumentParams{ID: dID, TripID: id})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get document", zap.Error(err), zap.String("document_id", documentID))
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if err != nil || !canReadDocument(document, participantID) {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON404Response(spec.Error{Message: "documento não encontrado"})
+	}
+
+	deleted, err := api.store.DeleteDocument(r.Context(), pgstore.DeleteDocumentParams{ID: dID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete document", zap.Error(err), zap.String("document_id", documentID))
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDDocumentsDocumentIDJSON404Response(spec.Error{Message: "documento não encontrado"})
+	}
+
+	api.deleteBlob(document.StorageKey)
+
+	return spec.DeleteTripsTripIDDocumentsDocumentIDJSON204Response(nil)
+}
+
+// documentResponse converts document into its response, signing a fresh
+// download URL for it.
+func (api *API) documentResponse(document pgstore.Document) (spec.GetTripDocumentsResponseArray, error) {
+	url, expiresAt, err := api.blobs.SignedURL(document.StorageKey, documentURLTTL)
+	if err != nil {
+		api.logger.Error("failed to sign document url", zap.Error(err), zap.String("document_id", document.ID.String()))
+		return spec.GetTripDocumentsResponseArray{}, err
+	}
+
+	response := spec.GetTripDocumentsResponseArray{
+		ID:           document.ID.String(),
+		Kind:         documentKindResponse(document.Kind),
+		Scope:        spec.DocumentScopeTrip,
+		FileName:     document.FileName,
+		ContentType:  document.ContentType,
+		Size:         document.Size,
+		URL:          url,
+		URLExpiresAt: expiresAt,
+		CreatedAt:    document.CreatedAt.Time,
+	}
+	if document.ParticipantID.Valid {
+		participantID := uuid.UUID(document.ParticipantID.Bytes).String()
+		response.Scope = spec.DocumentScopeParticipant
+		response.ParticipantID = &participantID
+	}
+
+	return response, nil
+}
+
+// deleteBlob removes the file of a document that is gone, even when the
+// request was canceled. It is only logged when it fails, an orphaned file
+// being harmless.
+func (api *API) deleteBlob(key string) {
+	if err := api.blobs.Delete(context.Background(), key); err != nil {
+		api.logger.Warn("failed to delete blob", zap.Error(err), zap.String("storage_key", key))
+	}
+}
+
+// canReadDocument reports whether participantID may see document, the
+// private documents being only found by their owner.
+func canReadDocument(document pgstore.Document, participantID uuid.UUID) bool {
+	return !document.ParticipantID.Valid || document.ParticipantID.Bytes == participantID
+}
+
+// sniffContentType detects the type of file from its first bytes, rewinding
+// it afterwards.
+func sniffContentType(file multipart.File) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+func documentKindResponse(k pgstore.DocumentKind) spec.DocumentKind {
+	switch k {
+	case pgstore.DocumentKindTicket:
+		return spec.DocumentKindTicket
+	case pgstore.DocumentKindPassport:
+		return spec.DocumentKindPassport
+	case pgstore.DocumentKindVisa:
+		return spec.DocumentKindVisa
+	case pgstore.DocumentKindInsurance:
+		return spec.DocumentKindInsurance
+	case pgstore.DocumentKindOther:
+		return spec.DocumentKindOther
+	}
+	return spec.UnknownDocumentKind
+}
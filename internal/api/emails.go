@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Preview an email of a trip as participants receive it.
+// (GET /trips/{tripId}/emails/preview)
+func (api *API) GetTripsTripIDEmailsPreview(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDEmailsPreviewParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDEmailsPreviewJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if params.Template == spec.UnknownEmailPreviewTemplate {
+		return spec.GetTripsTripIDEmailsPreviewJSON400Response(spec.Error{Message: "template inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEmailsPreviewJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.GetTripsTripIDEmailsPreviewJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	loc := locale(params.Locale)
+	if params.Locale == nil || *params.Locale == spec.UnknownLocale {
+		loc, err = api.ownerLocale(r.Context(), id, params.XOwnerEmail)
+		if err != nil {
+			api.logger.Error("failed to get trip owners", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.GetTripsTripIDEmailsPreviewJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+	}
+
+	html, err := api.emails.Preview(r.Context(), id, params.Template.ToValue(), loc)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDEmailsPreviewJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to preview email", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEmailsPreviewJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(html))
+
+	return nil
+}
+
+// ownerLocale returns the language the owner reads the trip in, so previews
+// default to it.
+func (api *API) ownerLocale(ctx context.Context, tripID uuid.UUID, email openapi_types.Email) (pgstore.Locale, error) {
+	owners, err := api.store.GetTripOwners(ctx, tripID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, owner := range owners {
+		if strings.EqualFold(owner.Email, string(email)) {
+			return owner.Locale, nil
+		}
+	}
+
+	return pgstore.LocalePtBR, nil
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/live"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Stream the changes of a trip as resumable server-sent events.
+// (GET /trips/{tripId}/events)
+func (api *API) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDEventsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDEventsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDEventsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEventsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The stream outlives the write timeout of the server.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		api.logger.Error("failed to disable write deadline", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEventsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var (
+		changes     <-chan live.Change
+		unsubscribe func()
+	)
+	if params.LastEventID != nil {
+		changes, unsubscribe = api.changes.Resume(id, *params.LastEventID)
+	} else {
+		changes, unsubscribe = api.changes.Subscribe(id)
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	if _, err := fmt.Fprint(w, ": connected\n\n"); err != nil {
+		return nil
+	}
+
+	for {
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+
+		var (
+			event   wsEvent
+			eventID string
+		)
+
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-heartbeat.C:
+			event = wsEvent{Event: "ping"}
+		case change := <-changes:
+			event = newWSEvent(change)
+			eventID = change.EventID
+		}
+
+		if err := writeEvent(w, eventID, event); err != nil {
+			return nil
+		}
+	}
+}
+
+// writeEvent writes event as an unnamed server-sent event, with its ID when
+// it has one for the stream to be resumed after it.
+func writeEvent(w http.ResponseWriter, eventID string, event wsEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if eventID != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", eventID); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
@@ -0,0 +1,290 @@
+package api
+
+import (
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Get a trip expenses.
+// (GET /trips/{tripId}/expenses)
+func (api *API) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	expenses, err := api.store.GetTripExpenses(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expenses", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetExpensesResponse{Expenses: make([]spec.GetExpensesResponseArray, len(expenses))}
+	for i, expense := range expenses {
+		response.Expenses[i] = expenseResponse(expense)
+	}
+
+	return spec.GetTripsTripIDExpensesJSON200Response(response)
+}
+
+// Log a trip expense.
+// (POST /trips/{tripId}/expenses)
+func (api *API) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateExpenseRequest
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	expenseID, err := api.store.CreateTripExpense(r.Context(), pgstore.CreateTripExpenseParams{
+		TripID:      id,
+		PayerID:     uuid.MustParse(body.PayerID),
+		ActivityID:  optionalUUID(body.ActivityID),
+		Description: body.Description,
+		Amount:      body.Amount,
+		Currency:    body.Currency,
+		Category:    expenseCategory(body.Category),
+		SpentAt:     spentAt(body.SpentAt),
+	})
+	if err != nil {
+		// The trip, the payer or the activity does not exist, or the payer
+		// or the activity belongs to another trip.
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDExpensesJSON422Response(e)
+		}
+		api.logger.Error("failed to create expense", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDExpensesJSON201Response(spec.CreateExpenseResponse{
+		ExpenseID: expenseID.String(),
+	})
+}
+
+// Update a trip expense.
+// (PUT /trips/{tripId}/expenses/{expenseId})
+func (api *API) PutTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string, params spec.PutTripsTripIDExpensesExpenseIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	eID, err := uuid.Parse(expenseID)
+	if err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.UpdateExpenseRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	updated, err := api.store.UpdateTripExpense(r.Context(), pgstore.UpdateTripExpenseParams{
+		PayerID:     uuid.MustParse(body.PayerID),
+		ActivityID:  optionalUUID(body.ActivityID),
+		Description: body.Description,
+		Amount:      body.Amount,
+		Currency:    body.Currency,
+		Category:    expenseCategory(body.Category),
+		SpentAt:     spentAt(body.SpentAt),
+		ID:          eID,
+		TripID:      id,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDExpensesExpenseIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update expense", zap.Error(err), zap.String("expense_id", expenseID))
+		return spec.PutTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDExpensesExpenseIDJSON404Response(spec.Error{Message: "despesa não encontrada"})
+	}
+
+	return spec.PutTripsTripIDExpensesExpenseIDJSON204Response(nil)
+}
+
+// Delete a trip expense.
+// (DELETE /trips/{tripId}/expenses/{expenseId})
+func (api *API) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string, params spec.DeleteTripsTripIDExpensesExpenseIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	eID, err := uuid.Parse(expenseID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	deleted, err := api.store.DeleteTripExpense(r.Context(), pgstore.DeleteTripExpenseParams{ID: eID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete expense", zap.Error(err), zap.String("expense_id", expenseID))
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDExpensesExpenseIDJSON404Response(spec.Error{Message: "despesa não encontrada"})
+	}
+
+	return spec.DeleteTripsTripIDExpensesExpenseIDJSON204Response(nil)
+}
+
+// Get how much was spent on a trip.
+// (GET /trips/{tripId}/expenses/totals)
+func (api *API) GetTripsTripIDExpensesTotals(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesTotalsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	rows, err := api.store.GetTripExpenseTotals(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expense totals", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesTotalsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDExpensesTotalsJSON200Response(spec.GetExpenseTotalsResponse{
+		Totals: expenseTotalsResponse(rows),
+	})
+}
+
+func expenseResponse(expense pgstore.Expense) spec.GetExpensesResponseArray {
+	response := spec.GetExpensesResponseArray{
+		ID:          expense.ID.String(),
+		PayerID:     expense.PayerID.String(),
+		Description: expense.Description,
+		Amount:      expense.Amount,
+		Currency:    expense.Currency,
+		Category:    expenseCategoryResponse(expense.Category),
+		SpentAt:     expense.SpentAt.Time,
+	}
+
+	if expense.ActivityID.Valid {
+		activityID := uuid.UUID(expense.ActivityID.Bytes).String()
+		response.ActivityID = &activityID
+	}
+
+	return response
+}
+
+// expenseTotalsResponse sums the totals of each currency, category and payer
+// into the totals of each currency, which rows are sorted by.
+func expenseTotalsResponse(rows []pgstore.GetTripExpenseTotalsRow) []spec.GetExpenseTotalsResponseArray {
+	totals := []spec.GetExpenseTotalsResponseArray{}
+
+	var payers map[uuid.UUID]int
+	for _, row := range rows {
+		if len(totals) == 0 || totals[len(totals)-1].Currency != row.Currency {
+			totals = append(totals, spec.GetExpenseTotalsResponseArray{
+				Currency:   row.Currency,
+				ByCategory: []spec.GetExpenseTotalsResponseCategoryArray{},
+				ByPayer:    []spec.GetExpenseTotalsResponsePayerArray{},
+			})
+			payers = make(map[uuid.UUID]int)
+		}
+
+		total := &totals[len(totals)-1]
+		total.Total += row.Total
+
+		category := expenseCategoryResponse(row.Category)
+		if n := len(total.ByCategory); n > 0 && total.ByCategory[n-1].Category == category {
+			total.ByCategory[n-1].Total += row.Total
+		} else {
+			total.ByCategory = append(total.ByCategory, spec.GetExpenseTotalsResponseCategoryArray{Category: category, Total: row.Total})
+		}
+
+		if i, ok := payers[row.PayerID]; ok {
+			total.ByPayer[i].Total += row.Total
+		} else {
+			payers[row.PayerID] = len(total.ByPayer)
+			total.ByPayer = append(total.ByPayer, spec.GetExpenseTotalsResponsePayerArray{ParticipantID: row.PayerID.String(), Total: row.Total})
+		}
+	}
+
+	return totals
+}
+
+func expenseCategoryResponse(category pgstore.ExpenseCategory) spec.ExpenseCategory {
+	switch category {
+	case pgstore.ExpenseCategoryFood:
+		return spec.ExpenseCategoryFood
+	case pgstore.ExpenseCategoryTransport:
+		return spec.ExpenseCategoryTransport
+	case pgstore.ExpenseCategoryLodging:
+		return spec.ExpenseCategoryLodging
+	case pgstore.ExpenseCategoryTickets:
+		return spec.ExpenseCategoryTickets
+	case pgstore.ExpenseCategoryShopping:
+		return spec.ExpenseCategoryShopping
+	case pgstore.ExpenseCategoryOther:
+		return spec.ExpenseCategoryOther
+	}
+	return spec.UnknownExpenseCategory
+}
+
+// expenseCategory converts an optional request category into the stored one,
+// defaulting to other.
+func expenseCategory(category *spec.ExpenseCategory) pgstore.ExpenseCategory {
+	if category == nil || *category == spec.UnknownExpenseCategory {
+		return pgstore.ExpenseCategoryOther
+	}
+	return pgstore.ExpenseCategory(category.ToValue())
+}
+
+// spentAt returns when an expense was made, now when the request leaves it
+// out.
+func spentAt(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	}
+	return pgtype.Timestamp{Valid: true, Time: *t}
+}
+
+// optionalUUID maps an omitted, already validated, ID to NULL.
+func optionalUUID(s *string) pgtype.UUID {
+	if s == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Valid: true, Bytes: uuid.MustParse(*s)}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"travel-api/internal/geocoding"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// geocode resolves a destination or an address for the maps. It returns false
+// when there is no geocoder, the place is not found or the geocoder fails,
+// the trips and activities being saved without a place rather than failing.
+func (api *API) geocode(ctx context.Context, query string) (geocoding.Place, bool) {
+	query = strings.TrimSpace(query)
+	if api.locations == nil || query == "" {
+		return geocoding.Place{}, false
+	}
+
+	place, err := api.locations.Geocode(ctx, query)
+	if err != nil {
+		if !errors.Is(err, geocoding.ErrNotFound) {
+			api.logger.Warn("failed to geocode place", zap.Error(err), zap.String("query", query))
+		}
+		return geocoding.Place{}, false
+	}
+
+	return place, true
+}
+
+// locateTrip geocodes the destination of the trip, clearing the place of the
+// previous destination when the new one is not found.
+func (api *API) locateTrip(ctx context.Context, tripID uuid.UUID, destination string) {
+	if api.locations == nil {
+		return
+	}
+
+	update := pgstore.UpdateTripPlaceParams{ID: tripID}
+	if place, ok := api.geocode(ctx, destination); ok {
+		update.Latitude = pgtype.Float8{Valid: true, Float64: place.Latitude}
+		update.Longitude = pgtype.Float8{Valid: true, Float64: place.Longitude}
+		update.PlaceName = pgtype.Text{Valid: true, String: place.Name}
+	}
+
+	if err := api.store.UpdateTripPlace(ctx, update); err != nil {
+		api.logger.Warn("failed to update trip place", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+// locateActivity geocodes the address of an activity, returning its place
+// name and its coordinates, the ones given with the activity being kept over
+// the geocoded ones.
+func (api *API) locateActivity(ctx context.Context, address pgtype.Text, latitude, longitude pgtype.Float8) (pgtype.Float8, pgtype.Float8, pgtype.Text) {
+	if !address.Valid {
+		return latitude, longitude, pgtype.Text{}
+	}
+
+	place, ok := api.geocode(ctx, address.String)
+	if !ok {
+		return latitude, longitude, pgtype.Text{}
+	}
+
+	if !latitude.Valid {
+		latitude = pgtype.Float8{Valid: true, Float64: place.Latitude}
+		longitude = pgtype.Float8{Valid: true, Float64: place.Longitude}
+	}
+
+	return latitude, longitude, pgtype.Text{Valid: true, String: place.Name}
+}
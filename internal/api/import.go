@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// maxImportRows caps how many activities a single import can create.
+const maxImportRows = 500
+
+// Import many activities at once.
+// (POST /trips/{tripId}/activities/import)
+func (api *API) PostTripsTripIDActivitiesImport(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDActivitiesImportParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var (
+		rows []spec.ImportActivityRow
+		// unreadable maps the rows of a CSV that could not be read to why.
+		unreadable map[int]string
+	)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/csv" {
+		rows, unreadable, err = parseActivitiesCSV(r.Body)
+		if err != nil {
+			return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "CSV inválido: " + err.Error()})
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&rows); err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if len(rows) == 0 {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "nenhuma atividade para importar"})
+	}
+
+	if len(rows) > maxImportRows {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: fmt.Sprintf("no máximo %d atividades por importação", maxImportRows)})
+	}
+
+	overlaps, err := api.activityOverlaps(r.Context(), id, params.Force)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var (
+		activities = make([]pgstore.CreateActivityParams, len(rows))
+		rowErrors  []spec.ImportActivitiesErrorResponseArray
+	)
+
+	for i, row := range rows {
+		if message, ok := unreadable[i+1]; ok {
+			rowErrors = append(rowErrors, spec.ImportActivitiesErrorResponseArray{Row: i + 1, Message: message})
+			continue
+		}
+
+		if err := api.validator.Struct(row); err != nil {
+			rowErrors = append(rowErrors, spec.ImportActivitiesErrorResponseArray{Row: i + 1, Message: "Invalid input:" + err.Error()})
+			continue
+		}
+
+		if !occursDuringTrip(trip, row.OccursAt, row.EndsAt) {
+			rowErrors = append(rowErrors, spec.ImportActivitiesErrorResponseArray{Row: i + 1, Message: outsideTripMessage(trip)})
+			continue
+		}
+
+		if conflicts := overlaps(uuid.Nil, row.OccursAt, row.EndsAt); len(conflicts) > 0 {
+			rowErrors = append(rowErrors, spec.ImportActivitiesErrorResponseArray{
+				Row:     i + 1,
+				Message: activityConflictMessage + ": " + strings.Join(conflicts, ", "),
+			})
+			continue
+		}
+
+		activities[i] = pgstore.CreateActivityParams{
+			TripID:    id,
+			Title:     row.Title,
+			OccursAt:  utcTimestamp(row.OccursAt),
+			EndsAt:    optionalTimestamp(row.EndsAt),
+			Address:   optionalText(row.Address),
+			Latitude:  optionalFloat8(row.Latitude),
+			Longitude: optionalFloat8(row.Longitude),
+			Category:  activityCategory(row.Category),
+		}
+	}
+
+	if len(rowErrors) > 0 {
+		return spec.PostTripsTripIDActivitiesImportJSON422Response(spec.ImportActivitiesErrorResponse{
+			Message: "algumas atividades são inválidas, nada foi importado",
+			Errors:  rowErrors,
+		})
+	}
+
+	activityIDs, err := api.store.CreateActivitiesTx(r.Context(), api.pool, activities)
+	if err != nil {
+		api.logger.Error("failed to import activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	ids := make([]string, len(activityIDs))
+	for i, activityID := range activityIDs {
+		ids[i] = activityID.String()
+	}
+
+	return spec.PostTripsTripIDActivitiesImportJSON201Response(spec.ImportActivitiesResponse{ActivityIds: ids})
+}
+
+// parseActivitiesCSV reads activities from a CSV whose first line is a header
+// naming the columns. Only title and occurs_at are mandatory columns. The rows
+// that cannot be read are returned as zero values, and reported in unreadable
+// by their 1-based index along with the line of the field at fault. Only a
+// missing or incomplete header is an error.
+func parseActivitiesCSV(r io.Reader) (rows []spec.ImportActivityRow, unreadable map[int]string, err error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	// Missing trailing fields are empty, and other rows are still read when
+	// one has too many.
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{"title", "occurs_at"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	unreadable = make(map[int]string)
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, unreadable, nil
+		}
+
+		rows = append(rows, spec.ImportActivityRow{})
+		if err != nil {
+			// The reader resumes at the line after a malformed record.
+			unreadable[len(rows)] = err.Error()
+			continue
+		}
+
+		row, err := parseActivityRecord(cr, columns, record)
+		if err != nil {
+			unreadable[len(rows)] = err.Error()
+			continue
+		}
+		rows[len(rows)-1] = row
+	}
+}
+
+// parseActivityRecord reads an activity from the CSV record last read by cr,
+// whose fields are named by columns.
+func parseActivityRecord(cr *csv.Reader, columns map[string]int, record []string) (spec.ImportActivityRow, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	invalid := func(name string) error {
+		// A missing field is reported on the line the record starts.
+		i := columns[name]
+		if i >= len(record) {
+			i = 0
+		}
+		line, _ := cr.FieldPos(i)
+		return fmt.Errorf("line %d: invalid %s", line, name)
+	}
+
+	var (
+		row spec.ImportActivityRow
+		err error
+	)
+	row.Title = field("title")
+
+	if row.OccursAt, err = time.Parse(time.RFC3339, field("occurs_at")); err != nil {
+		return row, invalid("occurs_at")
+	}
+
+	if v := field("ends_at"); v != "" {
+		endsAt, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return row, invalid("ends_at")
+		}
+		row.EndsAt = &endsAt
+	}
+
+	if v := field("category"); v != "" {
+		var category spec.ActivityCategory
+		if err := category.FromValue(v); err != nil {
+			return row, invalid("category")
+		}
+		row.Category = &category
+	}
+
+	if v := field("address"); v != "" {
+		row.Address = &v
+	}
+
+	for _, name := range []string{"latitude", "longitude"} {
+		v := field(name)
+		if v == "" {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return row, invalid(name)
+		}
+
+		if name == "latitude" {
+			row.Latitude = &f
+		} else {
+			row.Longitude = &f
+		}
+	}
+
+	return row, nil
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/domain"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// joinCodeAlphabet leaves out characters easily mistaken for one another,
+// such as O and 0, since join codes are typed by hand.
+const joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const joinCodeLength = 6
+
+// joinCodeAttempts is how many codes are drawn before giving up on finding one
+// that no other trip uses.
+const joinCodeAttempts = 5
+
+// Generate the join code of a trip.
+// (POST /trips/{tripId}/join-code)
+func (api *API) PostTripsTripIDJoinCode(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDJoinCodeParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDJoinCodeJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	// Codes are short, so a collision with another trip is possible; just
+	// draw a new one.
+	for attempt := 1; ; attempt++ {
+		code, err := newJoinCode()
+		if err != nil {
+			api.logger.Error("failed to generate join code", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+
+		err = api.store.SetTripJoinCode(r.Context(), pgstore.SetTripJoinCodeParams{
+			TripID: id,
+			Code:   code,
+		})
+		if err == nil {
+			return spec.PostTripsTripIDJoinCodeJSON201Response(spec.CreateTripJoinCodeResponse{Code: code})
+		}
+
+		if !isUniqueViolation(err) || attempt == joinCodeAttempts {
+			api.logger.Error("failed to set trip join code", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDJoinCodeJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+	}
+}
+
+// Join a trip with its join code.
+// (POST /trips/join)
+func (api *API) PostTripsJoin(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.JoinTripRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	email := strings.ToLower(string(body.Email))
+
+	tripID, err := api.store.GetTripIDByJoinCode(r.Context(), strings.ToUpper(body.Code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsJoinJSON400Response(spec.Error{Message: "código de acesso inválido"})
+		}
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripID)
+	if err != nil {
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	switch domain.TripStatus(trip.Status) {
+	case domain.TripStatusCompleted, domain.TripStatusCancelled:
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "esta viagem não aceita mais participantes"})
+	}
+
+	// The code is shared with many people, so the answer must not tell who
+	// was already invited: it is the same for every e-mail, and only the
+	// invitation emailed to the address carries the participant.
+	joined := spec.JoinTripResponse{TripID: tripID.String()}
+
+	if _, err := api.store.GetParticipantByEmail(r.Context(), pgstore.GetParticipantByEmailParams{
+		TripID: tripID,
+		Email:  email,
+	}); err == nil {
+		if trip.MaxParticipants.Valid {
+			active, err := api.store.CountActiveTripParticipants(r.Context(), tripID)
+			if err != nil {
+				return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+			}
+			joined.Waitlisted = active >= int64(trip.MaxParticipants.Int32)
+		}
+
+		return spec.PostTripsJoinJSON202Response(joined)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The participant is created unconfirmed, the invitation carrying the
+	// link they confirm with. The seats are counted in the same transaction,
+	// so concurrent joins cannot fill the trip beyond its maximum.
+	waitlisted, err := api.store.JoinTripTx(r.Context(), api.pool, pgstore.InsertParticipantParams{
+		TripID: tripID,
+		Email:  email,
+	})
+	if err != nil && !isUniqueViolation(err) {
+		api.logger.Error("failed to join trip", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return spec.PostTripsJoinJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	joined.Waitlisted = waitlisted
+
+	return spec.PostTripsJoinJSON202Response(joined)
+}
+
+// newJoinCode returns a random code of joinCodeLength characters from
+// joinCodeAlphabet.
+func newJoinCode() (string, error) {
+	b := make([]byte, joinCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(joinCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = joinCodeAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,381 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Get the journal of a trip.
+// (GET /trips/{tripId}/journal)
+func (api *API) GetTripsTripIDJournal(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDJournalParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDJournalJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetTripsTripIDJournalJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetTripsTripIDJournalJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.GetTripsTripIDJournalJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	entries, err := api.store.GetTripJournalEntries(r.Context(), pgstore.GetTripJournalEntriesParams{
+		TripID:        id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to get journal entries", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDJournalJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetTripJournalResponse{
+		Entries: make([]spec.GetTripJournalResponseArray, len(entries)),
+	}
+
+	for i, entry := range entries {
+		response.Entries[i] = spec.GetTripJournalResponseArray{
+			ID:            entry.ID.String(),
+			ParticipantID: entry.ParticipantID.String(),
+			AuthorEmail:   openapi_types.Email(entry.Email),
+			Date:          openapi_types.Date{Time: entry.Day.Time},
+			Body:          entry.Body,
+			Visibility:    journalVisibilityResponse(entry.Visibility),
+			CreatedAt:     entry.CreatedAt.Time,
+			UpdatedAt:     entry.UpdatedAt.Time,
+		}
+		if entry.Name.Valid {
+			response.Entries[i].AuthorName = &entry.Name.String
+		}
+		if entry.Title.Valid {
+			response.Entries[i].Title = &entry.Title.String
+		}
+	}
+
+	return spec.GetTripsTripIDJournalJSON200Response(response)
+}
+
+// Write an entry in the journal of a trip.
+// (POST /trips/{tripId}/journal)
+func (api *API) PostTripsTripIDJournal(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDJournalParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDJournalJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreateJournalEntryRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Body = sanitizeMarkdown(body.Body)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDJournalJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if first, last := tripDays(trip); body.Date.Before(first) || body.Date.After(last) {
+		return spec.PostTripsTripIDJournalJSON422Response(spec.Error{Message: outsideTripJournalMessage(first, last)})
+	}
+
+	entryID, err := api.store.CreateJournalEntry(r.Context(), pgstore.CreateJournalEntryParams{
+		TripID:        id,
+		ParticipantID: participantID,
+		Day:           pgtype.Date{Valid: true, Time: body.Date.Time},
+		Title:         journalTitle(body.Title),
+		Body:          body.Body,
+		Visibility:    journalVisibility(body.Visibility),
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDJournalJSON422Response(e)
+		}
+		api.logger.Error("failed to create journal entry", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDJournalJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDJournalJSON201Response(spec.CreateJournalEntryResponse{EntryID: entryID.String()})
+}
+
+// Update a journal entry written by the participant.
+// (PUT /trips/{tripId}/journal/{entryId})
+func (api *API) PutTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request, tripID string, entryID string, params spec.PutTripsTripIDJournalEntryIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	eID, err := uuid.Parse(entryID)
+	if err != nil {
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateJournalEntryRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Body = sanitizeMarkdown(body.Body)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDJournalEntryIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if first, last := tripDays(trip); body.Date.Before(first) || body.Date.After(last) {
+		return spec.PutTripsTripIDJournalEntryIDJSON422Response(spec.Error{Message: outsideTripJournalMessage(first, last)})
+	}
+
+	updated, err := api.store.UpdateJournalEntry(r.Context(), pgstore.UpdateJournalEntryParams{
+		ID:            eID,
+		TripID:        id,
+		ParticipantID: participantID,
+		Day:           pgtype.Date{Valid: true, Time: body.Date.Time},
+		Title:         journalTitle(body.Title),
+		Body:          body.Body,
+		Visibility:    journalVisibility(body.Visibility),
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDJournalEntryIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update journal entry", zap.Error(err), zap.String("entry_id", entryID))
+		return spec.PutTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDJournalEntryIDJSON404Response(spec.Error{Message: "registro do diário não encontrado"})
+	}
+
+	return spec.PutTripsTripIDJournalEntryIDJSON204Response(nil)
+}
+
+// Delete a journal entry written by the participant.
+// (DELETE /trips/{tripId}/journal/{entryId})
+func (api *API) DeleteTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request, tripID string, entryID string, params spec.DeleteTripsTripIDJournalEntryIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	eID, err := uuid.Parse(entryID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	deleted, err := api.store.DeleteJournalEntry(r.Context(), pgstore.DeleteJournalEntryParams{
+		ID:            eID,
+		TripID:        id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete journal entry", zap.Error(err), zap.String("entry_id", entryID))
+		return spec.DeleteTripsTripIDJournalEntryIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDJournalEntryIDJSON404Response(spec.Error{Message: "registro do diário não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDJournalEntryIDJSON204Response(nil)
+}
+
+// Export the journal of a trip once it is over.
+// (GET /trips/{tripId}/journal/export)
+func (api *API) GetTripsTripIDJournalExport(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDJournalExportParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDJournalExportJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetTripsTripIDJournalExportJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetTripsTripIDJournalExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.GetTripsTripIDJournalExportJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDJournalExportJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDJournalExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if time.Now().Before(trip.EndsAt.Time) {
+		return spec.GetTripsTripIDJournalExportJSON409Response(spec.Error{Message: "o diário só pode ser exportado depois da viagem"})
+	}
+
+	entries, err := api.store.GetTripJournalEntries(r.Context(), pgstore.GetTripJournalEntriesParams{
+		TripID:        id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to get journal entries", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDJournalExportJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="diario.md"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(journalMarkdown(trip, entries)))
+
+	return nil
+}
+
+// journalMarkdown renders the journal of trip as a Markdown document, its
+// entries, sorted by day, under a heading per day.
+func journalMarkdown(trip pgstore.Trip, entries []pgstore.GetTripJournalEntriesRow) string {
+	first, last := tripDays(trip)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", trip.Destination)
+	fmt.Fprintf(&b, "Diário da viagem de %s a %s.\n", first.Format(time.DateOnly), last.Format(time.DateOnly))
+
+	if len(entries) == 0 {
+		b.WriteString("\nNenhum registro no diário.\n")
+	}
+
+	var day time.Time
+	for _, entry := range entries {
+		if !entry.Day.Time.Equal(day) {
+			day = entry.Day.Time
+			fmt.Fprintf(&b, "\n## %s\n", day.Format(time.DateOnly))
+		}
+
+		if entry.Title.Valid {
+			fmt.Fprintf(&b, "\n### %s\n", entry.Title.String)
+		}
+
+		author := entry.Email
+		if entry.Name.Valid {
+			author = entry.Name.String
+		}
+		if entry.Visibility == pgstore.JournalVisibilityPrivate {
+			author += " (privado)"
+		}
+
+		fmt.Fprintf(&b, "\n*%s*\n\n%s\n", author, entry.Body)
+	}
+
+	return b.String()
+}
+
+// tripDays returns the first and last days of trip in its time zone, as
+// dates at midnight UTC like the days of the journal.
+func tripDays(trip pgstore.Trip) (time.Time, time.Time) {
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	day := func(t time.Time) time.Time {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return day(trip.StartsAt.Time), day(trip.EndsAt.Time)
+}
+
+func outsideTripJournalMessage(first, last time.Time) string {
+	return fmt.Sprintf(
+		"o registro deve ser de um dia da viagem, entre %s e %s",
+		first.Format(time.DateOnly),
+		last.Format(time.DateOnly),
+	)
+}
+
+// journalTitle converts an optional title into a nullable text, a blank one
+// being left out.
+func journalTitle(title *string) pgtype.Text {
+	if title == nil || strings.TrimSpace(*title) == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{Valid: true, String: strings.TrimSpace(*title)}
+}
+
+func journalVisibility(v *spec.JournalVisibility) pgstore.JournalVisibility {
+	if v == nil || *v == spec.UnknownJournalVisibility {
+		return pgstore.JournalVisibilityShared
+	}
+	return pgstore.JournalVisibility(v.ToValue())
+}
+
+func journalVisibilityResponse(v pgstore.JournalVisibility) spec.JournalVisibility {
+	switch v {
+	case pgstore.JournalVisibilityShared:
+		return spec.JournalVisibilityShared
+	case pgstore.JournalVisibilityPrivate:
+		return spec.JournalVisibilityPrivate
+	}
+	return spec.UnknownJournalVisibility
+}
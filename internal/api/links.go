@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// linkPreviewTimeout bounds the whole preview job, page download and
+// database write included.
+const linkPreviewTimeout = 15 * time.Second
+
+var (
+	errLinkMalformed = errors.New("link inválido")
+	errLinkScheme    = errors.New("o link deve começar com http:// ou https://")
+	errLinkHost      = errors.New("o link deve ter um domínio")
+)
+
+// trackingParams are query parameters added by ads and newsletters that do
+// not change the page a link points to.
+var trackingParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"dclid":   true,
+	"msclkid": true,
+	"yclid":   true,
+	"igshid":  true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"_ga":     true,
+	"_gl":     true,
+}
+
+// normalizeLinkURL validates that raw is an http(s) URL and returns it with
+// the scheme and host lowercased and tracking parameters removed. The returned
+// error message is meant to be shown to the user.
+func normalizeLinkURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", errLinkMalformed
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errLinkScheme
+	}
+
+	if u.Hostname() == "" {
+		return "", errLinkHost
+	}
+	u.Host = strings.ToLower(u.Host)
+
+	if u.RawQuery != "" {
+		query := u.Query()
+		for param := range query {
+			if trackingParams[strings.ToLower(param)] || strings.HasPrefix(strings.ToLower(param), "utm_") {
+				query.Del(param)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// fetchLinkPreview downloads the page at linkURL and stores its metadata on
+// the link. It is meant to run in the background after a link is saved. When
+// the page cannot be fetched the preview is cleared, so a link whose URL was
+// changed does not keep the card of the previous page.
+func (api *API) fetchLinkPreview(linkID uuid.UUID, linkURL string) {
+	ctx, cancel := context.WithTimeout(context.Background(), linkPreviewTimeout)
+	defer cancel()
+
+	preview, err := api.previews.Fetch(ctx, linkURL)
+	if err != nil {
+		api.logger.Warn("failed to fetch link preview", zap.Error(err), zap.String("link_id", linkID.String()))
+	}
+
+	if err := api.store.SetLinkPreview(ctx, pgstore.SetLinkPreviewParams{
+		PreviewTitle:       nonEmptyText(preview.Title),
+		PreviewDescription: nonEmptyText(preview.Description),
+		PreviewFaviconUrl:  nonEmptyText(preview.FaviconURL),
+		PreviewImageUrl:    nonEmptyText(preview.ImageURL),
+		ID:                 linkID,
+	}); err != nil {
+		api.logger.Error("failed to store link preview", zap.Error(err), zap.String("link_id", linkID.String()))
+	}
+}
+
+func nonEmptyText(s string) pgtype.Text {
+	return pgtype.Text{Valid: s != "", String: s}
+}
+
+// Open a trip link.
+// (GET /l/{linkId})
+func (api *API) GetLLinkID(w http.ResponseWriter, r *http.Request, linkID string) *spec.Response {
+	id, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.GetLLinkIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	linkURL, err := api.store.GetLinkURL(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetLLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
+		}
+		return spec.GetLLinkIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// A click that fails to be recorded must not keep people from the link.
+	if err := api.store.RecordLinkClick(r.Context(), id); err != nil {
+		api.logger.Error("failed to record link click", zap.Error(err), zap.String("link_id", linkID))
+	}
+
+	http.Redirect(w, r, linkURL, http.StatusFound)
+	return nil
+}
+
+// linkClicks returns how many times every link of a trip was opened.
+func (api *API) linkClicks(ctx context.Context, tripID uuid.UUID) (map[uuid.UUID]int, error) {
+	rows, err := api.store.GetTripLinkClickCounts(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	clicks := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		clicks[row.LinkID] = int(row.Clicks)
+	}
+
+	return clicks, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/live"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// liveHeartbeat keeps the stream of an idle trip from being closed by proxies.
+const liveHeartbeat = 25 * time.Second
+
+// Stream the changes of a trip.
+// (GET /trips/{tripId}/live)
+func (api *API) GetTripsTripIDLive(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLiveJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDLiveJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLiveJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The stream outlives the write timeout of the server.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		api.logger.Error("failed to disable write deadline", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLiveJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	changes, unsubscribe := api.changes.Subscribe(id)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	if _, err := fmt.Fprint(w, ": connected\n\n"); err != nil {
+		return nil
+	}
+
+	for {
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		case change := <-changes:
+			if err := writeChange(w, change); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// writeChange writes change as a server-sent event named after its table and
+// operation, such as activities.update.
+func writeChange(w http.ResponseWriter, change live.Change) error {
+	if change == live.Resync {
+		_, err := fmt.Fprint(w, "event: resync\ndata: {}\n\n")
+		return err
+	}
+
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", changeEvent(change), data)
+	return err
+}
+
+// changeEvent names change after its table and operation, such as
+// activities.update, or resync.
+func changeEvent(change live.Change) string {
+	if change == live.Resync {
+		return change.Table
+	}
+
+	return change.Table + "." + change.Op
+}
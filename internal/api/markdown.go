@@ -0,0 +1,218 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// allowedLinkSchemes are the schemes the links and images of user supplied
+// markdown may point to. Destinations without a scheme are relative to the
+// page and allowed too.
+var allowedLinkSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// sanitizeMarkdown strips raw HTML from user supplied markdown, dropping the
+// content of script and style elements entirely, and points the links and
+// images whose destination uses a scheme outside allowedLinkSchemes to #.
+// Plain markdown syntax is left untouched.
+func sanitizeMarkdown(s string) string {
+	return strings.TrimSpace(sanitizeLinks(stripHTML(s)))
+}
+
+// stripHTML removes the HTML tags and comments of s. Removing a tag may join
+// the text around it into a new one, as in <<b>script>, so it is repeated
+// until nothing is left to remove.
+func stripHTML(s string) string {
+	for {
+		stripped := stripHTMLOnce(s)
+		if stripped == s {
+			return s
+		}
+		s = stripped
+	}
+}
+
+func stripHTMLOnce(s string) string {
+	z := html.NewTokenizer(strings.NewReader(s))
+
+	var (
+		b    strings.Builder
+		skip bool
+	)
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// What is left at the end is text that only looked like the
+			// start of a tag, as in a<b, which is not HTML.
+			if errors.Is(z.Err(), io.EOF) && !skip {
+				b.Write(z.Raw())
+			}
+			return b.String()
+		case html.TextToken:
+			if !skip {
+				b.Write(z.Raw())
+			}
+		case html.StartTagToken:
+			if name, _ := z.TagName(); isRawTextElement(name) {
+				skip = true
+			}
+		case html.EndTagToken:
+			if name, _ := z.TagName(); isRawTextElement(name) {
+				skip = false
+			}
+		}
+	}
+}
+
+func isRawTextElement(name []byte) bool {
+	switch string(name) {
+	case "script", "style":
+		return true
+	}
+	return false
+}
+
+// sanitizeLinks parses the destinations of the inline links and images and of
+// the link reference definitions of s, replacing the unsafe ones with #.
+func sanitizeLinks(s string) string {
+	var b strings.Builder
+	last := 0
+
+	for i := 0; i < len(s); i++ {
+		from := -1
+		if i == 0 || s[i-1] == '\n' {
+			from = referenceDefinition(s, i)
+		}
+		if from < 0 && s[i] == ']' && i+1 < len(s) && s[i+1] == '(' {
+			from = i + 2
+		}
+		if from < 0 {
+			continue
+		}
+
+		start, end := linkDestination(s, from)
+		if !isSafeLinkDestination(s[start:end]) {
+			b.WriteString(s[last:start])
+			b.WriteString("#")
+			last = end
+		}
+		i = max(i, end-1)
+	}
+
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// referenceDefinition returns where the destination of the link reference
+// definition on the line starting at i begins, as in [label]: destination, or
+// -1 when the line is not one.
+func referenceDefinition(s string, i int) int {
+	for n := 0; n < 3 && i < len(s) && s[i] == ' '; n++ {
+		i++
+	}
+	if i >= len(s) || s[i] != '[' {
+		return -1
+	}
+
+	for i++; i < len(s) && s[i] != ']'; i++ {
+		if s[i] == '\\' {
+			i++
+		}
+	}
+	if i+1 >= len(s) || s[i+1] != ':' {
+		return -1
+	}
+
+	return i + 2
+}
+
+// linkDestination returns the bounds of the link destination starting at i,
+// after its leading whitespace. As in CommonMark, it is either enclosed in
+// <>, or runs up to the first whitespace or unbalanced parenthesis.
+func linkDestination(s string, i int) (int, int) {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n') {
+		i++
+	}
+	start := i
+
+	if i < len(s) && s[i] == '<' {
+		for i++; i < len(s) && s[i] != '>' && s[i] != '\n'; i++ {
+			if s[i] == '\\' {
+				i++
+			}
+		}
+		if i < len(s) && s[i] == '>' {
+			i++
+		}
+		return start, min(i, len(s))
+	}
+
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return start, i
+			}
+			depth--
+		case ' ', '\t', '\n', '\r':
+			return start, i
+		}
+	}
+
+	return start, len(s)
+}
+
+// isSafeLinkDestination reports whether the link destination dest, as found
+// in the markdown, has no scheme or one of allowedLinkSchemes once decoded
+// the way a renderer and a browser would.
+func isSafeLinkDestination(dest string) bool {
+	if strings.HasPrefix(dest, "<") {
+		dest = strings.TrimSuffix(dest[1:], ">")
+	}
+
+	dest = html.UnescapeString(unescapeMarkdown(dest))
+
+	// Browsers drop whitespace and control characters from URLs, which
+	// would otherwise hide the scheme.
+	dest = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, dest)
+
+	u, err := url.Parse(dest)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "" || allowedLinkSchemes[u.Scheme]
+}
+
+// unescapeMarkdown removes the backslashes escaping ASCII punctuation in s.
+func unescapeMarkdown(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte(markdownPunctuation, s[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// markdownPunctuation are the characters a backslash escapes in markdown.
+const markdownPunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
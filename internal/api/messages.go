@@ -0,0 +1,179 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// defaultMessagesPageSize and maxMessagesPageSize bound how many messages a
+// page of the board has.
+const (
+	defaultMessagesPageSize = 50
+	maxMessagesPageSize     = 100
+)
+
+// Get a trip messages, newest first.
+// (GET /trips/{tripId}/messages)
+func (api *API) GetTripsTripIDMessages(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDMessagesParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDMessagesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var cursor pgstore.Cursor
+	if params.Cursor != nil {
+		cursor, err = pgstore.ParseCursor(*params.Cursor)
+		if err != nil {
+			return spec.GetTripsTripIDMessagesJSON400Response(spec.Error{Message: "cursor inválido"})
+		}
+	}
+
+	size := defaultMessagesPageSize
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxMessagesPageSize {
+			return spec.GetTripsTripIDMessagesJSON400Response(spec.Error{Message: "limite inválido"})
+		}
+		size = *params.Limit
+	}
+
+	page, err := api.store.TripMessagesPage(r.Context(), id, cursor, int32(size))
+	if err != nil {
+		api.logger.Error("failed to get messages", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDMessagesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetMessagesResponse{
+		Messages: make([]spec.GetMessagesResponseArray, len(page.Items)),
+	}
+
+	for i, message := range page.Items {
+		response.Messages[i] = spec.GetMessagesResponseArray{
+			ID:            message.ID.String(),
+			ParticipantID: message.ParticipantID.String(),
+			AuthorEmail:   openapi_types.Email(message.Email),
+			Body:          message.Body,
+			CreatedAt:     message.CreatedAt.Time,
+		}
+		if message.Name.Valid {
+			response.Messages[i].AuthorName = &message.Name.String
+		}
+	}
+
+	if page.Next != nil {
+		next := page.Next.String()
+		response.NextCursor = &next
+	}
+
+	return spec.GetTripsTripIDMessagesJSON200Response(response)
+}
+
+// Write a message on a trip board.
+// (POST /trips/{tripId}/messages)
+func (api *API) PostTripsTripIDMessages(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDMessagesParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDMessagesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDMessagesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.CreateMessageRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDMessagesJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Body = strings.TrimSpace(body.Body)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDMessagesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	messageID, err := api.store.CreateMessage(r.Context(), pgstore.CreateMessageParams{
+		TripID:        id,
+		ParticipantID: participantID,
+		Body:          body.Body,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDMessagesJSON422Response(e)
+		}
+		api.logger.Error("failed to create message", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDMessagesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDMessagesJSON201Response(spec.CreateMessageResponse{MessageID: messageID.String()})
+}
+
+// Count the messages a participant has not read.
+// (GET /trips/{tripId}/messages/unread)
+func (api *API) GetTripsTripIDMessagesUnread(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDMessagesUnreadParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDMessagesUnreadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetTripsTripIDMessagesUnreadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetTripsTripIDMessagesUnreadJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if err != nil || participant.TripID != id {
+		return spec.GetTripsTripIDMessagesUnreadJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	unread, err := api.store.CountUnreadMessages(r.Context(), pgstore.CountUnreadMessagesParams{
+		TripID:        id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to count unread messages", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDMessagesUnreadJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDMessagesUnreadJSON200Response(spec.GetUnreadMessagesResponse{Unread: unread})
+}
+
+// Mark a trip messages as read by a participant.
+// (POST /trips/{tripId}/messages/read)
+func (api *API) PostTripsTripIDMessagesRead(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDMessagesReadParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDMessagesReadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDMessagesReadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := api.store.MarkMessagesRead(r.Context(), pgstore.MarkMessagesReadParams{
+		ParticipantID: participantID,
+		TripID:        id,
+	}); err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDMessagesReadJSON422Response(e)
+		}
+		api.logger.Error("failed to mark messages read", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDMessagesReadJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDMessagesReadJSON204Response(nil)
+}
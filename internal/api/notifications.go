@@ -0,0 +1,106 @@
+package api
+
+import (
+	"net/http"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Get the notifications of a participant, newest first.
+// (GET /notifications)
+func (api *API) GetNotifications(w http.ResponseWriter, r *http.Request, params spec.GetNotificationsParams) *spec.Response {
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.GetNotificationsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	notifications, err := api.store.GetParticipantNotifications(r.Context(), pgstore.GetParticipantNotificationsParams{
+		ParticipantID: participantID,
+		UnreadOnly:    params.Unread != nil && *params.Unread,
+	})
+	if err != nil {
+		api.logger.Error("failed to get notifications", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.GetNotificationsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetNotificationsResponse{
+		Notifications: make([]spec.GetNotificationsResponseArray, len(notifications)),
+	}
+
+	for i, notification := range notifications {
+		response.Notifications[i] = spec.GetNotificationsResponseArray{
+			ID:        notification.ID.String(),
+			TripID:    notification.TripID.String(),
+			Event:     notificationEventResponse(notification.Event),
+			SubjectID: notification.SubjectID.String(),
+			CreatedAt: notification.CreatedAt.Time,
+		}
+		if notification.ReadAt.Valid {
+			response.Notifications[i].ReadAt = &notification.ReadAt.Time
+		}
+	}
+
+	return spec.GetNotificationsJSON200Response(response)
+}
+
+// Mark all the notifications of a participant as read.
+// (POST /notifications/read)
+func (api *API) PostNotificationsRead(w http.ResponseWriter, r *http.Request, params spec.PostNotificationsReadParams) *spec.Response {
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostNotificationsReadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := api.store.MarkAllNotificationsRead(r.Context(), participantID); err != nil {
+		api.logger.Error("failed to mark notifications read", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostNotificationsReadJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostNotificationsReadJSON204Response(nil)
+}
+
+// Mark a notification as read.
+// (POST /notifications/{notificationId}/read)
+func (api *API) PostNotificationsNotificationIDRead(w http.ResponseWriter, r *http.Request, notificationID string, params spec.PostNotificationsNotificationIDReadParams) *spec.Response {
+	id, err := uuid.Parse(notificationID)
+	if err != nil {
+		return spec.PostNotificationsNotificationIDReadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostNotificationsNotificationIDReadJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	marked, err := api.store.MarkNotificationRead(r.Context(), pgstore.MarkNotificationReadParams{
+		ID:            id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to mark notification read", zap.Error(err), zap.String("notification_id", notificationID))
+		return spec.PostNotificationsNotificationIDReadJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if marked == 0 {
+		return spec.PostNotificationsNotificationIDReadJSON404Response(spec.Error{Message: "notificação não encontrada"})
+	}
+
+	return spec.PostNotificationsNotificationIDReadJSON204Response(nil)
+}
+
+func notificationEventResponse(event pgstore.NotificationEvent) spec.NotificationEvent {
+	switch event {
+	case pgstore.NotificationEventActivityCreated:
+		return spec.NotificationEventActivityCreated
+	case pgstore.NotificationEventParticipantConfirmed:
+		return spec.NotificationEventParticipantConfirmed
+	case pgstore.NotificationEventTripUpdated:
+		return spec.NotificationEventTripUpdated
+	case pgstore.NotificationEventFlightUpdated:
+		return spec.NotificationEventFlightUpdated
+	}
+	return spec.UnknownNotificationEvent
+}
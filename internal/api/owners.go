@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const errNotTripOwner = "apenas os donos da viagem podem fazer isso"
+
+// isTripOwner reports whether email belongs to one of the trip owners.
+func (api *API) isTripOwner(ctx context.Context, tripID uuid.UUID, email openapi_types.Email) (bool, error) {
+	return api.store.IsTripOwner(ctx, pgstore.IsTripOwnerParams{
+		TripID: tripID,
+		Email:  strings.ToLower(string(email)),
+	})
+}
+
+// Get a trip owners.
+// (GET /trips/{tripId}/owners)
+func (api *API) GetTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDOwnersParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDOwnersJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	// The owner emails are the credential of the owner endpoints, so only
+	// the owners see them.
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDOwnersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.GetTripsTripIDOwnersJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	owners, err := api.store.GetTripOwners(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDOwnersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	ownersRes := make([]spec.GetTripOwnersResponseArray, len(owners))
+
+	for i, owner := range owners {
+		ownersRes[i] = spec.GetTripOwnersResponseArray{
+			Email:  openapi_types.Email(owner.Email),
+			Name:   owner.Name,
+			Locale: localeResponse(owner.Locale),
+		}
+	}
+
+	return spec.GetTripsTripIDOwnersJSON200Response(spec.GetTripOwnersResponse{
+		Owners: ownersRes,
+	})
+}
+
+// Add a co-owner to the trip.
+// (POST /trips/{tripId}/owners)
+func (api *API) PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDOwnersParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDOwnersJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.AddTripOwnerRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if err := api.store.AddTripOwner(r.Context(), pgstore.AddTripOwnerParams{
+		TripID: id,
+		Email:  strings.ToLower(string(body.Email)),
+		Name:   body.Name,
+		Locale: locale(body.Locale),
+	}); err != nil {
+		api.logger.Error("failed to add trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDOwnersJSON201Response(nil)
+}
+
+// Remove a co-owner from the trip.
+// (DELETE /trips/{tripId}/owners/{ownerEmail})
+func (api *API) DeleteTripsTripIDOwnersOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string, ownerEmail openapi_types.Email, params spec.DeleteTripsTripIDOwnersOwnerEmailParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	owners, err := api.store.GetTripOwners(r.Context(), id)
+	if err != nil {
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if len(owners) <= 1 {
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON400Response(spec.Error{Message: "a viagem precisa ter pelo menos um dono"})
+	}
+
+	if err := api.store.RemoveTripOwner(r.Context(), pgstore.RemoveTripOwnerParams{
+		TripID: id,
+		Email:  strings.ToLower(string(ownerEmail)),
+	}); err != nil {
+		api.logger.Error("failed to remove trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDOwnersOwnerEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.DeleteTripsTripIDOwnersOwnerEmailJSON204Response(nil)
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/domain"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// isTripParticipant reports whether the participant is one of the trip, the
+// packing list and the tasks being shared by them all.
+func (api *API) isTripParticipant(ctx context.Context, tripID uuid.UUID, participantID uuid.UUID) (bool, error) {
+	participant, err := api.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return participant.TripID == tripID, nil
+}
+
+// Get the packing list of a trip.
+// (GET /trips/{tripId}/packing)
+func (api *API) GetTripsTripIDPacking(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPackingJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	items, err := api.store.GetTripPackingItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get packing items", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPackingJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetPackingListResponse{
+		Items: make([]spec.GetPackingListResponseArray, len(items)),
+	}
+
+	for i, item := range items {
+		response.Items[i] = spec.GetPackingListResponseArray{
+			ID:        item.ID.String(),
+			Name:      item.Name,
+			Quantity:  int(item.Quantity),
+			Checked:   item.Checked,
+			CreatedAt: item.CreatedAt.Time,
+		}
+		if item.AssigneeID.Valid {
+			assigneeID := uuid.UUID(item.AssigneeID.Bytes).String()
+			response.Items[i].AssigneeID = &assigneeID
+		}
+	}
+
+	return spec.GetTripsTripIDPackingJSON200Response(response)
+}
+
+// Add an item to the packing list of a trip.
+// (POST /trips/{tripId}/packing)
+func (api *API) PostTripsTripIDPacking(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDPackingParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDPackingJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreatePackingItemRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	quantity := 1
+	if body.Quantity != nil {
+		quantity = *body.Quantity
+	}
+
+	itemID, err := api.store.CreatePackingItem(r.Context(), pgstore.CreatePackingItemParams{
+		TripID:     id,
+		Name:       body.Name,
+		Quantity:   int32(quantity),
+		AssigneeID: optionalUUID(body.AssigneeID),
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDPackingJSON422Response(e)
+		}
+		api.logger.Error("failed to create packing item", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPackingJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDPackingJSON201Response(spec.CreatePackingItemResponse{ItemID: itemID.String()})
+}
+
+// Suggest items for the packing list of a trip.
+// (GET /trips/{tripId}/packing/suggestions)
+func (api *API) GetTripsTripIDPackingSuggestions(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPackingSuggestionsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDPackingSuggestionsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPackingSuggestionsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	items, err := api.store.GetTripPackingItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get packing items", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPackingSuggestionsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	packed := make(map[string]bool, len(items))
+	for _, item := range items {
+		packed[strings.ToLower(item.Name)] = true
+	}
+
+	response := spec.GetPackingSuggestionsResponse{
+		Items: []spec.GetPackingSuggestionsResponseArray{},
+	}
+
+	for _, suggestion := range domain.SuggestPacking(trip.Destination, trip.StartsAt.Time, trip.EndsAt.Time) {
+		if packed[strings.ToLower(suggestion.Name)] {
+			continue
+		}
+		response.Items = append(response.Items, spec.GetPackingSuggestionsResponseArray{
+			Name:     suggestion.Name,
+			Quantity: suggestion.Quantity,
+		})
+	}
+
+	return spec.GetTripsTripIDPackingSuggestionsJSON200Response(response)
+}
+
+// Update an item of the packing list of a trip.
+// (PUT /trips/{tripId}/packing/{itemId})
+func (api *API) PutTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string, params spec.PutTripsTripIDPackingItemIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	iID, err := uuid.Parse(itemID)
+	if err != nil {
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PutTripsTripIDPackingItemIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.UpdatePackingItemRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = strings.TrimSpace(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	updated, err := api.store.UpdatePackingItem(r.Context(), pgstore.UpdatePackingItemParams{
+		Name:       body.Name,
+		Quantity:   int32(body.Quantity),
+		AssigneeID: optionalUUID(body.AssigneeID),
+		Checked:    body.Checked,
+		ID:         iID,
+		TripID:     id,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDPackingItemIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update packing item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.PutTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDPackingItemIDJSON404Response(spec.Error{Message: "item não encontrado"})
+	}
+
+	return spec.PutTripsTripIDPackingItemIDJSON204Response(nil)
+}
+
+// Remove an item from the packing list of a trip.
+// (DELETE /trips/{tripId}/packing/{itemId})
+func (api *API) DeleteTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string, params spec.DeleteTripsTripIDPackingItemIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	iID, err := uuid.Parse(itemID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.DeleteTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.DeleteTripsTripIDPackingItemIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	deleted, err := api.store.DeletePackingItem(r.Context(), pgstore.DeletePackingItemParams{ID: iID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete packing item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.DeleteTripsTripIDPackingItemIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDPackingItemIDJSON404Response(spec.Error{Message: "item não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDPackingItemIDJSON204Response(nil)
+}
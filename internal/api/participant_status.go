@@ -0,0 +1,99 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Takes back a participant confirmation.
+// (PATCH /participants/{participantId}/unconfirm)
+func (api *API) PatchParticipantsParticipantIDUnconfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UnconfirmParticipantRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Reason = strings.TrimSpace(body.Reason)
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	if !participant.IsConfirmed {
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "Participante ainda não confirmou presença."})
+	}
+
+	if err := api.store.UnconfirmParticipantTx(r.Context(), api.pool, id, body.Reason); err != nil {
+		api.logger.Error("failed to unconfirm participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDUnconfirmJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	return spec.PatchParticipantsParticipantIDUnconfirmJSON204Response(nil)
+}
+
+// Get a participant status history.
+// (GET /participants/{participantId}/history)
+func (api *API) GetParticipantsParticipantIDHistory(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.GetParticipantsParticipantIDHistoryJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	changes, err := api.store.GetParticipantStatusChanges(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participant status changes", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDHistoryJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente."})
+	}
+
+	historyRes := make([]spec.GetParticipantHistoryResponseArray, len(changes))
+
+	for i, change := range changes {
+		historyRes[i] = spec.GetParticipantHistoryResponseArray{
+			Status:    participantStatusResponse(change.Status),
+			CreatedAt: change.CreatedAt.Time,
+		}
+
+		if change.Reason.Valid {
+			historyRes[i].Reason = &change.Reason.String
+		}
+	}
+
+	return spec.GetParticipantsParticipantIDHistoryJSON200Response(spec.GetParticipantHistoryResponse{
+		History: historyRes,
+	})
+}
+
+func participantStatusResponse(status pgstore.ParticipantStatus) spec.ParticipantStatus {
+	switch status {
+	case pgstore.ParticipantStatusConfirmed:
+		return spec.ParticipantStatusConfirmed
+	case pgstore.ParticipantStatusDeclined:
+		return spec.ParticipantStatusDeclined
+	case pgstore.ParticipantStatusUnconfirmed:
+		return spec.ParticipantStatusUnconfirmed
+	}
+	return spec.UnknownParticipantStatus
+}
@@ -0,0 +1,421 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+	"unicode/utf8"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxPhotoSize caps the size of a single uploaded photo.
+	maxPhotoSize = 20 << 20
+
+	// maxCaptionLength caps the caption of a photo, in characters.
+	maxCaptionLength = 500
+
+	// photoURLTTL is how long the signed URLs of a photo and its thumbnail
+	// stay valid.
+	photoURLTTL = 15 * time.Minute
+)
+
+// defaultPhotosPageSize and maxPhotosPageSize bound how many photos a page of
+// the gallery has.
+const (
+	defaultPhotosPageSize = 24
+	maxPhotosPageSize     = 100
+)
+
+// photoContentTypes are the types a photo may have, as sniffed by
+// http.DetectContentType, the ones the thumbnails are made of.
+var photoContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+// Get a page of the gallery of a trip, newest first.
+// (GET /trips/{tripId}/photos)
+func (api *API) GetTripsTripIDPhotos(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDPhotosParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPhotosJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var cursor pgstore.Cursor
+	if params.Cursor != nil {
+		cursor, err = pgstore.ParseCursor(*params.Cursor)
+		if err != nil {
+			return spec.GetTripsTripIDPhotosJSON400Response(spec.Error{Message: "cursor inválido"})
+		}
+	}
+
+	size := defaultPhotosPageSize
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxPhotosPageSize {
+			return spec.GetTripsTripIDPhotosJSON400Response(spec.Error{Message: "limite inválido"})
+		}
+		size = *params.Limit
+	}
+
+	page, err := api.store.TripPhotosPage(r.Context(), id, cursor, int32(size))
+	if err != nil {
+		api.logger.Error("failed to get photos", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPhotosJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetTripPhotosResponse{
+		Photos: make([]spec.GetTripPhotosResponseArray, len(page.Items)),
+	}
+
+	for i, photo := range page.Items {
+		if response.Photos[i], err = api.photoResponse(photo); err != nil {
+			return spec.GetTripsTripIDPhotosJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+	}
+
+	if page.Next != nil {
+		next := page.Next.String()
+		response.NextCursor = &next
+	}
+
+	return spec.GetTripsTripIDPhotosJSON200Response(response)
+}
+
+// photoResponse converts photo into its response, signing fresh download URLs
+// for it and its thumbnail.
+func (api *API) photoResponse(photo pgstore.GetTripPhotosPageRow) (spec.GetTripPhotosResponseArray, error) {
+	url, expiresAt, err := api.blobs.SignedURL(photo.StorageKey, photoURLTTL)
+	if err != nil {
+		api.logger.Error("failed to sign photo url", zap.Error(err), zap.String("photo_id", photo.ID.String()))
+		return spec.GetTripPhotosResponseArray{}, err
+	}
+
+	response := spec.GetTripPhotosResponseArray{
+		ID:              photo.ID.String(),
+		ParticipantID:   photo.ParticipantID.String(),
+		AuthorEmail:     openapi_types.Email(photo.Email),
+		FileName:        photo.FileName,
+		ContentType:     photo.ContentType,
+		Size:            photo.Size,
+		URL:             url,
+		URLExpiresAt:    expiresAt,
+		ThumbnailStatus: thumbnailStatusResponse(photo.ThumbnailStatus),
+		Comments:        int(photo.Comments),
+		CreatedAt:       photo.CreatedAt.Time,
+	}
+	if photo.Name.Valid {
+		response.AuthorName = &photo.Name.String
+	}
+	if photo.Caption.Valid {
+		response.Caption = &photo.Caption.String
+	}
+	if photo.Width.Valid && photo.Height.Valid {
+		width, height := int(photo.Width.Int32), int(photo.Height.Int32)
+		response.Width, response.Height = &width, &height
+	}
+	if photo.ThumbnailKey.Valid {
+		thumbnailURL, _, err := api.blobs.SignedURL(photo.ThumbnailKey.String, photoURLTTL)
+		if err != nil {
+			api.logger.Error("failed to sign thumbnail url", zap.Error(err), zap.String("photo_id", photo.ID.String()))
+			return spec.GetTripPhotosResponseArray{}, err
+		}
+		response.ThumbnailURL = &thumbnailURL
+	}
+
+	return response, nil
+}
+
+// Upload a photo to a trip gallery.
+// (POST /trips/{tripId}/photos)
+func (api *API) PostTripsTripIDPhotos(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDPhotosParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDPhotosJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxPhotoSize+1<<20)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return spec.PostTripsTripIDPhotosJSON413Response(spec.Error{Message: "arquivo muito grande"})
+		}
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	defer file.Close()
+
+	if header.Size > maxPhotoSize {
+		return spec.PostTripsTripIDPhotosJSON413Response(spec.Error{Message: "arquivo muito grande"})
+	}
+
+	var caption pgtype.Text
+	if value := strings.TrimSpace(r.FormValue("caption")); value != "" {
+		if utf8.RuneCountInString(value) > maxCaptionLength {
+			return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "legenda muito longa"})
+		}
+		caption = pgtype.Text{Valid: true, String: value}
+	}
+
+	contentType, err := sniffContentType(file)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "arquivo inválido"})
+	}
+	if !photoContentTypes[contentType] {
+		return spec.PostTripsTripIDPhotosJSON415Response(spec.Error{Message: "a foto deve ser uma imagem JPEG ou PNG"})
+	}
+
+	key := path.Join("trips", id.String(), "photos", uuid.NewString())
+
+	if err := api.blobs.Put(r.Context(), key, file); err != nil {
+		api.logger.Error("failed to store photo", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	photoID, err := api.store.CreatePhoto(r.Context(), pgstore.CreatePhotoParams{
+		TripID:        id,
+		ParticipantID: participantID,
+		Caption:       caption,
+		FileName:      path.Base(header.Filename),
+		ContentType:   contentType,
+		Size:          header.Size,
+		StorageKey:    key,
+	})
+	if err != nil {
+		api.logger.Error("failed to create photo", zap.Error(err), zap.String("trip_id", tripID))
+		api.deleteBlob(key)
+		return spec.PostTripsTripIDPhotosJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDPhotosJSON201Response(spec.CreatePhotoResponse{PhotoID: photoID.String()})
+}
+
+// Delete a photo uploaded by the participant.
+// (DELETE /trips/{tripId}/photos/{photoId})
+func (api *API) DeleteTripsTripIDPhotosPhotoID(w http.ResponseWriter, r *http.Request, tripID string, photoID string, params spec.DeleteTripsTripIDPhotosPhotoIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(photoID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	deleted, err := api.store.DeletePhoto(r.Context(), pgstore.DeletePhotoParams{
+		ID:            pID,
+		TripID:        id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDPhotosPhotoIDJSON404Response(spec.Error{Message: "foto não encontrada"})
+		}
+		api.logger.Error("failed to delete photo", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.DeleteTripsTripIDPhotosPhotoIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	api.deleteBlob(deleted.StorageKey)
+	if deleted.ThumbnailKey.Valid {
+		api.deleteBlob(deleted.ThumbnailKey.String)
+	}
+
+	return spec.DeleteTripsTripIDPhotosPhotoIDJSON204Response(nil)
+}
+
+// Get the comments of a photo.
+// (GET /trips/{tripId}/photos/{photoId}/comments)
+func (api *API) GetTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request, tripID string, photoID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(photoID)
+	if err != nil {
+		return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetPhoto(r.Context(), pgstore.GetPhotoParams{ID: pID, TripID: id}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON404Response(spec.Error{Message: "foto não encontrada"})
+		}
+		api.logger.Error("failed to get photo", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	comments, err := api.store.GetPhotoComments(r.Context(), pID)
+	if err != nil {
+		api.logger.Error("failed to get photo comments", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetPhotoCommentsResponse{
+		Comments: make([]spec.GetPhotoCommentsResponseArray, len(comments)),
+	}
+
+	for i, comment := range comments {
+		response.Comments[i] = spec.GetPhotoCommentsResponseArray{
+			ID:            comment.ID.String(),
+			ParticipantID: comment.ParticipantID.String(),
+			AuthorEmail:   openapi_types.Email(comment.Email),
+			Body:          comment.Body,
+			CreatedAt:     comment.CreatedAt.Time,
+		}
+		if comment.Name.Valid {
+			response.Comments[i].AuthorName = &comment.Name.String
+		}
+	}
+
+	return spec.GetTripsTripIDPhotosPhotoIDCommentsJSON200Response(response)
+}
+
+// Comment on a photo.
+// (POST /trips/{tripId}/photos/{photoId}/comments)
+func (api *API) PostTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request, tripID string, photoID string, params spec.PostTripsTripIDPhotosPhotoIDCommentsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(photoID)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreatePhotoCommentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Body = strings.TrimSpace(body.Body)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if _, err := api.store.GetPhoto(r.Context(), pgstore.GetPhotoParams{ID: pID, TripID: id}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON404Response(spec.Error{Message: "foto não encontrada"})
+		}
+		api.logger.Error("failed to get photo", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	commentID, err := api.store.CreatePhotoComment(r.Context(), pgstore.CreatePhotoCommentParams{
+		PhotoID:       pID,
+		ParticipantID: participantID,
+		Body:          body.Body,
+	})
+	if err != nil {
+		api.logger.Error("failed to create photo comment", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDPhotosPhotoIDCommentsJSON201Response(spec.CreatePhotoCommentResponse{CommentID: commentID.String()})
+}
+
+// Delete a comment written by the participant.
+// (DELETE /trips/{tripId}/photos/{photoId}/comments/{commentId})
+func (api *API) DeleteTripsTripIDPhotosPhotoIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, photoID string, commentID string, params spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(photoID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	cID, err := uuid.Parse(commentID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetPhoto(r.Context(), pgstore.GetPhotoParams{ID: pID, TripID: id}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON404Response(spec.Error{Message: "foto não encontrada"})
+		}
+		api.logger.Error("failed to get photo", zap.Error(err), zap.String("photo_id", photoID))
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	deleted, err := api.store.DeletePhotoComment(r.Context(), pgstore.DeletePhotoCommentParams{
+		ID:            cID,
+		PhotoID:       pID,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete photo comment", zap.Error(err), zap.String("comment_id", commentID))
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON404Response(spec.Error{Message: "comentário não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON204Response(nil)
+}
+
+func thumbnailStatusResponse(s pgstore.ThumbnailStatus) spec.ThumbnailStatus {
+	switch s {
+	case pgstore.ThumbnailStatusPending:
+		return spec.ThumbnailStatusPending
+	case pgstore.ThumbnailStatusReady:
+		return spec.ThumbnailStatusReady
+	case pgstore.ThumbnailStatusFailed:
+		return spec.ThumbnailStatusFailed
+	}
+	return spec.UnknownThumbnailStatus
+}
@@ -0,0 +1,352 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/actionlink"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// errPollApplied answers the changes of a poll closed by applying it.
+const errPollApplied = "a enquete já foi aplicada à viagem"
+
+// Get a trip polls, with their votes.
+// (GET /trips/{tripId}/polls)
+func (api *API) GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPollsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	polls, err := api.store.GetTripPolls(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get polls", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPollsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	options, err := api.store.GetTripPollOptions(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get poll options", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPollsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetPollsResponse{Polls: make([]spec.GetPollsResponseArray, len(polls))}
+	for i, poll := range polls {
+		response.Polls[i] = pollResponse(poll, pollOptions(options, poll.ID))
+	}
+
+	return spec.GetTripsTripIDPollsJSON200Response(response)
+}
+
+// Create a poll on the dates or the destination of a trip.
+// (POST /trips/{tripId}/polls)
+func (api *API) PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDPollsParams) *spec.Response {
+	var body spec.CreatePollRequest
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDPollsJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if body.Kind == spec.UnknownPollKind {
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "tipo de enquete inválido"})
+	}
+	kind := pgstore.PollKind(body.Kind.ToValue())
+
+	options := make([]pgstore.CreatePollOptionParams, len(body.Options))
+	for i, option := range body.Options {
+		switch kind {
+		case pgstore.PollKindDestination:
+			if option.Destination == nil || strings.TrimSpace(*option.Destination) == "" || option.StartsAt != nil || option.EndsAt != nil {
+				return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "as opções de uma enquete de destino devem ter apenas o destino"})
+			}
+			options[i].Destination = pgtype.Text{Valid: true, String: strings.TrimSpace(*option.Destination)}
+		case pgstore.PollKindDates:
+			if option.Destination != nil || option.StartsAt == nil || option.EndsAt == nil {
+				return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "as opções de uma enquete de datas devem ter apenas o início e o fim"})
+			}
+			if !option.EndsAt.After(*option.StartsAt) {
+				return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "a data final deve ser depois da data inicial"})
+			}
+			options[i].StartsAt = pgtype.Timestamp{Valid: true, Time: *option.StartsAt}
+			options[i].EndsAt = pgtype.Timestamp{Valid: true, Time: *option.EndsAt}
+		}
+	}
+
+	pollID, err := api.store.CreatePollTx(r.Context(), api.pool, pgstore.CreatePollParams{
+		TripID:   id,
+		Kind:     kind,
+		Question: body.Question,
+	}, options)
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDPollsJSON422Response(e)
+		}
+		api.logger.Error("failed to create poll", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPollsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDPollsJSON201Response(spec.CreatePollResponse{PollID: pollID.String()})
+}
+
+// Vote on a poll.
+// (POST /polls/{pollId}/votes)
+func (api *API) PostPollsPollIDVotes(w http.ResponseWriter, r *http.Request, pollID string, params spec.PostPollsPollIDVotesParams) *spec.Response {
+	var body spec.VotePollRequest
+
+	id, err := uuid.Parse(pollID)
+	if err != nil {
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	// The participant header is copied from anyone, only the token of the
+	// email link proves the vote is theirs.
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionVotePoll, participantID, false); !ok {
+		return spec.PostPollsPollIDVotesJSON403Response(spec.Error{Message: msg})
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	poll, err := api.store.GetPoll(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "enquete não encontrada"})
+		}
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if participant.TripID != poll.TripID {
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "participante não faz parte desta viagem"})
+	}
+
+	if poll.AppliedAt.Valid {
+		return spec.PostPollsPollIDVotesJSON409Response(spec.Error{Message: errPollApplied})
+	}
+
+	if err := api.store.VotePoll(r.Context(), pgstore.VotePollParams{
+		PollID:        id,
+		ParticipantID: participantID,
+		OptionID:      uuid.MustParse(body.OptionID),
+	}); err != nil {
+		// The option belongs to another poll.
+		if e, ok := constraintError(err); ok {
+			return spec.PostPollsPollIDVotesJSON422Response(e)
+		}
+		api.logger.Error("failed to vote poll", zap.Error(err), zap.String("poll_id", pollID))
+		return spec.PostPollsPollIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostPollsPollIDVotesJSON204Response(nil)
+}
+
+// Apply the winning option of a poll to the trip.
+// (POST /trips/{tripId}/polls/{pollId}/apply)
+func (api *API) PostTripsTripIDPollsPollIDApply(w http.ResponseWriter, r *http.Request, tripID string, pollID string, params spec.PostTripsTripIDPollsPollIDApplyParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(pollID)
+	if err != nil {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	poll, err := api.store.GetPoll(r.Context(), pID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.logger.Error("failed to get poll", zap.Error(err), zap.String("poll_id", pollID))
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if err != nil || poll.TripID != id {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON404Response(spec.Error{Message: "enquete não encontrada"})
+	}
+
+	if poll.AppliedAt.Valid {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON409Response(spec.TripRangeConflictResponse{
+			Message:    errPollApplied,
+			Activities: []spec.GetTripActivitiesResponseInnerArray{},
+		})
+	}
+
+	options, err := api.store.GetTripPollOptions(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get poll options", zap.Error(err), zap.String("poll_id", pollID))
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	winner, ok := pollWinner(pollOptions(options, pID))
+	if !ok {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON422Response(spec.Error{Message: "a enquete ainda não recebeu votos"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The trip is updated at the version it was read in, so the checks below
+	// still hold when the update succeeds.
+	update := pgstore.UpdateTripPartialParams{ID: id, Version: trip.Version}
+
+	switch poll.Kind {
+	case pgstore.PollKindDestination:
+		update.Destination = winner.Destination
+	case pgstore.PollKindDates:
+		startsAt, endsAt := winner.StartsAt.Time, winner.EndsAt.Time
+		if trip.RsvpDeadline.Valid && trip.RsvpDeadline.Time.After(startsAt) {
+			return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: errRSVPDeadlineAfterStart})
+		}
+
+		activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+		if err != nil {
+			return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+
+		if outside := activitiesOutsideRange(activities, startsAt, endsAt); len(outside) > 0 {
+			return spec.PostTripsTripIDPollsPollIDApplyJSON409Response(spec.TripRangeConflictResponse{
+				Message:    "o novo período deixa atividades fora da viagem",
+				Activities: outside,
+			})
+		}
+
+		update.StartsAt = winner.StartsAt
+		update.EndsAt = winner.EndsAt
+	}
+
+	applied, err := api.store.ApplyPollTx(r.Context(), api.pool, pID, update)
+	if err != nil {
+		api.logger.Error("failed to apply poll", zap.Error(err), zap.String("poll_id", pollID))
+		return spec.PostTripsTripIDPollsPollIDApplyJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !applied {
+		return spec.PostTripsTripIDPollsPollIDApplyJSON409Response(tripChangedResponse())
+	}
+
+	return spec.PostTripsTripIDPollsPollIDApplyJSON200Response(spec.ApplyPollResponse{OptionID: winner.ID.String()})
+}
+
+// pollOptions returns the options of the poll among the options of its trip,
+// keeping their order.
+func pollOptions(options []pgstore.GetTripPollOptionsRow, pollID uuid.UUID) []pgstore.GetTripPollOptionsRow {
+	var res []pgstore.GetTripPollOptionsRow
+	for _, option := range options {
+		if option.PollID == pollID {
+			res = append(res, option)
+		}
+	}
+	return res
+}
+
+// pollWinner returns the option with the most votes, the first one on a tie.
+// It returns false while nobody voted.
+func pollWinner(options []pgstore.GetTripPollOptionsRow) (pgstore.GetTripPollOptionsRow, bool) {
+	var winner pgstore.GetTripPollOptionsRow
+	for _, option := range options {
+		if option.Votes > winner.Votes {
+			winner = option
+		}
+	}
+	return winner, winner.Votes > 0
+}
+
+func pollResponse(poll pgstore.Poll, options []pgstore.GetTripPollOptionsRow) spec.GetPollsResponseArray {
+	response := spec.GetPollsResponseArray{
+		ID:        poll.ID.String(),
+		Kind:      pollKindResponse(poll.Kind),
+		Question:  poll.Question,
+		Options:   make([]spec.GetPollsResponseOptionArray, len(options)),
+		CreatedAt: poll.CreatedAt.Time,
+	}
+
+	if poll.AppliedAt.Valid {
+		response.AppliedAt = &poll.AppliedAt.Time
+	}
+
+	for i, option := range options {
+		res := spec.GetPollsResponseOptionArray{
+			ID:    option.ID.String(),
+			Votes: option.Votes,
+		}
+		if option.Destination.Valid {
+			res.Destination = &option.Destination.String
+		}
+		if option.StartsAt.Valid {
+			res.StartsAt = &option.StartsAt.Time
+		}
+		if option.EndsAt.Valid {
+			res.EndsAt = &option.EndsAt.Time
+		}
+		response.Options[i] = res
+	}
+
+	if winner, ok := pollWinner(options); ok {
+		winnerID := winner.ID.String()
+		response.WinnerOptionID = &winnerID
+	}
+
+	return response
+}
+
+func pollKindResponse(kind pgstore.PollKind) spec.PollKind {
+	switch kind {
+	case pgstore.PollKindDates:
+		return spec.PollKindDates
+	case pgstore.PollKindDestination:
+		return spec.PollKindDestination
+	}
+	return spec.UnknownPollKind
+}
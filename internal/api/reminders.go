@@ -0,0 +1,178 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Turns activity reminder e-mails on or off for a participant.
+// (PATCH /participants/{participantId}/reminders)
+func (api *API) PatchParticipantsParticipantIDReminders(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDRemindersJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateReminderPreferenceRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDRemindersJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDRemindersJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDRemindersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if err := api.store.UpdateNotificationPreferencesTx(r.Context(), api.pool, id, map[pgstore.NotificationKind]bool{
+		pgstore.NotificationKindActivityReminder: body.Enabled,
+		pgstore.NotificationKindPreTripReminder:  body.Enabled,
+	}); err != nil {
+		api.logger.Error("failed to update reminder preference", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDRemindersJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchParticipantsParticipantIDRemindersJSON204Response(nil)
+}
+
+// Turns the daily itinerary e-mails on or off for a participant.
+// (PATCH /participants/{participantId}/digest)
+func (api *API) PatchParticipantsParticipantIDDigest(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDDigestJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateReminderPreferenceRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDDigestJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDDigestJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDigestJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if err := api.store.UpdateNotificationPreferencesTx(r.Context(), api.pool, id, map[pgstore.NotificationKind]bool{
+		pgstore.NotificationKindDailyDigest: body.Enabled,
+	}); err != nil {
+		api.logger.Error("failed to update digest preference", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDigestJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchParticipantsParticipantIDDigestJSON204Response(nil)
+}
+
+// Get the notification e-mails a participant receives.
+// (GET /participants/{participantId}/notifications)
+func (api *API) GetParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.GetParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	optOuts, err := api.store.GetNotificationOptOuts(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get notification opt-outs", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	res := spec.GetNotificationPreferencesResponse{
+		ActivityReminder: true,
+		RsvpReminder:     true,
+		PreTripReminder:  true,
+		DailyDigest:      true,
+		TaskReminder:     true,
+		FlightUpdate:     true,
+	}
+	for _, kind := range optOuts {
+		switch kind {
+		case pgstore.NotificationKindActivityReminder:
+			res.ActivityReminder = false
+		case pgstore.NotificationKindRsvpReminder:
+			res.RsvpReminder = false
+		case pgstore.NotificationKindPreTripReminder:
+			res.PreTripReminder = false
+		case pgstore.NotificationKindDailyDigest:
+			res.DailyDigest = false
+		case pgstore.NotificationKindTaskReminder:
+			res.TaskReminder = false
+		case pgstore.NotificationKindFlightUpdate:
+			res.FlightUpdate = false
+		}
+	}
+
+	return spec.GetParticipantsParticipantIDNotificationsJSON200Response(res)
+}
+
+// Turns notification e-mails on or off for a participant.
+// (PATCH /participants/{participantId}/notifications)
+func (api *API) PatchParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateNotificationPreferencesRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	enabled := make(map[pgstore.NotificationKind]bool)
+	if body.ActivityReminder != nil {
+		enabled[pgstore.NotificationKindActivityReminder] = *body.ActivityReminder
+	}
+	if body.RsvpReminder != nil {
+		enabled[pgstore.NotificationKindRsvpReminder] = *body.RsvpReminder
+	}
+	if body.PreTripReminder != nil {
+		enabled[pgstore.NotificationKindPreTripReminder] = *body.PreTripReminder
+	}
+	if body.DailyDigest != nil {
+		enabled[pgstore.NotificationKindDailyDigest] = *body.DailyDigest
+	}
+	if body.TaskReminder != nil {
+		enabled[pgstore.NotificationKindTaskReminder] = *body.TaskReminder
+	}
+	if body.FlightUpdate != nil {
+		enabled[pgstore.NotificationKindFlightUpdate] = *body.FlightUpdate
+	}
+
+	if err := api.store.UpdateNotificationPreferencesTx(r.Context(), api.pool, id, enabled); err != nil {
+		api.logger.Error("failed to update notification preferences", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDNotificationsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchParticipantsParticipantIDNotificationsJSON204Response(nil)
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Mark whether a participant attends an activity.
+// (PATCH /activities/{activityId}/rsvp)
+func (api *API) PatchActivitiesActivityIDRsvp(w http.ResponseWriter, r *http.Request, activityID string) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.UpdateActivityRSVPRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	tripID, err := api.store.GetActivityTripID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participantID := uuid.MustParse(body.ParticipantID)
+
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if participant.TripID != tripID {
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "participante não faz parte desta viagem"})
+	}
+
+	if err := api.store.UpsertActivityRSVP(r.Context(), pgstore.UpsertActivityRSVPParams{
+		ActivityID:    id,
+		ParticipantID: participantID,
+		Attending:     body.Attending,
+	}); err != nil {
+		api.logger.Error("failed to save activity rsvp", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PatchActivitiesActivityIDRsvpJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PatchActivitiesActivityIDRsvpJSON204Response(nil)
+}
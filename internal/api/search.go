@@ -0,0 +1,54 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxSearchResults caps how many matches a trip search returns.
+const maxSearchResults = 50
+
+// likeEscaper escapes the LIKE wildcards so search terms match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search a trip.
+// (GET /trips/{tripId}/search)
+func (api *API) GetTripsTripIDSearch(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDSearchParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSearchJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	q := strings.TrimSpace(params.Q)
+	if q == "" {
+		return spec.GetTripsTripIDSearchJSON400Response(spec.Error{Message: "informe o que deseja buscar"})
+	}
+
+	matches, err := api.store.SearchTrip(r.Context(), pgstore.SearchTripParams{
+		TripID:     id,
+		Query:      q,
+		Pattern:    "%" + likeEscaper.Replace(q) + "%",
+		MaxResults: maxSearchResults,
+	})
+	if err != nil {
+		api.logger.Error("failed to search trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSearchJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	results := make([]spec.SearchTripResponseArray, len(matches))
+
+	for i, match := range matches {
+		results[i] = spec.SearchTripResponseArray{
+			Type: match.Kind,
+			ID:   match.ID.String(),
+			Text: match.Match,
+		}
+	}
+
+	return spec.GetTripsTripIDSearchJSON200Response(spec.SearchTripResponse{Results: results})
+}
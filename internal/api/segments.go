@@ -0,0 +1,351 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Get the transport segments of a trip.
+// (GET /trips/{tripId}/segments)
+func (api *API) GetTripsTripIDSegments(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSegmentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	segments, err := api.transportSegments(r.Context(), id, nil, nil)
+	if err != nil {
+		api.logger.Error("failed to get transport segments", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSegmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetTransportSegmentsResponse{
+		Segments: make([]spec.GetTransportSegmentsResponseArray, len(segments)),
+	}
+
+	for i, segment := range segments {
+		response.Segments[i] = spec.GetTransportSegmentsResponseArray{
+			ID:             segment.segment.ID.String(),
+			Mode:           transportModeResponse(segment.segment.Mode),
+			Carrier:        segment.segment.Carrier,
+			DeparturePlace: segment.segment.DeparturePlace,
+			DepartureAt:    segment.segment.DepartureAt.Time,
+			ArrivalPlace:   segment.segment.ArrivalPlace,
+			ArrivalAt:      segment.segment.ArrivalAt.Time,
+			ParticipantIds: segment.participantIDs,
+			CreatedAt:      segment.segment.CreatedAt.Time,
+		}
+		if segment.segment.Number.Valid {
+			response.Segments[i].Number = &segment.segment.Number.String
+		}
+	}
+
+	return spec.GetTripsTripIDSegmentsJSON200Response(response)
+}
+
+// Add a transport segment to a trip.
+// (POST /trips/{tripId}/segments)
+func (api *API) PostTripsTripIDSegments(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDSegmentsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDSegmentsJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreateTransportSegmentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Carrier = strings.TrimSpace(body.Carrier)
+	body.DeparturePlace = strings.TrimSpace(body.DeparturePlace)
+	body.ArrivalPlace = strings.TrimSpace(body.ArrivalPlace)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if body.Mode == spec.UnknownTransportMode {
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "meio de transporte inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDSegmentsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if !occursDuringTrip(trip, body.DepartureAt, nil) {
+		return spec.PostTripsTripIDSegmentsJSON422Response(spec.Error{Message: outsideTripSegmentMessage(trip)})
+	}
+
+	segmentID, err := api.store.CreateTransportSegmentTx(r.Context(), api.pool, pgstore.CreateTransportSegmentParams{
+		TripID:         id,
+		Mode:           pgstore.TransportMode(body.Mode.ToValue()),
+		Carrier:        body.Carrier,
+		Number:         optionalText(body.Number),
+		DeparturePlace: body.DeparturePlace,
+		DepartureAt:    pgtype.Timestamp{Valid: true, Time: body.DepartureAt},
+		ArrivalPlace:   body.ArrivalPlace,
+		ArrivalAt:      pgtype.Timestamp{Valid: true, Time: body.ArrivalAt},
+	}, parseUUIDs(body.ParticipantIds))
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDSegmentsJSON422Response(e)
+		}
+		api.logger.Error("failed to create transport segment", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSegmentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDSegmentsJSON201Response(spec.CreateTransportSegmentResponse{SegmentID: segmentID.String()})
+}
+
+// Update a transport segment of a trip.
+// (PUT /trips/{tripId}/segments/{segmentId})
+func (api *API) PutTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request, tripID string, segmentID string, params spec.PutTripsTripIDSegmentsSegmentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	sID, err := uuid.Parse(segmentID)
+	if err != nil {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.UpdateTransportSegmentRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Carrier = strings.TrimSpace(body.Carrier)
+	body.DeparturePlace = strings.TrimSpace(body.DeparturePlace)
+	body.ArrivalPlace = strings.TrimSpace(body.ArrivalPlace)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if body.Mode == spec.UnknownTransportMode {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "meio de transporte inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDSegmentsSegmentIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if !occursDuringTrip(trip, body.DepartureAt, nil) {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON422Response(spec.Error{Message: outsideTripSegmentMessage(trip)})
+	}
+
+	updated, err := api.store.UpdateTransportSegmentTx(r.Context(), api.pool, pgstore.UpdateTransportSegmentParams{
+		Mode:           pgstore.TransportMode(body.Mode.ToValue()),
+		Carrier:        body.Carrier,
+		Number:         optionalText(body.Number),
+		DeparturePlace: body.DeparturePlace,
+		DepartureAt:    pgtype.Timestamp{Valid: true, Time: body.DepartureAt},
+		ArrivalPlace:   body.ArrivalPlace,
+		ArrivalAt:      pgtype.Timestamp{Valid: true, Time: body.ArrivalAt},
+		ID:             sID,
+		TripID:         id,
+	}, parseUUIDs(body.ParticipantIds))
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDSegmentsSegmentIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update transport segment", zap.Error(err), zap.String("segment_id", segmentID))
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDSegmentsSegmentIDJSON404Response(spec.Error{Message: "trecho não encontrado"})
+	}
+
+	return spec.PutTripsTripIDSegmentsSegmentIDJSON204Response(nil)
+}
+
+// Delete a transport segment of a trip.
+// (DELETE /trips/{tripId}/segments/{segmentId})
+func (api *API) DeleteTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request, tripID string, segmentID string, params spec.DeleteTripsTripIDSegmentsSegmentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	sID, err := uuid.Parse(segmentID)
+	if err != nil {
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	deleted, err := api.store.DeleteTransportSegment(r.Context(), pgstore.DeleteTransportSegmentParams{ID: sID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete transport segment", zap.Error(err), zap.String("segment_id", segmentID))
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDSegmentsSegmentIDJSON404Response(spec.Error{Message: "trecho não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDSegmentsSegmentIDJSON204Response(nil)
+}
+
+// transportSegment is a transport segment along with the IDs of the
+// participants taking it.
+type transportSegment struct {
+	segment        pgstore.TransportSegment
+	participantIDs []string
+}
+
+// transportSegments lists the segments of the trip departing between from
+// and to, when given, the earliest first.
+func (api *API) transportSegments(ctx context.Context, tripID uuid.UUID, from, to *time.Time) ([]transportSegment, error) {
+	segments, err := api.store.GetTripTransportSegments(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := api.store.GetTripTransportSegmentParticipants(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	participantIDs := make(map[uuid.UUID][]string, len(segments))
+	for _, p := range participants {
+		participantIDs[p.SegmentID] = append(participantIDs[p.SegmentID], p.ParticipantID.String())
+	}
+
+	var res []transportSegment
+	for _, segment := range segments {
+		departureAt := segment.DepartureAt.Time
+		if (from != nil && departureAt.Before(*from)) || (to != nil && departureAt.After(*to)) {
+			continue
+		}
+
+		ids := participantIDs[segment.ID]
+		if ids == nil {
+			ids = []string{}
+		}
+		res = append(res, transportSegment{segment, ids})
+	}
+
+	return res, nil
+}
+
+func transportSegmentResponse(s transportSegment) spec.GetTripActivitiesResponseSegment {
+	res := spec.GetTripActivitiesResponseSegment{
+		ID:             s.segment.ID.String(),
+		Mode:           transportModeResponse(s.segment.Mode),
+		Carrier:        s.segment.Carrier,
+		DeparturePlace: s.segment.DeparturePlace,
+		DepartureAt:    s.segment.DepartureAt.Time,
+		ArrivalPlace:   s.segment.ArrivalPlace,
+		ArrivalAt:      s.segment.ArrivalAt.Time,
+		ParticipantIds: s.participantIDs,
+	}
+
+	if s.segment.Number.Valid {
+		res.Number = &s.segment.Number.String
+	}
+
+	return res
+}
+
+func transportModeResponse(mode pgstore.TransportMode) spec.TransportMode {
+	switch mode {
+	case pgstore.TransportModeFlight:
+		return spec.TransportModeFlight
+	case pgstore.TransportModeTrain:
+		return spec.TransportModeTrain
+	case pgstore.TransportModeBus:
+		return spec.TransportModeBus
+	}
+	return spec.UnknownTransportMode
+}
+
+// outsideTripSegmentMessage explains which window the departure of a segment
+// of trip must fall in.
+func outsideTripSegmentMessage(trip pgstore.Trip) string {
+	return fmt.Sprintf(
+		"a partida deve acontecer durante a viagem, entre %s e %s",
+		trip.StartsAt.Time.Format(time.RFC3339),
+		trip.EndsAt.Time.Format(time.RFC3339),
+	)
+}
+
+// parseUUIDs parses the already validated ids, leaving out the repeated
+// ones.
+func parseUUIDs(ids []string) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		u := uuid.MustParse(id)
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		res = append(res, u)
+	}
+	return res
+}
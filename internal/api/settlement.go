@@ -0,0 +1,215 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/domain"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Get who owes whom on a trip.
+// (GET /trips/{tripId}/expenses/settlement)
+func (api *API) GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	expenses, err := api.store.GetTripExpenses(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expenses", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	payments, err := api.store.GetTripSettlementPayments(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get settlement payments", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetSettlementResponse{
+		Settlements: settlementsResponse(expenseBalances(expenses, participants, payments), participants),
+		Payments:    make([]spec.GetSettlementResponsePaymentArray, len(payments)),
+	}
+
+	for i, payment := range payments {
+		response.Payments[i] = spec.GetSettlementResponsePaymentArray{
+			ID:                payment.ID.String(),
+			FromParticipantID: payment.FromParticipantID.String(),
+			ToParticipantID:   payment.ToParticipantID.String(),
+			Amount:            payment.Amount,
+			Currency:          payment.Currency,
+			PaidAt:            payment.PaidAt.Time,
+		}
+	}
+
+	return spec.GetTripsTripIDExpensesSettlementJSON200Response(response)
+}
+
+// Mark a settlement transfer as paid.
+// (POST /trips/{tripId}/expenses/settlement/payments)
+func (api *API) PostTripsTripIDExpensesSettlementPayments(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateSettlementPaymentRequest
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDExpensesSettlementPaymentsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDExpensesSettlementPaymentsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDExpensesSettlementPaymentsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	paymentID, err := api.store.CreateSettlementPayment(r.Context(), pgstore.CreateSettlementPaymentParams{
+		TripID:            id,
+		FromParticipantID: uuid.MustParse(body.FromParticipantID),
+		ToParticipantID:   uuid.MustParse(body.ToParticipantID),
+		Amount:            body.Amount,
+		Currency:          body.Currency,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDExpensesSettlementPaymentsJSON422Response(e)
+		}
+		api.logger.Error("failed to create settlement payment", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDExpensesSettlementPaymentsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDExpensesSettlementPaymentsJSON201Response(spec.CreateSettlementPaymentResponse{
+		PaymentID: paymentID.String(),
+	})
+}
+
+// Delete a settlement payment.
+// (DELETE /trips/{tripId}/expenses/settlement/payments/{paymentId})
+func (api *API) DeleteTripsTripIDExpensesSettlementPaymentsPaymentID(w http.ResponseWriter, r *http.Request, tripID string, paymentID string, params spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	pID, err := uuid.Parse(paymentID)
+	if err != nil {
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	deleted, err := api.store.DeleteSettlementPayment(r.Context(), pgstore.DeleteSettlementPaymentParams{ID: pID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete settlement payment", zap.Error(err), zap.String("payment_id", paymentID))
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON404Response(spec.Error{Message: "pagamento não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON204Response(nil)
+}
+
+// expenseBalances returns, by currency, what each participant paid less what
+// they owe. Every expense is split evenly between the participants who did
+// not decline the trip, the payer included, and the payments made to settle
+// them move the balances of both ends.
+func expenseBalances(expenses []pgstore.Expense, participants []pgstore.Participant, payments []pgstore.SettlementPayment) map[string]map[string]int64 {
+	var sharers []string
+	for _, p := range participants {
+		if !p.DeclinedAt.Valid {
+			sharers = append(sharers, p.ID.String())
+		}
+	}
+	// The cents an expense cannot be split in go to the first sharers, which
+	// must not depend on the order the participants were read in.
+	sort.Strings(sharers)
+
+	balances := make(map[string]map[string]int64)
+	balance := func(currency string) map[string]int64 {
+		if balances[currency] == nil {
+			balances[currency] = make(map[string]int64)
+		}
+		return balances[currency]
+	}
+
+	for _, expense := range expenses {
+		if len(sharers) == 0 {
+			break
+		}
+
+		b := balance(expense.Currency)
+		b[expense.PayerID.String()] += expense.Amount
+		for i, share := range domain.Split(expense.Amount, len(sharers)) {
+			b[sharers[i]] -= share
+		}
+	}
+
+	for _, payment := range payments {
+		b := balance(payment.Currency)
+		b[payment.FromParticipantID.String()] += payment.Amount
+		b[payment.ToParticipantID.String()] -= payment.Amount
+	}
+
+	return balances
+}
+
+// settlementsResponse returns the settlement of each currency, sorted by
+// currency, with the balances in the order of the participants.
+func settlementsResponse(balances map[string]map[string]int64, participants []pgstore.Participant) []spec.GetSettlementResponseArray {
+	currencies := make([]string, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	settlements := make([]spec.GetSettlementResponseArray, len(currencies))
+	for i, currency := range currencies {
+		settlement := spec.GetSettlementResponseArray{
+			Currency:  currency,
+			Balances:  []spec.GetSettlementResponseBalanceArray{},
+			Transfers: []spec.GetSettlementResponseTransferArray{},
+		}
+
+		for _, p := range participants {
+			if amount, ok := balances[currency][p.ID.String()]; ok {
+				settlement.Balances = append(settlement.Balances, spec.GetSettlementResponseBalanceArray{
+					ParticipantID: p.ID.String(),
+					Balance:       amount,
+				})
+			}
+		}
+
+		for _, transfer := range domain.Settle(balances[currency]) {
+			settlement.Transfers = append(settlement.Transfers, spec.GetSettlementResponseTransferArray{
+				FromParticipantID: transfer.From,
+				ToParticipantID:   transfer.To,
+				Amount:            transfer.Amount,
+			})
+		}
+
+		settlements[i] = settlement
+	}
+
+	return settlements
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Create a public read-only share link for a trip.
+// (POST /trips/{tripId}/share)
+func (api *API) PostTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDShareParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDShareJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	slug, err := newShareSlug()
+	if err != nil {
+		api.logger.Error("failed to generate share slug", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if err := api.store.CreateTripShare(r.Context(), pgstore.CreateTripShareParams{
+		TripID: id,
+		Slug:   slug,
+	}); err != nil {
+		api.logger.Error("failed to create trip share", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDShareJSON201Response(spec.CreateTripShareResponse{Slug: slug})
+}
+
+// Revoke every share link of a trip.
+// (DELETE /trips/{tripId}/share)
+func (api *API) DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string, params spec.DeleteTripsTripIDShareParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDShareJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteTripsTripIDShareJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	if err := api.store.RevokeTripShares(r.Context(), id); err != nil {
+		api.logger.Error("failed to revoke trip shares", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDShareJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.DeleteTripsTripIDShareJSON204Response(nil)
+}
+
+// Get a read-only view of a shared trip.
+// (GET /shared/{slug})
+func (api *API) GetSharedSlug(w http.ResponseWriter, r *http.Request, slug string) *spec.Response {
+	id, err := api.store.GetTripIDByShareSlug(r.Context(), slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetSharedSlugJSON400Response(spec.Error{Message: "link de compartilhamento inválido"})
+		}
+		return spec.GetSharedSlugJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return spec.GetSharedSlugJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return spec.GetSharedSlugJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	counts, err := api.activityCounts(r.Context(), id)
+	if err != nil {
+		return spec.GetSharedSlugJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	links, err := api.store.GetTripLinks(r.Context(), id)
+	if err != nil {
+		return spec.GetSharedSlugJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetSharedSlugJSON200Response(spec.GetSharedTripResponse{
+		Trip:       tripResponse(trip),
+		Activities: activitiesResponse(activities, nil, nil, counts, tripLocation(trip)),
+		// Click counts are meant for the trip owners, not public viewers.
+		Links: linksResponse(links, nil),
+	})
+}
+
+// newShareSlug returns a random, URL safe slug that is hard to guess.
+func newShareSlug() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -23,21 +23,449 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for ActivityCategory.
+var (
+	UnknownActivityCategory = ActivityCategory{}
+
+	ActivityCategoryFood = ActivityCategory{"food"}
+
+	ActivityCategoryLodging = ActivityCategory{"lodging"}
+
+	ActivityCategoryOther = ActivityCategory{"other"}
+
+	ActivityCategorySightseeing = ActivityCategory{"sightseeing"}
+
+	ActivityCategoryTransport = ActivityCategory{"transport"}
+)
+
+// Defines values for BookingEvent.
+var (
+	UnknownBookingEvent = BookingEvent{}
+
+	BookingEventCheckIn = BookingEvent{"check_in"}
+
+	BookingEventCheckOut = BookingEvent{"check_out"}
+)
+
+// Defines values for BookingType.
+var (
+	UnknownBookingType = BookingType{}
+
+	BookingTypeLodging = BookingType{"lodging"}
+)
+
+// Defines values for DocumentKind.
+var (
+	UnknownDocumentKind = DocumentKind{}
+
+	DocumentKindInsurance = DocumentKind{"insurance"}
+
+	DocumentKindOther = DocumentKind{"other"}
+
+	DocumentKindPassport = DocumentKind{"passport"}
+
+	DocumentKindTicket = DocumentKind{"ticket"}
+
+	DocumentKindVisa = DocumentKind{"visa"}
+)
+
+// Defines values for DocumentScope.
+var (
+	UnknownDocumentScope = DocumentScope{}
+
+	DocumentScopeParticipant = DocumentScope{"participant"}
+
+	DocumentScopeTrip = DocumentScope{"trip"}
+)
+
+// Defines values for EmailIssue.
+var (
+	UnknownEmailIssue = EmailIssue{}
+
+	EmailIssueBounce = EmailIssue{"bounce"}
+
+	EmailIssueComplaint = EmailIssue{"complaint"}
+)
+
+// Defines values for EmailPreviewTemplate.
+var (
+	UnknownEmailPreviewTemplate = EmailPreviewTemplate{}
+
+	EmailPreviewTemplateConfirmTrip = EmailPreviewTemplate{"confirm_trip"}
+
+	EmailPreviewTemplateEmailVerification = EmailPreviewTemplate{"email_verification"}
+
+	EmailPreviewTemplateInvitation = EmailPreviewTemplate{"invitation"}
+
+	EmailPreviewTemplatePreTripReminder = EmailPreviewTemplate{"pre_trip_reminder"}
+
+	EmailPreviewTemplateRsvpReminder = EmailPreviewTemplate{"rsvp_reminder"}
+)
+
+// Defines values for ErrorCode.
+var (
+	UnknownErrorCode = ErrorCode{}
+
+	ErrorCodeActivityNotFound = ErrorCode{"activity_not_found"}
+
+	ErrorCodeConflict = ErrorCode{"conflict"}
+
+	ErrorCodeInvalidValue = ErrorCode{"invalid_value"}
+
+	ErrorCodeLinkAlreadyAdded = ErrorCode{"link_already_added"}
+
+	ErrorCodeParticipantAlreadyInvited = ErrorCode{"participant_already_invited"}
+
+	ErrorCodeParticipantNotFound = ErrorCode{"participant_not_found"}
+
+	ErrorCodePollOptionNotFound = ErrorCode{"poll_option_not_found"}
+
+	ErrorCodeReferenceNotFound = ErrorCode{"reference_not_found"}
+
+	ErrorCodeTagNameTaken = ErrorCode{"tag_name_taken"}
+
+	ErrorCodeTripNotFound = ErrorCode{"trip_not_found"}
+)
+
+// Defines values for ExpenseCategory.
+var (
+	UnknownExpenseCategory = ExpenseCategory{}
+
+	ExpenseCategoryFood = ExpenseCategory{"food"}
+
+	ExpenseCategoryLodging = ExpenseCategory{"lodging"}
+
+	ExpenseCategoryOther = ExpenseCategory{"other"}
+
+	ExpenseCategoryShopping = ExpenseCategory{"shopping"}
+
+	ExpenseCategoryTickets = ExpenseCategory{"tickets"}
+
+	ExpenseCategoryTransport = ExpenseCategory{"transport"}
+)
+
+// Defines values for JournalVisibility.
+var (
+	UnknownJournalVisibility = JournalVisibility{}
+
+	JournalVisibilityPrivate = JournalVisibility{"private"}
+
+	JournalVisibilityShared = JournalVisibility{"shared"}
+)
+
+// Defines values for LinkCategory.
+var (
+	UnknownLinkCategory = LinkCategory{}
+
+	LinkCategoryDocs = LinkCategory{"docs"}
+
+	LinkCategoryLodging = LinkCategory{"lodging"}
+
+	LinkCategoryOther = LinkCategory{"other"}
+
+	LinkCategoryTickets = LinkCategory{"tickets"}
+
+	LinkCategoryTransport = LinkCategory{"transport"}
+)
+
+// Defines values for Locale.
+var (
+	UnknownLocale = Locale{}
+
+	LocaleEn = Locale{"en"}
+
+	LocaleEs = Locale{"es"}
+
+	LocalePtBR = Locale{"pt-BR"}
+)
+
+// Defines values for NotificationEvent.
+var (
+	UnknownNotificationEvent = NotificationEvent{}
+
+	NotificationEventActivityCreated = NotificationEvent{"activity_created"}
+
+	NotificationEventFlightUpdated = NotificationEvent{"flight_updated"}
+
+	NotificationEventParticipantConfirmed = NotificationEvent{"participant_confirmed"}
+
+	NotificationEventTripUpdated = NotificationEvent{"trip_updated"}
+)
+
+// Defines values for ParticipantStatus.
+var (
+	UnknownParticipantStatus = ParticipantStatus{}
+
+	ParticipantStatusConfirmed = ParticipantStatus{"confirmed"}
+
+	ParticipantStatusDeclined = ParticipantStatus{"declined"}
+
+	ParticipantStatusUnconfirmed = ParticipantStatus{"unconfirmed"}
+)
+
+// Defines values for PollKind.
+var (
+	UnknownPollKind = PollKind{}
+
+	PollKindDates = PollKind{"dates"}
+
+	PollKindDestination = PollKind{"destination"}
+)
+
+// Defines values for SuggestionKind.
+var (
+	UnknownSuggestionKind = SuggestionKind{}
+
+	SuggestionKindAttraction = SuggestionKind{"attraction"}
+
+	SuggestionKindRestaurant = SuggestionKind{"restaurant"}
+)
+
+// Defines values for ThumbnailStatus.
+var (
+	UnknownThumbnailStatus = ThumbnailStatus{}
+
+	ThumbnailStatusFailed = ThumbnailStatus{"failed"}
+
+	ThumbnailStatusPending = ThumbnailStatus{"pending"}
+
+	ThumbnailStatusReady = ThumbnailStatus{"ready"}
+)
+
+// Defines values for TransportMode.
+var (
+	UnknownTransportMode = TransportMode{}
+
+	TransportModeBus = TransportMode{"bus"}
+
+	TransportModeFlight = TransportMode{"flight"}
+
+	TransportModeTrain = TransportMode{"train"}
+)
+
+// Defines values for TripStatus.
+var (
+	UnknownTripStatus = TripStatus{}
+
+	TripStatusCancelled = TripStatus{"cancelled"}
+
+	TripStatusCompleted = TripStatus{"completed"}
+
+	TripStatusConfirmed = TripStatus{"confirmed"}
+
+	TripStatusDraft = TripStatus{"draft"}
+
+	TripStatusOngoing = TripStatus{"ongoing"}
+)
+
+// Defines values for WeatherCondition.
+var (
+	UnknownWeatherCondition = WeatherCondition{}
+
+	WeatherConditionClear = WeatherCondition{"clear"}
+
+	WeatherConditionCloudy = WeatherCondition{"cloudy"}
+
+	WeatherConditionFog = WeatherCondition{"fog"}
+
+	WeatherConditionPartlyCloudy = WeatherCondition{"partly_cloudy"}
+
+	WeatherConditionRain = WeatherCondition{"rain"}
+
+	WeatherConditionSnow = WeatherCondition{"snow"}
+
+	WeatherConditionStorm = WeatherCondition{"storm"}
+)
+
+// Defines values for WebhookDeliveryStatus.
+var (
+	UnknownWebhookDeliveryStatus = WebhookDeliveryStatus{}
+
+	WebhookDeliveryStatusDead = WebhookDeliveryStatus{"dead"}
+
+	WebhookDeliveryStatusDelivered = WebhookDeliveryStatus{"delivered"}
+
+	WebhookDeliveryStatusPending = WebhookDeliveryStatus{"pending"}
+)
+
+// Defines values for WebhookEvent.
+var (
+	UnknownWebhookEvent = WebhookEvent{}
+
+	WebhookEventActivityCreated = WebhookEvent{"activity.created"}
+
+	WebhookEventParticipantConfirmed = WebhookEvent{"participant.confirmed"}
+
+	WebhookEventTripConfirmed = WebhookEvent{"trip.confirmed"}
+
+	WebhookEventTripCreated = WebhookEvent{"trip.created"}
+)
+
+// ActivityConflictResponse defines model for ActivityConflictResponse.
+type ActivityConflictResponse struct {
+	ActivityIds []string `json:"activity_ids"`
+	Message     string   `json:"message"`
+}
+
+// Repeats the activity every N days until the given date or the end of the trip.
+type ActivityRecurrence struct {
+	EveryDays int `json:"every_days" validate:"required,min=1"`
+
+	// Last moment an occurrence can start. Defaults to the end of the trip.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// AddTripOwnerRequest defines model for AddTripOwnerRequest.
+type AddTripOwnerRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	Locale *Locale `json:"locale,omitempty"`
+	Name   string  `json:"name" validate:"required"`
+}
+
+// ApplyPollResponse defines model for ApplyPollResponse.
+type ApplyPollResponse struct {
+	// The winning option, now applied to the trip.
+	OptionID string `json:"option_id"`
+}
+
+// ConfirmParticipantRequest defines model for ConfirmParticipantRequest.
+type ConfirmParticipantRequest struct {
+	// Extra guests coming along with the participant.
+	Guests *int `json:"guests,omitempty" validate:"omitempty,min=0"`
+}
+
+// CreateActivityAttachmentResponse defines model for CreateActivityAttachmentResponse.
+type CreateActivityAttachmentResponse struct {
+	AttachmentID string `json:"attachmentId"`
+}
+
+// CreateActivityCommentRequest defines model for CreateActivityCommentRequest.
+type CreateActivityCommentRequest struct {
+	Body          string `json:"body" validate:"required,max=2000"`
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+}
+
+// CreateActivityCommentResponse defines model for CreateActivityCommentResponse.
+type CreateActivityCommentResponse struct {
+	CommentID string `json:"commentId"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	Address  *string           `json:"address,omitempty" validate:"omitempty,max=500"`
+	Category *ActivityCategory `json:"category,omitempty"`
+
+	// When the activity ends. Omit for activities that happen at an instant.
+	EndsAt    *time.Time `json:"ends_at,omitempty" validate:"omitempty,gtfield=OccursAt"`
+	Latitude  *float64   `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64   `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
+	OccursAt  time.Time  `json:"occurs_at" validate:"required"`
+
+	// Repeats the activity every N days until the given date or the end of the trip.
+	Recurrence *ActivityRecurrence `json:"recurrence,omitempty"`
+	Title      string              `json:"title" validate:"required"`
 }
 
 // CreateActivityResponse defines model for CreateActivityResponse.
 type CreateActivityResponse struct {
 	ActivityID string `json:"activityId"`
+
+	// Every activity created, when the request had a recurrence.
+	ActivityIds []string `json:"activityIds,omitempty"`
+}
+
+// CreateBookingRequest defines model for CreateBookingRequest.
+type CreateBookingRequest struct {
+	Address *string `json:"address,omitempty" validate:"omitempty,max=500"`
+
+	// Must fall within the trip dates.
+	CheckInAt time.Time `json:"check_in_at" validate:"required"`
+
+	// Must be after the check-in and no later than the end of the trip.
+	CheckOutAt time.Time `json:"check_out_at" validate:"required,gtfield=CheckInAt"`
+
+	// The reservation number given by the place or the booking site.
+	ConfirmationCode *string `json:"confirmation_code,omitempty" validate:"omitempty,max=100"`
+
+	// ISO 4217 code of the currency of the price, such as BRL.
+	Currency *string `json:"currency,omitempty" validate:"required_with=Price,omitempty,iso4217"`
+
+	// Name of the place, such as the hotel.
+	Name string `json:"name" validate:"required,max=255"`
+
+	// In the minor unit of the currency, such as cents. Given along with the currency.
+	Price *int64       `json:"price,omitempty" validate:"required_with=Currency,omitempty,min=1"`
+	Type  *BookingType `json:"type,omitempty"`
+
+	// Page of the reservation.
+	URL *string `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+}
+
+// CreateBookingResponse defines model for CreateBookingResponse.
+type CreateBookingResponse struct {
+	BookingID string `json:"bookingId"`
+}
+
+// CreateDocumentResponse defines model for CreateDocumentResponse.
+type CreateDocumentResponse struct {
+	DocumentID string `json:"documentId"`
+}
+
+// CreateExpenseRequest defines model for CreateExpenseRequest.
+type CreateExpenseRequest struct {
+	// The activity the expense is for, if any.
+	ActivityID *string `json:"activity_id,omitempty" validate:"omitempty,uuid"`
+
+	// In the minor unit of the currency, such as cents.
+	Amount   int64            `json:"amount" validate:"required,min=1"`
+	Category *ExpenseCategory `json:"category,omitempty"`
+
+	// ISO 4217 code of the currency, such as BRL.
+	Currency    string `json:"currency" validate:"required,iso4217"`
+	Description string `json:"description" validate:"required,max=255"`
+
+	// The participant who paid.
+	PayerID string `json:"payer_id" validate:"required,uuid"`
+
+	// When the money was spent. Defaults to now.
+	SpentAt *time.Time `json:"spent_at,omitempty"`
+}
+
+// CreateExpenseResponse defines model for CreateExpenseResponse.
+type CreateExpenseResponse struct {
+	ExpenseID string `json:"expenseId"`
+}
+
+// CreateJournalEntryRequest defines model for CreateJournalEntryRequest.
+type CreateJournalEntryRequest struct {
+	// Markdown text of the entry. Raw HTML is stripped before storage.
+	Body string `json:"body" validate:"required,max=20000"`
+
+	// Day of the trip the entry is about, in the time zone of the trip, e.g. 2024-07-21.
+	Date  openapi_types.Date `json:"date"`
+	Title *string            `json:"title,omitempty" validate:"omitempty,max=200"`
+
+	// `shared` entries are read by every participant, `private` ones by their author only. Defaults to shared.
+	Visibility *JournalVisibility `json:"visibility,omitempty"`
+}
+
+// CreateJournalEntryResponse defines model for CreateJournalEntryResponse.
+type CreateJournalEntryResponse struct {
+	EntryID string `json:"entryId"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
-	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
+	Category *LinkCategory `json:"category,omitempty"`
+
+	// Pinned links are listed first, outside of their category.
+	Pinned *bool  `json:"pinned,omitempty"`
+	Title  string `json:"title" validate:"required"`
+
+	// Must be an http or https URL. Tracking parameters such as utm_source are removed.
+	URL string `json:"url" validate:"required"`
 }
 
 // CreateLinkResponse defines model for CreateLinkResponse.
@@ -45,468 +473,12494 @@ type CreateLinkResponse struct {
 	LinkID string `json:"linkId"`
 }
 
-// CreateTripRequest defines model for CreateTripRequest.
-type CreateTripRequest struct {
-	Destination    string                `json:"destination" validate:"required,min=4"`
-	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+// CreateMessageRequest defines model for CreateMessageRequest.
+type CreateMessageRequest struct {
+	Body string `json:"body" validate:"required,max=2000"`
 }
 
-// CreateTripResponse defines model for CreateTripResponse.
-type CreateTripResponse struct {
-	TripID string `json:"tripId"`
+// CreateMessageResponse defines model for CreateMessageResponse.
+type CreateMessageResponse struct {
+	MessageID string `json:"messageId"`
 }
 
-// Bad request
-type Error struct {
-	Message string `json:"message"`
+// CreatePackingItemRequest defines model for CreatePackingItemRequest.
+type CreatePackingItemRequest struct {
+	// The participant bringing the item, if any.
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Name       string  `json:"name" validate:"required,max=255"`
+
+	// Defaults to 1.
+	Quantity *int `json:"quantity,omitempty" validate:"omitempty,min=1"`
 }
 
-// GetLinksResponse defines model for GetLinksResponse.
-type GetLinksResponse struct {
-	Links []GetLinksResponseArray `json:"links"`
+// CreatePackingItemResponse defines model for CreatePackingItemResponse.
+type CreatePackingItemResponse struct {
+	ItemID string `json:"itemId"`
 }
 
-// GetLinksResponseArray defines model for GetLinksResponseArray.
-type GetLinksResponseArray struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+// CreatePhotoCommentRequest defines model for CreatePhotoCommentRequest.
+type CreatePhotoCommentRequest struct {
+	Body string `json:"body" validate:"required,max=2000"`
 }
 
-// GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
-type GetTripActivitiesResponse struct {
-	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+// CreatePhotoCommentResponse defines model for CreatePhotoCommentResponse.
+type CreatePhotoCommentResponse struct {
+	CommentID string `json:"commentId"`
 }
 
-// GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
-type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+// CreatePhotoResponse defines model for CreatePhotoResponse.
+type CreatePhotoResponse struct {
+	PhotoID string `json:"photoId"`
 }
 
-// GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
-type GetTripActivitiesResponseOuterArray struct {
-	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
-	Date       time.Time                             `json:"date"`
+// CreatePollRequest defines model for CreatePollRequest.
+type CreatePollRequest struct {
+	Kind PollKind `json:"kind"`
+
+	// The candidates, in the order they are shown. Dates polls set starts_at and ends_at, destination polls the destination.
+	Options  []CreatePollRequestOptionArray `json:"options" validate:"min=2,max=10,dive"`
+	Question string                         `json:"question" validate:"required,max=255"`
 }
 
-// GetTripDetailsResponse defines model for GetTripDetailsResponse.
-type GetTripDetailsResponse struct {
-	Trip GetTripDetailsResponseTripObj `json:"trip"`
+// CreatePollRequestOptionArray defines model for CreatePollRequestOptionArray.
+type CreatePollRequestOptionArray struct {
+	Destination *string    `json:"destination,omitempty" validate:"omitempty,max=255"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+	StartsAt    *time.Time `json:"starts_at,omitempty"`
 }
 
-// GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
-type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+// CreatePollResponse defines model for CreatePollResponse.
+type CreatePollResponse struct {
+	PollID string `json:"pollId"`
 }
 
-// GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
-type GetTripParticipantsResponse struct {
-	Participants []GetTripParticipantsResponseArray `json:"participants"`
+// CreateSettlementPaymentRequest defines model for CreateSettlementPaymentRequest.
+type CreateSettlementPaymentRequest struct {
+	// In the minor unit of the currency, such as cents.
+	Amount int64 `json:"amount" validate:"required,min=1"`
+
+	// ISO 4217 code of the currency, such as BRL.
+	Currency string `json:"currency" validate:"required,iso4217"`
+
+	// The participant who paid.
+	FromParticipantID string `json:"from_participant_id" validate:"required,uuid"`
+
+	// The participant who was paid.
+	ToParticipantID string `json:"to_participant_id" validate:"required,uuid,nefield=FromParticipantID"`
 }
 
-// GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
-type GetTripParticipantsResponseArray struct {
-	Email       openapi_types.Email `json:"email"`
-	ID          string              `json:"id"`
-	IsConfirmed bool                `json:"is_confirmed"`
-	Name        *string             `json:"name"`
+// CreateSettlementPaymentResponse defines model for CreateSettlementPaymentResponse.
+type CreateSettlementPaymentResponse struct {
+	PaymentID string `json:"paymentId"`
 }
 
-// InviteParticipantRequest defines model for InviteParticipantRequest.
-type InviteParticipantRequest struct {
-	Email openapi_types.Email `json:"email" validate:"required,email"`
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
 }
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+// CreateTagResponse defines model for CreateTagResponse.
+type CreateTagResponse struct {
+	TagID string `json:"tagId"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// CreateTaskRequest defines model for CreateTaskRequest.
+type CreateTaskRequest struct {
+	// The participant doing the task, if any. They are e-mailed once it is overdue.
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+	// When the task is to be done by, if ever.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	Title string     `json:"title" validate:"required,max=255"`
+}
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+// CreateTaskResponse defines model for CreateTaskResponse.
+type CreateTaskResponse struct {
+	TaskID string `json:"taskId"`
+}
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+// CreateTransportSegmentRequest defines model for CreateTransportSegmentRequest.
+type CreateTransportSegmentRequest struct {
+	// Must be after the departure.
+	ArrivalAt    time.Time `json:"arrival_at" validate:"required,gtfield=DepartureAt"`
+	ArrivalPlace string    `json:"arrival_place" validate:"required,max=255"`
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+	// The airline or the company running the train or the bus.
+	Carrier string `json:"carrier" validate:"required,max=255"`
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+	// Must fall within the trip dates.
+	DepartureAt time.Time `json:"departure_at" validate:"required"`
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
-}
+	// Such as the airport or the station.
+	DeparturePlace string        `json:"departure_place" validate:"required,max=255"`
+	Mode           TransportMode `json:"mode"`
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+	// Such as the flight number, LA3040.
+	Number *string `json:"number,omitempty" validate:"omitempty,max=20"`
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// The participants of the trip taking the segment.
+	ParticipantIds []string `json:"participant_ids,omitempty" validate:"omitempty,max=100,dive,uuid"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
-
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// CreateTransportSegmentResponse defines model for CreateTransportSegmentResponse.
+type CreateTransportSegmentResponse struct {
+	SegmentID string `json:"segmentId"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// CreateTripJoinCodeResponse defines model for CreateTripJoinCodeResponse.
+type CreateTripJoinCodeResponse struct {
+	Code string `json:"code"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// CreateTripRequest defines model for CreateTripRequest.
+type CreateTripRequest struct {
+	// Markdown notes about the trip. Raw HTML is stripped before storage.
+	Description    *string               `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Destination    string                `json:"destination" validate:"required,min=4"`
+	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
+	EndsAt         time.Time             `json:"ends_at" validate:"required,gtfield=StartsAt"`
+
+	// How many extra guests each participant may bring. Defaults to 0.
+	MaxGuests *int `json:"max_guests,omitempty" validate:"omitempty,min=0"`
+
+	// Invitations beyond this number of participants go to the waitlist. Unlimited when absent.
+	MaxParticipants *int                `json:"max_participants,omitempty" validate:"omitempty,min=1"`
+	OwnerEmail      openapi_types.Email `json:"owner_email" validate:"required,email"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	OwnerLocale *Locale `json:"owner_locale,omitempty"`
+	OwnerName   string  `json:"owner_name" validate:"required"`
+
+	// How many days before the trip starts confirmed participants are e-mailed the day-one agenda and the trip links. 0 disables the reminder. Defaults to 2.
+	PreTripReminderDays *int `json:"pre_trip_reminder_days,omitempty" validate:"omitempty,min=0,max=30"`
+
+	// Participants have until this time to answer their invitation. Must not be after starts_at.
+	RsvpDeadline *time.Time `json:"rsvp_deadline,omitempty"`
+	StartsAt     time.Time  `json:"starts_at" validate:"required,future"`
+
+	// IANA timezone of the trip, such as America/Sao_Paulo, used to group the activities by day. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// CreateTripResponse defines model for CreateTripResponse.
+type CreateTripResponse struct {
+	TripID string `json:"tripId"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// CreateTripShareResponse defines model for CreateTripShareResponse.
+type CreateTripShareResponse struct {
+	Slug string `json:"slug"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	URL string `json:"url" validate:"required,http_url"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// CreateWebhookResponse defines model for CreateWebhookResponse.
+type CreateWebhookResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+
+	// Signs the payloads posted to the webhook. It is only returned here.
+	Secret string `json:"secret"`
+	TripID string `json:"trip_id"`
+	URL    string `json:"url"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// DeclineInvitationRequest defines model for DeclineInvitationRequest.
+type DeclineInvitationRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=500"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// Bad request
+type Error struct {
+	// Tells apart the errors of the data conflicting with existing data or breaking a rule of the database.
+	Code    *ErrorCode `json:"code,omitempty"`
+	Message string     `json:"message"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// GetActivityAttachmentsResponse defines model for GetActivityAttachmentsResponse.
+type GetActivityAttachmentsResponse struct {
+	Attachments []GetActivityAttachmentsResponseArray `json:"attachments"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// GetActivityAttachmentsResponseArray defines model for GetActivityAttachmentsResponseArray.
+type GetActivityAttachmentsResponseArray struct {
+	ContentType  string    `json:"content_type"`
+	CreatedAt    time.Time `json:"created_at"`
+	FileName     string    `json:"file_name"`
+	ID           string    `json:"id"`
+	Size         int64     `json:"size"`
+	URL          string    `json:"url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// GetActivityCommentsResponse defines model for GetActivityCommentsResponse.
+type GetActivityCommentsResponse struct {
+	Comments []GetActivityCommentsResponseArray `json:"comments"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetActivityCommentsResponseArray defines model for GetActivityCommentsResponseArray.
+type GetActivityCommentsResponseArray struct {
+	AuthorEmail   openapi_types.Email `json:"author_email"`
+	Body          string              `json:"body"`
+	CreatedAt     time.Time           `json:"created_at"`
+	ID            string              `json:"id"`
+	ParticipantID string              `json:"participant_id"`
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// GetActivityResponse defines model for GetActivityResponse.
+type GetActivityResponse struct {
+	Activity    GetTripActivitiesResponseInnerArray   `json:"activity"`
+	Attachments []GetActivityAttachmentsResponseArray `json:"attachments"`
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetBookingsResponse defines model for GetBookingsResponse.
+type GetBookingsResponse struct {
+	// The bookings by check-in, the earliest first.
+	Bookings []GetBookingsResponseArray `json:"bookings"`
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// GetBookingsResponseArray defines model for GetBookingsResponseArray.
+type GetBookingsResponseArray struct {
+	Address          *string     `json:"address,omitempty"`
+	CheckInAt        time.Time   `json:"check_in_at"`
+	CheckOutAt       time.Time   `json:"check_out_at"`
+	ConfirmationCode *string     `json:"confirmation_code,omitempty"`
+	CreatedAt        time.Time   `json:"created_at"`
+	Currency         *string     `json:"currency,omitempty"`
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	Price            *int64      `json:"price,omitempty"`
+	Type             BookingType `json:"type"`
+	URL              *string     `json:"url,omitempty"`
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetExpenseTotalsResponse defines model for GetExpenseTotalsResponse.
+type GetExpenseTotalsResponse struct {
+	// The totals of each currency spent in, amounts in different currencies not being converted.
+	Totals []GetExpenseTotalsResponseArray `json:"totals"`
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// GetExpenseTotalsResponseArray defines model for GetExpenseTotalsResponseArray.
+type GetExpenseTotalsResponseArray struct {
+	// The totals of each category.
+	ByCategory []GetExpenseTotalsResponseCategoryArray `json:"by_category"`
+
+	// The totals of each participant who paid.
+	ByPayer  []GetExpenseTotalsResponsePayerArray `json:"by_payer"`
+	Currency string                               `json:"currency"`
+	Total    int64                                `json:"total"`
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetExpenseTotalsResponseCategoryArray defines model for GetExpenseTotalsResponseCategoryArray.
+type GetExpenseTotalsResponseCategoryArray struct {
+	Category ExpenseCategory `json:"category"`
+	Total    int64           `json:"total"`
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// GetExpenseTotalsResponsePayerArray defines model for GetExpenseTotalsResponsePayerArray.
+type GetExpenseTotalsResponsePayerArray struct {
+	ParticipantID string `json:"participant_id"`
+	Total         int64  `json:"total"`
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetExpensesResponse defines model for GetExpensesResponse.
+type GetExpensesResponse struct {
+	// The expenses, in the order they were spent.
+	Expenses []GetExpensesResponseArray `json:"expenses"`
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// GetExpensesResponseArray defines model for GetExpensesResponseArray.
+type GetExpensesResponseArray struct {
+	ActivityID *string `json:"activity_id,omitempty"`
+
+	// In the minor unit of the currency, such as cents.
+	Amount      int64           `json:"amount"`
+	Category    ExpenseCategory `json:"category"`
+	Currency    string          `json:"currency"`
+	Description string          `json:"description"`
+	ID          string          `json:"id"`
+	PayerID     string          `json:"payer_id"`
+	SpentAt     time.Time       `json:"spent_at"`
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetLinksResponse defines model for GetLinksResponse.
+type GetLinksResponse struct {
+	// Links that are not pinned, grouped by category.
+	Links []GetLinksResponseOuterArray `json:"links"`
+
+	// Pinned links, in display order.
+	Pinned []GetLinksResponseArray `json:"pinned"`
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// GetLinksResponseArray defines model for GetLinksResponseArray.
+type GetLinksResponseArray struct {
+	Category LinkCategory `json:"category"`
+
+	// How many times the link was opened through /l/{linkId}.
+	Clicks int    `json:"clicks"`
+	ID     string `json:"id"`
+	Pinned bool   `json:"pinned"`
+
+	// Metadata of the linked page, fetched in the background after the link is saved. Absent until then.
+	Preview *LinkPreview `json:"preview,omitempty"`
+	Title   string       `json:"title"`
+	URL     string       `json:"url"`
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetLinksResponseOuterArray defines model for GetLinksResponseOuterArray.
+type GetLinksResponseOuterArray struct {
+	Category LinkCategory            `json:"category"`
+	Links    []GetLinksResponseArray `json:"links"`
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// GetMessagesResponse defines model for GetMessagesResponse.
+type GetMessagesResponse struct {
+	// The messages, newest first.
+	Messages []GetMessagesResponseArray `json:"messages"`
+
+	// Cursor of the page of older messages. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetMessagesResponseArray defines model for GetMessagesResponseArray.
+type GetMessagesResponseArray struct {
+	AuthorEmail   openapi_types.Email `json:"author_email"`
+	AuthorName    *string             `json:"author_name,omitempty"`
+	Body          string              `json:"body"`
+	CreatedAt     time.Time           `json:"created_at"`
+	ID            string              `json:"id"`
+	ParticipantID string              `json:"participant_id"`
 }
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// GetNotificationPreferencesResponse defines model for GetNotificationPreferencesResponse.
+type GetNotificationPreferencesResponse struct {
+	ActivityReminder bool `json:"activity_reminder"`
+	DailyDigest      bool `json:"daily_digest"`
+	FlightUpdate     bool `json:"flight_update"`
+	PreTripReminder  bool `json:"pre_trip_reminder"`
+	RsvpReminder     bool `json:"rsvp_reminder"`
+	TaskReminder     bool `json:"task_reminder"`
 }
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetNotificationsResponse defines model for GetNotificationsResponse.
+type GetNotificationsResponse struct {
+	// The notifications, newest first.
+	Notifications []GetNotificationsResponseArray `json:"notifications"`
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// GetNotificationsResponseArray defines model for GetNotificationsResponseArray.
+type GetNotificationsResponseArray struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Event     NotificationEvent `json:"event"`
+	ID        string            `json:"id"`
+	ReadAt    *time.Time        `json:"read_at,omitempty"`
+
+	// The activity created, the participant confirmed or the trip updated.
+	SubjectID string `json:"subject_id"`
+	TripID    string `json:"trip_id"`
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// GetPackingListResponse defines model for GetPackingListResponse.
+type GetPackingListResponse struct {
+	Items []GetPackingListResponseArray `json:"items"`
 }
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// GetPackingListResponseArray defines model for GetPackingListResponseArray.
+type GetPackingListResponseArray struct {
+	AssigneeID *string   `json:"assignee_id,omitempty"`
+	Checked    bool      `json:"checked"`
+	CreatedAt  time.Time `json:"created_at"`
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Quantity   int       `json:"quantity"`
+}
+
+// GetPackingSuggestionsResponse defines model for GetPackingSuggestionsResponse.
+type GetPackingSuggestionsResponse struct {
+	Items []GetPackingSuggestionsResponseArray `json:"items"`
+}
+
+// GetPackingSuggestionsResponseArray defines model for GetPackingSuggestionsResponseArray.
+type GetPackingSuggestionsResponseArray struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+// GetParticipantHistoryResponse defines model for GetParticipantHistoryResponse.
+type GetParticipantHistoryResponse struct {
+	History []GetParticipantHistoryResponseArray `json:"history"`
+}
+
+// GetParticipantHistoryResponseArray defines model for GetParticipantHistoryResponseArray.
+type GetParticipantHistoryResponseArray struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Reason    *string           `json:"reason,omitempty"`
+	Status    ParticipantStatus `json:"status"`
+}
+
+// GetParticipantResponse defines model for GetParticipantResponse.
+type GetParticipantResponse struct {
+	Participant GetTripParticipantsResponseArray `json:"participant"`
+	Trip        GetTripDetailsResponseTripObj    `json:"trip"`
+}
+
+// GetPhotoCommentsResponse defines model for GetPhotoCommentsResponse.
+type GetPhotoCommentsResponse struct {
+	// The comments, oldest first.
+	Comments []GetPhotoCommentsResponseArray `json:"comments"`
+}
+
+// GetPhotoCommentsResponseArray defines model for GetPhotoCommentsResponseArray.
+type GetPhotoCommentsResponseArray struct {
+	AuthorEmail   openapi_types.Email `json:"author_email"`
+	AuthorName    *string             `json:"author_name,omitempty"`
+	Body          string              `json:"body"`
+	CreatedAt     time.Time           `json:"created_at"`
+	ID            string              `json:"id"`
+	ParticipantID string              `json:"participant_id"`
+}
+
+// GetPollsResponse defines model for GetPollsResponse.
+type GetPollsResponse struct {
+	// The polls, in the order they were created.
+	Polls []GetPollsResponseArray `json:"polls"`
+}
+
+// GetPollsResponseArray defines model for GetPollsResponseArray.
+type GetPollsResponseArray struct {
+	// When the winning option was applied to the trip, which closes the poll.
+	AppliedAt *time.Time                    `json:"applied_at,omitempty"`
+	CreatedAt time.Time                     `json:"created_at"`
+	ID        string                        `json:"id"`
+	Kind      PollKind                      `json:"kind"`
+	Options   []GetPollsResponseOptionArray `json:"options"`
+	Question  string                        `json:"question"`
+
+	// The option with the most votes, the first one shown on a tie. Absent while nobody voted.
+	WinnerOptionID *string `json:"winner_option_id,omitempty"`
+}
+
+// GetPollsResponseOptionArray defines model for GetPollsResponseOptionArray.
+type GetPollsResponseOptionArray struct {
+	Destination *string    `json:"destination,omitempty"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+	ID          string     `json:"id"`
+	StartsAt    *time.Time `json:"starts_at,omitempty"`
+	Votes       int64      `json:"votes"`
+}
+
+// GetSettlementResponse defines model for GetSettlementResponse.
+type GetSettlementResponse struct {
+	// The payments already made, in the order they were made.
+	Payments []GetSettlementResponsePaymentArray `json:"payments"`
+
+	// The settlement of each currency spent in.
+	Settlements []GetSettlementResponseArray `json:"settlements"`
+}
+
+// GetSettlementResponseArray defines model for GetSettlementResponseArray.
+type GetSettlementResponseArray struct {
+	// What each participant paid less their share of the expenses, the payments made included. A participant with a negative balance owes money.
+	Balances []GetSettlementResponseBalanceArray `json:"balances"`
+	Currency string                              `json:"currency"`
+
+	// The fewest payments settling the balances.
+	Transfers []GetSettlementResponseTransferArray `json:"transfers"`
+}
+
+// GetSettlementResponseBalanceArray defines model for GetSettlementResponseBalanceArray.
+type GetSettlementResponseBalanceArray struct {
+	// In the minor unit of the currency, such as cents.
+	Balance       int64  `json:"balance"`
+	ParticipantID string `json:"participant_id"`
+}
+
+// GetSettlementResponsePaymentArray defines model for GetSettlementResponsePaymentArray.
+type GetSettlementResponsePaymentArray struct {
+	// In the minor unit of the currency, such as cents.
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	FromParticipantID string    `json:"from_participant_id"`
+	ID                string    `json:"id"`
+	PaidAt            time.Time `json:"paid_at"`
+	ToParticipantID   string    `json:"to_participant_id"`
+}
+
+// GetSettlementResponseTransferArray defines model for GetSettlementResponseTransferArray.
+type GetSettlementResponseTransferArray struct {
+	// In the minor unit of the currency, such as cents.
+	Amount            int64  `json:"amount"`
+	FromParticipantID string `json:"from_participant_id"`
+	ToParticipantID   string `json:"to_participant_id"`
+}
+
+// GetSharedTripResponse defines model for GetSharedTripResponse.
+type GetSharedTripResponse struct {
+	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+	Links      []GetLinksResponseArray               `json:"links"`
+	Trip       GetTripDetailsResponseTripObj         `json:"trip"`
+}
+
+// GetTagsResponse defines model for GetTagsResponse.
+type GetTagsResponse struct {
+	Tags []GetTagsResponseArray `json:"tags"`
+}
+
+// GetTagsResponseArray defines model for GetTagsResponseArray.
+type GetTagsResponseArray struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetTasksResponse defines model for GetTasksResponse.
+type GetTasksResponse struct {
+	// The tasks left to do first, the soonest due first.
+	Tasks []GetTasksResponseArray `json:"tasks"`
+}
+
+// GetTasksResponseArray defines model for GetTasksResponseArray.
+type GetTasksResponseArray struct {
+	AssigneeID *string    `json:"assignee_id,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Done       bool       `json:"done"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	ID         string     `json:"id"`
+
+	// Whether the task is not done and past its due date.
+	Overdue bool   `json:"overdue"`
+	Title   string `json:"title"`
+}
+
+// GetTransportSegmentsResponse defines model for GetTransportSegmentsResponse.
+type GetTransportSegmentsResponse struct {
+	// The segments by departure, the earliest first.
+	Segments []GetTransportSegmentsResponseArray `json:"segments"`
+}
+
+// GetTransportSegmentsResponseArray defines model for GetTransportSegmentsResponseArray.
+type GetTransportSegmentsResponseArray struct {
+	ArrivalAt      time.Time     `json:"arrival_at"`
+	ArrivalPlace   string        `json:"arrival_place"`
+	Carrier        string        `json:"carrier"`
+	CreatedAt      time.Time     `json:"created_at"`
+	DepartureAt    time.Time     `json:"departure_at"`
+	DeparturePlace string        `json:"departure_place"`
+	ID             string        `json:"id"`
+	Mode           TransportMode `json:"mode"`
+	Number         *string       `json:"number,omitempty"`
+	ParticipantIds []string      `json:"participant_ids"`
+}
+
+// GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
+type GetTripActivitiesResponse struct {
+	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+}
+
+// GetTripActivitiesResponseBooking defines model for GetTripActivitiesResponseBooking.
+type GetTripActivitiesResponseBooking struct {
+	Address *string `json:"address,omitempty"`
+
+	// When the check-in or the check-out is.
+	At               time.Time    `json:"at"`
+	ConfirmationCode *string      `json:"confirmation_code,omitempty"`
+	Event            BookingEvent `json:"event"`
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	Type             BookingType  `json:"type"`
+}
+
+// GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
+type GetTripActivitiesResponseInnerArray struct {
+	Address *string `json:"address,omitempty"`
+
+	// How many participants said they will attend.
+	AttendeesCount int              `json:"attendees_count"`
+	Category       ActivityCategory `json:"category"`
+
+	// Length of the activity, only present when ends_at is set.
+	DurationMinutes *int       `json:"duration_minutes,omitempty"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"`
+	ID              string     `json:"id"`
+	Latitude        *float64   `json:"latitude,omitempty"`
+	Longitude       *float64   `json:"longitude,omitempty"`
+	OccursAt        time.Time  `json:"occurs_at"`
+
+	// Canonical name of the place the address was geocoded to.
+	PlaceName *string `json:"place_name,omitempty"`
+
+	// Display order among activities happening at the same time.
+	Position int    `json:"position"`
+	Title    string `json:"title"`
+
+	// How many participants upvoted the activity.
+	VotesCount int `json:"votes_count"`
+}
+
+// GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
+type GetTripActivitiesResponseOuterArray struct {
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+
+	// The check-ins and check-outs of the day, the earliest first.
+	Bookings []GetTripActivitiesResponseBooking `json:"bookings"`
+
+	// Day of the activities, bookings and segments in the requested time zone, e.g. 2024-07-21.
+	Date openapi_types.Date `json:"date"`
+
+	// The flights, trains and buses departing on the day, the earliest first.
+	Segments []GetTripActivitiesResponseSegment `json:"segments"`
+}
+
+// GetTripActivitiesResponseSegment defines model for GetTripActivitiesResponseSegment.
+type GetTripActivitiesResponseSegment struct {
+	ArrivalAt      time.Time     `json:"arrival_at"`
+	ArrivalPlace   string        `json:"arrival_place"`
+	Carrier        string        `json:"carrier"`
+	DepartureAt    time.Time     `json:"departure_at"`
+	DeparturePlace string        `json:"departure_place"`
+	ID             string        `json:"id"`
+	Mode           TransportMode `json:"mode"`
+	Number         *string       `json:"number,omitempty"`
+	ParticipantIds []string      `json:"participant_ids"`
+}
+
+// GetTripDetailsResponse defines model for GetTripDetailsResponse.
+type GetTripDetailsResponse struct {
+	Trip GetTripDetailsResponseTripObj `json:"trip"`
+}
+
+// GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
+type GetTripDetailsResponseTripObj struct {
+	Description string    `json:"description"`
+	Destination string    `json:"destination"`
+	EndsAt      time.Time `json:"ends_at"`
+	ID          string    `json:"id"`
+	IsConfirmed bool      `json:"is_confirmed"`
+
+	// Where the destination was geocoded to, absent when it was not found.
+	Latitude        *float64 `json:"latitude,omitempty"`
+	Longitude       *float64 `json:"longitude,omitempty"`
+	MaxGuests       int      `json:"max_guests"`
+	MaxParticipants *int     `json:"max_participants,omitempty"`
+
+	// Canonical name of the place the destination was geocoded to, e.g. Lisboa, Portugal.
+	PlaceName           *string    `json:"place_name,omitempty"`
+	PreTripReminderDays int        `json:"pre_trip_reminder_days"`
+	RsvpDeadline        *time.Time `json:"rsvp_deadline,omitempty"`
+	StartsAt            time.Time  `json:"starts_at"`
+	Status              TripStatus `json:"status"`
+	Timezone            string     `json:"timezone"`
+
+	// Incremented on every change to the trip. Send it in the If-Match header to update the trip.
+	Version int `json:"version"`
+}
+
+// GetTripDocumentsResponse defines model for GetTripDocumentsResponse.
+type GetTripDocumentsResponse struct {
+	Documents []GetTripDocumentsResponseArray `json:"documents"`
+}
+
+// GetTripDocumentsResponseArray defines model for GetTripDocumentsResponseArray.
+type GetTripDocumentsResponseArray struct {
+	ContentType string       `json:"content_type"`
+	CreatedAt   time.Time    `json:"created_at"`
+	FileName    string       `json:"file_name"`
+	ID          string       `json:"id"`
+	Kind        DocumentKind `json:"kind"`
+
+	// The participant the document is private to, absent for the documents of the whole trip.
+	ParticipantID *string       `json:"participant_id,omitempty"`
+	Scope         DocumentScope `json:"scope"`
+	Size          int64         `json:"size"`
+
+	// Signed download URL.
+	URL          string    `json:"url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+}
+
+// GetTripJournalResponse defines model for GetTripJournalResponse.
+type GetTripJournalResponse struct {
+	// The entries, by day and then by when they were written.
+	Entries []GetTripJournalResponseArray `json:"entries"`
+}
+
+// GetTripJournalResponseArray defines model for GetTripJournalResponseArray.
+type GetTripJournalResponseArray struct {
+	AuthorEmail openapi_types.Email `json:"author_email"`
+	AuthorName  *string             `json:"author_name,omitempty"`
+	Body        string              `json:"body"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Date        openapi_types.Date  `json:"date"`
+	ID          string              `json:"id"`
+
+	// The participant who wrote the entry.
+	ParticipantID string    `json:"participant_id"`
+	Title         *string   `json:"title,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	// `shared` entries are read by every participant, `private` ones by their author only. Defaults to shared.
+	Visibility JournalVisibility `json:"visibility"`
+}
+
+// GetTripOwnersResponse defines model for GetTripOwnersResponse.
+type GetTripOwnersResponse struct {
+	Owners []GetTripOwnersResponseArray `json:"owners"`
+}
+
+// GetTripOwnersResponseArray defines model for GetTripOwnersResponseArray.
+type GetTripOwnersResponseArray struct {
+	Email openapi_types.Email `json:"email"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	Locale Locale `json:"locale"`
+	Name   string `json:"name"`
+}
+
+// GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
+type GetTripParticipantsResponse struct {
+	Participants []GetTripParticipantsResponseArray `json:"participants"`
+}
+
+// GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
+type GetTripParticipantsResponseArray struct {
+	AvatarURL     *string             `json:"avatar_url,omitempty"`
+	DeclineReason *string             `json:"decline_reason,omitempty"`
+	DeclinedAt    *time.Time          `json:"declined_at,omitempty"`
+	Email         openapi_types.Email `json:"email"`
+
+	// Why e-mails to an address are not delivered: it bounced, or its owner reported the e-mails as spam.
+	EmailIssue *EmailIssue `json:"email_issue,omitempty"`
+
+	// The participant confirmed their address with a verification code.
+	EmailVerified bool `json:"email_verified"`
+
+	// Extra guests the participant is bringing.
+	Guests      int    `json:"guests"`
+	ID          string `json:"id"`
+	IsConfirmed bool   `json:"is_confirmed"`
+	IsDeclined  bool   `json:"is_declined"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	Locale Locale  `json:"locale"`
+	Name   *string `json:"name"`
+
+	// The participant did not answer before the RSVP deadline.
+	NoResponse bool    `json:"no_response"`
+	Phone      *string `json:"phone,omitempty"`
+}
+
+// GetTripPhotosResponse defines model for GetTripPhotosResponse.
+type GetTripPhotosResponse struct {
+	// Cursor of the page of older photos. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// The photos, newest first.
+	Photos []GetTripPhotosResponseArray `json:"photos"`
+}
+
+// GetTripPhotosResponseArray defines model for GetTripPhotosResponseArray.
+type GetTripPhotosResponseArray struct {
+	AuthorEmail openapi_types.Email `json:"author_email"`
+	AuthorName  *string             `json:"author_name,omitempty"`
+	Caption     *string             `json:"caption,omitempty"`
+
+	// How many comments the photo has.
+	Comments    int       `json:"comments"`
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	FileName    string    `json:"file_name"`
+
+	// Height of the photo in pixels, known once its thumbnail is made.
+	Height *int   `json:"height,omitempty"`
+	ID     string `json:"id"`
+
+	// The participant who uploaded the photo.
+	ParticipantID   string          `json:"participant_id"`
+	Size            int64           `json:"size"`
+	ThumbnailStatus ThumbnailStatus `json:"thumbnail_status"`
+
+	// Signed download URL of the thumbnail, a JPEG of at most 320 pixels a side. Absent until the thumbnail is ready.
+	ThumbnailURL *string `json:"thumbnail_url,omitempty"`
+
+	// Signed download URL of the photo.
+	URL          string    `json:"url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+
+	// Width of the photo in pixels, known once its thumbnail is made.
+	Width *int `json:"width,omitempty"`
+}
+
+// GetTripStatsResponse defines model for GetTripStatsResponse.
+type GetTripStatsResponse struct {
+	// Confirmed participants plus their guests.
+	Attendees int `json:"attendees"`
+	Confirmed int `json:"confirmed"`
+	Declined  int `json:"declined"`
+
+	// Extra guests brought by confirmed participants.
+	Guests int `json:"guests"`
+
+	// Participants invited to the trip.
+	Invited int `json:"invited"`
+
+	// Participants that did not answer before the RSVP deadline.
+	NoResponse int `json:"no_response"`
+}
+
+// GetTripSuggestionsResponse defines model for GetTripSuggestionsResponse.
+type GetTripSuggestionsResponse struct {
+	// The places around the destination, the most relevant first.
+	Suggestions []GetTripSuggestionsResponseArray `json:"suggestions"`
+}
+
+// GetTripSuggestionsResponseArray defines model for GetTripSuggestionsResponseArray.
+type GetTripSuggestionsResponseArray struct {
+	Activity *CreateActivityRequest `json:"activity,omitempty"`
+	Address  *string                `json:"address,omitempty"`
+
+	// Identifier of the place at the places provider.
+	ID        string         `json:"id"`
+	Kind      SuggestionKind `json:"kind"`
+	Latitude  float64        `json:"latitude"`
+	Longitude float64        `json:"longitude"`
+	Name      string         `json:"name"`
+
+	// Average rating of the place, from 1 to 5. Absent when it has none.
+	Rating *float64 `json:"rating,omitempty"`
+}
+
+// GetTripSummaryResponse defines model for GetTripSummaryResponse.
+type GetTripSummaryResponse struct {
+	Activities   []GetTripActivitiesResponseOuterArray `json:"activities"`
+	Links        []GetLinksResponseArray               `json:"links"`
+	Participants []GetTripParticipantsResponseArray    `json:"participants"`
+	Trip         GetTripDetailsResponseTripObj         `json:"trip"`
+}
+
+// GetTripWaitlistResponse defines model for GetTripWaitlistResponse.
+type GetTripWaitlistResponse struct {
+	Waitlist []GetTripWaitlistResponseArray `json:"waitlist"`
+}
+
+// GetTripWaitlistResponseArray defines model for GetTripWaitlistResponseArray.
+type GetTripWaitlistResponseArray struct {
+	CreatedAt time.Time           `json:"created_at"`
+	Email     openapi_types.Email `json:"email"`
+}
+
+// GetTripWeatherResponse defines model for GetTripWeatherResponse.
+type GetTripWeatherResponse struct {
+	// The days of the trip within the horizon of the forecast, the first first. Empty while the trip is too far ahead.
+	Days        []GetTripWeatherResponseArray `json:"days"`
+	Destination string                        `json:"destination"`
+}
+
+// GetTripWeatherResponseArray defines model for GetTripWeatherResponseArray.
+type GetTripWeatherResponseArray struct {
+	Condition WeatherCondition `json:"condition"`
+
+	// Day of the forecast in the trip time zone, e.g. 2024-07-21.
+	Date openapi_types.Date `json:"date"`
+
+	// In degrees Celsius.
+	MaxTemperature float32 `json:"max_temperature"`
+
+	// In degrees Celsius.
+	MinTemperature float32 `json:"min_temperature"`
+
+	// Chance of rain or snow, in percent.
+	PrecipitationProbability int `json:"precipitation_probability"`
+}
+
+// GetTripsResponse defines model for GetTripsResponse.
+type GetTripsResponse struct {
+	Trips []GetTripDetailsResponseTripObj `json:"trips"`
+}
+
+// GetUnreadMessagesResponse defines model for GetUnreadMessagesResponse.
+type GetUnreadMessagesResponse struct {
+	// Messages the other participants wrote since the participant last read the board.
+	Unread int64 `json:"unread"`
+}
+
+// GetWebhookDeliveriesResponse defines model for GetWebhookDeliveriesResponse.
+type GetWebhookDeliveriesResponse struct {
+	// The deliveries, newest first.
+	Deliveries []GetWebhookDeliveriesResponseArray `json:"deliveries"`
+}
+
+// GetWebhookDeliveriesResponseArray defines model for GetWebhookDeliveriesResponseArray.
+type GetWebhookDeliveriesResponseArray struct {
+	Attempts    int          `json:"attempts"`
+	CreatedAt   time.Time    `json:"created_at"`
+	DeliveredAt *time.Time   `json:"delivered_at,omitempty"`
+	Event       WebhookEvent `json:"event"`
+	EventID     string       `json:"event_id"`
+	ID          string       `json:"id"`
+	LastError   *string      `json:"last_error,omitempty"`
+
+	// When the delivery is attempted again, while it is pending.
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// The status the webhook answered the last attempt with, if it answered.
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	TripID         string                `json:"trip_id"`
+}
+
+// GetWebhooksResponse defines model for GetWebhooksResponse.
+type GetWebhooksResponse struct {
+	Webhooks []GetWebhooksResponseArray `json:"webhooks"`
+}
+
+// GetWebhooksResponseArray defines model for GetWebhooksResponseArray.
+type GetWebhooksResponseArray struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+	TripID    string    `json:"trip_id"`
+	URL       string    `json:"url"`
+}
+
+// ImportActivitiesErrorResponse defines model for ImportActivitiesErrorResponse.
+type ImportActivitiesErrorResponse struct {
+	Errors  []ImportActivitiesErrorResponseArray `json:"errors"`
+	Message string                               `json:"message"`
+}
+
+// ImportActivitiesErrorResponseArray defines model for ImportActivitiesErrorResponseArray.
+type ImportActivitiesErrorResponseArray struct {
+	Message string `json:"message"`
+
+	// 1-based index of the row, not counting the CSV header.
+	Row int `json:"row"`
+}
+
+// ImportActivitiesRequest defines model for ImportActivitiesRequest.
+type ImportActivitiesRequest []ImportActivityRow
+
+// ImportActivitiesResponse defines model for ImportActivitiesResponse.
+type ImportActivitiesResponse struct {
+	ActivityIds []string `json:"activityIds"`
+}
+
+// ImportActivityRow defines model for ImportActivityRow.
+type ImportActivityRow struct {
+	Address  *string           `json:"address,omitempty" validate:"omitempty,max=500"`
+	Category *ActivityCategory `json:"category,omitempty"`
+
+	// When the activity ends. Omit for activities that happen at an instant.
+	EndsAt    *time.Time `json:"ends_at,omitempty" validate:"omitempty,gtfield=OccursAt"`
+	Latitude  *float64   `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64   `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
+	OccursAt  time.Time  `json:"occurs_at" validate:"required"`
+	Title     string     `json:"title" validate:"required"`
+}
+
+// InviteParticipantsRequest defines model for InviteParticipantsRequest.
+type InviteParticipantsRequest struct {
+	Emails []string `json:"emails" validate:"required,min=1,max=50"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	Locale *Locale `json:"locale,omitempty"`
+
+	// Send a verification code to the invited addresses first. The invitation is only sent once the participant confirms the code.
+	VerifyEmails *bool `json:"verify_emails,omitempty"`
+}
+
+// InviteParticipantsResponse defines model for InviteParticipantsResponse.
+type InviteParticipantsResponse struct {
+	Results []InviteParticipantsResponseArray `json:"results"`
+}
+
+// InviteParticipantsResponseArray defines model for InviteParticipantsResponseArray.
+type InviteParticipantsResponseArray struct {
+	// The e-mail was already a participant of the trip, so nothing was done.
+	AlreadyInvited bool   `json:"already_invited"`
+	Email          string `json:"email"`
+
+	// Why the e-mail was not invited, only present when invited is false.
+	Error   *string `json:"error,omitempty"`
+	Invited bool    `json:"invited"`
+
+	// The trip is full, so the e-mail was put on the waitlist instead.
+	Waitlisted bool `json:"waitlisted"`
+}
+
+// JoinTripRequest defines model for JoinTripRequest.
+type JoinTripRequest struct {
+	Code  string              `json:"code" validate:"required,len=6"`
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// JoinTripResponse defines model for JoinTripResponse.
+type JoinTripResponse struct {
+	TripID string `json:"trip_id"`
+
+	// Whether the trip is full and the e-mail was put on the waitlist.
+	Waitlisted bool `json:"waitlisted"`
+}
+
+// Metadata of the linked page, fetched in the background after the link is saved. Absent until then.
+type LinkPreview struct {
+	Description *string `json:"description,omitempty"`
+	FaviconURL  *string `json:"favicon_url,omitempty"`
+	ImageURL    *string `json:"image_url,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// PatchActivityRequest defines model for PatchActivityRequest.
+type PatchActivityRequest struct {
+	Address   *string           `json:"address,omitempty" validate:"omitempty,max=500"`
+	Category  *ActivityCategory `json:"category,omitempty"`
+	EndsAt    *time.Time        `json:"ends_at,omitempty"`
+	Latitude  *float64          `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64          `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
+	OccursAt  *time.Time        `json:"occurs_at,omitempty"`
+	Title     *string           `json:"title,omitempty"`
+}
+
+// PatchLinkRequest defines model for PatchLinkRequest.
+type PatchLinkRequest struct {
+	Category *LinkCategory `json:"category,omitempty"`
+
+	// Pinned links are listed first, outside of their category.
+	Pinned *bool   `json:"pinned,omitempty"`
+	Title  *string `json:"title,omitempty" validate:"omitempty,min=1"`
+
+	// Must be an http or https URL. Tracking parameters such as utm_source are removed.
+	URL *string `json:"url,omitempty"`
+}
+
+// PatchTripRequest defines model for PatchTripRequest.
+type PatchTripRequest struct {
+	// Markdown notes about the trip. Raw HTML is stripped before storage.
+	Description *string    `json:"description" validate:"omitempty,max=10000"`
+	Destination *string    `json:"destination,omitempty" validate:"omitempty,min=4"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+
+	// How many extra guests each participant may bring. Defaults to 0.
+	MaxGuests *int `json:"max_guests" validate:"omitempty,min=0"`
+
+	// Invitations beyond this number of participants go to the waitlist. Unlimited when absent.
+	MaxParticipants *int `json:"max_participants" validate:"omitempty,min=1"`
+
+	// How many days before the trip starts confirmed participants are e-mailed the day-one agenda and the trip links. 0 disables the reminder. Defaults to 2.
+	PreTripReminderDays *int `json:"pre_trip_reminder_days" validate:"omitempty,min=0,max=30"`
+
+	// Participants have until this time to answer their invitation. Must not be after starts_at.
+	RsvpDeadline *time.Time `json:"rsvp_deadline"`
+	StartsAt     *time.Time `json:"starts_at,omitempty"`
+
+	// IANA timezone of the trip, such as America/Sao_Paulo, used to group the activities by day. Defaults to UTC.
+	Timezone *string `json:"timezone" validate:"omitempty,timezone"`
+}
+
+// ReorderActivitiesRequest defines model for ReorderActivitiesRequest.
+type ReorderActivitiesRequest struct {
+	ActivityIds []string `json:"activity_ids" validate:"required,min=1,unique,dive,uuid"`
+}
+
+// ReorderLinksRequest defines model for ReorderLinksRequest.
+type ReorderLinksRequest struct {
+	LinkIds []string `json:"link_ids" validate:"required,min=1,unique,dive,uuid"`
+}
+
+// SearchTripResponse defines model for SearchTripResponse.
+type SearchTripResponse struct {
+	Results []SearchTripResponseArray `json:"results"`
+}
+
+// SearchTripResponseArray defines model for SearchTripResponseArray.
+type SearchTripResponseArray struct {
+	ID string `json:"id"`
+
+	// The activity title, link title or participant e-mail that matched.
+	Text string `json:"text"`
+
+	// What matched: activity, link or participant.
+	Type string `json:"type"`
+}
+
+// TripRangeConflictResponse defines model for TripRangeConflictResponse.
+type TripRangeConflictResponse struct {
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+	Message    string                                `json:"message"`
+}
+
+// UnconfirmParticipantRequest defines model for UnconfirmParticipantRequest.
+type UnconfirmParticipantRequest struct {
+	// Why the participant is no longer coming. Sent to the trip owners.
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// UpdateActivityRSVPRequest defines model for UpdateActivityRSVPRequest.
+type UpdateActivityRSVPRequest struct {
+	Attending     bool   `json:"attending"`
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+}
+
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	Address  *string           `json:"address,omitempty" validate:"omitempty,max=500"`
+	Category *ActivityCategory `json:"category,omitempty"`
+
+	// When the activity ends. Omit for activities that happen at an instant.
+	EndsAt    *time.Time `json:"ends_at,omitempty" validate:"omitempty,gtfield=OccursAt"`
+	Latitude  *float64   `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64   `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
+	OccursAt  time.Time  `json:"occurs_at" validate:"required"`
+	Title     string     `json:"title" validate:"required"`
+}
+
+// UpdateBookingRequest defines model for UpdateBookingRequest.
+type UpdateBookingRequest struct {
+	Address *string `json:"address,omitempty" validate:"omitempty,max=500"`
+
+	// Must fall within the trip dates.
+	CheckInAt time.Time `json:"check_in_at" validate:"required"`
+
+	// Must be after the check-in and no later than the end of the trip.
+	CheckOutAt time.Time `json:"check_out_at" validate:"required,gtfield=CheckInAt"`
+
+	// The reservation number given by the place or the booking site.
+	ConfirmationCode *string `json:"confirmation_code,omitempty" validate:"omitempty,max=100"`
+
+	// ISO 4217 code of the currency of the price, such as BRL.
+	Currency *string `json:"currency,omitempty" validate:"required_with=Price,omitempty,iso4217"`
+
+	// Name of the place, such as the hotel.
+	Name string `json:"name" validate:"required,max=255"`
+
+	// In the minor unit of the currency, such as cents. Given along with the currency.
+	Price *int64       `json:"price,omitempty" validate:"required_with=Currency,omitempty,min=1"`
+	Type  *BookingType `json:"type,omitempty"`
+
+	// Page of the reservation.
+	URL *string `json:"url,omitempty" validate:"omitempty,url,max=2048"`
+}
+
+// UpdateExpenseRequest defines model for UpdateExpenseRequest.
+type UpdateExpenseRequest struct {
+	// The activity the expense is for, if any.
+	ActivityID *string `json:"activity_id,omitempty" validate:"omitempty,uuid"`
+
+	// In the minor unit of the currency, such as cents.
+	Amount   int64            `json:"amount" validate:"required,min=1"`
+	Category *ExpenseCategory `json:"category,omitempty"`
+
+	// ISO 4217 code of the currency, such as BRL.
+	Currency    string `json:"currency" validate:"required,iso4217"`
+	Description string `json:"description" validate:"required,max=255"`
+
+	// The participant who paid.
+	PayerID string `json:"payer_id" validate:"required,uuid"`
+
+	// When the money was spent. Defaults to now.
+	SpentAt *time.Time `json:"spent_at,omitempty"`
+}
+
+// UpdateJournalEntryRequest defines model for UpdateJournalEntryRequest.
+type UpdateJournalEntryRequest struct {
+	// Markdown text of the entry. Raw HTML is stripped before storage.
+	Body string `json:"body" validate:"required,max=20000"`
+
+	// Day of the trip the entry is about, in the time zone of the trip, e.g. 2024-07-21.
+	Date  openapi_types.Date `json:"date"`
+	Title *string            `json:"title,omitempty" validate:"omitempty,max=200"`
+
+	// `shared` entries are read by every participant, `private` ones by their author only. Defaults to shared.
+	Visibility *JournalVisibility `json:"visibility,omitempty"`
+}
+
+// UpdateLinkRequest defines model for UpdateLinkRequest.
+type UpdateLinkRequest struct {
+	Category *LinkCategory `json:"category,omitempty"`
+	Title    string        `json:"title" validate:"required"`
+
+	// Must be an http or https URL. Tracking parameters such as utm_source are removed.
+	URL string `json:"url" validate:"required"`
+}
+
+// UpdateNotificationPreferencesRequest defines model for UpdateNotificationPreferencesRequest.
+type UpdateNotificationPreferencesRequest struct {
+	ActivityReminder *bool `json:"activity_reminder,omitempty"`
+	DailyDigest      *bool `json:"daily_digest,omitempty"`
+	FlightUpdate     *bool `json:"flight_update,omitempty"`
+	PreTripReminder  *bool `json:"pre_trip_reminder,omitempty"`
+	RsvpReminder     *bool `json:"rsvp_reminder,omitempty"`
+	TaskReminder     *bool `json:"task_reminder,omitempty"`
+}
+
+// UpdatePackingItemRequest defines model for UpdatePackingItemRequest.
+type UpdatePackingItemRequest struct {
+	// The participant bringing the item, if any.
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+
+	// Whether the item is packed.
+	Checked  bool   `json:"checked"`
+	Name     string `json:"name" validate:"required,max=255"`
+	Quantity int    `json:"quantity" validate:"required,min=1"`
+}
+
+// UpdateParticipantRequest defines model for UpdateParticipantRequest.
+type UpdateParticipantRequest struct {
+	AvatarURL *string `json:"avatar_url,omitempty" validate:"omitempty,url"`
+
+	// Language the emails are written in. Defaults to pt-BR.
+	Locale *Locale `json:"locale,omitempty"`
+	Name   *string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Phone  *string `json:"phone,omitempty" validate:"omitempty,max=32"`
+}
+
+// UpdateReminderPreferenceRequest defines model for UpdateReminderPreferenceRequest.
+type UpdateReminderPreferenceRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// UpdateTaskRequest defines model for UpdateTaskRequest.
+type UpdateTaskRequest struct {
+	// The participant doing the task, if any. They are e-mailed once it is overdue.
+	AssigneeID *string `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	Done       bool    `json:"done"`
+
+	// When the task is to be done by, if ever.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	Title string     `json:"title" validate:"required,max=255"`
+}
+
+// UpdateTransportSegmentRequest defines model for UpdateTransportSegmentRequest.
+type UpdateTransportSegmentRequest struct {
+	// Must be after the departure.
+	ArrivalAt    time.Time `json:"arrival_at" validate:"required,gtfield=DepartureAt"`
+	ArrivalPlace string    `json:"arrival_place" validate:"required,max=255"`
+
+	// The airline or the company running the train or the bus.
+	Carrier string `json:"carrier" validate:"required,max=255"`
+
+	// Must fall within the trip dates.
+	DepartureAt time.Time `json:"departure_at" validate:"required"`
+
+	// Such as the airport or the station.
+	DeparturePlace string        `json:"departure_place" validate:"required,max=255"`
+	Mode           TransportMode `json:"mode"`
+
+	// Such as the flight number, LA3040.
+	Number *string `json:"number,omitempty" validate:"omitempty,max=20"`
+
+	// The participants of the trip taking the segment.
+	ParticipantIds []string `json:"participant_ids,omitempty" validate:"omitempty,max=100,dive,uuid"`
+}
+
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	// Markdown notes about the trip. Raw HTML is stripped before storage.
+	Description *string   `json:"description,omitempty" validate:"omitempty,max=10000"`
+	Destination string    `json:"destination" validate:"required,min=4"`
+	EndsAt      time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	StartsAt    time.Time `json:"starts_at" validate:"required"`
+}
+
+// UpdateTripStatusRequest defines model for UpdateTripStatusRequest.
+type UpdateTripStatusRequest struct {
+	Status TripStatus `json:"status"`
+}
+
+// VerifyParticipantEmailRequest defines model for VerifyParticipantEmailRequest.
+type VerifyParticipantEmailRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+// VotePollRequest defines model for VotePollRequest.
+type VotePollRequest struct {
+	OptionID string `json:"option_id" validate:"required,uuid"`
+}
+
+// ActivityCategory defines model for ActivityCategory.
+type ActivityCategory struct {
+	value string
+}
+
+func (t *ActivityCategory) ToValue() string {
+	return t.value
+}
+func (t ActivityCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ActivityCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ActivityCategory) FromValue(value string) error {
+	switch value {
+
+	case ActivityCategoryFood.value:
+		t.value = value
+		return nil
+
+	case ActivityCategoryLodging.value:
+		t.value = value
+		return nil
+
+	case ActivityCategoryOther.value:
+		t.value = value
+		return nil
+
+	case ActivityCategorySightseeing.value:
+		t.value = value
+		return nil
+
+	case ActivityCategoryTransport.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// BookingEvent defines model for BookingEvent.
+type BookingEvent struct {
+	value string
+}
+
+func (t *BookingEvent) ToValue() string {
+	return t.value
+}
+func (t BookingEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *BookingEvent) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *BookingEvent) FromValue(value string) error {
+	switch value {
+
+	case BookingEventCheckIn.value:
+		t.value = value
+		return nil
+
+	case BookingEventCheckOut.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// BookingType defines model for BookingType.
+type BookingType struct {
+	value string
+}
+
+func (t *BookingType) ToValue() string {
+	return t.value
+}
+func (t BookingType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *BookingType) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *BookingType) FromValue(value string) error {
+	switch value {
+
+	case BookingTypeLodging.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// DocumentKind defines model for DocumentKind.
+type DocumentKind struct {
+	value string
+}
+
+func (t *DocumentKind) ToValue() string {
+	return t.value
+}
+func (t DocumentKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *DocumentKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *DocumentKind) FromValue(value string) error {
+	switch value {
+
+	case DocumentKindInsurance.value:
+		t.value = value
+		return nil
+
+	case DocumentKindOther.value:
+		t.value = value
+		return nil
+
+	case DocumentKindPassport.value:
+		t.value = value
+		return nil
+
+	case DocumentKindTicket.value:
+		t.value = value
+		return nil
+
+	case DocumentKindVisa.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// DocumentScope defines model for DocumentScope.
+type DocumentScope struct {
+	value string
+}
+
+func (t *DocumentScope) ToValue() string {
+	return t.value
+}
+func (t DocumentScope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *DocumentScope) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *DocumentScope) FromValue(value string) error {
+	switch value {
+
+	case DocumentScopeParticipant.value:
+		t.value = value
+		return nil
+
+	case DocumentScopeTrip.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Why e-mails to an address are not delivered: it bounced, or its owner reported the e-mails as spam.
+type EmailIssue struct {
+	value string
+}
+
+func (t *EmailIssue) ToValue() string {
+	return t.value
+}
+func (t EmailIssue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *EmailIssue) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *EmailIssue) FromValue(value string) error {
+	switch value {
+
+	case EmailIssueBounce.value:
+		t.value = value
+		return nil
+
+	case EmailIssueComplaint.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// The emails of a trip that can be previewed.
+type EmailPreviewTemplate struct {
+	value string
+}
+
+func (t *EmailPreviewTemplate) ToValue() string {
+	return t.value
+}
+func (t EmailPreviewTemplate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *EmailPreviewTemplate) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *EmailPreviewTemplate) FromValue(value string) error {
+	switch value {
+
+	case EmailPreviewTemplateConfirmTrip.value:
+		t.value = value
+		return nil
+
+	case EmailPreviewTemplateEmailVerification.value:
+		t.value = value
+		return nil
+
+	case EmailPreviewTemplateInvitation.value:
+		t.value = value
+		return nil
+
+	case EmailPreviewTemplatePreTripReminder.value:
+		t.value = value
+		return nil
+
+	case EmailPreviewTemplateRsvpReminder.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Tells apart the errors of the data conflicting with existing data or breaking a rule of the database.
+type ErrorCode struct {
+	value string
+}
+
+func (t *ErrorCode) ToValue() string {
+	return t.value
+}
+func (t ErrorCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ErrorCode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ErrorCode) FromValue(value string) error {
+	switch value {
+
+	case ErrorCodeActivityNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeConflict.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInvalidValue.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeLinkAlreadyAdded.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeParticipantAlreadyInvited.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeParticipantNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodePollOptionNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeReferenceNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeTagNameTaken.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeTripNotFound.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ExpenseCategory defines model for ExpenseCategory.
+type ExpenseCategory struct {
+	value string
+}
+
+func (t *ExpenseCategory) ToValue() string {
+	return t.value
+}
+func (t ExpenseCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ExpenseCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ExpenseCategory) FromValue(value string) error {
+	switch value {
+
+	case ExpenseCategoryFood.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryLodging.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryOther.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryShopping.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryTickets.value:
+		t.value = value
+		return nil
+
+	case ExpenseCategoryTransport.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// `shared` entries are read by every participant, `private` ones by their author only. Defaults to shared.
+type JournalVisibility struct {
+	value string
+}
+
+func (t *JournalVisibility) ToValue() string {
+	return t.value
+}
+func (t JournalVisibility) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *JournalVisibility) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *JournalVisibility) FromValue(value string) error {
+	switch value {
+
+	case JournalVisibilityPrivate.value:
+		t.value = value
+		return nil
+
+	case JournalVisibilityShared.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// LinkCategory defines model for LinkCategory.
+type LinkCategory struct {
+	value string
+}
+
+func (t *LinkCategory) ToValue() string {
+	return t.value
+}
+func (t LinkCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *LinkCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *LinkCategory) FromValue(value string) error {
+	switch value {
+
+	case LinkCategoryDocs.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryLodging.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryOther.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryTickets.value:
+		t.value = value
+		return nil
+
+	case LinkCategoryTransport.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Language the emails are written in. Defaults to pt-BR.
+type Locale struct {
+	value string
+}
+
+func (t *Locale) ToValue() string {
+	return t.value
+}
+func (t Locale) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *Locale) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *Locale) FromValue(value string) error {
+	switch value {
+
+	case LocaleEn.value:
+		t.value = value
+		return nil
+
+	case LocaleEs.value:
+		t.value = value
+		return nil
+
+	case LocalePtBR.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// NotificationEvent defines model for NotificationEvent.
+type NotificationEvent struct {
+	value string
+}
+
+func (t *NotificationEvent) ToValue() string {
+	return t.value
+}
+func (t NotificationEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *NotificationEvent) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *NotificationEvent) FromValue(value string) error {
+	switch value {
+
+	case NotificationEventActivityCreated.value:
+		t.value = value
+		return nil
+
+	case NotificationEventFlightUpdated.value:
+		t.value = value
+		return nil
+
+	case NotificationEventParticipantConfirmed.value:
+		t.value = value
+		return nil
+
+	case NotificationEventTripUpdated.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ParticipantStatus defines model for ParticipantStatus.
+type ParticipantStatus struct {
+	value string
+}
+
+func (t *ParticipantStatus) ToValue() string {
+	return t.value
+}
+func (t ParticipantStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ParticipantStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ParticipantStatus) FromValue(value string) error {
+	switch value {
+
+	case ParticipantStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case ParticipantStatusDeclined.value:
+		t.value = value
+		return nil
+
+	case ParticipantStatusUnconfirmed.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PollKind defines model for PollKind.
+type PollKind struct {
+	value string
+}
+
+func (t *PollKind) ToValue() string {
+	return t.value
+}
+func (t PollKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *PollKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *PollKind) FromValue(value string) error {
+	switch value {
+
+	case PollKindDates.value:
+		t.value = value
+		return nil
+
+	case PollKindDestination.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// SuggestionKind defines model for SuggestionKind.
+type SuggestionKind struct {
+	value string
+}
+
+func (t *SuggestionKind) ToValue() string {
+	return t.value
+}
+func (t SuggestionKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SuggestionKind) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SuggestionKind) FromValue(value string) error {
+	switch value {
+
+	case SuggestionKindAttraction.value:
+		t.value = value
+		return nil
+
+	case SuggestionKindRestaurant.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// ThumbnailStatus defines model for ThumbnailStatus.
+type ThumbnailStatus struct {
+	value string
+}
+
+func (t *ThumbnailStatus) ToValue() string {
+	return t.value
+}
+func (t ThumbnailStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ThumbnailStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ThumbnailStatus) FromValue(value string) error {
+	switch value {
+
+	case ThumbnailStatusFailed.value:
+		t.value = value
+		return nil
+
+	case ThumbnailStatusPending.value:
+		t.value = value
+		return nil
+
+	case ThumbnailStatusReady.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// TransportMode defines model for TransportMode.
+type TransportMode struct {
+	value string
+}
+
+func (t *TransportMode) ToValue() string {
+	return t.value
+}
+func (t TransportMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TransportMode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TransportMode) FromValue(value string) error {
+	switch value {
+
+	case TransportModeBus.value:
+		t.value = value
+		return nil
+
+	case TransportModeFlight.value:
+		t.value = value
+		return nil
+
+	case TransportModeTrain.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// TripStatus defines model for TripStatus.
+type TripStatus struct {
+	value string
+}
+
+func (t *TripStatus) ToValue() string {
+	return t.value
+}
+func (t TripStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *TripStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *TripStatus) FromValue(value string) error {
+	switch value {
+
+	case TripStatusCancelled.value:
+		t.value = value
+		return nil
+
+	case TripStatusCompleted.value:
+		t.value = value
+		return nil
+
+	case TripStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case TripStatusDraft.value:
+		t.value = value
+		return nil
+
+	case TripStatusOngoing.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// WeatherCondition defines model for WeatherCondition.
+type WeatherCondition struct {
+	value string
+}
+
+func (t *WeatherCondition) ToValue() string {
+	return t.value
+}
+func (t WeatherCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *WeatherCondition) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *WeatherCondition) FromValue(value string) error {
+	switch value {
+
+	case WeatherConditionClear.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionCloudy.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionFog.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionPartlyCloudy.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionRain.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionSnow.value:
+		t.value = value
+		return nil
+
+	case WeatherConditionStorm.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus struct {
+	value string
+}
+
+func (t *WebhookDeliveryStatus) ToValue() string {
+	return t.value
+}
+func (t WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *WebhookDeliveryStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *WebhookDeliveryStatus) FromValue(value string) error {
+	switch value {
+
+	case WebhookDeliveryStatusDead.value:
+		t.value = value
+		return nil
+
+	case WebhookDeliveryStatusDelivered.value:
+		t.value = value
+		return nil
+
+	case WebhookDeliveryStatusPending.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent struct {
+	value string
+}
+
+func (t *WebhookEvent) ToValue() string {
+	return t.value
+}
+func (t WebhookEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *WebhookEvent) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *WebhookEvent) FromValue(value string) error {
+	switch value {
+
+	case WebhookEventActivityCreated.value:
+		t.value = value
+		return nil
+
+	case WebhookEventParticipantConfirmed.value:
+		t.value = value
+		return nil
+
+	case WebhookEventTripConfirmed.value:
+		t.value = value
+		return nil
+
+	case WebhookEventTripCreated.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PostActivitiesActivityIDCommentsJSONBody defines parameters for PostActivitiesActivityIDComments.
+type PostActivitiesActivityIDCommentsJSONBody CreateActivityCommentRequest
+
+// DeleteActivitiesActivityIDCommentsCommentIDParams defines parameters for DeleteActivitiesActivityIDCommentsCommentID.
+type DeleteActivitiesActivityIDCommentsCommentIDParams struct {
+	// ID of the participant who wrote the comment.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostActivitiesActivityIDCommentsCommentIDRestoreParams defines parameters for PostActivitiesActivityIDCommentsCommentIDRestore.
+type PostActivitiesActivityIDCommentsCommentIDRestoreParams struct {
+	// ID of the participant who wrote the comment.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PatchActivitiesActivityIDRsvpJSONBody defines parameters for PatchActivitiesActivityIDRsvp.
+type PatchActivitiesActivityIDRsvpJSONBody UpdateActivityRSVPRequest
+
+// DeleteActivitiesActivityIDVotesParams defines parameters for DeleteActivitiesActivityIDVotes.
+type DeleteActivitiesActivityIDVotesParams struct {
+	// ID of the participant voting.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostActivitiesActivityIDVotesParams defines parameters for PostActivitiesActivityIDVotes.
+type PostActivitiesActivityIDVotesParams struct {
+	// ID of the participant voting.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Only the notifications not read yet.
+	Unread *bool `json:"unread,omitempty"`
+
+	// ID of the participant the notifications are for.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostNotificationsReadParams defines parameters for PostNotificationsRead.
+type PostNotificationsReadParams struct {
+	// ID of the participant the notifications are for.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostNotificationsNotificationIDReadParams defines parameters for PostNotificationsNotificationIDRead.
+type PostNotificationsNotificationIDReadParams struct {
+	// ID of the participant the notifications are for.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PatchParticipantsParticipantIDJSONBody defines parameters for PatchParticipantsParticipantID.
+type PatchParticipantsParticipantIDJSONBody UpdateParticipantRequest
+
+// PatchParticipantsParticipantIDConfirmJSONBody defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmJSONBody ConfirmParticipantRequest
+
+// PatchParticipantsParticipantIDConfirmParams defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmParams struct {
+	// Signed token of the email link the request comes from. Requests are rejected when it is invalid or expired.
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDDeclineJSONBody defines parameters for PatchParticipantsParticipantIDDecline.
+type PatchParticipantsParticipantIDDeclineJSONBody DeclineInvitationRequest
+
+// PatchParticipantsParticipantIDDeclineParams defines parameters for PatchParticipantsParticipantIDDecline.
+type PatchParticipantsParticipantIDDeclineParams struct {
+	// Signed token of the email link the request comes from. Requests are rejected when it is invalid or expired.
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDDigestJSONBody defines parameters for PatchParticipantsParticipantIDDigest.
+type PatchParticipantsParticipantIDDigestJSONBody UpdateReminderPreferenceRequest
+
+// PatchParticipantsParticipantIDNotificationsJSONBody defines parameters for PatchParticipantsParticipantIDNotifications.
+type PatchParticipantsParticipantIDNotificationsJSONBody UpdateNotificationPreferencesRequest
+
+// PatchParticipantsParticipantIDRemindersJSONBody defines parameters for PatchParticipantsParticipantIDReminders.
+type PatchParticipantsParticipantIDRemindersJSONBody UpdateReminderPreferenceRequest
+
+// PatchParticipantsParticipantIDUnconfirmJSONBody defines parameters for PatchParticipantsParticipantIDUnconfirm.
+type PatchParticipantsParticipantIDUnconfirmJSONBody UnconfirmParticipantRequest
+
+// PostParticipantsParticipantIDVerifyEmailJSONBody defines parameters for PostParticipantsParticipantIDVerifyEmail.
+type PostParticipantsParticipantIDVerifyEmailJSONBody VerifyParticipantEmailRequest
+
+// PostPollsPollIDVotesJSONBody defines parameters for PostPollsPollIDVotes.
+type PostPollsPollIDVotesJSONBody VotePollRequest
+
+// PostPollsPollIDVotesParams defines parameters for PostPollsPollIDVotes.
+type PostPollsPollIDVotesParams struct {
+	// Signed token of the email link the request comes from, issued for the participant of the X-Participant-ID header. Requests are rejected when it is invalid or expired.
+	Token string `json:"token"`
+
+	// ID of the participant voting.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTagsJSONBody defines parameters for PostTags.
+type PostTagsJSONBody CreateTagRequest
+
+// PutTagsTagIDJSONBody defines parameters for PutTagsTagID.
+type PutTagsTagIDJSONBody UpdateTagRequest
+
+// GetTripsParams defines parameters for GetTrips.
+type GetTripsParams struct {
+	// Only return trips labeled with this tag name.
+	Tag *string `json:"tag,omitempty"`
+
+	// Only return trips in this lifecycle status (draft, confirmed, ongoing, completed or cancelled).
+	Status *string `json:"status,omitempty"`
+
+	// Only return trips whose destination or description match these words, in Portuguese or English. Supports quoted phrases, `or` and `-` to exclude a word.
+	Q *string `json:"q,omitempty"`
+}
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// PostTripsJoinJSONBody defines parameters for PostTripsJoin.
+type PostTripsJoinJSONBody JoinTripRequest
+
+// PatchTripsTripIDJSONBody defines parameters for PatchTripsTripID.
+type PatchTripsTripIDJSONBody PatchTripRequest
+
+// PatchTripsTripIDParams defines parameters for PatchTripsTripID.
+type PatchTripsTripIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+
+	// Version of the trip the update is based on, as returned in the trip details. The update is rejected with 409 when the trip has changed since.
+	IfMatch string `json:"If-Match"`
+}
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// PutTripsTripIDParams defines parameters for PutTripsTripID.
+type PutTripsTripIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+
+	// Version of the trip the update is based on, as returned in the trip details. The update is rejected with 409 when the trip has changed since.
+	IfMatch string `json:"If-Match"`
+}
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Only return activities of this category (food, transport, sightseeing, lodging or other).
+	Category *string `json:"category,omitempty"`
+
+	// Only return activities, check-ins, check-outs and departures occurring at or after this time.
+	From *time.Time `json:"from,omitempty"`
+
+	// Only return activities, check-ins, check-outs and departures occurring at or before this time.
+	To *time.Time `json:"to,omitempty"`
+
+	// IANA time zone used to group activities, check-ins, check-outs and departures by day, e.g. America/Sao_Paulo. Defaults to the time zone of the trip.
+	Tz *string `json:"tz,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PostTripsTripIDActivitiesParams defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesParams struct {
+	// Save the activity even if it overlaps other activities of the trip.
+	Force *bool `json:"force,omitempty"`
+}
+
+// PostTripsTripIDActivitiesImportJSONBody defines parameters for PostTripsTripIDActivitiesImport.
+type PostTripsTripIDActivitiesImportJSONBody ImportActivitiesRequest
+
+// PostTripsTripIDActivitiesImportParams defines parameters for PostTripsTripIDActivitiesImport.
+type PostTripsTripIDActivitiesImportParams struct {
+	// Import the activities even if they overlap other activities of the trip.
+	Force *bool `json:"force,omitempty"`
+}
+
+// PatchTripsTripIDActivitiesReorderJSONBody defines parameters for PatchTripsTripIDActivitiesReorder.
+type PatchTripsTripIDActivitiesReorderJSONBody ReorderActivitiesRequest
+
+// DeleteTripsTripIDActivitiesActivityIDParams defines parameters for DeleteTripsTripIDActivitiesActivityID.
+type DeleteTripsTripIDActivitiesActivityIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSONBody defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDJSONBody PatchActivityRequest
+
+// PatchTripsTripIDActivitiesActivityIDParams defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDParams struct {
+	// Save the activity even if it overlaps other activities of the trip.
+	Force *bool `json:"force,omitempty"`
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// PutTripsTripIDActivitiesActivityIDParams defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDParams struct {
+	// Save the activity even if it overlaps other activities of the trip.
+	Force *bool `json:"force,omitempty"`
+}
+
+// PostTripsTripIDActivitiesActivityIDRestoreParams defines parameters for PostTripsTripIDActivitiesActivityIDRestore.
+type PostTripsTripIDActivitiesActivityIDRestoreParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDBookingsJSONBody defines parameters for PostTripsTripIDBookings.
+type PostTripsTripIDBookingsJSONBody CreateBookingRequest
+
+// PostTripsTripIDBookingsParams defines parameters for PostTripsTripIDBookings.
+type PostTripsTripIDBookingsParams struct {
+	// ID of the participant editing the bookings.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDBookingsBookingIDParams defines parameters for DeleteTripsTripIDBookingsBookingID.
+type DeleteTripsTripIDBookingsBookingIDParams struct {
+	// ID of the participant editing the bookings.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PutTripsTripIDBookingsBookingIDJSONBody defines parameters for PutTripsTripIDBookingsBookingID.
+type PutTripsTripIDBookingsBookingIDJSONBody UpdateBookingRequest
+
+// PutTripsTripIDBookingsBookingIDParams defines parameters for PutTripsTripIDBookingsBookingID.
+type PutTripsTripIDBookingsBookingIDParams struct {
+	// ID of the participant editing the bookings.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDConfirmParams defines parameters for PostTripsTripIDConfirm.
+type PostTripsTripIDConfirmParams struct {
+	// Signed token of the email link the request comes from. Requests carrying it are rejected when it is invalid or expired. Without it, the X-Owner-Email header must be one of a trip owner.
+	Token *string `json:"token,omitempty"`
+
+	// E-mail of the trip owner confirming it, needed without a token.
+	XOwnerEmail *openapi_types.Email `json:"X-Owner-Email,omitempty"`
+}
+
+// GetTripsTripIDDocumentsParams defines parameters for GetTripsTripIDDocuments.
+type GetTripsTripIDDocumentsParams struct {
+	// ID of the participant reading or uploading the documents.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDDocumentsParams defines parameters for PostTripsTripIDDocuments.
+type PostTripsTripIDDocumentsParams struct {
+	// ID of the participant reading or uploading the documents.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDDocumentsDocumentIDParams defines parameters for DeleteTripsTripIDDocumentsDocumentID.
+type DeleteTripsTripIDDocumentsDocumentIDParams struct {
+	// ID of the participant reading or uploading the documents.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDDocumentsDocumentIDParams defines parameters for GetTripsTripIDDocumentsDocumentID.
+type GetTripsTripIDDocumentsDocumentIDParams struct {
+	// ID of the participant reading or uploading the documents.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDEmailsPreviewParams defines parameters for GetTripsTripIDEmailsPreview.
+type GetTripsTripIDEmailsPreviewParams struct {
+	Template EmailPreviewTemplate `json:"template"`
+
+	// Language of the e-mail, the language of the owner by default.
+	Locale *Locale `json:"locale,omitempty"`
+
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDEventsParams defines parameters for GetTripsTripIDEvents.
+type GetTripsTripIDEventsParams struct {
+	// ID of the last change received, sent by browsers when reconnecting, for the changes missed since to be sent first.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// PostTripsTripIDExpensesJSONBody defines parameters for PostTripsTripIDExpenses.
+type PostTripsTripIDExpensesJSONBody CreateExpenseRequest
+
+// PostTripsTripIDExpensesSettlementPaymentsJSONBody defines parameters for PostTripsTripIDExpensesSettlementPayments.
+type PostTripsTripIDExpensesSettlementPaymentsJSONBody CreateSettlementPaymentRequest
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDParams defines parameters for DeleteTripsTripIDExpensesSettlementPaymentsPaymentID.
+type DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// DeleteTripsTripIDExpensesExpenseIDParams defines parameters for DeleteTripsTripIDExpensesExpenseID.
+type DeleteTripsTripIDExpensesExpenseIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PutTripsTripIDExpensesExpenseIDJSONBody defines parameters for PutTripsTripIDExpensesExpenseID.
+type PutTripsTripIDExpensesExpenseIDJSONBody UpdateExpenseRequest
+
+// PutTripsTripIDExpensesExpenseIDParams defines parameters for PutTripsTripIDExpensesExpenseID.
+type PutTripsTripIDExpensesExpenseIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantsRequest
+
+// PostTripsTripIDJoinCodeParams defines parameters for PostTripsTripIDJoinCode.
+type PostTripsTripIDJoinCodeParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDJournalParams defines parameters for GetTripsTripIDJournal.
+type GetTripsTripIDJournalParams struct {
+	// ID of the participant reading the journal, who also reads their private entries.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDJournalJSONBody defines parameters for PostTripsTripIDJournal.
+type PostTripsTripIDJournalJSONBody CreateJournalEntryRequest
+
+// PostTripsTripIDJournalParams defines parameters for PostTripsTripIDJournal.
+type PostTripsTripIDJournalParams struct {
+	// ID of the participant writing the entry.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDJournalExportParams defines parameters for GetTripsTripIDJournalExport.
+type GetTripsTripIDJournalExportParams struct {
+	// ID of the participant reading the journal, who also reads their private entries.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDJournalEntryIDParams defines parameters for DeleteTripsTripIDJournalEntryID.
+type DeleteTripsTripIDJournalEntryIDParams struct {
+	// ID of the participant writing the entry.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PutTripsTripIDJournalEntryIDJSONBody defines parameters for PutTripsTripIDJournalEntryID.
+type PutTripsTripIDJournalEntryIDJSONBody UpdateJournalEntryRequest
+
+// PutTripsTripIDJournalEntryIDParams defines parameters for PutTripsTripIDJournalEntryID.
+type PutTripsTripIDJournalEntryIDParams struct {
+	// ID of the participant writing the entry.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PatchTripsTripIDLinksReorderJSONBody defines parameters for PatchTripsTripIDLinksReorder.
+type PatchTripsTripIDLinksReorderJSONBody ReorderLinksRequest
+
+// PatchTripsTripIDLinksReorderParams defines parameters for PatchTripsTripIDLinksReorder.
+type PatchTripsTripIDLinksReorderParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// DeleteTripsTripIDLinksLinkIDParams defines parameters for DeleteTripsTripIDLinksLinkID.
+type DeleteTripsTripIDLinksLinkIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PatchTripsTripIDLinksLinkIDJSONBody defines parameters for PatchTripsTripIDLinksLinkID.
+type PatchTripsTripIDLinksLinkIDJSONBody PatchLinkRequest
+
+// PatchTripsTripIDLinksLinkIDParams defines parameters for PatchTripsTripIDLinksLinkID.
+type PatchTripsTripIDLinksLinkIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PutTripsTripIDLinksLinkIDParams defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDLinksLinkIDRestoreParams defines parameters for PostTripsTripIDLinksLinkIDRestore.
+type PostTripsTripIDLinksLinkIDRestoreParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDMessagesParams defines parameters for GetTripsTripIDMessages.
+type GetTripsTripIDMessagesParams struct {
+	// The next_cursor of the previous page. The first page has the newest messages.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// Messages per page, 50 by default.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// PostTripsTripIDMessagesJSONBody defines parameters for PostTripsTripIDMessages.
+type PostTripsTripIDMessagesJSONBody CreateMessageRequest
+
+// PostTripsTripIDMessagesParams defines parameters for PostTripsTripIDMessages.
+type PostTripsTripIDMessagesParams struct {
+	// ID of the participant reading or writing the board.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDMessagesReadParams defines parameters for PostTripsTripIDMessagesRead.
+type PostTripsTripIDMessagesReadParams struct {
+	// ID of the participant reading or writing the board.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDMessagesUnreadParams defines parameters for GetTripsTripIDMessagesUnread.
+type GetTripsTripIDMessagesUnreadParams struct {
+	// ID of the participant reading or writing the board.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDOwnersParams defines parameters for GetTripsTripIDOwners.
+type GetTripsTripIDOwnersParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDOwnersJSONBody defines parameters for PostTripsTripIDOwners.
+type PostTripsTripIDOwnersJSONBody AddTripOwnerRequest
+
+// PostTripsTripIDOwnersParams defines parameters for PostTripsTripIDOwners.
+type PostTripsTripIDOwnersParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// DeleteTripsTripIDOwnersOwnerEmailParams defines parameters for DeleteTripsTripIDOwnersOwnerEmail.
+type DeleteTripsTripIDOwnersOwnerEmailParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDPackingJSONBody defines parameters for PostTripsTripIDPacking.
+type PostTripsTripIDPackingJSONBody CreatePackingItemRequest
+
+// PostTripsTripIDPackingParams defines parameters for PostTripsTripIDPacking.
+type PostTripsTripIDPackingParams struct {
+	// ID of the participant editing the list.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDPackingItemIDParams defines parameters for DeleteTripsTripIDPackingItemID.
+type DeleteTripsTripIDPackingItemIDParams struct {
+	// ID of the participant editing the list.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PutTripsTripIDPackingItemIDJSONBody defines parameters for PutTripsTripIDPackingItemID.
+type PutTripsTripIDPackingItemIDJSONBody UpdatePackingItemRequest
+
+// PutTripsTripIDPackingItemIDParams defines parameters for PutTripsTripIDPackingItemID.
+type PutTripsTripIDPackingItemIDParams struct {
+	// ID of the participant editing the list.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteParams defines parameters for PostTripsTripIDParticipantsParticipantIDResendInvite.
+type PostTripsTripIDParticipantsParticipantIDResendInviteParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDPhotosParams defines parameters for GetTripsTripIDPhotos.
+type GetTripsTripIDPhotosParams struct {
+	// The next_cursor of the previous page. The first page has the newest photos.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// Photos per page, 24 by default.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// PostTripsTripIDPhotosParams defines parameters for PostTripsTripIDPhotos.
+type PostTripsTripIDPhotosParams struct {
+	// ID of the participant uploading or deleting the photo.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDPhotosPhotoIDParams defines parameters for DeleteTripsTripIDPhotosPhotoID.
+type DeleteTripsTripIDPhotosPhotoIDParams struct {
+	// ID of the participant uploading or deleting the photo.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDPhotosPhotoIDCommentsJSONBody defines parameters for PostTripsTripIDPhotosPhotoIDComments.
+type PostTripsTripIDPhotosPhotoIDCommentsJSONBody CreatePhotoCommentRequest
+
+// PostTripsTripIDPhotosPhotoIDCommentsParams defines parameters for PostTripsTripIDPhotosPhotoIDComments.
+type PostTripsTripIDPhotosPhotoIDCommentsParams struct {
+	// ID of the participant writing or deleting the comment.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDParams defines parameters for DeleteTripsTripIDPhotosPhotoIDCommentsCommentID.
+type DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDParams struct {
+	// ID of the participant writing or deleting the comment.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PostTripsTripIDPollsJSONBody defines parameters for PostTripsTripIDPolls.
+type PostTripsTripIDPollsJSONBody CreatePollRequest
+
+// PostTripsTripIDPollsParams defines parameters for PostTripsTripIDPolls.
+type PostTripsTripIDPollsParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDPollsPollIDApplyParams defines parameters for PostTripsTripIDPollsPollIDApply.
+type PostTripsTripIDPollsPollIDApplyParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDSearchParams defines parameters for GetTripsTripIDSearch.
+type GetTripsTripIDSearchParams struct {
+	// Text to look for, case insensitive.
+	Q string `json:"q"`
+}
+
+// PostTripsTripIDSegmentsJSONBody defines parameters for PostTripsTripIDSegments.
+type PostTripsTripIDSegmentsJSONBody CreateTransportSegmentRequest
+
+// PostTripsTripIDSegmentsParams defines parameters for PostTripsTripIDSegments.
+type PostTripsTripIDSegmentsParams struct {
+	// ID of the participant editing the segments.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDSegmentsSegmentIDParams defines parameters for DeleteTripsTripIDSegmentsSegmentID.
+type DeleteTripsTripIDSegmentsSegmentIDParams struct {
+	// ID of the participant editing the segments.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSONBody defines parameters for PutTripsTripIDSegmentsSegmentID.
+type PutTripsTripIDSegmentsSegmentIDJSONBody UpdateTransportSegmentRequest
+
+// PutTripsTripIDSegmentsSegmentIDParams defines parameters for PutTripsTripIDSegmentsSegmentID.
+type PutTripsTripIDSegmentsSegmentIDParams struct {
+	// ID of the participant editing the segments.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDShareParams defines parameters for DeleteTripsTripIDShare.
+type DeleteTripsTripIDShareParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDShareParams defines parameters for PostTripsTripIDShare.
+type PostTripsTripIDShareParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PatchTripsTripIDStatusJSONBody defines parameters for PatchTripsTripIDStatus.
+type PatchTripsTripIDStatusJSONBody UpdateTripStatusRequest
+
+// PatchTripsTripIDStatusParams defines parameters for PatchTripsTripIDStatus.
+type PatchTripsTripIDStatusParams struct {
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetTripsTripIDSuggestionsParams defines parameters for GetTripsTripIDSuggestions.
+type GetTripsTripIDSuggestionsParams struct {
+	// Only suggest places of this kind (attraction or restaurant).
+	Kind *string `json:"kind,omitempty"`
+}
+
+// PostTripsTripIDTasksJSONBody defines parameters for PostTripsTripIDTasks.
+type PostTripsTripIDTasksJSONBody CreateTaskRequest
+
+// PostTripsTripIDTasksParams defines parameters for PostTripsTripIDTasks.
+type PostTripsTripIDTasksParams struct {
+	// ID of the participant editing the tasks.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// DeleteTripsTripIDTasksTaskIDParams defines parameters for DeleteTripsTripIDTasksTaskID.
+type DeleteTripsTripIDTasksTaskIDParams struct {
+	// ID of the participant editing the tasks.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// PutTripsTripIDTasksTaskIDJSONBody defines parameters for PutTripsTripIDTasksTaskID.
+type PutTripsTripIDTasksTaskIDJSONBody UpdateTaskRequest
+
+// PutTripsTripIDTasksTaskIDParams defines parameters for PutTripsTripIDTasksTaskID.
+type PutTripsTripIDTasksTaskIDParams struct {
+	// ID of the participant editing the tasks.
+	XParticipantID string `json:"X-Participant-ID"`
+}
+
+// GetTripsTripIDWebhooksParams defines parameters for GetTripsTripIDWebhooks.
+type GetTripsTripIDWebhooksParams struct {
+	// Signed token of the webhooks link emailed to the trip owners.
+	Token string `json:"token"`
+
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostTripsTripIDWebhooksJSONBody defines parameters for PostTripsTripIDWebhooks.
+type PostTripsTripIDWebhooksJSONBody CreateWebhookRequest
+
+// PostTripsTripIDWebhooksParams defines parameters for PostTripsTripIDWebhooks.
+type PostTripsTripIDWebhooksParams struct {
+	// Signed token of the webhooks link emailed to the trip owners.
+	Token string `json:"token"`
+
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// DeleteWebhooksWebhookIDParams defines parameters for DeleteWebhooksWebhookID.
+type DeleteWebhooksWebhookIDParams struct {
+	// Signed token of the webhooks link emailed to the trip owners.
+	Token string `json:"token"`
+
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// GetWebhooksWebhookIDDeliveriesParams defines parameters for GetWebhooksWebhookIDDeliveries.
+type GetWebhooksWebhookIDDeliveriesParams struct {
+	// Signed token of the webhooks link emailed to the trip owners.
+	Token string `json:"token"`
+
+	// How many deliveries to return.
+	Limit *int `json:"limit,omitempty"`
+
+	// E-mail of the trip owner performing the operation.
+	XOwnerEmail openapi_types.Email `json:"X-Owner-Email"`
+}
+
+// PostActivitiesActivityIDCommentsJSONRequestBody defines body for PostActivitiesActivityIDComments for application/json ContentType.
+type PostActivitiesActivityIDCommentsJSONRequestBody PostActivitiesActivityIDCommentsJSONBody
+
+// Bind implements render.Binder.
+func (PostActivitiesActivityIDCommentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchActivitiesActivityIDRsvpJSONRequestBody defines body for PatchActivitiesActivityIDRsvp for application/json ContentType.
+type PatchActivitiesActivityIDRsvpJSONRequestBody PatchActivitiesActivityIDRsvpJSONBody
+
+// Bind implements render.Binder.
+func (PatchActivitiesActivityIDRsvpJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDJSONRequestBody defines body for PatchParticipantsParticipantID for application/json ContentType.
+type PatchParticipantsParticipantIDJSONRequestBody PatchParticipantsParticipantIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDConfirmJSONRequestBody defines body for PatchParticipantsParticipantIDConfirm for application/json ContentType.
+type PatchParticipantsParticipantIDConfirmJSONRequestBody PatchParticipantsParticipantIDConfirmJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDConfirmJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDDeclineJSONRequestBody defines body for PatchParticipantsParticipantIDDecline for application/json ContentType.
+type PatchParticipantsParticipantIDDeclineJSONRequestBody PatchParticipantsParticipantIDDeclineJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDDeclineJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDDigestJSONRequestBody defines body for PatchParticipantsParticipantIDDigest for application/json ContentType.
+type PatchParticipantsParticipantIDDigestJSONRequestBody PatchParticipantsParticipantIDDigestJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDDigestJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDNotificationsJSONRequestBody defines body for PatchParticipantsParticipantIDNotifications for application/json ContentType.
+type PatchParticipantsParticipantIDNotificationsJSONRequestBody PatchParticipantsParticipantIDNotificationsJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDNotificationsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDRemindersJSONRequestBody defines body for PatchParticipantsParticipantIDReminders for application/json ContentType.
+type PatchParticipantsParticipantIDRemindersJSONRequestBody PatchParticipantsParticipantIDRemindersJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDRemindersJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDUnconfirmJSONRequestBody defines body for PatchParticipantsParticipantIDUnconfirm for application/json ContentType.
+type PatchParticipantsParticipantIDUnconfirmJSONRequestBody PatchParticipantsParticipantIDUnconfirmJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDUnconfirmJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostParticipantsParticipantIDVerifyEmailJSONRequestBody defines body for PostParticipantsParticipantIDVerifyEmail for application/json ContentType.
+type PostParticipantsParticipantIDVerifyEmailJSONRequestBody PostParticipantsParticipantIDVerifyEmailJSONBody
+
+// Bind implements render.Binder.
+func (PostParticipantsParticipantIDVerifyEmailJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostPollsPollIDVotesJSONRequestBody defines body for PostPollsPollIDVotes for application/json ContentType.
+type PostPollsPollIDVotesJSONRequestBody PostPollsPollIDVotesJSONBody
+
+// Bind implements render.Binder.
+func (PostPollsPollIDVotesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTagsJSONRequestBody defines body for PostTags for application/json ContentType.
+type PostTagsJSONRequestBody PostTagsJSONBody
+
+// Bind implements render.Binder.
+func (PostTagsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTagsTagIDJSONRequestBody defines body for PutTagsTagID for application/json ContentType.
+type PutTagsTagIDJSONRequestBody PutTagsTagIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTagsTagIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJoinJSONRequestBody defines body for PostTripsJoin for application/json ContentType.
+type PostTripsJoinJSONRequestBody PostTripsJoinJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJoinJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDJSONRequestBody defines body for PatchTripsTripID for application/json ContentType.
+type PatchTripsTripIDJSONRequestBody PatchTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesImportJSONRequestBody defines body for PostTripsTripIDActivitiesImport for application/json ContentType.
+type PostTripsTripIDActivitiesImportJSONRequestBody PostTripsTripIDActivitiesImportJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesImportJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesReorderJSONRequestBody defines body for PatchTripsTripIDActivitiesReorder for application/json ContentType.
+type PatchTripsTripIDActivitiesReorderJSONRequestBody PatchTripsTripIDActivitiesReorderJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesReorderJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityID for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDJSONRequestBody PatchTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDBookingsJSONRequestBody defines body for PostTripsTripIDBookings for application/json ContentType.
+type PostTripsTripIDBookingsJSONRequestBody PostTripsTripIDBookingsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDBookingsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDBookingsBookingIDJSONRequestBody defines body for PutTripsTripIDBookingsBookingID for application/json ContentType.
+type PutTripsTripIDBookingsBookingIDJSONRequestBody PutTripsTripIDBookingsBookingIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDBookingsBookingIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesJSONRequestBody defines body for PostTripsTripIDExpenses for application/json ContentType.
+type PostTripsTripIDExpensesJSONRequestBody PostTripsTripIDExpensesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesSettlementPaymentsJSONRequestBody defines body for PostTripsTripIDExpensesSettlementPayments for application/json ContentType.
+type PostTripsTripIDExpensesSettlementPaymentsJSONRequestBody PostTripsTripIDExpensesSettlementPaymentsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesSettlementPaymentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDExpensesExpenseIDJSONRequestBody defines body for PutTripsTripIDExpensesExpenseID for application/json ContentType.
+type PutTripsTripIDExpensesExpenseIDJSONRequestBody PutTripsTripIDExpensesExpenseIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDExpensesExpenseIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDJournalJSONRequestBody defines body for PostTripsTripIDJournal for application/json ContentType.
+type PostTripsTripIDJournalJSONRequestBody PostTripsTripIDJournalJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDJournalJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJournalEntryIDJSONRequestBody defines body for PutTripsTripIDJournalEntryID for application/json ContentType.
+type PutTripsTripIDJournalEntryIDJSONRequestBody PutTripsTripIDJournalEntryIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJournalEntryIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDLinksReorderJSONRequestBody defines body for PatchTripsTripIDLinksReorder for application/json ContentType.
+type PatchTripsTripIDLinksReorderJSONRequestBody PatchTripsTripIDLinksReorderJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDLinksReorderJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDLinksLinkIDJSONRequestBody defines body for PatchTripsTripIDLinksLinkID for application/json ContentType.
+type PatchTripsTripIDLinksLinkIDJSONRequestBody PatchTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDMessagesJSONRequestBody defines body for PostTripsTripIDMessages for application/json ContentType.
+type PostTripsTripIDMessagesJSONRequestBody PostTripsTripIDMessagesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDMessagesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDOwnersJSONRequestBody defines body for PostTripsTripIDOwners for application/json ContentType.
+type PostTripsTripIDOwnersJSONRequestBody PostTripsTripIDOwnersJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDOwnersJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPackingJSONRequestBody defines body for PostTripsTripIDPacking for application/json ContentType.
+type PostTripsTripIDPackingJSONRequestBody PostTripsTripIDPackingJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPackingJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDPackingItemIDJSONRequestBody defines body for PutTripsTripIDPackingItemID for application/json ContentType.
+type PutTripsTripIDPackingItemIDJSONRequestBody PutTripsTripIDPackingItemIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDPackingItemIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPhotosPhotoIDCommentsJSONRequestBody defines body for PostTripsTripIDPhotosPhotoIDComments for application/json ContentType.
+type PostTripsTripIDPhotosPhotoIDCommentsJSONRequestBody PostTripsTripIDPhotosPhotoIDCommentsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPhotosPhotoIDCommentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPollsJSONRequestBody defines body for PostTripsTripIDPolls for application/json ContentType.
+type PostTripsTripIDPollsJSONRequestBody PostTripsTripIDPollsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPollsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDSegmentsJSONRequestBody defines body for PostTripsTripIDSegments for application/json ContentType.
+type PostTripsTripIDSegmentsJSONRequestBody PostTripsTripIDSegmentsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDSegmentsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSONRequestBody defines body for PutTripsTripIDSegmentsSegmentID for application/json ContentType.
+type PutTripsTripIDSegmentsSegmentIDJSONRequestBody PutTripsTripIDSegmentsSegmentIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDSegmentsSegmentIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDStatusJSONRequestBody defines body for PatchTripsTripIDStatus for application/json ContentType.
+type PatchTripsTripIDStatusJSONRequestBody PatchTripsTripIDStatusJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDStatusJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTasksJSONRequestBody defines body for PostTripsTripIDTasks for application/json ContentType.
+type PostTripsTripIDTasksJSONRequestBody PostTripsTripIDTasksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTasksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDTasksTaskIDJSONRequestBody defines body for PutTripsTripIDTasksTaskID for application/json ContentType.
+type PutTripsTripIDTasksTaskIDJSONRequestBody PutTripsTripIDTasksTaskIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDTasksTaskIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDWebhooksJSONRequestBody defines body for PostTripsTripIDWebhooks for application/json ContentType.
+type PostTripsTripIDWebhooksJSONRequestBody PostTripsTripIDWebhooksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDWebhooksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// GetActivitiesActivityIDAttachmentsJSON200Response is a constructor method for a GetActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesActivityIDAttachmentsJSON200Response(body GetActivityAttachmentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetActivitiesActivityIDAttachmentsJSON400Response is a constructor method for a GetActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesActivityIDAttachmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDAttachmentsJSON201Response is a constructor method for a PostActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDAttachmentsJSON201Response(body CreateActivityAttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDAttachmentsJSON400Response is a constructor method for a PostActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDAttachmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDAttachmentsJSON415Response is a constructor method for a PostActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDAttachmentsJSON415Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        415,
+		contentType: "application/json",
+	}
+}
+
+// GetActivitiesActivityIDCommentsJSON200Response is a constructor method for a GetActivitiesActivityIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesActivityIDCommentsJSON200Response(body GetActivityCommentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetActivitiesActivityIDCommentsJSON400Response is a constructor method for a GetActivitiesActivityIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesActivityIDCommentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDCommentsJSON201Response is a constructor method for a PostActivitiesActivityIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDCommentsJSON201Response(body CreateActivityCommentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDCommentsJSON400Response is a constructor method for a PostActivitiesActivityIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDCommentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDCommentsCommentIDJSON204Response is a constructor method for a DeleteActivitiesActivityIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDCommentsCommentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDCommentsCommentIDJSON400Response is a constructor method for a DeleteActivitiesActivityIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDCommentsCommentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDCommentsCommentIDJSON404Response is a constructor method for a DeleteActivitiesActivityIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDCommentsCommentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDCommentsCommentIDRestoreJSON204Response is a constructor method for a PostActivitiesActivityIDCommentsCommentIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDCommentsCommentIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response is a constructor method for a PostActivitiesActivityIDCommentsCommentIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDCommentsCommentIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDCommentsCommentIDRestoreJSON404Response is a constructor method for a PostActivitiesActivityIDCommentsCommentIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDCommentsCommentIDRestoreJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchActivitiesActivityIDRsvpJSON204Response is a constructor method for a PatchActivitiesActivityIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchActivitiesActivityIDRsvpJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchActivitiesActivityIDRsvpJSON400Response is a constructor method for a PatchActivitiesActivityIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchActivitiesActivityIDRsvpJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDVotesJSON204Response is a constructor method for a DeleteActivitiesActivityIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDVotesJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDVotesJSON400Response is a constructor method for a DeleteActivitiesActivityIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDVotesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteActivitiesActivityIDVotesJSON404Response is a constructor method for a DeleteActivitiesActivityIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteActivitiesActivityIDVotesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDVotesJSON204Response is a constructor method for a PostActivitiesActivityIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDVotesJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostActivitiesActivityIDVotesJSON400Response is a constructor method for a PostActivitiesActivityIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostActivitiesActivityIDVotesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetLLinkIDJSON400Response is a constructor method for a GetLLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetLLinkIDJSON404Response is a constructor method for a GetLLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetLLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetNotificationsJSON200Response is a constructor method for a GetNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetNotificationsJSON200Response(body GetNotificationsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetNotificationsJSON400Response is a constructor method for a GetNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetNotificationsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsReadJSON204Response is a constructor method for a PostNotificationsRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsReadJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsReadJSON400Response is a constructor method for a PostNotificationsRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsReadJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsNotificationIDReadJSON204Response is a constructor method for a PostNotificationsNotificationIDRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsNotificationIDReadJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsNotificationIDReadJSON400Response is a constructor method for a PostNotificationsNotificationIDRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsNotificationIDReadJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostNotificationsNotificationIDReadJSON404Response is a constructor method for a PostNotificationsNotificationIDRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostNotificationsNotificationIDReadJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDJSON200Response is a constructor method for a GetParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDJSON200Response(body GetParticipantResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDJSON400Response is a constructor method for a GetParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDJSON204Response is a constructor method for a PatchParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDJSON400Response is a constructor method for a PatchParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON403Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON204Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON400Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON403Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDigestJSON204Response is a constructor method for a PatchParticipantsParticipantIDDigest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDigestJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDigestJSON400Response is a constructor method for a PatchParticipantsParticipantIDDigest response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDigestJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDHistoryJSON200Response is a constructor method for a GetParticipantsParticipantIDHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDHistoryJSON200Response(body GetParticipantHistoryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDHistoryJSON400Response is a constructor method for a GetParticipantsParticipantIDHistory response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDHistoryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNotificationsJSON200Response is a constructor method for a GetParticipantsParticipantIDNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNotificationsJSON200Response(body GetNotificationPreferencesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNotificationsJSON400Response is a constructor method for a GetParticipantsParticipantIDNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNotificationsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDNotificationsJSON204Response is a constructor method for a PatchParticipantsParticipantIDNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDNotificationsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDNotificationsJSON400Response is a constructor method for a PatchParticipantsParticipantIDNotifications response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDNotificationsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRemindersJSON204Response is a constructor method for a PatchParticipantsParticipantIDReminders response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRemindersJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRemindersJSON400Response is a constructor method for a PatchParticipantsParticipantIDReminders response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRemindersJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDUnconfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDUnconfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDUnconfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDUnconfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDUnconfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDUnconfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDVerifyEmailJSON204Response is a constructor method for a PostParticipantsParticipantIDVerifyEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDVerifyEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDVerifyEmailJSON400Response is a constructor method for a PostParticipantsParticipantIDVerifyEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDVerifyEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDVerifyEmailJSON429Response is a constructor method for a PostParticipantsParticipantIDVerifyEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDVerifyEmailJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostPollsPollIDVotesJSON204Response is a constructor method for a PostPollsPollIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPollsPollIDVotesJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostPollsPollIDVotesJSON400Response is a constructor method for a PostPollsPollIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPollsPollIDVotesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostPollsPollIDVotesJSON403Response is a constructor method for a PostPollsPollIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPollsPollIDVotesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostPollsPollIDVotesJSON409Response is a constructor method for a PostPollsPollIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPollsPollIDVotesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostPollsPollIDVotesJSON422Response is a constructor method for a PostPollsPollIDVotes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostPollsPollIDVotesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedSlugJSON200Response is a constructor method for a GetSharedSlug response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedSlugJSON200Response(body GetSharedTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedSlugJSON400Response is a constructor method for a GetSharedSlug response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedSlugJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTagsJSON200Response is a constructor method for a GetTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTagsJSON200Response(body GetTagsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTagsJSON400Response is a constructor method for a GetTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTagsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTagsJSON201Response is a constructor method for a PostTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTagsJSON201Response(body CreateTagResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTagsJSON400Response is a constructor method for a PostTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTagsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTagsJSON409Response is a constructor method for a PostTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTagsJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTagsTagIDJSON204Response is a constructor method for a DeleteTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTagsTagIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTagsTagIDJSON400Response is a constructor method for a DeleteTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTagsTagIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTagsTagIDJSON204Response is a constructor method for a PutTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTagsTagIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTagsTagIDJSON400Response is a constructor method for a PutTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTagsTagIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTagsTagIDJSON409Response is a constructor method for a PutTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTagsTagIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON200Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON200Response(body GetTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON400Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJoinJSON202Response is a constructor method for a PostTripsJoin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJoinJSON202Response(body JoinTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJoinJSON400Response is a constructor method for a PostTripsJoin response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJoinJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDJSON204Response is a constructor method for a PatchTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDJSON400Response is a constructor method for a PatchTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDJSON403Response is a constructor method for a PatchTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDJSON409Response is a constructor method for a PatchTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDJSON409Response(body TripRangeConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON409Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON409Response(body TripRangeConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON409Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON409Response(body ActivityConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON422Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON201Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON201Response(body ImportActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON400Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON422Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON422Response(body ImportActivitiesErrorResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesReorderJSON204Response is a constructor method for a PatchTripsTripIDActivitiesReorder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesReorderJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesReorderJSON400Response is a constructor method for a PatchTripsTripIDActivitiesReorder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesReorderJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON200Response(body GetActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON409Response(body ActivityConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON409Response(body ActivityConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDRestoreJSON204Response is a constructor method for a PostTripsTripIDActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDRestoreJSON400Response is a constructor method for a PostTripsTripIDActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDRestoreJSON403Response is a constructor method for a PostTripsTripIDActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDRestoreJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDRestoreJSON404Response is a constructor method for a PostTripsTripIDActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDRestoreJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBookingsJSON200Response is a constructor method for a GetTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBookingsJSON200Response(body GetBookingsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBookingsJSON400Response is a constructor method for a GetTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBookingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDBookingsJSON201Response is a constructor method for a PostTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDBookingsJSON201Response(body CreateBookingResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDBookingsJSON400Response is a constructor method for a PostTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDBookingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDBookingsJSON404Response is a constructor method for a PostTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDBookingsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDBookingsJSON422Response is a constructor method for a PostTripsTripIDBookings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDBookingsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDBookingsBookingIDJSON204Response is a constructor method for a DeleteTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDBookingsBookingIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDBookingsBookingIDJSON400Response is a constructor method for a DeleteTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDBookingsBookingIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDBookingsBookingIDJSON404Response is a constructor method for a DeleteTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDBookingsBookingIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBookingsBookingIDJSON204Response is a constructor method for a PutTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBookingsBookingIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBookingsBookingIDJSON400Response is a constructor method for a PutTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBookingsBookingIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBookingsBookingIDJSON404Response is a constructor method for a PutTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBookingsBookingIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDBookingsBookingIDJSON422Response is a constructor method for a PutTripsTripIDBookingsBookingID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDBookingsBookingIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON400Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON404Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON204Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON400Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON403Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsJSON200Response is a constructor method for a GetTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsJSON200Response(body GetTripDocumentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsJSON400Response is a constructor method for a GetTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsJSON404Response is a constructor method for a GetTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDocumentsJSON201Response is a constructor method for a PostTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDocumentsJSON201Response(body CreateDocumentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDocumentsJSON400Response is a constructor method for a PostTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDocumentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDocumentsJSON404Response is a constructor method for a PostTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDocumentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDocumentsJSON413Response is a constructor method for a PostTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDocumentsJSON413Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        413,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDocumentsJSON415Response is a constructor method for a PostTripsTripIDDocuments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDocumentsJSON415Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        415,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDDocumentsDocumentIDJSON204Response is a constructor method for a DeleteTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDDocumentsDocumentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDDocumentsDocumentIDJSON400Response is a constructor method for a DeleteTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDDocumentsDocumentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDDocumentsDocumentIDJSON404Response is a constructor method for a DeleteTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDDocumentsDocumentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsDocumentIDJSON200Response is a constructor method for a GetTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsDocumentIDJSON200Response(body GetTripDocumentsResponseArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsDocumentIDJSON400Response is a constructor method for a GetTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsDocumentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDocumentsDocumentIDJSON404Response is a constructor method for a GetTripsTripIDDocumentsDocumentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDocumentsDocumentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailsPreviewJSON400Response is a constructor method for a GetTripsTripIDEmailsPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailsPreviewJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailsPreviewJSON403Response is a constructor method for a GetTripsTripIDEmailsPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailsPreviewJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailsPreviewJSON404Response is a constructor method for a GetTripsTripIDEmailsPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailsPreviewJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEventsJSON400Response is a constructor method for a GetTripsTripIDEvents response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEventsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEventsJSON404Response is a constructor method for a GetTripsTripIDEvents response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEventsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON200Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON200Response(body GetExpensesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON400Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON201Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON400Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON422Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON200Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON200Response(body GetSettlementResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON400Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesSettlementPaymentsJSON201Response is a constructor method for a PostTripsTripIDExpensesSettlementPayments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesSettlementPaymentsJSON201Response(body CreateSettlementPaymentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesSettlementPaymentsJSON400Response is a constructor method for a PostTripsTripIDExpensesSettlementPayments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesSettlementPaymentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesSettlementPaymentsJSON422Response is a constructor method for a PostTripsTripIDExpensesSettlementPayments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesSettlementPaymentsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON204Response is a constructor method for a DeleteTripsTripIDExpensesSettlementPaymentsPaymentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response is a constructor method for a DeleteTripsTripIDExpensesSettlementPaymentsPaymentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON403Response is a constructor method for a DeleteTripsTripIDExpensesSettlementPaymentsPaymentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON404Response is a constructor method for a DeleteTripsTripIDExpensesSettlementPaymentsPaymentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesTotalsJSON200Response is a constructor method for a GetTripsTripIDExpensesTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesTotalsJSON200Response(body GetExpenseTotalsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesTotalsJSON400Response is a constructor method for a GetTripsTripIDExpensesTotals response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesTotalsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON403Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDExpensesExpenseIDJSON404Response is a constructor method for a DeleteTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDExpensesExpenseIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON204Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON400Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON403Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON404Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDExpensesExpenseIDJSON422Response is a constructor method for a PutTripsTripIDExpensesExpenseID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDExpensesExpenseIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body InviteParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON409Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJoinCodeJSON201Response is a constructor method for a PostTripsTripIDJoinCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJoinCodeJSON201Response(body CreateTripJoinCodeResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJoinCodeJSON400Response is a constructor method for a PostTripsTripIDJoinCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJoinCodeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJoinCodeJSON403Response is a constructor method for a PostTripsTripIDJoinCode response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJoinCodeJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalJSON200Response is a constructor method for a GetTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalJSON200Response(body GetTripJournalResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalJSON400Response is a constructor method for a GetTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalJSON404Response is a constructor method for a GetTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJournalJSON201Response is a constructor method for a PostTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJournalJSON201Response(body CreateJournalEntryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJournalJSON400Response is a constructor method for a PostTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJournalJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJournalJSON404Response is a constructor method for a PostTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJournalJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDJournalJSON422Response is a constructor method for a PostTripsTripIDJournal response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDJournalJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalExportJSON400Response is a constructor method for a GetTripsTripIDJournalExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalExportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalExportJSON404Response is a constructor method for a GetTripsTripIDJournalExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalExportJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJournalExportJSON409Response is a constructor method for a GetTripsTripIDJournalExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJournalExportJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJournalEntryIDJSON204Response is a constructor method for a DeleteTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJournalEntryIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJournalEntryIDJSON400Response is a constructor method for a DeleteTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJournalEntryIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJournalEntryIDJSON404Response is a constructor method for a DeleteTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJournalEntryIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJournalEntryIDJSON204Response is a constructor method for a PutTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJournalEntryIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJournalEntryIDJSON400Response is a constructor method for a PutTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJournalEntryIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJournalEntryIDJSON404Response is a constructor method for a PutTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJournalEntryIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJournalEntryIDJSON422Response is a constructor method for a PutTripsTripIDJournalEntryID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJournalEntryIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON409Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON422Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksReorderJSON204Response is a constructor method for a PatchTripsTripIDLinksReorder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksReorderJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksReorderJSON400Response is a constructor method for a PatchTripsTripIDLinksReorder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksReorderJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksReorderJSON403Response is a constructor method for a PatchTripsTripIDLinksReorder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksReorderJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON403Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON404Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON409Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON422Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON409Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON422Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksLinkIDRestoreJSON204Response is a constructor method for a PostTripsTripIDLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksLinkIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksLinkIDRestoreJSON400Response is a constructor method for a PostTripsTripIDLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksLinkIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksLinkIDRestoreJSON403Response is a constructor method for a PostTripsTripIDLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksLinkIDRestoreJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksLinkIDRestoreJSON404Response is a constructor method for a PostTripsTripIDLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksLinkIDRestoreJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksLinkIDRestoreJSON409Response is a constructor method for a PostTripsTripIDLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksLinkIDRestoreJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLiveJSON400Response is a constructor method for a GetTripsTripIDLive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLiveJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLiveJSON404Response is a constructor method for a GetTripsTripIDLive response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLiveJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDMessagesJSON200Response is a constructor method for a GetTripsTripIDMessages response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDMessagesJSON200Response(body GetMessagesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDMessagesJSON400Response is a constructor method for a GetTripsTripIDMessages response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDMessagesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesJSON201Response is a constructor method for a PostTripsTripIDMessages response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesJSON201Response(body CreateMessageResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesJSON400Response is a constructor method for a PostTripsTripIDMessages response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesJSON422Response is a constructor method for a PostTripsTripIDMessages response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesReadJSON204Response is a constructor method for a PostTripsTripIDMessagesRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesReadJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesReadJSON400Response is a constructor method for a PostTripsTripIDMessagesRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesReadJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMessagesReadJSON422Response is a constructor method for a PostTripsTripIDMessagesRead response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMessagesReadJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDMessagesUnreadJSON200Response is a constructor method for a GetTripsTripIDMessagesUnread response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDMessagesUnreadJSON200Response(body GetUnreadMessagesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDMessagesUnreadJSON400Response is a constructor method for a GetTripsTripIDMessagesUnread response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDMessagesUnreadJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDMessagesUnreadJSON404Response is a constructor method for a GetTripsTripIDMessagesUnread response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDMessagesUnreadJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnersJSON200Response is a constructor method for a GetTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnersJSON200Response(body GetTripOwnersResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnersJSON400Response is a constructor method for a GetTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnersJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnersJSON403Response is a constructor method for a GetTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnersJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON201Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON400Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON403Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersOwnerEmailJSON204Response is a constructor method for a DeleteTripsTripIDOwnersOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersOwnerEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersOwnerEmailJSON400Response is a constructor method for a DeleteTripsTripIDOwnersOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersOwnerEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersOwnerEmailJSON403Response is a constructor method for a DeleteTripsTripIDOwnersOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersOwnerEmailJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingJSON200Response is a constructor method for a GetTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingJSON200Response(body GetPackingListResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingJSON400Response is a constructor method for a GetTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingJSON201Response is a constructor method for a PostTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingJSON201Response(body CreatePackingItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingJSON400Response is a constructor method for a PostTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingJSON404Response is a constructor method for a PostTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingJSON422Response is a constructor method for a PostTripsTripIDPacking response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingSuggestionsJSON200Response is a constructor method for a GetTripsTripIDPackingSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingSuggestionsJSON200Response(body GetPackingSuggestionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingSuggestionsJSON400Response is a constructor method for a GetTripsTripIDPackingSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingSuggestionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingSuggestionsJSON404Response is a constructor method for a GetTripsTripIDPackingSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingSuggestionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemIDJSON204Response is a constructor method for a DeleteTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemIDJSON400Response is a constructor method for a DeleteTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemIDJSON404Response is a constructor method for a DeleteTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemIDJSON204Response is a constructor method for a PutTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemIDJSON400Response is a constructor method for a PutTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemIDJSON404Response is a constructor method for a PutTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemIDJSON422Response is a constructor method for a PutTripsTripIDPackingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON403Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInviteJSON429Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDResendInviteJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPhotosJSON200Response is a constructor method for a GetTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPhotosJSON200Response(body GetTripPhotosResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPhotosJSON400Response is a constructor method for a GetTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPhotosJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosJSON201Response is a constructor method for a PostTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosJSON201Response(body CreatePhotoResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosJSON400Response is a constructor method for a PostTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosJSON404Response is a constructor method for a PostTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosJSON413Response is a constructor method for a PostTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosJSON413Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        413,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosJSON415Response is a constructor method for a PostTripsTripIDPhotos response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosJSON415Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        415,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDJSON204Response is a constructor method for a DeleteTripsTripIDPhotosPhotoID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDJSON400Response is a constructor method for a DeleteTripsTripIDPhotosPhotoID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDJSON404Response is a constructor method for a DeleteTripsTripIDPhotosPhotoID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPhotosPhotoIDCommentsJSON200Response is a constructor method for a GetTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPhotosPhotoIDCommentsJSON200Response(body GetPhotoCommentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPhotosPhotoIDCommentsJSON400Response is a constructor method for a GetTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPhotosPhotoIDCommentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPhotosPhotoIDCommentsJSON404Response is a constructor method for a GetTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPhotosPhotoIDCommentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosPhotoIDCommentsJSON201Response is a constructor method for a PostTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosPhotoIDCommentsJSON201Response(body CreatePhotoCommentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosPhotoIDCommentsJSON400Response is a constructor method for a PostTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosPhotoIDCommentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPhotosPhotoIDCommentsJSON404Response is a constructor method for a PostTripsTripIDPhotosPhotoIDComments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPhotosPhotoIDCommentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON204Response is a constructor method for a DeleteTripsTripIDPhotosPhotoIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response is a constructor method for a DeleteTripsTripIDPhotosPhotoIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON404Response is a constructor method for a DeleteTripsTripIDPhotosPhotoIDCommentsCommentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPollsJSON200Response is a constructor method for a GetTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPollsJSON200Response(body GetPollsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPollsJSON400Response is a constructor method for a GetTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPollsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsJSON201Response is a constructor method for a PostTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsJSON201Response(body CreatePollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsJSON400Response is a constructor method for a PostTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsJSON403Response is a constructor method for a PostTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsJSON422Response is a constructor method for a PostTripsTripIDPolls response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON200Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON200Response(body ApplyPollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON400Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON403Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON404Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON409Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON409Response(body TripRangeConflictResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPollsPollIDApplyJSON422Response is a constructor method for a PostTripsTripIDPollsPollIDApply response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPollsPollIDApplyJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSearchJSON200Response is a constructor method for a GetTripsTripIDSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSearchJSON200Response(body SearchTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSearchJSON400Response is a constructor method for a GetTripsTripIDSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSearchJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSegmentsJSON200Response is a constructor method for a GetTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSegmentsJSON200Response(body GetTransportSegmentsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSegmentsJSON400Response is a constructor method for a GetTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSegmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSegmentsJSON201Response is a constructor method for a PostTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSegmentsJSON201Response(body CreateTransportSegmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSegmentsJSON400Response is a constructor method for a PostTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSegmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSegmentsJSON404Response is a constructor method for a PostTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSegmentsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSegmentsJSON422Response is a constructor method for a PostTripsTripIDSegments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSegmentsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSegmentsSegmentIDJSON204Response is a constructor method for a DeleteTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSegmentsSegmentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSegmentsSegmentIDJSON400Response is a constructor method for a DeleteTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSegmentsSegmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSegmentsSegmentIDJSON404Response is a constructor method for a DeleteTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSegmentsSegmentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSON204Response is a constructor method for a PutTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSegmentsSegmentIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSON400Response is a constructor method for a PutTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSegmentsSegmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSON404Response is a constructor method for a PutTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSegmentsSegmentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSegmentsSegmentIDJSON422Response is a constructor method for a PutTripsTripIDSegmentsSegmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSegmentsSegmentIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON204Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON400Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON403Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON201Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON201Response(body CreateTripShareResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON400Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON403Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDStatsJSON200Response is a constructor method for a GetTripsTripIDStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDStatsJSON200Response(body GetTripStatsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDStatsJSON400Response is a constructor method for a GetTripsTripIDStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDStatsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON204Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON400Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON403Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSuggestionsJSON200Response is a constructor method for a GetTripsTripIDSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSuggestionsJSON200Response(body GetTripSuggestionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSuggestionsJSON400Response is a constructor method for a GetTripsTripIDSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSuggestionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSuggestionsJSON404Response is a constructor method for a GetTripsTripIDSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSuggestionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSuggestionsJSON422Response is a constructor method for a GetTripsTripIDSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSuggestionsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSuggestionsJSON503Response is a constructor method for a GetTripsTripIDSuggestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSuggestionsJSON503Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        503,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSummaryJSON200Response is a constructor method for a GetTripsTripIDSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSummaryJSON200Response(body GetTripSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSummaryJSON400Response is a constructor method for a GetTripsTripIDSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTagsJSON200Response is a constructor method for a GetTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTagsJSON200Response(body GetTagsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTagsJSON400Response is a constructor method for a GetTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTagsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTagsTagIDJSON204Response is a constructor method for a DeleteTripsTripIDTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTagsTagIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTagsTagIDJSON400Response is a constructor method for a DeleteTripsTripIDTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTagsTagIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsTagIDJSON204Response is a constructor method for a PutTripsTripIDTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsTagIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsTagIDJSON400Response is a constructor method for a PutTripsTripIDTagsTagID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsTagIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON200Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON200Response(body GetTasksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON400Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON201Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON201Response(body CreateTaskResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON400Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON404Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON422Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON204Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON400Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON404Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON204Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON400Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON404Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON422Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWaitlistJSON200Response is a constructor method for a GetTripsTripIDWaitlist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWaitlistJSON200Response(body GetTripWaitlistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWaitlistJSON400Response is a constructor method for a GetTripsTripIDWaitlist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWaitlistJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWeatherJSON200Response is a constructor method for a GetTripsTripIDWeather response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWeatherJSON200Response(body GetTripWeatherResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWeatherJSON400Response is a constructor method for a GetTripsTripIDWeather response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWeatherJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWeatherJSON404Response is a constructor method for a GetTripsTripIDWeather response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWeatherJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWeatherJSON503Response is a constructor method for a GetTripsTripIDWeather response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWeatherJSON503Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        503,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON200Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON200Response(body GetWebhooksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON400Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON403Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON201Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON201Response(body CreateWebhookResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON400Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON403Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON404Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWsJSON400Response is a constructor method for a GetTripsTripIDWs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWsJSON404Response is a constructor method for a GetTripsTripIDWs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteWebhooksWebhookIDJSON204Response is a constructor method for a DeleteWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteWebhooksWebhookIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteWebhooksWebhookIDJSON400Response is a constructor method for a DeleteWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteWebhooksWebhookIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteWebhooksWebhookIDJSON403Response is a constructor method for a DeleteWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteWebhooksWebhookIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteWebhooksWebhookIDJSON404Response is a constructor method for a DeleteWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteWebhooksWebhookIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetWebhooksWebhookIDDeliveriesJSON200Response is a constructor method for a GetWebhooksWebhookIDDeliveries response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWebhooksWebhookIDDeliveriesJSON200Response(body GetWebhookDeliveriesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetWebhooksWebhookIDDeliveriesJSON400Response is a constructor method for a GetWebhooksWebhookIDDeliveries response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWebhooksWebhookIDDeliveriesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetWebhooksWebhookIDDeliveriesJSON403Response is a constructor method for a GetWebhooksWebhookIDDeliveries response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWebhooksWebhookIDDeliveriesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetWebhooksWebhookIDDeliveriesJSON404Response is a constructor method for a GetWebhooksWebhookIDDeliveries response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetWebhooksWebhookIDDeliveriesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get an activity attachments.
+	// (GET /activities/{activityId}/attachments)
+	GetActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, activityID string) *Response
+	// Upload an attachment to an activity.
+	// (POST /activities/{activityId}/attachments)
+	PostActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, activityID string) *Response
+	// Get an activity comments.
+	// (GET /activities/{activityId}/comments)
+	GetActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request, activityID string) *Response
+	// Comment on an activity.
+	// (POST /activities/{activityId}/comments)
+	PostActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request, activityID string) *Response
+	// Delete a comment written by the participant.
+	// (DELETE /activities/{activityId}/comments/{commentId})
+	DeleteActivitiesActivityIDCommentsCommentID(w http.ResponseWriter, r *http.Request, activityID string, commentID string, params DeleteActivitiesActivityIDCommentsCommentIDParams) *Response
+	// Restore a comment deleted by the participant.
+	// (POST /activities/{activityId}/comments/{commentId}/restore)
+	PostActivitiesActivityIDCommentsCommentIDRestore(w http.ResponseWriter, r *http.Request, activityID string, commentID string, params PostActivitiesActivityIDCommentsCommentIDRestoreParams) *Response
+	// Mark whether a participant attends an activity.
+	// (PATCH /activities/{activityId}/rsvp)
+	PatchActivitiesActivityIDRsvp(w http.ResponseWriter, r *http.Request, activityID string) *Response
+	// Remove the participant vote from an activity.
+	// (DELETE /activities/{activityId}/votes)
+	DeleteActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request, activityID string, params DeleteActivitiesActivityIDVotesParams) *Response
+	// Upvote a proposed activity.
+	// (POST /activities/{activityId}/votes)
+	PostActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request, activityID string, params PostActivitiesActivityIDVotesParams) *Response
+	// Open a trip link.
+	// (GET /l/{linkId})
+	GetLLinkID(w http.ResponseWriter, r *http.Request, linkID string) *Response
+	// Get the notifications of a participant, newest first.
+	// (GET /notifications)
+	GetNotifications(w http.ResponseWriter, r *http.Request, params GetNotificationsParams) *Response
+	// Mark all the notifications of a participant as read.
+	// (POST /notifications/read)
+	PostNotificationsRead(w http.ResponseWriter, r *http.Request, params PostNotificationsReadParams) *Response
+	// Mark a notification as read.
+	// (POST /notifications/{notificationId}/read)
+	PostNotificationsNotificationIDRead(w http.ResponseWriter, r *http.Request, notificationID string, params PostNotificationsNotificationIDReadParams) *Response
+	// Get a participant details.
+	// (GET /participants/{participantId})
+	GetParticipantsParticipantID(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Update a participant profile.
+	// (PATCH /participants/{participantId})
+	PatchParticipantsParticipantID(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDConfirmParams) *Response
+	// Declines a trip invitation.
+	// (PATCH /participants/{participantId}/decline)
+	PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDDeclineParams) *Response
+	// Turns the daily itinerary e-mails on or off for a participant.
+	// (PATCH /participants/{participantId}/digest)
+	PatchParticipantsParticipantIDDigest(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get a participant status history.
+	// (GET /participants/{participantId}/history)
+	GetParticipantsParticipantIDHistory(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get the notification e-mails a participant receives.
+	// (GET /participants/{participantId}/notifications)
+	GetParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Turns notification e-mails on or off for a participant.
+	// (PATCH /participants/{participantId}/notifications)
+	PatchParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Turns activity reminder e-mails on or off for a participant.
+	// (PATCH /participants/{participantId}/reminders)
+	PatchParticipantsParticipantIDReminders(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Takes back a participant confirmation.
+	// (PATCH /participants/{participantId}/unconfirm)
+	PatchParticipantsParticipantIDUnconfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Confirms the e-mail of a participant with the verification code.
+	// (POST /participants/{participantId}/verify-email)
+	PostParticipantsParticipantIDVerifyEmail(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Vote on a poll.
+	// (POST /polls/{pollId}/votes)
+	PostPollsPollIDVotes(w http.ResponseWriter, r *http.Request, pollID string, params PostPollsPollIDVotesParams) *Response
+	// Get a read-only view of a shared trip.
+	// (GET /shared/{slug})
+	GetSharedSlug(w http.ResponseWriter, r *http.Request, slug string) *Response
+	// Get all tags.
+	// (GET /tags)
+	GetTags(w http.ResponseWriter, r *http.Request) *Response
+	// Create a tag.
+	// (POST /tags)
+	PostTags(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a tag.
+	// (DELETE /tags/{tagId})
+	DeleteTagsTagID(w http.ResponseWriter, r *http.Request, tagID string) *Response
+	// Rename a tag.
+	// (PUT /tags/{tagId})
+	PutTagsTagID(w http.ResponseWriter, r *http.Request, tagID string) *Response
+	// List trips.
+	// (GET /trips)
+	GetTrips(w http.ResponseWriter, r *http.Request, params GetTripsParams) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// Join a trip with its join code.
+	// (POST /trips/join)
+	PostTripsJoin(w http.ResponseWriter, r *http.Request) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Partially update a trip.
+	// (PATCH /trips/{tripId})
+	PatchTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDParams) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDParams) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesParams) *Response
+	// Import many activities at once.
+	// (POST /trips/{tripId}/activities/import)
+	PostTripsTripIDActivitiesImport(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesImportParams) *Response
+	// Reorder a trip activities.
+	// (PATCH /trips/{tripId}/activities/reorder)
+	PatchTripsTripIDActivitiesReorder(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params DeleteTripsTripIDActivitiesActivityIDParams) *Response
+	// Get a trip activity.
+	// (GET /trips/{tripId}/activities/{activityId})
+	GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Partially update a trip activity.
+	// (PATCH /trips/{tripId}/activities/{activityId})
+	PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PatchTripsTripIDActivitiesActivityIDParams) *Response
+	// Update a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PutTripsTripIDActivitiesActivityIDParams) *Response
+	// Restore a deleted trip activity.
+	// (POST /trips/{tripId}/activities/{activityId}/restore)
+	PostTripsTripIDActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PostTripsTripIDActivitiesActivityIDRestoreParams) *Response
+	// Get the bookings of a trip.
+	// (GET /trips/{tripId}/bookings)
+	GetTripsTripIDBookings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add a booking to a trip.
+	// (POST /trips/{tripId}/bookings)
+	PostTripsTripIDBookings(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDBookingsParams) *Response
+	// Delete a booking of a trip.
+	// (DELETE /trips/{tripId}/bookings/{bookingId})
+	DeleteTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request, tripID string, bookingID string, params DeleteTripsTripIDBookingsBookingIDParams) *Response
+	// Update a booking of a trip.
+	// (PUT /trips/{tripId}/bookings/{bookingId})
+	PutTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request, tripID string, bookingID string, params PutTripsTripIDBookingsBookingIDParams) *Response
+	// Get the calendar feed of a trip.
+	// (GET /trips/{tripId}/calendar.ics)
+	GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (POST /trips/{tripId}/confirm)
+	PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDConfirmParams) *Response
+	// Get the documents of a trip.
+	// (GET /trips/{tripId}/documents)
+	GetTripsTripIDDocuments(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDDocumentsParams) *Response
+	// Upload a document to a trip.
+	// (POST /trips/{tripId}/documents)
+	PostTripsTripIDDocuments(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDDocumentsParams) *Response
+	// Delete a document of a trip.
+	// (DELETE /trips/{tripId}/documents/{documentId})
+	DeleteTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request, tripID string, documentID string, params DeleteTripsTripIDDocumentsDocumentIDParams) *Response
+	// Get a document of a trip.
+	// (GET /trips/{tripId}/documents/{documentId})
+	GetTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request, tripID string, documentID string, params GetTripsTripIDDocumentsDocumentIDParams) *Response
+	// Preview an e-mail of the trip.
+	// (GET /trips/{tripId}/emails/preview)
+	GetTripsTripIDEmailsPreview(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDEmailsPreviewParams) *Response
+	// Stream the changes of a trip as resumable server-sent events.
+	// (GET /trips/{tripId}/events)
+	GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDEventsParams) *Response
+	// Get a trip expenses.
+	// (GET /trips/{tripId}/expenses)
+	GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Log a trip expense.
+	// (POST /trips/{tripId}/expenses)
+	PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get who owes whom on a trip.
+	// (GET /trips/{tripId}/expenses/settlement)
+	GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Mark a settlement transfer as paid.
+	// (POST /trips/{tripId}/expenses/settlement/payments)
+	PostTripsTripIDExpensesSettlementPayments(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a settlement payment.
+	// (DELETE /trips/{tripId}/expenses/settlement/payments/{paymentId})
+	DeleteTripsTripIDExpensesSettlementPaymentsPaymentID(w http.ResponseWriter, r *http.Request, tripID string, paymentID string, params DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDParams) *Response
+	// Get how much was spent on a trip.
+	// (GET /trips/{tripId}/expenses/totals)
+	GetTripsTripIDExpensesTotals(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip expense.
+	// (DELETE /trips/{tripId}/expenses/{expenseId})
+	DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string, params DeleteTripsTripIDExpensesExpenseIDParams) *Response
+	// Update a trip expense.
+	// (PUT /trips/{tripId}/expenses/{expenseId})
+	PutTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request, tripID string, expenseID string, params PutTripsTripIDExpensesExpenseIDParams) *Response
+	// Invite people to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Generate the join code of a trip.
+	// (POST /trips/{tripId}/join-code)
+	PostTripsTripIDJoinCode(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDJoinCodeParams) *Response
+	// Get the journal of a trip.
+	// (GET /trips/{tripId}/journal)
+	GetTripsTripIDJournal(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDJournalParams) *Response
+	// Write an entry in the journal of a trip.
+	// (POST /trips/{tripId}/journal)
+	PostTripsTripIDJournal(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDJournalParams) *Response
+	// Export the journal of a trip once it is over.
+	// (GET /trips/{tripId}/journal/export)
+	GetTripsTripIDJournalExport(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDJournalExportParams) *Response
+	// Delete a journal entry written by the participant.
+	// (DELETE /trips/{tripId}/journal/{entryId})
+	DeleteTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request, tripID string, entryID string, params DeleteTripsTripIDJournalEntryIDParams) *Response
+	// Update a journal entry written by the participant.
+	// (PUT /trips/{tripId}/journal/{entryId})
+	PutTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request, tripID string, entryID string, params PutTripsTripIDJournalEntryIDParams) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Reorder a trip links.
+	// (PATCH /trips/{tripId}/links/reorder)
+	PatchTripsTripIDLinksReorder(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDLinksReorderParams) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params DeleteTripsTripIDLinksLinkIDParams) *Response
+	// Partially update a trip link.
+	// (PATCH /trips/{tripId}/links/{linkId})
+	PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params PatchTripsTripIDLinksLinkIDParams) *Response
+	// Update a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params PutTripsTripIDLinksLinkIDParams) *Response
+	// Restore a deleted trip link.
+	// (POST /trips/{tripId}/links/{linkId}/restore)
+	PostTripsTripIDLinksLinkIDRestore(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params PostTripsTripIDLinksLinkIDRestoreParams) *Response
+	// Stream the changes of a trip.
+	// (GET /trips/{tripId}/live)
+	GetTripsTripIDLive(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip messages, newest first.
+	// (GET /trips/{tripId}/messages)
+	GetTripsTripIDMessages(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDMessagesParams) *Response
+	// Write a message on a trip board.
+	// (POST /trips/{tripId}/messages)
+	PostTripsTripIDMessages(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDMessagesParams) *Response
+	// Mark a trip messages as read by a participant.
+	// (POST /trips/{tripId}/messages/read)
+	PostTripsTripIDMessagesRead(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDMessagesReadParams) *Response
+	// Count the messages a participant has not read.
+	// (GET /trips/{tripId}/messages/unread)
+	GetTripsTripIDMessagesUnread(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDMessagesUnreadParams) *Response
+	// Get a trip owners.
+	// (GET /trips/{tripId}/owners)
+	GetTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDOwnersParams) *Response
+	// Add a co-owner to the trip.
+	// (POST /trips/{tripId}/owners)
+	PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDOwnersParams) *Response
+	// Remove a co-owner from the trip.
+	// (DELETE /trips/{tripId}/owners/{ownerEmail})
+	DeleteTripsTripIDOwnersOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string, ownerEmail openapi_types.Email, params DeleteTripsTripIDOwnersOwnerEmailParams) *Response
+	// Get the packing list of a trip.
+	// (GET /trips/{tripId}/packing)
+	GetTripsTripIDPacking(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add an item to the packing list of a trip.
+	// (POST /trips/{tripId}/packing)
+	PostTripsTripIDPacking(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDPackingParams) *Response
+	// Suggest items for the packing list of a trip.
+	// (GET /trips/{tripId}/packing/suggestions)
+	GetTripsTripIDPackingSuggestions(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove an item from the packing list of a trip.
+	// (DELETE /trips/{tripId}/packing/{itemId})
+	DeleteTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string, params DeleteTripsTripIDPackingItemIDParams) *Response
+	// Update an item of the packing list of a trip.
+	// (PUT /trips/{tripId}/packing/{itemId})
+	PutTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string, params PutTripsTripIDPackingItemIDParams) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Resend the invitation e-mail to a participant.
+	// (POST /trips/{tripId}/participants/{participantId}/resend-invite)
+	PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params PostTripsTripIDParticipantsParticipantIDResendInviteParams) *Response
+	// Get a page of the gallery of a trip, newest first.
+	// (GET /trips/{tripId}/photos)
+	GetTripsTripIDPhotos(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDPhotosParams) *Response
+	// Upload a photo to a trip gallery.
+	// (POST /trips/{tripId}/photos)
+	PostTripsTripIDPhotos(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDPhotosParams) *Response
+	// Delete a photo uploaded by the participant.
+	// (DELETE /trips/{tripId}/photos/{photoId})
+	DeleteTripsTripIDPhotosPhotoID(w http.ResponseWriter, r *http.Request, tripID string, photoID string, params DeleteTripsTripIDPhotosPhotoIDParams) *Response
+	// Get the comments of a photo.
+	// (GET /trips/{tripId}/photos/{photoId}/comments)
+	GetTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request, tripID string, photoID string) *Response
+	// Comment on a photo.
+	// (POST /trips/{tripId}/photos/{photoId}/comments)
+	PostTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request, tripID string, photoID string, params PostTripsTripIDPhotosPhotoIDCommentsParams) *Response
+	// Delete a comment written by the participant.
+	// (DELETE /trips/{tripId}/photos/{photoId}/comments/{commentId})
+	DeleteTripsTripIDPhotosPhotoIDCommentsCommentID(w http.ResponseWriter, r *http.Request, tripID string, photoID string, commentID string, params DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDParams) *Response
+	// Get a trip polls, with their votes.
+	// (GET /trips/{tripId}/polls)
+	GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a poll on the dates or the destination of a trip.
+	// (POST /trips/{tripId}/polls)
+	PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDPollsParams) *Response
+	// Apply the winning option of a poll to the trip.
+	// (POST /trips/{tripId}/polls/{pollId}/apply)
+	PostTripsTripIDPollsPollIDApply(w http.ResponseWriter, r *http.Request, tripID string, pollID string, params PostTripsTripIDPollsPollIDApplyParams) *Response
+	// Search a trip.
+	// (GET /trips/{tripId}/search)
+	GetTripsTripIDSearch(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDSearchParams) *Response
+	// Get the transport segments of a trip.
+	// (GET /trips/{tripId}/segments)
+	GetTripsTripIDSegments(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add a transport segment to a trip.
+	// (POST /trips/{tripId}/segments)
+	PostTripsTripIDSegments(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDSegmentsParams) *Response
+	// Delete a transport segment of a trip.
+	// (DELETE /trips/{tripId}/segments/{segmentId})
+	DeleteTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request, tripID string, segmentID string, params DeleteTripsTripIDSegmentsSegmentIDParams) *Response
+	// Update a transport segment of a trip.
+	// (PUT /trips/{tripId}/segments/{segmentId})
+	PutTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request, tripID string, segmentID string, params PutTripsTripIDSegmentsSegmentIDParams) *Response
+	// Revoke every share link of a trip.
+	// (DELETE /trips/{tripId}/share)
+	DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string, params DeleteTripsTripIDShareParams) *Response
+	// Create a public read-only share link for a trip.
+	// (POST /trips/{tripId}/share)
+	PostTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDShareParams) *Response
+	// Get a trip attendance stats.
+	// (GET /trips/{tripId}/stats)
+	GetTripsTripIDStats(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Move a trip to another lifecycle status.
+	// (PATCH /trips/{tripId}/status)
+	PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDStatusParams) *Response
+	// Suggest places to visit at the destination of a trip.
+	// (GET /trips/{tripId}/suggestions)
+	GetTripsTripIDSuggestions(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDSuggestionsParams) *Response
+	// Get a trip along with its participants, activities and links.
+	// (GET /trips/{tripId}/summary)
+	GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip tags.
+	// (GET /trips/{tripId}/tags)
+	GetTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a tag from a trip.
+	// (DELETE /trips/{tripId}/tags/{tagId})
+	DeleteTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request, tripID string, tagID string) *Response
+	// Add a tag to a trip.
+	// (PUT /trips/{tripId}/tags/{tagId})
+	PutTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request, tripID string, tagID string) *Response
+	// Get the tasks of a trip.
+	// (GET /trips/{tripId}/tasks)
+	GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add a task to a trip.
+	// (POST /trips/{tripId}/tasks)
+	PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDTasksParams) *Response
+	// Delete a task of a trip.
+	// (DELETE /trips/{tripId}/tasks/{taskId})
+	DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params DeleteTripsTripIDTasksTaskIDParams) *Response
+	// Update a task of a trip.
+	// (PUT /trips/{tripId}/tasks/{taskId})
+	PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params PutTripsTripIDTasksTaskIDParams) *Response
+	// Get a trip waitlist.
+	// (GET /trips/{tripId}/waitlist)
+	GetTripsTripIDWaitlist(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the weather forecast of a trip.
+	// (GET /trips/{tripId}/weather)
+	GetTripsTripIDWeather(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the webhooks of a trip.
+	// (GET /trips/{tripId}/webhooks)
+	GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDWebhooksParams) *Response
+	// Register a webhook for the events of a trip.
+	// (POST /trips/{tripId}/webhooks)
+	PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDWebhooksParams) *Response
+	// Stream the changes of a trip over a WebSocket.
+	// (GET /trips/{tripId}/ws)
+	GetTripsTripIDWs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a webhook.
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request, webhookID string, params DeleteWebhooksWebhookIDParams) *Response
+	// Get the latest deliveries of a webhook, newest first.
+	// (GET /webhooks/{webhookId}/deliveries)
+	GetWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request, webhookID string, params GetWebhooksWebhookIDDeliveriesParams) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// GetActivitiesActivityIDAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetActivitiesActivityIDAttachments(w, r, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostActivitiesActivityIDAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostActivitiesActivityIDAttachments(w, r, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetActivitiesActivityIDComments operation middleware
+func (siw *ServerInterfaceWrapper) GetActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetActivitiesActivityIDComments(w, r, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostActivitiesActivityIDComments operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesActivityIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostActivitiesActivityIDComments(w, r, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteActivitiesActivityIDCommentsCommentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteActivitiesActivityIDCommentsCommentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteActivitiesActivityIDCommentsCommentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteActivitiesActivityIDCommentsCommentID(w, r, activityID, commentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostActivitiesActivityIDCommentsCommentIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesActivityIDCommentsCommentIDRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostActivitiesActivityIDCommentsCommentIDRestoreParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostActivitiesActivityIDCommentsCommentIDRestore(w, r, activityID, commentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchActivitiesActivityIDRsvp operation middleware
+func (siw *ServerInterfaceWrapper) PatchActivitiesActivityIDRsvp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchActivitiesActivityIDRsvp(w, r, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteActivitiesActivityIDVotes operation middleware
+func (siw *ServerInterfaceWrapper) DeleteActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteActivitiesActivityIDVotesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteActivitiesActivityIDVotes(w, r, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostActivitiesActivityIDVotes operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostActivitiesActivityIDVotesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostActivitiesActivityIDVotes(w, r, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetLLinkID operation middleware
+func (siw *ServerInterfaceWrapper) GetLLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetLLinkID(w, r, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsParams
+
+	// ------------- Optional query parameter "unread" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "unread", r.URL.Query(), &params.Unread); err != nil {
+		err = fmt.Errorf("invalid format for parameter unread: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "unread"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetNotifications(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostNotificationsReadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostNotificationsRead(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostNotificationsNotificationIDRead operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsNotificationIDRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "notificationId" -------------
+	var notificationID string
+
+	if err := runtime.BindStyledParameter("simple", false, "notificationId", chi.URLParam(r, "notificationId"), &notificationID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notificationId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostNotificationsNotificationIDReadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostNotificationsNotificationIDRead(w, r, notificationID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantID(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantID(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDDeclineParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDDecline(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDDigest operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDDigest(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDHistory(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDNotifications(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDNotifications(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDReminders operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDReminders(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDUnconfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDUnconfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDUnconfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDVerifyEmail(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostPollsPollIDVotes operation middleware
+func (siw *ServerInterfaceWrapper) PostPollsPollIDVotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPollsPollIDVotesParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostPollsPollIDVotes(w, r, pollID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSharedSlug operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedSlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	if err := runtime.BindStyledParameter("simple", false, "slug", chi.URLParam(r, "slug"), &slug); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "slug"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSharedSlug(w, r, slug)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTags(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTags operation middleware
+func (siw *ServerInterfaceWrapper) PostTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTags(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTagsTagID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTagsTagID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tagId" -------------
+	var tagID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tagId", chi.URLParam(r, "tagId"), &tagID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tagId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTagsTagID(w, r, tagID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTagsTagID operation middleware
+func (siw *ServerInterfaceWrapper) PutTagsTagID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tagId" -------------
+	var tagID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tagId", chi.URLParam(r, "tagId"), &tagID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tagId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTagsTagID(w, r, tagID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsParams
+
+	// ------------- Optional query parameter "tag" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag); err != nil {
+		err = fmt.Errorf("invalid format for parameter tag: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tag"})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status); err != nil {
+		err = fmt.Errorf("invalid format for parameter status: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "status"})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "q"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsJoin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsJoin(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"If-Match"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"If-Match"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category); err != nil {
+		err = fmt.Errorf("invalid format for parameter category: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "category"})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From); err != nil {
+		err = fmt.Errorf("invalid format for parameter from: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "from"})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To); err != nil {
+		err = fmt.Errorf("invalid format for parameter to: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "to"})
+		return
+	}
+
+	// ------------- Optional query parameter "tz" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tz", r.URL.Query(), &params.Tz); err != nil {
+		err = fmt.Errorf("invalid format for parameter tz: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tz"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		err = fmt.Errorf("invalid format for parameter force: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesImportParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		err = fmt.Errorf("invalid format for parameter force: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesImport(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesReorder operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesReorder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesReorder(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDActivitiesActivityIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDActivitiesActivityIDParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		err = fmt.Errorf("invalid format for parameter force: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDActivitiesActivityIDParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		err = fmt.Errorf("invalid format for parameter force: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesActivityIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesActivityIDRestoreParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesActivityIDRestore(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBookings operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBookings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBookings(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDBookings operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDBookings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDBookingsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDBookings(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDBookingsBookingID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "bookingId" -------------
+	var bookingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "bookingId", chi.URLParam(r, "bookingId"), &bookingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "bookingId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDBookingsBookingIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDBookingsBookingID(w, r, tripID, bookingID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDBookingsBookingID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDBookingsBookingID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "bookingId" -------------
+	var bookingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "bookingId", chi.URLParam(r, "bookingId"), &bookingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "bookingId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDBookingsBookingIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDBookingsBookingID(w, r, tripID, bookingID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDCalendarIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDCalendarIcs(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDConfirmParams
+
+	// ------------- Optional query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = &XOwnerEmail
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDConfirm(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDocuments operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDocuments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDDocumentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDocuments(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDDocuments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDDocuments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDDocumentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDDocuments(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDDocumentsDocumentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "documentId" -------------
+	var documentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "documentId", chi.URLParam(r, "documentId"), &documentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "documentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDDocumentsDocumentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDDocumentsDocumentID(w, r, tripID, documentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDocumentsDocumentID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDocumentsDocumentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "documentId" -------------
+	var documentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "documentId", chi.URLParam(r, "documentId"), &documentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "documentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDDocumentsDocumentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDocumentsDocumentID(w, r, tripID, documentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDEmailsPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDEmailsPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDEmailsPreviewParams
+
+	// ------------- Required query parameter "template" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "template", r.URL.Query(), &params.Template); err != nil {
+		err = fmt.Errorf("invalid format for parameter template: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "template"})
+		return
+	}
+
+	// ------------- Optional query parameter "locale" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "locale", r.URL.Query(), &params.Locale); err != nil {
+		err = fmt.Errorf("invalid format for parameter locale: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "locale"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDEmailsPreview(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "Last-Event-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Last-Event-ID"})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDEvents(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesSettlement operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesSettlement(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpensesSettlementPayments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpensesSettlementPayments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpensesSettlementPayments(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDExpensesSettlementPaymentsPaymentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDExpensesSettlementPaymentsPaymentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "paymentId" -------------
+	var paymentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "paymentId", chi.URLParam(r, "paymentId"), &paymentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "paymentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDExpensesSettlementPaymentsPaymentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDExpensesSettlementPaymentsPaymentID(w, r, tripID, paymentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesTotals operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesTotals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesTotals(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDExpensesExpenseIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDExpensesExpenseID(w, r, tripID, expenseID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDExpensesExpenseID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDExpensesExpenseID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDExpensesExpenseIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDExpensesExpenseID(w, r, tripID, expenseID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDJoinCode operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDJoinCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDJoinCodeParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDJoinCode(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDJournal operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDJournal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDJournalParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDJournal(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDJournal operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDJournal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDJournalParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDJournal(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDJournalExport operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDJournalExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDJournalExportParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDJournalExport(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDJournalEntryID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryID string
+
+	if err := runtime.BindStyledParameter("simple", false, "entryId", chi.URLParam(r, "entryId"), &entryID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "entryId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDJournalEntryIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDJournalEntryID(w, r, tripID, entryID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDJournalEntryID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDJournalEntryID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryID string
+
+	if err := runtime.BindStyledParameter("simple", false, "entryId", chi.URLParam(r, "entryId"), &entryID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "entryId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDJournalEntryIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDJournalEntryID(w, r, tripID, entryID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDLinksReorder operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLinksReorder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDLinksReorderParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDLinksReorder(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinksLinkIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinksLinkIDRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDLinksLinkIDRestoreParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinksLinkIDRestore(w, r, tripID, linkID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLive operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLive(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDMessagesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDMessages(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDMessagesParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDMessages(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDMessagesRead operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDMessagesRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDMessagesReadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDMessagesRead(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDMessagesUnread operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDMessagesUnread(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDMessagesUnreadParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDMessagesUnread(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDOwners operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDOwnersParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDOwners(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDOwners operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDOwnersParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDOwners(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDOwnersOwnerEmail operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDOwnersOwnerEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "ownerEmail" -------------
+	var ownerEmail openapi_types.Email
+
+	if err := runtime.BindStyledParameter("simple", false, "ownerEmail", chi.URLParam(r, "ownerEmail"), &ownerEmail); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ownerEmail"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDOwnersOwnerEmailParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDOwnersOwnerEmail(w, r, tripID, ownerEmail, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPacking operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPacking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPacking(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPacking operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPacking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDPackingParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPacking(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPackingSuggestions operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPackingSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPackingSuggestions(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDPackingItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDPackingItemIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDPackingItemID(w, r, tripID, itemID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDPackingItemID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDPackingItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDPackingItemIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDPackingItemID(w, r, tripID, itemID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDParticipantsParticipantIDResendInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDParticipantsParticipantIDResendInviteParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDParticipantsParticipantIDResendInvite(w, r, tripID, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPhotos operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPhotos(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDPhotosParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPhotos(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPhotos operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPhotos(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDPhotosParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPhotos(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDPhotosPhotoID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDPhotosPhotoID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoID string
+
+	if err := runtime.BindStyledParameter("simple", false, "photoId", chi.URLParam(r, "photoId"), &photoID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "photoId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDPhotosPhotoIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDPhotosPhotoID(w, r, tripID, photoID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPhotosPhotoIDComments operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoID string
+
+	if err := runtime.BindStyledParameter("simple", false, "photoId", chi.URLParam(r, "photoId"), &photoID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "photoId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPhotosPhotoIDComments(w, r, tripID, photoID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPhotosPhotoIDComments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPhotosPhotoIDComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoID string
+
+	if err := runtime.BindStyledParameter("simple", false, "photoId", chi.URLParam(r, "photoId"), &photoID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "photoId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDPhotosPhotoIDCommentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPhotosPhotoIDComments(w, r, tripID, photoID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDPhotosPhotoIDCommentsCommentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDPhotosPhotoIDCommentsCommentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "photoId" -------------
+	var photoID string
+
+	if err := runtime.BindStyledParameter("simple", false, "photoId", chi.URLParam(r, "photoId"), &photoID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "photoId"})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "commentId", chi.URLParam(r, "commentId"), &commentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDPhotosPhotoIDCommentsCommentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDPhotosPhotoIDCommentsCommentID(w, r, tripID, photoID, commentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPolls operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPolls(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPolls operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDPollsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPolls(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPollsPollIDApply operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPollsPollIDApply(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "pollId" -------------
+	var pollID string
+
+	if err := runtime.BindStyledParameter("simple", false, "pollId", chi.URLParam(r, "pollId"), &pollID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "pollId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDPollsPollIDApplyParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPollsPollIDApply(w, r, tripID, pollID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "q"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSearch(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSegments operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSegments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSegments(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDSegments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDSegments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDSegmentsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDSegments(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDSegmentsSegmentID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "segmentId" -------------
+	var segmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "segmentId", chi.URLParam(r, "segmentId"), &segmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "segmentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDSegmentsSegmentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDSegmentsSegmentID(w, r, tripID, segmentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDSegmentsSegmentID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDSegmentsSegmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "segmentId" -------------
+	var segmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "segmentId", chi.URLParam(r, "segmentId"), &segmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "segmentId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDSegmentsSegmentIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDSegmentsSegmentID(w, r, tripID, segmentID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDShare operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDShareParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDShare(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDShare operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDShareParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDShare(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDStats operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDStats(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDStatusParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDStatus(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSuggestions operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDSuggestionsParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind); err != nil {
+		err = fmt.Errorf("invalid format for parameter kind: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "kind"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSuggestions(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// GetTripsTripIDTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDTags(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDTagsTagID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "tagId" -------------
+	var tagID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tagId", chi.URLParam(r, "tagId"), &tagID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tagId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.DeleteTripsTripIDTagsTagID(w, r, tripID, tagID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -519,12 +12973,28 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDTagsTagID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "tagId" -------------
+	var tagID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tagId", chi.URLParam(r, "tagId"), &tagID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tagId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.PutTripsTripIDTagsTagID(w, r, tripID, tagID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -537,8 +13007,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -550,7 +13020,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTasks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -563,8 +13033,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -575,8 +13045,34 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDTasksParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTasks(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -589,8 +13085,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -601,8 +13097,42 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDTasksTaskIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDTasksTaskID(w, r, tripID, taskID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -615,8 +13145,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -627,8 +13157,42 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDTasksTaskIDParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Participant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Participant-ID")]; found {
+		var XParticipantID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Participant-ID"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Participant-ID", runtime.ParamLocationHeader, valueList[0], &XParticipantID); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Participant-ID"})
+			return
+		}
+
+		params.XParticipantID = XParticipantID
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Participant-ID"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDTasksTaskID(w, r, tripID, taskID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +13205,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWaitlist(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -654,7 +13218,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWaitlist(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -667,8 +13231,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWeather operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWeather(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -680,7 +13244,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWeather(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -693,8 +13257,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -705,8 +13269,42 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDWebhooksParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWebhooks(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +13317,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -731,8 +13329,42 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDWebhooksParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDWebhooks(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -745,8 +13377,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWs operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -758,7 +13390,135 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWs(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhooksWebhookIDParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteWebhooksWebhookID(w, r, webhookID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetWebhooksWebhookIDDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksWebhookIDDeliveriesParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Owner-Email" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Owner-Email")]; found {
+		var XOwnerEmail openapi_types.Email
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "X-Owner-Email"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Owner-Email", runtime.ParamLocationHeader, valueList[0], &XOwnerEmail); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "X-Owner-Email"})
+			return
+		}
+
+		params.XOwnerEmail = XOwnerEmail
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{"X-Owner-Email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetWebhooksWebhookIDDeliveries(w, r, webhookID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -886,17 +13646,134 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/activities/{activityId}/attachments", wrapper.GetActivitiesActivityIDAttachments)
+		r.Post("/activities/{activityId}/attachments", wrapper.PostActivitiesActivityIDAttachments)
+		r.Get("/activities/{activityId}/comments", wrapper.GetActivitiesActivityIDComments)
+		r.Post("/activities/{activityId}/comments", wrapper.PostActivitiesActivityIDComments)
+		r.Delete("/activities/{activityId}/comments/{commentId}", wrapper.DeleteActivitiesActivityIDCommentsCommentID)
+		r.Post("/activities/{activityId}/comments/{commentId}/restore", wrapper.PostActivitiesActivityIDCommentsCommentIDRestore)
+		r.Patch("/activities/{activityId}/rsvp", wrapper.PatchActivitiesActivityIDRsvp)
+		r.Delete("/activities/{activityId}/votes", wrapper.DeleteActivitiesActivityIDVotes)
+		r.Post("/activities/{activityId}/votes", wrapper.PostActivitiesActivityIDVotes)
+		r.Get("/l/{linkId}", wrapper.GetLLinkID)
+		r.Get("/notifications", wrapper.GetNotifications)
+		r.Post("/notifications/read", wrapper.PostNotificationsRead)
+		r.Post("/notifications/{notificationId}/read", wrapper.PostNotificationsNotificationIDRead)
+		r.Get("/participants/{participantId}", wrapper.GetParticipantsParticipantID)
+		r.Patch("/participants/{participantId}", wrapper.PatchParticipantsParticipantID)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Patch("/participants/{participantId}/decline", wrapper.PatchParticipantsParticipantIDDecline)
+		r.Patch("/participants/{participantId}/digest", wrapper.PatchParticipantsParticipantIDDigest)
+		r.Get("/participants/{participantId}/history", wrapper.GetParticipantsParticipantIDHistory)
+		r.Get("/participants/{participantId}/notifications", wrapper.GetParticipantsParticipantIDNotifications)
+		r.Patch("/participants/{participantId}/notifications", wrapper.PatchParticipantsParticipantIDNotifications)
+		r.Patch("/participants/{participantId}/reminders", wrapper.PatchParticipantsParticipantIDReminders)
+		r.Patch("/participants/{participantId}/unconfirm", wrapper.PatchParticipantsParticipantIDUnconfirm)
+		r.Post("/participants/{participantId}/verify-email", wrapper.PostParticipantsParticipantIDVerifyEmail)
+		r.Post("/polls/{pollId}/votes", wrapper.PostPollsPollIDVotes)
+		r.Get("/shared/{slug}", wrapper.GetSharedSlug)
+		r.Get("/tags", wrapper.GetTags)
+		r.Post("/tags", wrapper.PostTags)
+		r.Delete("/tags/{tagId}", wrapper.DeleteTagsTagID)
+		r.Put("/tags/{tagId}", wrapper.PutTagsTagID)
+		r.Get("/trips", wrapper.GetTrips)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Post("/trips/join", wrapper.PostTripsJoin)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
+		r.Patch("/trips/{tripId}", wrapper.PatchTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
-		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Post("/trips/{tripId}/activities/import", wrapper.PostTripsTripIDActivitiesImport)
+		r.Patch("/trips/{tripId}/activities/reorder", wrapper.PatchTripsTripIDActivitiesReorder)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/activities/{activityId}", wrapper.GetTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}", wrapper.PatchTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Post("/trips/{tripId}/activities/{activityId}/restore", wrapper.PostTripsTripIDActivitiesActivityIDRestore)
+		r.Get("/trips/{tripId}/bookings", wrapper.GetTripsTripIDBookings)
+		r.Post("/trips/{tripId}/bookings", wrapper.PostTripsTripIDBookings)
+		r.Delete("/trips/{tripId}/bookings/{bookingId}", wrapper.DeleteTripsTripIDBookingsBookingID)
+		r.Put("/trips/{tripId}/bookings/{bookingId}", wrapper.PutTripsTripIDBookingsBookingID)
+		r.Get("/trips/{tripId}/calendar.ics", wrapper.GetTripsTripIDCalendarIcs)
+		r.Post("/trips/{tripId}/confirm", wrapper.PostTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/documents", wrapper.GetTripsTripIDDocuments)
+		r.Post("/trips/{tripId}/documents", wrapper.PostTripsTripIDDocuments)
+		r.Delete("/trips/{tripId}/documents/{documentId}", wrapper.DeleteTripsTripIDDocumentsDocumentID)
+		r.Get("/trips/{tripId}/documents/{documentId}", wrapper.GetTripsTripIDDocumentsDocumentID)
+		r.Get("/trips/{tripId}/emails/preview", wrapper.GetTripsTripIDEmailsPreview)
+		r.Get("/trips/{tripId}/events", wrapper.GetTripsTripIDEvents)
+		r.Get("/trips/{tripId}/expenses", wrapper.GetTripsTripIDExpenses)
+		r.Post("/trips/{tripId}/expenses", wrapper.PostTripsTripIDExpenses)
+		r.Get("/trips/{tripId}/expenses/settlement", wrapper.GetTripsTripIDExpensesSettlement)
+		r.Post("/trips/{tripId}/expenses/settlement/payments", wrapper.PostTripsTripIDExpensesSettlementPayments)
+		r.Delete("/trips/{tripId}/expenses/settlement/payments/{paymentId}", wrapper.DeleteTripsTripIDExpensesSettlementPaymentsPaymentID)
+		r.Get("/trips/{tripId}/expenses/totals", wrapper.GetTripsTripIDExpensesTotals)
+		r.Delete("/trips/{tripId}/expenses/{expenseId}", wrapper.DeleteTripsTripIDExpensesExpenseID)
+		r.Put("/trips/{tripId}/expenses/{expenseId}", wrapper.PutTripsTripIDExpensesExpenseID)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
+		r.Post("/trips/{tripId}/join-code", wrapper.PostTripsTripIDJoinCode)
+		r.Get("/trips/{tripId}/journal", wrapper.GetTripsTripIDJournal)
+		r.Post("/trips/{tripId}/journal", wrapper.PostTripsTripIDJournal)
+		r.Get("/trips/{tripId}/journal/export", wrapper.GetTripsTripIDJournalExport)
+		r.Delete("/trips/{tripId}/journal/{entryId}", wrapper.DeleteTripsTripIDJournalEntryID)
+		r.Put("/trips/{tripId}/journal/{entryId}", wrapper.PutTripsTripIDJournalEntryID)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Patch("/trips/{tripId}/links/reorder", wrapper.PatchTripsTripIDLinksReorder)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Patch("/trips/{tripId}/links/{linkId}", wrapper.PatchTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
+		r.Post("/trips/{tripId}/links/{linkId}/restore", wrapper.PostTripsTripIDLinksLinkIDRestore)
+		r.Get("/trips/{tripId}/live", wrapper.GetTripsTripIDLive)
+		r.Get("/trips/{tripId}/messages", wrapper.GetTripsTripIDMessages)
+		r.Post("/trips/{tripId}/messages", wrapper.PostTripsTripIDMessages)
+		r.Post("/trips/{tripId}/messages/read", wrapper.PostTripsTripIDMessagesRead)
+		r.Get("/trips/{tripId}/messages/unread", wrapper.GetTripsTripIDMessagesUnread)
+		r.Get("/trips/{tripId}/owners", wrapper.GetTripsTripIDOwners)
+		r.Post("/trips/{tripId}/owners", wrapper.PostTripsTripIDOwners)
+		r.Delete("/trips/{tripId}/owners/{ownerEmail}", wrapper.DeleteTripsTripIDOwnersOwnerEmail)
+		r.Get("/trips/{tripId}/packing", wrapper.GetTripsTripIDPacking)
+		r.Post("/trips/{tripId}/packing", wrapper.PostTripsTripIDPacking)
+		r.Get("/trips/{tripId}/packing/suggestions", wrapper.GetTripsTripIDPackingSuggestions)
+		r.Delete("/trips/{tripId}/packing/{itemId}", wrapper.DeleteTripsTripIDPackingItemID)
+		r.Put("/trips/{tripId}/packing/{itemId}", wrapper.PutTripsTripIDPackingItemID)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Post("/trips/{tripId}/participants/{participantId}/resend-invite", wrapper.PostTripsTripIDParticipantsParticipantIDResendInvite)
+		r.Get("/trips/{tripId}/photos", wrapper.GetTripsTripIDPhotos)
+		r.Post("/trips/{tripId}/photos", wrapper.PostTripsTripIDPhotos)
+		r.Delete("/trips/{tripId}/photos/{photoId}", wrapper.DeleteTripsTripIDPhotosPhotoID)
+		r.Get("/trips/{tripId}/photos/{photoId}/comments", wrapper.GetTripsTripIDPhotosPhotoIDComments)
+		r.Post("/trips/{tripId}/photos/{photoId}/comments", wrapper.PostTripsTripIDPhotosPhotoIDComments)
+		r.Delete("/trips/{tripId}/photos/{photoId}/comments/{commentId}", wrapper.DeleteTripsTripIDPhotosPhotoIDCommentsCommentID)
+		r.Get("/trips/{tripId}/polls", wrapper.GetTripsTripIDPolls)
+		r.Post("/trips/{tripId}/polls", wrapper.PostTripsTripIDPolls)
+		r.Post("/trips/{tripId}/polls/{pollId}/apply", wrapper.PostTripsTripIDPollsPollIDApply)
+		r.Get("/trips/{tripId}/search", wrapper.GetTripsTripIDSearch)
+		r.Get("/trips/{tripId}/segments", wrapper.GetTripsTripIDSegments)
+		r.Post("/trips/{tripId}/segments", wrapper.PostTripsTripIDSegments)
+		r.Delete("/trips/{tripId}/segments/{segmentId}", wrapper.DeleteTripsTripIDSegmentsSegmentID)
+		r.Put("/trips/{tripId}/segments/{segmentId}", wrapper.PutTripsTripIDSegmentsSegmentID)
+		r.Delete("/trips/{tripId}/share", wrapper.DeleteTripsTripIDShare)
+		r.Post("/trips/{tripId}/share", wrapper.PostTripsTripIDShare)
+		r.Get("/trips/{tripId}/stats", wrapper.GetTripsTripIDStats)
+		r.Patch("/trips/{tripId}/status", wrapper.PatchTripsTripIDStatus)
+		r.Get("/trips/{tripId}/suggestions", wrapper.GetTripsTripIDSuggestions)
+		r.Get("/trips/{tripId}/summary", wrapper.GetTripsTripIDSummary)
+		r.Get("/trips/{tripId}/tags", wrapper.GetTripsTripIDTags)
+		r.Delete("/trips/{tripId}/tags/{tagId}", wrapper.DeleteTripsTripIDTagsTagID)
+		r.Put("/trips/{tripId}/tags/{tagId}", wrapper.PutTripsTripIDTagsTagID)
+		r.Get("/trips/{tripId}/tasks", wrapper.GetTripsTripIDTasks)
+		r.Post("/trips/{tripId}/tasks", wrapper.PostTripsTripIDTasks)
+		r.Delete("/trips/{tripId}/tasks/{taskId}", wrapper.DeleteTripsTripIDTasksTaskID)
+		r.Put("/trips/{tripId}/tasks/{taskId}", wrapper.PutTripsTripIDTasksTaskID)
+		r.Get("/trips/{tripId}/waitlist", wrapper.GetTripsTripIDWaitlist)
+		r.Get("/trips/{tripId}/weather", wrapper.GetTripsTripIDWeather)
+		r.Get("/trips/{tripId}/webhooks", wrapper.GetTripsTripIDWebhooks)
+		r.Post("/trips/{tripId}/webhooks", wrapper.PostTripsTripIDWebhooks)
+		r.Get("/trips/{tripId}/ws", wrapper.GetTripsTripIDWs)
+		r.Delete("/webhooks/{webhookId}", wrapper.DeleteWebhooksWebhookID)
+		r.Get("/webhooks/{webhookId}/deliveries", wrapper.GetWebhooksWebhookIDDeliveries)
 	})
 	return r
 }
@@ -922,30 +13799,338 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RazW7bOBB+FYK7RzlOd3MSsIe2KQoDBRosuqeiCGhpbDORSJUcOTUMP80e9gn2Cfpi",
-	"C5KyTcmyTSvRpk4vra1QnJ9v5psZ0kuayLyQAgRqGi+pTmaQM/vxrQKG8DpBPue4+BO+lqDR/IGlKUcu",
-	"BctulCxAIQdN4wnLNES08B4tqUySUulbZt+bSJWbTzRlCAPkOdCI4qIAGlONiospjei3wVQO4BsqNkA2",
-	"tZvMWcbNKzSmCr6WXEFKV6uIIscMzILOe6yi7bf4s6ftevMvGwXl+A4SpKtoxy+6kELDiY5h1eujtOaZ",
-	"suTpjlOaanrv7tfvAxf33TB7vFsjWqqsbpfinbGOzGY7WDktnaRjXuiEUMbFfRd0qvf26/RJ8aIbMilo",
-	"5IKZ1eZrzsUHEFOc0fiqs3NzLv64skZAznimb1HecjHnaP3FEXJd84FdteuEzQOmFFuEi0/5HCK3p9VB",
-	"pH2xhXwQoG6dqOMGBRuw1d0JECx/bPJoZAr7cUMjVv2A8uVugWgJi5qldb8eC/pOiYiKF10SsXqvTad3",
-	"Skl1VI0UdKJ44dKNvmEpUVXaNlXMQWs2bcG9qdN6YZtS7wENXelH8JWu5eyvCiY0pr8MtyV+WNX3YVPY",
-	"a5u2zTRu4zYdpLzb7zQLeAjIe8t+YNVpmuRkHCkm7wFNAFc1n4N+XNVf2xsIVLvojyWCCoPNE3uSdSMh",
-	"1iJ6QfLU7vAA+IdQ3Yo5yXrPwc+HsgfBDsoRdQQf5rsm9TNL5WGhcQ1oisAjCDzQAQ1B5tHH8V0rtZ+g",
-	"73qb3rqtkzuXVRSaI1zfJlJMuMoh9eJ+LGUGTNAO7UJrroR0AjVVDnj/hinkCS+YwK4hU3hbnJpEbeLD",
-	"eLIm9UQDuxBFaDO6iZYO0bHuR0WZZWxsuBNVCUExUTV4a52Owj+y/aHnnG5TTm8tesPG/S3rX0X6I89p",
-	"/c1IP9LksQuM2YOLiaxc7PXm73QBCZ/whH3/5/u/oEnKyOubESmYYkSSMUvuByBS85gVmVv2tyRFxoS4",
-	"AHWxaRZiun5GIzoHpd3+ry4uLy5tx1KAYAWnMf3dPopowXBmzR767DFcet9G6WpYZY7jNkxm9nCsAGU9",
-	"YKYbemMe+8zifR5dv63eNwIVywFBaRp/XlJu9DNKrBM2pjXR1Pe7S33HlyED1RfzsuM3a+Nvl1fmv0QK",
-	"BOGyorD+NFYM77SL9+3+IMrcoG3IxwBaJyELaB3Ia5iwMkOyKRuriF5dXp4k9FCJcINfi2B/urOZUOY5",
-	"Uwsa08rzmjDiOZZIQRgxfYgNHhv6zQJi9hmaJa6kSccjDdSltiVFVziBxjcyXTyZwbtHTo1UtEDswPyq",
-	"FwXWmJ4H7lZxwoiABwu0h7MD1QN4uHSnDSujyBRagK5aB23+GV0H5XF1gPG0Cfx0Pt0zG5wHuu8Bq/wl",
-	"qTPgogXfiBZlW9KWz4bl0zPEbrMTxBA/XyFwjmph/f1sMKwfBVTEUBf4acY1UbJEIA88y4gCLJUgLMsI",
-	"zoAYmZqMAR8AhH1ig3bTMREmUlL1TG5xRGBul0pttsSZLJFsFTGaH6Km7RnECyKplpO7s+OpOoTr4PMP",
-	"cAxfHe4ynhXivrqb5hX1s3Q4O/fBZ9bl+CG22BtgLRTnTTYBjc8pc0wv1PLTDjAbjEVKtBmGYZAznhF7",
-	"q2dV0YFFzd0Dhgw1DvNRtf68uWbv6VYPdPMSws75i2iZgxRAUG6al5CJeRttm3vNAHaxV5AvpG2p3wWf",
-	"XbdiYfORru6OQ3uU/x/KvtoT/5dYz9Ka1H4EdY5tiQmdtlBqYYvmxVEAafhnri9o5Gm9hTs7GvHxPFQ3",
-	"Vqv/AgAA///zZsJryioAAA==",
+	"H4sIAAAAAAAC/+y925LbOLIo+isInfUwK4J1sdueNeMd/VBte3rcy92ucNndO/ZM7zJEQhKmKIANgFXW",
+	"+PhrzsP5gvMF+8dOZAIgwatIqu7Wi12SSCABZCbynl9msVxnUjBh9OzFl5mOV2xN8c+T2PBLbjYvqWFL",
+	"qTbwHRP5evbiH7OFlMksmhlFhc6kMrNopvlyZTRjXCxn0SyVydL+Jc2Kqdnv0cxsMjZ7MdNGwQ9fo3IC",
+	"KRYpj817pjMpNIOJaJJww6Wg6amSGVOGMz17saCpZtEsC776MqNumHOe4Gdu2Br/WEi1pmb2YpbnPJm1",
+	"AOC+oErRDXxeM63pEuevPfs1min2R84VS2D5/sGoOnm5SDn/F4tNuMj3LM6VYiLevryE6VjxDH6fvZi9",
+	"ZxmjRhOzYsTPRtglUxvyC0noRpNcGJ7i70t+yQRJqGFEKvyGiYTIBf5pFM8OZ/Xdw5HOYRz4tOaCr+GI",
+	"nxRL4cKwJVOzaPb5YCkP2Gej6IGhS3z+kqYcppu9KPYnWnPx/RPcMgQMHquu6C3VhqzlmglDqCAy9jtD",
+	"YiqINlSZQ/KKLWiewrpl10KK8wUIDgxfs1m05eCC1bYeVpJ8UDx7dyWYes/+yJk2I5GRraldcgGc/aYO",
+	"2ODdtK/DOlIZ0xSx5z8UW8xezP6vo5J2jxzhHr21T32NZoKuW1B56MSzr429cwvBcVt3L8vSzalM04mE",
+	"LBFBznnSRJkPK0auuBBcLIl9LCJCXhGaZSlniUeSBma0U35tYeW8bav6QcoLLpavL5kwIQuMVyy+OOdi",
+	"Frk/ZW5a2Zwb4AN+X77vOWTbK8ARuVqfUmV4zDMqzDRsXMI7urmdr+H0if2VxHIN20pTKZbkipsVbmVW",
+	"zg07WjCG49GMQa6BI2dmg5zh2CJWY5tfKkYN89zyxBgar4BDTL0UigHeJAPughpGVN7+fSu0L+V6zaae",
+	"0VwmeLWu6ee3TCzNavbi6fHxMW65/+LJZPaxpp+/h+FwicGZnvMB2zJ4Fny7wTBq00V2qSO2c9LJx3I9",
+	"9djLV7cDOe2waZIopnXtvJ8fH4/d+oCo6Ofvn7sDjgNRre+SaIh2X6MZE4k+p6bJLH5bMVGTPkSiD8m7",
+	"NTdkIZX/njMQUqghK5plTBCKtzsX2jgeMuC+Hr7spVlwlibfvwPpQZ8Ye0VSw02esMrRJzKfpzDVmn62",
+	"POyvxwFDO/hrufkiX89HiDrnwC2/fyvFEmeNSugKQOzF7R7YAtaTv1TgevKXXQGjpgFXAQoAhpKXP/Rr",
+	"OJ1AdohmqiLwDsHGQESGG4Kb9Frll3K1fvAhVL6TSjKICUXB4213NYr6Be3FCF8SkStPlspyIrKiCaGk",
+	"3HYguam6UP0+LNfTvWdOzrmfjNEJa60M7udcG7KgaYrSDxeFKImalL4m1lUhjkJi7AZozghdGGbVOHz+",
+	"gAtCRUKEJCm1v1Cxg3I0+Hr3vPYlQPFGOGYbWyGVovAcy4Q1F/IB0VMzdYlPEcvGnJo631hBM6Vxoa7O",
+	"LQ4RzQ3ib4ALT3bFhSceFyx9bJrgvjl7R549ffJfBFbjN9Q/7j9niscsIjqPV4Rq8sP7t6hUU2OYgkH+",
+	"9z9ODv7X71+++/ofkzfcsu9TnKhcA9cSgMM1eN2uCv8vdF2AjdtagglfraRhaW1Xnz5/fp2S5vPnVtAE",
+	"0Fv212LrmgupSC64qe9xCW/MhNGH5EfElJpq4p+uoDkX5s/PQkVlugXDbv9LD1NVf7GWDbPJtl5rod4H",
+	"xhDVYgo5pcvixAJCqeqwitfO7PjZX6aTQq5SpxU8+0vzkkTEqvLLGrcacAFMujMd6U+R28tXu4F7JeN8",
+	"B60ica9PAS94txu+158zJjSbeHuWVsh2JuwfsLeFnYpwDeJ7RPiCULHZbjcZgWNWH4xmdC1zYa6BE9wQ",
+	"qQckPVR3cgcVqk4Tb5SbvUQq90UFqi+NC+A6WD7dMNWJf4EpgFytJMkoT3ZHuLr9IZrpjAnTr8WupWAb",
+	"ckU1wYerlmYhryZalov1Vze7IIEASwYwgUk8ytH1FBZVvtoN3E8yV4Kmr4VRm91MXTVpl6qLRF4JYtjn",
+	"gg8wmOWQvKdX5O8ffn4LvApAzzKWkDlbSMWINlLRZV1MBGPXdRvPrNxof61D/4puQtG7BB5ApnOZm4h4",
+	"lYKvGfm3FCx8ISLscHlInh4/fXZw/F8HT5808K9VW/PKcXXluwnIT91CL7nmc55ys5UXOpT4tXyhcffZ",
+	"BWyx/FVRaxryw7uTUN+92A3dWy4upiH80DsFZggvlIwLwVpY6Sl+T1IuLjShipGUa8MSsuBKm4jI3Ghe",
+	"3DNcET//YbkLcylTRsX1WFg6pNpCfxVkZUwGyh38r8nH928PyQdFY1TyMqromhmmdHER5mZ9rmWuYobL",
+	"U2wtL1nSIg5fl1HI7oFdxzYMmISXcFZT0NK91w3Tz9YHfc+dDg1JPdkMWdOkrXZe+Sm7Xb7aDdypRds3",
+	"hq0nSula86VgbJCUNAc4gUrgogAufaNyurcn3Lhl4I+cCuNultpFGohhTw53E++bKnubmjvwqCfhIsw/",
+	"BRHdez2graSRD8PhOI72qwu7j64/hHAiaBm8OwUw/2IPWBhxMQUTLrhItokmMPp/w3PgL0Ja1e3MK6Yi",
+	"QWzQhcArVWLt1xu8zfVKXolD8gqeIZlMU000MzbgB/wyaNx2bsiIJEwbLqzd2D4MQwbfVvwbfUtobNM7",
+	"hPukCPuin9/YcZ5YKnCfnta8IttoAHjNU2dqjhJ+yRzHY9pu0w3o3TVkwQMNpiyPbBD6hPsy0jxWHsvu",
+	"66xpJe7mCPzTQ9TzaFbg1dBXvm7Zo2mEL9N0Et3b97rP7YwZkzJgWqd0M/02eEgWuntvZlsouT5vRtnc",
+	"nT3MyEnggHXsBkCKBLOOxL8pGQa3vXnVZGVtW9m2nnEGthaimUbV9u1JhF282g3mBzrRjd4ixz8/3ume",
+	"eX48WnpG6Cdtq6GT/D/2tT6A9MUtqGyJ9Pqaofqi0NfIBy8AsQMIoWUJkRDszA2YCOUlU0nObkSrS3LW",
+	"bwsHOAEII8Fck4Bpcr5BwNklU0Mt4R3myBvQH1uNN9vOfSIm6otpqKj7zTYffMrEGVvucGsrxS9pOjB6",
+	"JGGAprliNxUY8spP4EJDPHgYhHArdoUYpmSqw/vJVcpFEWQCKgIVG6JyG1Bu0BRPufAPzHN9K2ESxbnc",
+	"l7CkEqDi5KownQXhJJQrwGO/adoUWtmNb9zaRRv1aX0Fof0MD4ONy8ZQ9q5pkUIWk4tTisjbk++Onx03",
+	"PEy7ullagrH11utFV91M9MKjrraMZIdwv9FxVKjddkR949mUBNlEqhre17lFFDK3MXx0Ep93mzeF1Zev",
+	"9kHJs58kFy9lwiYbtZIBGWn4VD8c026aWuRAh+tWSMOcu7OMQpzgvX1ivbc7hvl5h23NKFFyoWfTuRAX",
+	"3z/D0TEdSp8bec7FJbeu4Sb9tWd/jSXAYnqkuzIlbJxNZPS1foYWFHenr+nn8658or/LK7KGK5WFiUWM",
+	"xquKgLymG+vXqAZdHF97gpGFNphatxk4Lrm9sjSZs40UCTErrn2MqlxUme9S+iSzK8pNyrU5JB9FymHu",
+	"xAZj07lmtWyp63BdRDMJ+YjnN5haaCcYm2Bo39o5zRA4DjsH9nCu2JqLhKkiH7UDzeBnz0iKK9Ha+4gL",
+	"S2ZJ9fwq+he8k9DNASg8dMlEQtH2XAyFDvZDckwSruk8ZVY48NBVsffpYZjD8d3xdaIyMrTvLEYrfZmd",
+	"J4wmIMq2RZIGi13RS1akBXNtI0+MJFToK6sTcEV4QQCHBGVNIQO9obCeDtcCxxpcByPqIje5stZ0GAhC",
+	"aFro+eSXE+J/rkbYePvfyZopHtOjMyrPT2meyojk2maPLpXMszDLiTMNEeoJ3VSP++OHl4c76OYF/A25",
+	"Kbytwr0suXzLnVMhwiqj2CYMTFOLFc8mqcX2vX6YzlZUTZWSdJovt0tJ+FQ3EL+x+UrKiaYiFwlzLXEq",
+	"EYTLnMOIDTTpD1QpVjBN1MQxklFeFj4sxUmzWLEWJfeML4V2CcebVNIEPIMY0+RvW7uiQ/LGmstEuiGK",
+	"mVwJlpAVszaNxnR4mwwEbcjB1U/BjuQmsUNE4fYVC247qlcsBh5eCiDTEE4xqqW4mYypNleYj6D/b+c1",
+	"9onshscXzKBfRftiIJdc01k040LnioqY9dYB8QOfxbKaIg8bPKtoyq3vvwZ+90brnLWZOjfu2tf2AiQu",
+	"0wwFArjxEpbyS6ZY8gIssnOZi5glEVg1OOjcwFGJYrAuJzj44TCCmK4PZ1EBsH0bUEGus5TyPoBPFbvk",
+	"7OoDgydNR/KUZfhwm1EfXkoNlsmYM5LZEVgSglDe6v6+OL9kii947L9EQcLLMrMW6WtW5Hbh9+1LUEqq",
+	"kZVMfqCJT1acdWm5vZH3MOdLZ80ZXauljRLLEZu7zyDkgALu2VOHRwsbTEINJbGrW8N9chL7zDV+wp+l",
+	"InPFrJ2GEpWnLHx7TjULzy00B9FUMZps3CWfzCKMBiy+pkmCXxq6xIv/3NALJtypAUCeNwlpzhcyx5iA",
+	"IkUk/DKctPK9TNNzVxYj/F6xBcPc0sq3XCA3Ob+kac7asaWWM9FfSaisHWRZi55FM72SWbatoNCPzDQL",
+	"SOidK0hUqwr1YWg/AEXASX+ubTBvG84OmWOshUkYJsy5T2pr7OsUuWDBU9ahG46QGvi/q0nzPrCgplYN",
+	"vcXxsXP2OeOKjYwMadz+5QKj6g46sL1UUJuxsptbztdFw+ndwuEmoW996mG4W8w4cmFTsJbmZiWHW0W+",
+	"RkXg47Xg90AMHltypRXVmiEP4drdwsYg1o5lDQbgEehzJ4Um7ed7IwRTBSrdGYf1y4iGMFuX1ap3S2vt",
+	"8O/4X8HU4HP8IytvUJVypo3N7Rgc69gC8LBNKeAcuA2TSLYs8tCkwWqFhmFEWC+jMPCttsIF18IUwgi1",
+	"qRyj884skuoH3IbT89OH6L32ltueKj6EJTnR8IM0NJ1KYwZfbqcw+xtI3uiO8Cdkkz8JUJuNH9MQtJzw",
+	"BQq4xj/HmXZmUZDiYykumTIsGUOOrQscRpNuXWN2bgpZzjfnYaLa9j0Mssp22gWvD3TsRgSQYV7tILC6",
+	"Yip3AvEUpu+Er5feEbxB9FqXovyofoyockTBtozBjOpm30weY0tu/PRdKNdrxxiz2ODYxgZ2jpTXdlhh",
+	"S3Tr9nXq3ZLDO7ik/7UtdeOKKeZy5cdT00iOV0A5cBMmSSHVYhlbz/dGI+SbV/c11aFoLKMWyDFdoSnr",
+	"PGx9OCzHMFXPHlFYIQqZRjF3BypBTq/eIam3hZJwSGui9dZlm8kdWf8iRL9sJl2fFWDf5abnThqSOx5Z",
+	"YUdnKd1YUp8MzDC6dkBFbueGHMlN3lP1fPs45fFFX8ABoKt1U8ECMD9CZkygR0DJfLkiR+nRF5uz/fWw",
+	"la6H0ldxfM2EfWfwH7I6513oSfPfyfMVZM1XiK44Z7ejQw46QOfbOe2Cem8Q4YM96UV5l/Gud0t577jV",
+	"/a8REexqki2hDl4n1xHsszmPc6Vli7D+Er8vKtO5mmcyBRHDw3hITjB8ikh7raZUG3z0cGjy/uA9vh1r",
+	"o3ujU53fWyN/kabwS54WriW9axuIwo3ZykMTytPNecKXzuHefMLGgJ/nmS/408qIay7T1seqntbWRyBf",
+	"pPeRDttl+c4wh25l2fVp62secF5TT0mEY7Rzrcoj01lXK7TDmHcVyDG7Mekam0DvzHcj6NuEEELbvmA4",
+	"p1CMjoNI57g52ysQFgWMa10GgsBNl1GCAQ8WJQdkoo6K+ukP57G7W1nTEH7mqoa85drsUDVklGTSMuUw",
+	"FLcTDF/IpDuzmjy59fjQdtwl+97grdh5RYfFarbYcnBkZxEvXiuXNAJ9zvLlkukKV7klLGqZ+fqQqXPw",
+	"aQnOu5xV/Zg6AS9409+5NnJyabqVfXvciXTNPexE/JSjl3ZbF1gZvNgWRm7yrZsULOHMvlDfAzfOMNIL",
+	"Gu1MLAhQjDDQOx7M2UJuimcDx3nFDOWl5Rv7V83/1Wdsdndd52YEhZiuI/SkpVSQ+zVCHXCKcNcK4/XE",
+	"qPQMvVcZ71plhEI8eodKPF1ptvBTp+/DgTUKP0M4B5pIEbwh656EjLZRWn85iGqHNbRytjRYg9YjHNzA",
+	"qdTOKAqwD88TukFs3aGi2aSjrVUSq1vFwtpfDVhht5k639L1zp+Fb36wltqQS4ll1uAzsk4ihSuvBuYz",
+	"SgxnhT3tasVTUKqBxvDFZHyHPHyku7jYaMq9tkJjjU0dXSVsqCdrbDGxaIaHNMUvjBDYtzs2syxjtFv9",
+	"oi526H4lLuKcrGnCOtkj/DiGNzaBd7WYOslIF290AFw+0B3osxuIw3h4CGhUbvLgU5wUvUNTKuI2F8Bv",
+	"4IhshMZklCckZVq7NFC9oqrITCjjAEyIB3DEhIs4zROWHJKTaqwNsCZKBFtSwy8ZcQARecW0rba/29b/",
+	"YMebGISjqNALpjoQZ2GNi8VC8QB9YQ2/s7uB/8FBMFA6LX3ZxcGGqxiMSpVdm4RRtxbxsKuI2ewz6RYw",
+	"eLMqDOge1U9s7lUvsneUH9x6uQ1WBfg4oa21+uAEyWOXWoAl2IOxoUqx9xodpp74NZzM6EPp2n+4f5Id",
+	"ctLLhP0xYnx7gkJ/aM2NBA7chLHHJc4GO7MlDOEDnZzg4DOKB288HZuagDMMAHwKve7mIuh0AnRCqy/0",
+	"DhUJu2Lc4SeSsoUBPT2Rvh8I8BctpWDakCRn481sFXiHHpbuQzN9oW/VpTTB1JC48iItsQM5GzXSQCBd",
+	"CdBWw4xZuRqOvlQnpqxj3RqRkIxqg2nqcLoAyqhGL72hXbgLJWxDVPx6STa9W022Tl3P/orVWXwhuV1z",
+	"pjpBH6r3LXs1vS3D71L7cxgmNgpyNimlLKB5PVRUq2059q1uSAdS1Y41IrcYwnV7ubdJ3ZXxlWstm9iE",
+	"dhj9tolE91Qaa4+L4kyPW51LgrvGRMZeC3vRx1mGfZ2haiLXIwzogxIYB8UIufWPDQ/qdFGNTjzcnmDo",
+	"o3HGIm6QbHxDvcjxtA0TCWP6PG5X/IoQ8ko1Og1GOGtC5WlK7CiHO+WDFLn1QaRzkiuLJGsuctNmI7Sr",
+	"88qoD9KKbJ2lTDHnQmDCN2bBWp7MtMN6U9b3lBpu8qSa/ZrIfJ6ysPjeX8Piewd/Lc/LsXUYSYrlkKGe",
+	"/KUy1pO/tA0mY4h4HrVgZNbn7e27X1IhBY9pSkS9kTf+5SsXgV9uySQQPjjmWgtgZVLz9pqtr8K0D0h+",
+	"FUt/7pxB6cAsY+gFpLbwjQZYYDntR96dXYAejHFkkWfwUlLBxcOBDhMvuJaHUslMqBNqsEVVUEfxmMm5",
+	"C9d5TVarKjRyaHsLEPgLSaM+UVxHQaGjze6ydd/F2wLx1kar5eZFZQUFgL/QDZyrylWbYomtgAmFF6f1",
+	"W+1XSWz0tI5sFXkLyjzXTDsFBX3q4mb302kWWwUlt8KKaahAkWiAJtM3+X1XZPZKyb1SSnpwrGbonFCm",
+	"9AYsqsPh9cPsVGu9BREHFzS/QWGM63OngHTFaofiWkMRciWbg7XUBZrIVdK2cic3+DvYvbDgXJVf37kM",
+	"WC2L3pSP2gqRN5/aRSzs3Um87N5yPZc0IqdSmXxJ03aJsbMIdxPcRi3qG+rINzQYGKsH2ydrNaKbUilT",
+	"ulUufiNihW5A7M+EDZCgJhMVSxZGvx2SMyYSwEonY7xZHPxMTbwiK0YxMEa6nJXylYES7JAK0BXiq2fE",
+	"FxHPAVJ2HmywT+Wu9HE4V6V1Kk9O/PtjBd7GxMMsQuV8Yxb1uOonDgmHrJT1bY3G6O96hizIjQHGgQwu",
+	"fMNCPr6QqvJYoV1crWRa0sjW5WhfIHjIemw14UlFJJslqllCoM9JKmmCTexvtrSki7S0y73RSpO2P02u",
+	"BJ3a35QJo3hnWRn7Y+Qq6fsmCwI+XzmbqIsivFLcGCbGKkI14IexBg/z8E15hPH/XsveqvtOThQY0GdU",
+	"SXdPwpFshiV5dpezyJLRu3DJNZ/zdEBRS4cRv5YvTEp1cLvsMh6C6Wv144PF9KDpOyhPPvVGxtrmo6/j",
+	"6pTDCM7NNHghU8htBJ2NbXQzLPrDT+c4tZukZ81tGWDT085GH2R/AlrfcVZmHbnASZz0khqqzgeWeE5s",
+	"e4XznhRD98g4VjECwfCHc+5bEvSW8SqbF3ytFuxnA5homTNvg7kLF4GNyQ4r/2Nv7Pb4kK62Xq/Dbl71",
+	"dH2ubS8vaOfVU/BogvmA63N/QO0PTKVfkacptHOavTAqZ21uTXmuAkLs3/uEJ2iVcE2VgnZU789+PSVe",
+	"PW7f8mzVrqD2pJV7bKupgOFuVVdQHGyxYw0E6yNeyIOcXOZjajmgDGcdUwwI99LIrlQW/G16FZHmTgxk",
+	"kRamwft7PwTMmHYbH7tTegtfnn/Eniusj6yo7nBr377SvGLgpmmBH78v8BHh5oJk/DODrNALYfPqsGU3",
+	"rC1fzwXlKeE2MWa3cm8TpOY8Az2UJSW4w7Tn4XpwscbzgfY3/3xghCuGGKpU+wMo3owIJT+dvv4RfqDG",
+	"Zj5+9/TYHQyhRPOkzHP07e5Y9YQwge2wp+/TYLiKnb4mzR8yQBOzasLwG3x9reg4RVGZbHVoIE/AO4ba",
+	"JACPdmjcYj39LTdPe2/ILM19Npy9MTtZVkNsCX5ukVmCXwfJWHOsJWmwSmgrqB28xvUJ6m8K6Z6qGLNb",
+	"h+uVgipDYqnT8XJQJ14W/Y6qNu5t0k154n0otXNdHV2O0MGowSejCVXgp6r7ZqIyfVuxlF1SMVEcmVym",
+	"J4R/3EbtUmZ526ps48KyM4ntDwbCSk+QZdtV+SZhwoBYq6o+MmrKD2CelpfcFbydZC8vt8dbzO9pdFqn",
+	"HKSocSGv1Q08uWQKhHH7e2UTIwLpXuQJMI/nQWkB659doX/WkngT6hpgPRqOs3sX+xluSC/CrtdUbb7V",
+	"9LFbsgHdYJ5aZQWj0tYUz35z7bgnHr/v5j125+rTDmPBxWwjFnRrNSWH6pEdds9hkt1vjJoVU1OdyHTT",
+	"cffCL2HXZzSBORf9Sir+byn8zyCfxNQnyNkCKvjvIXkNfVBdyZRiJK6JkZIsqCIUnPxjL+zakjvpq7+2",
+	"SW+7aNyX4bs+0cudFCHFfat2c70snh8S0unPxEdV4MbvGLQJURCGrTOmqMlVez2DhC0VY5q8ZKnmuT5s",
+	"3ld40V7LOJliMc9cd9bzTMk5LV1PNUVlZUtoLIiiNltEC3mFJVgypmLXi6OUB4772853BIGWR9pcZHP7",
+	"+hbQg3q7xPCNjxXpuHe2Ja3iXB2L+CgUo8mOhdpzHKR50H5YRHrsbFpVTa2HVHPhgr2CH61VFIbFX+aS",
+	"qmRQ2YDa4h1oHat3HcVf2S7J0/PCkmKADv5d/D7dWNsJ68CIoRLEsZsxSUEyQF1dEYHTci5dK+vrryXt",
+	"Vl7kieFL11xfBPD5nPnG0o2f0afgNq2/jp3bhw3c3O4FlhC6pFxE7nLnNlaJicT5r4aWLbXnHdhFm6hs",
+	"fwu71zvbiDPZIt06uFBMiQhfAET+qXazzDBTbBVFN4FBdqfi2MV5l/l4fsAg7rBA6eZpDZEPHexTWYzb",
+	"7FGXRn3KgWK8n2ngQm5LhB9IaMNRYZceMQV6uC4x/cf/Zp1JZUqVGluzT0QE5CHD0aB36k5pfXQD+sjD",
+	"NXr5U9CnG7xopuRVk3M9OZhTzRLCRcI+e7FcgdAJxl3MjfOF0V6e/eqinQcYdWGyqLcLf33t3gY46fw2",
+	"7+VV23E1J9mpy8iba022CUfdukO4whvKaI5mnw+W8oCBU+LAV/bB7v6owM3kmiNH30Rr+vn758fHuJRd",
+	"UpSDLJWO29xvDmYgH5J3a24jioOkVfRD2MxVMPdSQbjQhlotaQDrHL7spVlwlibfv8Ms0xOD678mA/A2",
+	"KDzGnIPM8P1bbxqNSugKQL5eqzF5JGDUNOAqQPk6IW166PSzr93hoSPGqMcuBvnEdvBWCkXXVdWcWnCx",
+	"sVGMVdbSZtd4Y398bk/OfXpS4zJD1xytufj+iaNohzrjAqwwnmhzXgJfc6ozkbRFo3lXpPdMOjbFtDfH",
+	"ffA/2ne4tnUJXGhQi0rs/IZW+O4KeGuzX+rBpzrp2lBM5+kI83z3xMMEVD/fuEVN0mJtieDzTh80nCE7",
+	"gD22BcXt84RWzi0w3EZESxA5ViBtwBuJ7AqiKyzWTZ3Wa5H1O2VDTBUeEG4c7G1VLzxqck1w+a1uw2Dt",
+	"TSC9yb9rb7yJeZGnKa69BmCWF2Fwfii83Zwheghyz6LAtV4/sAqEbfjyk+TClmecwtB8eZpA7vhzFKau",
+	"/nkqu49SJr7/M654qOti8ND29a/N3hFJGYnZv1eTbZ5DFbM+vKoUjgvwyyfgbMGvAXhVandbsaeeNtGA",
+	"9hOWoE4++XQhbJ6Lds35xuVhBrwiIp9citknIgXDOnAu+BkDmJCID8krtqDAAuGOsePDqpgAGecfM/sN",
+	"mrRxqNnvLTtc6Rv64kvxciqTpcUm41P14W8eXzA0fSQyhv/Qmts58GnZy7UXQermYkMTaqhnmOAdxRCh",
+	"JbjomYlXqMG58tXxxdKGotCFYap4AbBB00uWFI78InYO86/GJaUv6CWPpRganc/XdMmGPtxTuLCBaG8L",
+	"maVW4YiKZU6XLs0I73pCy4QzqAxfQZbMHPzwPsQV/AI/wz+69USbrfYCfCl6NjoTSC34Lox1QrJyqT/1",
+	"toxJ68zNPkjBzB1hVLkof2gf08SrekTOY9Q4h1nV9urdjap3I+kckRM46ER5ZGLT6CFt1ZGv2LvQl+CV",
+	"udE88VUbuKp0f++p1BoISE92IBlQ7HAbW4Odf861IXMG9pKVMRm4meF/jSnO5IOynfvg8qVrZpjSRY3y",
+	"3KzPtcxVzNxlvZaX9cYyHYbi9gOdLmDW7qfaCqm6gFhuEPOZJnQOpRbLUg7v6RX5+4ef3+KNCF9hi34b",
+	"wKqNVC7jJOBgT46PgYf1ZxKN42k4JG7NiPou43Dg2ezrFMZXrW3SkfTBwgDmRreRNd3YNLHqJXt8OKsG",
+	"LHRsqDcqj1uu3c22yiv1uA1vWtBkzjYShWOuieWLQLPh+2QpvcGiEJTJR5HyNWqIqC7aggeVxT255sVZ",
+	"eu6u1tJxTPBzGJsNLxNbY6Qj1hwp2yoKznGZ0M0Blp5eMpHQQpnAoZABHpJjknANa7UmGA9d9fSfVoJX",
+	"vju+SVRAEvvOYkSjbE1PaPuKXrJCLubaxiIZ6aPcLTMvbVOHBJmpkJahorxdVHDptEJvTUicUDEnrH5T",
+	"Q/eTX06I/7lmcnF8/WTNFI/p0RmV56c0T2VEcm1zBkCVyGrl91xdh+rpfvzw8nB2fSyyWM/X9tvDd3ML",
+	"xF4YQ9cK6rTJue8ZFsFs9TxN6Ty/WwW2kebaXPA/chYl/JJFOP7Xzl7xXbXW3PpdmPGUpQPV37dlFzC1",
+	"LfmMURWvdjDRjLXkNifc3YLbNeaNtL4w7LPZ0tIdpdbI2hbwb5AkQynAmZvQTbemaKU47EaM1j5m7rUX",
+	"QY1inK860+HW2AT8NXIhCrC01g2u5niEurwxisYuZlIxbWiuaKVqbLmaelZkMIwLPJrZRvtABAu8ZtvH",
+	"qRSEDEax5gFrh+IA0DzXHSPw7D0VSwYJcCmPzT3IleivWjshumJLCfigRlx4VSi6MLWEMymW0h4OrCdl",
+	"LiWNipilXWf00ZtUKq2kp7DUsmxFu++iVoNBSALqN1OQfY1y9hkTJkzyI7b6Sk2Ree5ChidrmSVfLuwy",
+	"DRaGK2k7jI9o0SpMTGe/nk68ejHzz2UztVRZGNnjavCa22+eZkptAd6ATXjEdrZ9ZMfe9PdQIzsslbpy",
+	"5feTSKFq+zkXrcSGaumCpmmYEIXXAoypr4mMKgdl4ZG56Qao0JErTVHAoAD3GbW/UAssE0morF4zxAXd",
+	"vwQo3ghH+K0tVpoisGKaqUt8yluNlvzSFjgsM5Bd+UtXZp1oblrMijsbES3cQX/QmvZ/9o48e/rkv2zk",
+	"Ta37pP+cKR6z0hzwgy11mVFjmIJB/vc/Tg7+1+9fvvv6H5M33LKSU5yoXAPXEoDDNbSXQf6lXvy4BNPm",
+	"+BmW1nb16fPn1yjjPH3+3Jne+LU0xyU/IqZQYKFlf3f/dGsmT2hUHGkWq27/Sw9Tm21xdA+fDvfCqavs",
+	"ZKqE0uIlCM/s+NlfppNCrlJ7VsfP/tJk+L6aScAva9yq+wJ4bTtj72wh2qZKl024MWhDKkwOoWJAccwR",
+	"22TF1+hm29VeA7YGWDlUFHUHFUqiE5nizfLBCsurObHqPOw6uBbdMDW4xFRGebI7wtXVpWiGzfj7lQLs",
+	"GI+RSfhw1bYs5NXQZK2GbubWXy+a3mwZ3c0EXDDTa2HURH3Nl/DtcFMa9rkgNVsSd4KP8qn1UV7fxVc6",
+	"KLelcdu0bQ884c7lGhVp3T6ju+p+mJLdXbrKKyvfTYx66hZ6nWWBw4q/3ah1+xENu+tT9yWsYLoK6JuG",
+	"wTq6zyaM7jpVbMGAT+zsLfK+0Y5ew5Snm/OEL90MzScqwWHtjzS8xO2PoV+0/xFoP9z7yNfO3Tu15w1J",
+	"ChN3rNr0uf/28jVpbWKBYesblZ9QeNwWgQtvYbYxhWfbA3+8znPj2ssfORXG8bXrFNDaRe1itnKrfu9B",
+	"lB1N5yPqRI9SKSblxHSd6E6xXFWRztcQDqb47uluF+B3Tzv87PaI3jsOUHLBaSfFBIQGJB2MpNqrwT7Z",
+	"jTYf6ESzXMsJPT/e0Q3SQQl90OuLW2CLifQ8ETh5wRMhw2pTDfRxlUUx2co2f78Rzjmky36HhuBb4RsJ",
+	"UgYMROYbXBIkCwwv5tAqQd4A0+2QOXAHehCj1jN+IpJUeixus8QWzfxuysj6yk/gzKyNdo43fv8F7SGb",
+	"FEO5SrkoDLbA3yF2TuVCFOTjCzLBh3mub8XkWO9beecm/paWmLWUz8A0S7kCPPabpk1hBrzxjdux32b3",
+	"mqzw7Wz+EXl78t3xs+OGHr6rMuosN40en728vloGz9ALj7qut2ulotL1xmo1fBJ9cVrX2ly0j48+qMjy",
+	"exdJXpH0pwSSj74kzjDo1d0QYyNgp9sCtrdf7EcyG1w0DdXGt7msAe8GaIPwV0zOD5QrbDmzWzZvaAk/",
+	"Pvjr71/+vIslHBN5I5Fj8HFH1m3ryqRhEP87bS0SSfk24oLKmdpW0aiXGSbwpYwqly+Ybs7jVOYYLVj8",
+	"sZAAnQv/gxqR8J+Rat0apdZeHKw1LrGoJYd/06RvvEbGI/LAMtvRfgwi7MKIzfD7oue/f7c56VfMt1/I",
+	"lkr6OmMx2un+z//7f/4/pklCycnpG7QzEonpsAdMJPA1zVL72P8jwZcsxKErZWQ1gpn/LuiM+mL25PD4",
+	"8BhWLTMmaMZnL2bf4VewHrPCfTwqQ5eOvpRVfb4eUWNovCoaqCxZixz3GpJnygdB/GRFKyfd0pgCg6Nc",
+	"7wUnxVNb3w4WI7FyJ5fiTTJ7AVXJyhjMEw/Zq5MArmhWmmRnL/7xZcYBKlibL9H9IqhUNAtx3Ib4Wz41",
+	"pMLc72UpPdwPsLuXnVThT5rhGQH8R/9yAZHl+FsiTv36gtUVEa9fG/62mfMwkfKZaPbsGiHCYl5tE/9A",
+	"E6J8xXu47Gwlc3tchIrSLxzgDyIq8qB/VGJeQVSUugWvTuKYZUYTStZ5ajgQ3xEc0AFmkoNfogw/WGBx",
+	"RKtCfIIPnwheyrYWCxxjUbEXn9QkYYbFmPGo5Bpbkrg9w3Cetdcxyemrv0VAbNx7uQBZf3zztwh7vETk",
+	"9Jcf4bvf2PyUYJJ4E4dPpb53SIyH94Pz6gXY0rLVVYSpXkawm5U551wAJmxza+J7zVvl69f6wr42KO7J",
+	"teF3tYVDeRr3n+ai2bMnz29+zo9C5xmomCwha5ZwipRUI/mP2NYJqb68A4wM2UAn6X+Nuq+esHGXu3cG",
+	"XQ0v5fpuSOrm7wW/tAd+KfiTHXAjDGOkd3bkXVz0uniSW1jRXeYu+WMBy4PCPQc1keLaGNLRF/fXm+Sr",
+	"KxHODGti6yv8vg9f3f9vXt0m4katgxdL2nXsWqDaq7JfZlcPbTc1nAkCZku0lqD9z4PACHDw5tVOEDY5",
+	"9bNR6OlVRUgTBgGmmi58nwWG42c3P+cvEjJhcpHUqNCSAqH+rIsqRvNGcti1keaRYtpI2/di2nVSkOd7",
+	"N9KeSvdU+oip1KF5QKb2akuui0whYMuZYeNVCz0G9bsqBPke3nv4sl139uggwe6bIIEKQoKzCgrUYEha",
+	"tfKqTVHVOwt1l9IwPU2K+xVfvd07YQjfvpTGNQfZM+rHyqghwLjt4Jk1Zw6hirFK9h7dv1l0r9n7EM8o",
+	"AUuw1CwZxoDToy9Q+MTpzK2OpPcslgp4OolTHl/48r7wGhrlFUu4YrFNqeHGBua3eYzeQs7AQK3aAnWt",
+	"WPHd8dO2xVngfYkLXNXH929nkUNZfBXicL0ztQ2A1uKEX79FHvgOSyuURdxC5HPNVxHvRJAE0e3DbMQi",
+	"gfLjO4S7ErR+NqoYsaMW5fMC4ybX0A/A1h7jJiJUNNrjleX+pUI8rrTbxuA3+CWAkMQrKpbt7tFfKgts",
+	"oPwQFmpWfkVuGFzjQqrb4aoNRv8O6tk3gYKzwPraG1bqoX/kTG1KwFxXwHD6RqT2DVvrKwfyAE31zY2X",
+	"i6r03Wx16Cmv8l4bBR75fpLdwkdt/2jyIJF6Lyo4/Q0CfLejFKEaaXscMn0JP1qb3yjsCj+8edWOay0y",
+	"Q3XW2xBy98j8DYo4lnwq5z6UTEJZ5uhL8Klf/ja5ErqJfHJpTTBFuI3Pl94QaPxZdJsxslVCCRBRB38P",
+	"FNArwN9nL30lD/DhOeireVe2IXWIZsHPznzgrbgdwhsGYOmiL5Fv7wFxW8C8rEibtIRLwbj3CmduyhTc",
+	"kjm6twR3GB1gv2pImim5cEGjHUi6jRUeOU1sm1OiExtfuvdvFykbQsOZjbI18oKJog4H1q61xW1XzO+r",
+	"i84FK+EhcUjnOxf9ywZE2gZimEnJBUaLgzZq43WTLr0Lp+5d1G0F0HTWM/3qaOvblGO+u/k5/ybVnCcJ",
+	"E40YHGfuqJKv9PabXQjY2WYmE/Ar9/6egO8LAbsTKZtc7K/Ge0bP7oS0N4gG7SR2oeSiZsw0QravPyLp",
+	"sLtoxZ4SWoXED4X2ilWICDdcMEXVxlWJ0HDlSGiQs7D1mrsCWsai7opr4ypZtSrWL4OqrDry/gSNTq2i",
+	"qV1pGKvp3xGRaVKxtg7XsP/uIHusirZb34PXt20eLHGItAsudvm7huPMFofSQ8aczopoD95JU7C4Kl4p",
+	"FjN+yXYz5FxwMcCOQ7AghW0yQlMPT1mMlAddy4DxoegQVzkjjEfTK7rRxDckGyMD3Dnm3pQosKWS314e",
+	"6JEHWqnkhgQBX2lQTxZj3xcj7CXZbx1zi5gSj1Y3jb6FLFpB3/4KbUsJ/fRofGHrHBvoQMjF0jUcdB0Y",
+	"S95f7b5oW/xUI2rmXlwfy/2LXkaPhHR6WjPtyaadbOiFR0baFnC1s43iEmvSHKBlLgwzaJJIgPHcCjO2",
+	"QqFNpUmwBEFRQuSQnGAVjOeQbyOW+ABIcoJdESlYUY3ArdoSCYpjSVEytmKDaYY9dFKNLbODtXUeB930",
+	"1w3aU05gRHz615uf84OUtpMvNVjyS3c5B9DkbRsuNoKDisADJEAvzAGZbKNmmaZAxjJNK9ke7YT7K4aS",
+	"E7qkXBDFsF6bdg1m2CWXubYx9k0bTQfRwezwz5joeQvrY4ucvx6PR0S41jkUbZGqsRw3SB1sYtf1OJ0l",
+	"9VJie/Z2dz4SmPEWGKrvhWo5+NPbqAKTKRkzjf3RCbOF0atM/FfkigK4tkzTCk8GFuiYsV5RxZKjLzrN",
+	"l1/7TJNn+OBZmi8HcUxtHxxJjDdnZbTgV5o1PyS7tGI0OZBg/Lvk7MpexvboGt56+OxP137Xfagf4Peb",
+	"3XiY4iFuOURI02XFSIv/9yfpFRt6U1Voggr1d1J5Bud/AKW4bpfrV6Vn3ChCAX9a0MfT5dEXQ5eDytUA",
+	"Un2gy4FxljjqPrJ8Rx5QVEdpP8RoluVtLCA3d3JYN2UkHsttvhkp9u64y3sGqNPPXVAC6Lv28YEtGVzo",
+	"alSYfoAyhiYpnTNo6+E0f64BBgLgdOpkdDnrE/qi7ZOia5NrkvIFizdxyrxf/k/Ybz8qLXYRcd32I1I0",
+	"2we9sei2/59dYNoRd4X0aiU1C/NG6wmjazCVgz6sGbmSKtHYy+5UKpMvc/hSKvJaLFOuV4fkzFZ51OSP",
+	"XMJCspWimumIfJLqE1rsPx18Avs++xyneQIYAWN2LfGP2R0K34hvD0wIfMu1sQfbJlz3yoCOum5QCAz6",
+	"ENyNFPjw9KhCKgMDPhxjl8oEfx/9S3LRbZO0Y2Ghm8I9ByQaGL68MazoYokOhjmDDs3almJNrG0NjZnr",
+	"msMgctwE7W5Fajo3tnayP13sXLRiRMONcBW0iXM2W+h8SlPQHTdFPphUREjMgU8IKpQaIjv8y9zgS1lu",
+	"fKWHK8pNytHXEdNcs3JVXJNFnqbtZlYkgp9gF2+GEGDo0WTw9Aamf1BEAGD7CGG8xbnRBHC9YbdvksQX",
+	"+K+aqtguWMA/Q8VfHPI+R6fBYl7ZxLsHaTjCo27JHAwusvaYgr/JNJVXmvx09u4X8jNTS0bQ1U80W1Nh",
+	"eKxfWP4xIK3Q9hVNLNOh9mmiGdZdAaEfWIlv0Eqwg2jCaGLre4iErOnn80ohEBgT21nAmDCTLedhe2PQ",
+	"1IOzlEUQhFkxDsIYnpKFo1Lgo2jRgj+5Ji0gOQrpvLxF+9iW8Ic7QPuGPPq6cNNVIzlIxhQM5p3SBfg9",
+	"PqZ38OKB9z8PAJK5R7dC+attgdHo8WwxBPBgTjXcUiKyadUgX7OEVDqROWwmHyovlt4jYGzPjv9q3UjF",
+	"ayuqfVwk0VzErHMD3iwOfkaiuAfOpAK/9nr4Y/Mm4akCPnpNv++C8c+8QIQGGTZjisuEpIxesiIujTNN",
+	"ZG6qLdGl6qEC/MljPPEtaqpXCTpvaZpuPLnRTq9Dj2FszyT3TPJGjZV7LrnnknfIJT9u441NXSoogdtT",
+	"Bg/oVuaGkSuept7m6Es42dJ0c2auWEjI7QItPhxBb2N4VIIdkpsVbEUJiGUZ2GL9gLu8EPtJ5rWGoHMp",
+	"oSOoFchTjlGQFCwcZXBYuOMLL4bTDTKuVCZLNNfCFNxoYnz7VN9fVHsrREI3traNbeOJrxdPt6bfBddN",
+	"WTn1zi6e0Fpc3xKuSUwNW0q1IX9aSJlE5dIioqE5rGYMN8rtGAaag8rTadH2A063aZdQRiUyRCEmWCXK",
+	"dVXVRMZxrmBkQm1HLtcKmmtieLeLAELIZq1729Nw+4ZBd81Vt8Ju5DVA/ubklxOchfxbCkZybetULpXM",
+	"s/FLmW8sebHD5SE5wS6Y9OiMyvNTmqfykLhLSfuSp+XEAWF3rvffd+1FKEn54VpjAlY7pQ70/WJsZ9QV",
+	"vS4SY/By4QvCDTb9T2mmnX2mcRf0IttCqpgNKBp60/2e7kWjp28t5qZscDVc6LtPwZdlDFBA8f3FuDsl",
+	"wyO+zqQy3e6osiknGmuxv7rtifny7FfbXPNP0CfzKNaX/1mKZlajI9ioNsIrEGTEyMmKkRchIpokimkd",
+	"pdRwkycsAgkP/zokr6H3L1HyCpRL38XYGXrxkmKJDd3XJjDUtjOCyN+7VGzMCm5jrommlyz5H/iMAnM0",
+	"F4TBubiAcdcI0VdDjMrVwdLRglz0GAWjMzUEieWQnKSpHZFisUTNcCB0j2gulimzQpjNne7xcdU58Rt7",
+	"WHfFj+30ddHb82QUoR1XfmBM2S4svP/La9Yjd3W0lm7Pt8e+m+A+AAb+9OmNrR9h6NuEAUzV4bbNXCrR",
+	"lto0vonMVTGpEqZ6smvPmHEVbLjOUmStwDcdtSw50FYAjhOs7UNIcDTLGFXePAd68nY/Uog5FsDb9Kde",
+	"P/m6VbTR795S16IvuP0aoTP0o3nYYGlAxHEbIpZtZ27xdrvFVjb30jnw+95qfXtW6/vQjXOYwhD1Vwtn",
+	"KJZbHxAgtBSlgh4RLiBiFAVso4MO4dpKz9pmgSbySmAX8Y/v3+rhJtZHxiVuqYv4w9Dv75A+mvazPuK4",
+	"vZimMZLcY79AH6MtMOx/utnLrHurX5+C2hEnM4hjbY+a2TOSh8xIao2G95xkz0l6OMnHcfxjuO4/rAP/",
+	"Fq4zpvf+3gywNwPszQDj2/37Nv+TGYAPzhqYr/ODf/xx5O345TzQMtL+8Gyll3pAof91eKTIbZ/uwGpn",
+	"LOHG81O/qFvsnnhToSNut+80cqSAYW9YqnPc+yPmnSSQD+cwH7OC+2i9h8kffXF/jXXveMbg/r9rjbJY",
+	"xTfAffa9W+/Kx+IJbsDlut0ss6egR3R/W717yv29J+BHflcXJpmh3KPluo5pykRC1SGPuxN+TgR5/7eX",
+	"5PnzZ8/JgrHEE16YC1Bm3oikzBYJ0mbCsEojic7nMMWcwQdIuSCUeGAgPskm/YCV1WhywVjmUnU+vnlF",
+	"aKyk9vlQOiI6GC4pRtHe5o3VTLjQhlEEnSboao5l5qJX+rTQl260N/E9UkRteKGDbGuMYUvdeb8qPM5v",
+	"1n0LCBmHO9FOQT3ZcmEnjCFa75hOwLeabuwWYkMwIiIYS1hS5uLZCtyD7Yq75Brv2uQ0pkpt7DrG1PAm",
+	"v7m1wvJthfBgTT403fdYcOlJNNjCbVXAx2Qp7U2pt9ZvuPBoiIRoJhJfqSpoRDaQFyQyzvGu60mcZaR4",
+	"yldLLvIDrlYyZSUw8JUUTJNM8Uu8xmSz5yM+FPZ9JK9pvComcRSCU9C2MCpiVtQ4GtAuQZKSq5VrmN53",
+	"Mb4qlnu/LHiK0cRlpOZZKu0HE+78XWnU118Gyq9ob1MbeNuX1Nd60xc/V+zYXblN6zw1HFDvCHDhIKGG",
+	"2misgqQx08nFaX2CD59sEFdEKDl99TebEvXT6esfI3L6y4/w8Tc2PyV8TZf2fjFkLbUhT4/Jzz9ENiN9",
+	"k0HVPkBqLfhiwRIrPcNvbm+t6PwJGjK6+YhhaQo19Wh1GwjH8p1mxdQnTNRlLhcJB9CxzDzElltpLMfX",
+	"wrD+9An++2QZkhvlP2E9ILnjWzUuVpJmSLt/+hR8+vSfW5Oc9jxoB5tEC/pWKTBTsPm+FASgb2XOORdU",
+	"bZqzRjPAvK0N3N1O/De3SjUi29CXzvDhqhHkHxbC3wt45BwEv7vxdXhA94y51YDy5BbkvlO6QSnHSElS",
+	"qpZ2f588vw3TjbalkllC1izhFJl2w3iD0NGSF7c6W8IbqU/mPPri/2z4W+q2nE216R0VzrPfvCCrTN4y",
+	"d8/HUTTF0GR7GeHhwh1izTSFSrTF21NwcP/HXVury238toXVvfX4Ft0/BQ8YIJW2apZgNAGRdKGYXlU1",
+	"PF/32Y9ihbuSzgPBjAobwRpiqOu6auEfrBPuqflbUz1PlKKbvZjTmyw0nMxbrnpbmv0Ie0yyq04b03uG",
+	"jcHDoutUk0qxYtfuH4u3/0rTHLtXUkMSljGswd60M/n2/PZyB5y1bfhTtjC2cJ0CZbfoxaHpOku3O1fQ",
+	"tqpP3ZJumVPU7bRsnaXUsN6xe5ECFuPW8sEP1sI73lKxzJ2CX56Sla7S2m/WOB9o5h1G5lSCH2M2FNS3",
+	"9vGHG2vc6hBbmXU6wRmmkGJYQv7+4ee31UPZxxjfCnd0REOoCHr7hokzA6zv1lPcyRbPmEiC1hSYb7lm",
+	"WtMl046xge3tTMYXrFaGk2qSC0BqcBCoS6YOMP3SThihfBWnHD6QOVtxkZBMyc/cc9V5KuOLcmztTPTW",
+	"eY11Pakgb17ZCkWKxVIIFmMMi+uHQLggn95SbQ5ew5QHb159ssZ+9FdY0O1omqy51r5CaGTNi58U0xsR",
+	"f7IAF9V1N06yI1A7iSlyIeSV2MqvL++JsS2l2vgtdNdZEtkG6vMNmUMZJbgEcbHhnkaFONy2YyADz5kd",
+	"xrpTuphb5Th2LEGIvAsP50Abxeh6JA87IfY12Jsmgpb+UFh1gfJuHztQngUYap1HgKLfpOR2Zvc2RJnS",
+	"8Yu1rnW+xhid5t4PZV2fM+bRY0BexGv/+OPIi/DLebjlM/35hcftvxueEHEnx3pT+QZuMXeab1DA8G3V",
+	"OdslovCtXNaQugOne7jYkWbGpGztFtIqjaEE5F7AmopZyi3TTDeVyuEVvfVqJUnCEzRHJSzGWoqFlIhW",
+	"rTlNqYiZLaFo4QgCLBbsimELPSr0gint77lcKSbiDSi+3GgyRA5yaz0rl/o4mHG5oAfIjgE/5BVDRFnb",
+	"RvN1BWI0Ch9ldFME9Yzi4+VWnvohHgNnbyzrTnl8CzR7bj+U2/9M1QWhpET2gjVakyFProd0jr64v8am",
+	"gnWTkvv/rt0Lxbr2Cf/7KNXHVvcv4AsOzyewAyMNTcdqth/sS49Kv7VreoBS1UpekTW4f6ANr87QfbWD",
+	"aPXF/TX1LnD/3zXnL1ax5/x7zv8oK772GwAGJSLvafZuafamspGnWPf2LOORsIz7WpJutMESM7zYcMPO",
+	"G/f8w7bi2FUEwWb6jgw4bYB8Wy2lOucs6z9Wu43gjpGMySwtEmnqYnhoMW/H+39JLg5imbDu/k2ndoqY",
+	"CgJPlxdbYUuH98OcehsEbkkKw8BtIMch+ZEJpCloZYj9T/FNxbKUxky7kHJ2yWWuiRRsa8rPT5KLlwD8",
+	"vmNzr4h+3ZZW2H2/9w+DUO8wt9ghvXVPIQUh1o+tLvAvmStB00432luuXSMgl0vMhFHYgMjlD1eTNRbN",
+	"uM54paSQqVzyGKpEb/N7/eQAup/ZdnazEcIInUE01RJ/9YGrfj/cPj2aaGx3Lvtss4FpwA5L2gnS/diT",
+	"AoyVaYRRG8I1drBOrEkMekLXIhe33GX3kZyuVFlCC1f5COpfup1+Dcu5U4dlFZA9ud5f7fI3xUG5FJ7S",
+	"xRjO0X2ZgyXc9U7dnr/hrvO2C09HyG58d22qCSXg0IXcr0pOKdbO8q3PfbCM1zA0yYXhafEL9uUfKAW8",
+	"/ny3fUUfpyyA0cBrd5ATshk8gnJRoMM3xDvuUDu35NDOJLAdqKs9JS+ZGs00viAT2pLa/SFgGY40Gvmc",
+	"eltCZ8PxFt5Yd27Bt7vw2KWgfSL2XXm+POXaOx9wwDDhqhqE6NGpL+Tmhuiy6lnbE+UjUE2s/2KyarJn",
+	"DN+Kf2s3rtQiVkAlzaFBWW/x2ccRi4VrebiJRnhs4SHjF8NTjG7/KG/KngMruVM7jgVg76+8t/3q7DGF",
+	"lNNGOF288UjZbvcAYUfr3jPmfC8J11kKll94wUstSw79IXEs76m1v9vU6yxjVHmbUsptpnF/117HuyxY",
+	"e+fnzXMat9du3/eC2b3ysLrD2XozdhL4F/hvbBAy4gL8c9c6lwV+H3u8p65HGXvcdV139tF/N7BHvi3h",
+	"kAy8bfeU/vBvcTzY0erCnsk8nmjlb1UD6ur938Nct6d07Pnio8rk2DPGPWP85hjjx0HscKvmeKRsa/TB",
+	"CSQB73Rd1fcsdK9E7tnYA2BjJ64yOpBMmQSDpUShxjqkxtMkwbZ1JeL7oDuOmS34kLU0JQ1jFnIDQv3v",
+	"EzjTJesMKfxv7MFjs3ZsIUwpgABF0fQMa5Da+qC20GkQL4h8FB4sSLYsB/2p7AB6aIXMTxEW3WSXQbhh",
+	"5NvlcaMrPUPD+IcIl6ujokQl/Bxf2EZ+bK0jYqi+CBuNStXWZzRsCurLsiq2YAYqGKyorxqahMlLmUxT",
+	"LpaH5KReLRWmLIYxshgJ1rcxK6woaveqqCdKN2RFL6FHExOuuui2gMq3/PLWroK7rkdqkSErdqxaePQW",
+	"MGRfxbRWxXRgMpLf9YFhAz/7x+/KVQVRV4J9NudxrrQsHHNFsmFGl8xWDbTFlDPqyjEbfBFLBfo1d5Vd",
+	"t0P31v9twOU3BmkAJo3I8+MhJd75mpvKVGv6ma9BMnlyfBzN1ly4T8XmcGHYkqmbj6vwa3q4oRUlT3FH",
+	"X9R/9qThnxgebnHnJLC1S0kYTzeXVCWPINXH7fqdRocUMOyLEY5Mt/GEWNaYKhGzhRJ77qkjwPPBunnJ",
+	"wWiyp9e9/nwvK3VWbipbfJ5isQXaFQU6kFRy4YllhGT3Uag9sdx6CKvd9YcjcN2x4vNS5sJUW19UiAUl",
+	"fiEt4gynHLRpDlWF3tmH9zF7t1sUwW77vlbJ1lolhRJksTqkAvvNcJVnj+q3pu2cJEmB5Teo7Ox9H1OI",
+	"6iSB1s6xPLDo11Grq6Cuzhvm6Av+j1g4LlTVUuK74u27dTXKEI4daGnvb9zTXHdI+FpespDsFkquRxOe",
+	"8ykMlO1O3dOPIz/OrQbqij1AU67VBXEFmFPT7uJwTwyXaW75iAcquiwptVufQPTALbduo98Ytr5T620F",
+	"jr1+fX8TpFHKEuj99SLWGPrvZv5HOl8umQZIulvKgusQpnbdxuwbLClvnQRGELgpRUXGhJqyCoT1eftg",
+	"Cp0LHSvGBPYopWSO3cmwu6w0K6bt15qsqcCiT2gB5AZ7oepDEoCTgjFj4xt541aEzbq3hSM4/D8L9uBR",
+	"XW/Bwvb0vS1wwO6VwyzfPPeaqOwLjDo2By/gzncdg27Bf+S3/b7myC2TnNdj3MVWXCcjRdvtuRx7Snro",
+	"crONZJ8qN++J+RspIOQ4SUENO17eQYOBoUaS4JXHIUrCwh5W74oup094nuMaSYRPHH0JPrnkGCaSA9sR",
+	"orvTxImwTSOslhRTQeaMYLgwNWQttbGlMzOmyErmhSUdI+/DmAdyWusUrZlrQUG4dfBeMsUXnCVkw4zr",
+	"Dw2zYE8K+1vsgAhaW2wt4R1OG/yNGT5MJLZlx103KQ0OZp/ts7e+P+zeU7eQ7fNBSmtlcZurm/k6zNlz",
+	"Aubl2I2R3eFYA3jqShqp+xvn4zMklmumbS6S5kvBEgK1nlNJE0hJ0hEq69yg5Yljqap8PReUpxExkAfD",
+	"PmdcMZe9QsnViqdsq2XIQveQQ/rtBl9nQL/dlCCc/+mzBx7Oj8INrupBijV47L4OG01TpjalnNsd4O9I",
+	"r7vtx0kcs8xoCFLOU8OBmI8Ahw8SaqitMVOkBloadeVnPi14yj7Z2jQRoeSn09c/EqnI6S8/Er520Hp5",
+	"5+kx+fmHCMmWCiJxcpqSTzHFPz+Fzz4/PoZ8HkVjIMVD8iakcxB81jRhHoo5jS+WChhpFIA4Z14XYIkF",
+	"n5JPGRMQRfkpGGzNqOjgEXWR6G6ZRLvan2fAGV1kKNoavWyC23APrAAtOFWllUzBthtuSd6hg2Mcb5lY",
+	"mtXsxfPj48a00QzQrwLfnAuKzKixm8Hq/mHf+714Ss7/xeK78snBKe2t9a0i0ZNbEPtO6QZFCyMlSala",
+	"2v198vw27Bw6zzKpgD+tWcIpQXSsWzoQOuqYGspgqI44/t/K5zvFr6Mv+P+WdgvWM+ETq9c2/dizHgSD",
+	"plIsrUBiB77GtgyWy+K/d228dZv1bbHxvSX1rioEWtqymGB7jfaUQR9D7EeeioeaNUMSfOnfffCkeNNO",
+	"eADR79b+Rh/YsTC8YGjJ7Hp0lwEy+qND3ZFtROp3iNvkxxBKFxDZ3cbSVQDZE/uWZDXcJ5v73E3jY66y",
+	"oy/ur9FhNm0cwv3/SATOlpGLzfq22NBemL0rYdad9cCmPr0sQKbpYMkVn30kQZ2wlgfsfgfwo8JwzBW5",
+	"lIZVPfHwyJZ21FnN/U0SnqA9IWFxygULqtIpRtB1ysBeggXtjMRJ4d6xMceZ99X2C5G3iUXfdK6nk6Vk",
+	"mt6tMIcA7POpWxzh963rEfAMnwDgcg5UIymhPfjLcZuuK+boC/wHH2GJm+7QnrJBUs/8Be36Jkn4dulH",
+	"QzcXckT0iMWp1L4htkzTYSwK/nnz6gShvVu5FTduX91ht7x3OMc9J3qcBXiBat9TsWS+bnjfIftnXhT8",
+	"wBbidelPCAVzzm6I8suY4jIhKaOXLCwzSmRuqilZsixei/Ejrmbsvcp9AzJAKK+4EKhGZiVTx83oqDrQ",
+	"w+A1oypeBUpEnaPDz+XebYjhJmWuMqv7gHy6EnGPHKqS9LYtzshOdHdxRuyzgd1LpbyAMKqIxFRjTCgT",
+	"mht+ybqiev7oBWTNhXfUP7ldpmk3FKnrYWlKFvBxlWqLSr/DlOEz//hjiUx3FY/9uh5oJn9Lbe9WedX/",
+	"Otz5cdsHPiEzyS/qEbgi6vh4pxpsE5i9S+Ke5/c3GUEZ3tPBB3ruhKMv7q+x/hDPNNz/d+0CKVbxDXCm",
+	"vXfi7ppx1klvwBWcDzFRG3pRwyg0TCuWpTS2UT3B8+c80S22ntzsCfRxig42c3Un0WHPKL6R7OYJXKpN",
+	"QFhRxcZJBPjG3v21v67vvPDhpbxgticVQTy29rjeDj9DdeU9km9H8uvXUnmGG793cWxB/dLfmc9THmMV",
+	"9wMp0god2HJqYwyIhg63HuKzj6eoBa7n4YbTUGOYSKiIGcFTHHHiOW5pRo31gFQnfwc4dUlTnlhxg8P3",
+	"NmuHYlooS16QRNGFIQf/zI+Pv8Nmiwuu1iwh/zeJAaI0BW9U+bV/UIqlBE5Wecx/WY62zmxvyOCxFp0I",
+	"wK+hZ74P0rlNncXSUK732so9uyx+tuWhfbgJLXq6Lli8iVPLMfLBLGNgiVBqjKKxYxcCdkMbmiuX9AdK",
+	"VT0uJiJUW3WrCAcFo4gmmZKXPGHqkNg6EPBtWAcCHi1ds5Kcvjv7AP/XQQ9c37APSUK4Cb3FEaHWB2PL",
+	"KCwUY0SnsuIkd+UlNLngkFDua4ti99eq81zIYAR4DvLUCRX6iilNnj19amtP1HcBfflCGrJkMpYJ8ETY",
+	"vufH39k5hKxvC+HactdlrljinfjFrwvKU73V83z7RU+j1rvGoZdfI+48t7tN/lTiFKyyxKj/7PJLw2u9",
+	"VS1uQ7LYl129t4aVaPb8NjjzGVOXPGYkF/SScntltdebdWgPkclcc+MZ0tboxZK1dXFtN1MHx34raaKD",
+	"9s6EC0KJ5mKZMoJEdUhOSvYJzBd5L7A+G75tJVBme2ZjWHUsc2T2IrEdjGsvxCmPL9xD/4NoxkI+zln4",
+	"IhNJJjkM5irxrrfzM7veR6Sg2BU9YBUFqgLYCxvuz2qL67ZjHyiQ2EcGKa0f4NFHghJ0+YDVVTizyvHS",
+	"Zc/pHn0xdDnWcQ0b9IEu79ofhpDvfcE7ok7R48bQpa0M3WLYosuKJ7bPabpHjkeEHC5chi7bA2T6eIu+",
+	"GH51wLOP5e7QFw82PBJg73DxwE/DXTy3eqITAhpwOY8hEJLqi7sNfkQA9pr3vQ94pPqii4Xriz4eDgKi",
+	"vhgvIeoLDf/cvRigL3Yf+L7zl32U0p2FMwJhbbsy28IXX0L+l8eXJLcprd7ATDXWX2ZuZJgjZUajcl/8",
+	"Nvfl6FlC6JJyYevac0O4JvKSqSRn2yIc93R68SiiGsfKAXse8c1EMm5lUC03/xXlJuXaBApcrXYrk1nK",
+	"yBW1tGTDYTSjJiIyTcpa2FCodIMhDbZrR0JobuSaGh7TNN1Yt1ultr2vLlJk99vGukRRrq0Z2xU1JyJf",
+	"zxlWbK/0G6m27YipENIAr1Ro50hCE0fkJoMlcKhxQq1nUjszOixomzX8N79Vj8cc7pf0cG2gHn8Hmrmv",
+	"GDUrpnp97gupWEw1Xq6LtsITlyzI8Abi0xG5YJlxxGEd0s75rpm6ZKritA6d0A6ea/VC/+bW+IjQ1K5o",
+	"r3623UP3xPfqTUseowsq6g0kbiXR+UrKwSbF3/zj+yi1PijPbJcXIy9YUTrH77QNdPVlvYysLaSz3wkO",
+	"1gvnLfMKjwv7yOctwWwlsToMaCVS/+uW6nH4WoxGwiRyn3yYalQpRlh8bRuUuGAz/y4ETvhi9jAbPKbJ",
+	"T2fvfvE4+fH92wiu13hF1rk2RDEt00vmuibZIG6aJIppHcijrsGRbU8iyN9/PnlJzv5+cvD0+Z89JWgW",
+	"KwbjmVzBs1IQBArj6bDVm2uD8j8PPih6ydIDoCdqcsWIpWIA1XyP4bZxLvhnYvia4UcWXT5xP6zYZzu9",
+	"mxaeiQgliTRFj2/oBGPfOyR/sxSZsJRDkzmmXZqjUdwviH22yMBpij1a5GKxta7VnmU+NJZ5U14Fhwl3",
+	"6lgoYNjz7LsvyFWLF1hy7Rq82UMq9CHHqrddG23ine6pECUS1xEFS2YVVZ+A/RFtFKPr8kqAMktrpjVd",
+	"gv6l83gFv3368s8ZAvfP2QvyzyCi75ALzZT55ywi/5wZEGDrT9ifZGa/rzyueHbOE/vD4eGh/bbyxddP",
+	"tmdenHLcGeySlym2YIr8xuZnMr7AiobSaYQHeKvYbTwkJ+STYnoj4k/2K4L+2WIsSWAgE6+C2EIb0Pwp",
+	"w05b7jguGMsIT1JMHxHMxY3LjImtOuOdeeWfHD9pwYQrbuIVXgOWtRZbCLqwkbFMvSAQU2VvRosWDiOw",
+	"oZ7FompxNrSrF0ce1QLobKCkVAVifZMujzNLaTVCdAYXtH7Q8kC6tDrPBY6+uL8GORa9aOL+H+irKGbY",
+	"iyn3R7PbJyU9RpmgcIc6FOu5+Ns4wFGpy/TZdxps4FX52p4hPAiG0ADr7/LK9kUO1Fkjnc49vNGt64wL",
+	"3Sqje9T11mFqiad7febe8S5v9UqpYdqEeIjijaOR7h6/IX/7+vX/HwDsxdS2INICAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+	"travel-api/internal/api/spec"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Get a trip attendance stats.
+// (GET /trips/{tripId}/stats)
+func (api *API) GetTripsTripIDStats(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDStatsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	stats, err := api.store.GetTripParticipantStats(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip participant stats", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDStatsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDStatsJSON200Response(spec.GetTripStatsResponse{
+		Invited:    int(stats.Invited),
+		Confirmed:  int(stats.Confirmed),
+		Declined:   int(stats.Declined),
+		NoResponse: int(stats.NoResponse),
+		Guests:     int(stats.Guests),
+		Attendees:  int(stats.Confirmed + stats.Guests),
+	})
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+	"travel-api/internal/places"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Suggest places to visit at the destination of a trip.
+// (GET /trips/{tripId}/suggestions)
+func (api *API) GetTripsTripIDSuggestions(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDSuggestionsParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSuggestionsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var kind places.Kind
+	if params.Kind != nil {
+		kind, err = places.ParseKind(*params.Kind)
+		if err != nil {
+			return spec.GetTripsTripIDSuggestionsJSON400Response(spec.Error{Message: "tipo inválido"})
+		}
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDSuggestionsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSuggestionsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if api.places == nil {
+		return spec.GetTripsTripIDSuggestionsJSON503Response(spec.Error{Message: "sugestões indisponíveis"})
+	}
+
+	if !trip.Latitude.Valid || !trip.Longitude.Valid {
+		return spec.GetTripsTripIDSuggestionsJSON422Response(spec.Error{Message: "o destino da viagem não foi localizado no mapa"})
+	}
+
+	found, err := api.places.Nearby(r.Context(), trip.Latitude.Float64, trip.Longitude.Float64, kind)
+	if err != nil {
+		api.logger.Warn("failed to get places", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSuggestionsJSON503Response(spec.Error{Message: "sugestões indisponíveis"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		api.logger.Error("failed to get trip activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSuggestionsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// Every place of a kind is suggested at the same slot, the first one
+	// still free.
+	type slot struct {
+		startsAt, endsAt time.Time
+		ok               bool
+	}
+	slots := make(map[places.Kind]slot)
+	now := time.Now()
+
+	response := spec.GetTripSuggestionsResponse{
+		Suggestions: make([]spec.GetTripSuggestionsResponseArray, len(found)),
+	}
+
+	for i, place := range found {
+		suggestion := spec.GetTripSuggestionsResponseArray{
+			ID:        place.ID,
+			Name:      place.Name,
+			Kind:      suggestionKindResponse(place.Kind),
+			Latitude:  place.Latitude,
+			Longitude: place.Longitude,
+		}
+
+		if place.Address != "" {
+			suggestion.Address = &place.Address
+		}
+
+		if place.Rating > 0 {
+			suggestion.Rating = &place.Rating
+		}
+
+		s, ok := slots[place.Kind]
+		if !ok {
+			s.startsAt, s.endsAt, s.ok = freeSlot(trip, activities, place.Kind, now)
+			slots[place.Kind] = s
+		}
+
+		if s.ok {
+			suggestion.Activity = &spec.CreateActivityRequest{
+				Title:     place.Name,
+				OccursAt:  s.startsAt,
+				EndsAt:    &s.endsAt,
+				Address:   suggestion.Address,
+				Latitude:  &suggestion.Latitude,
+				Longitude: &suggestion.Longitude,
+				Category:  suggestionCategory(place.Kind),
+			}
+		}
+
+		response.Suggestions[i] = suggestion
+	}
+
+	return spec.GetTripsTripIDSuggestionsJSON200Response(response)
+}
+
+// visitSlot is when a kind of place is usually visited, in the time of the
+// destination.
+type visitSlot struct {
+	hour, minute int
+	duration     time.Duration
+}
+
+// visitSlots are the slots the places are suggested at, the earlier first.
+var visitSlots = map[places.Kind][]visitSlot{
+	places.Attraction: {{10, 0, 2 * time.Hour}, {15, 0, 2 * time.Hour}},
+	places.Restaurant: {{12, 30, 90 * time.Minute}, {19, 30, 90 * time.Minute}},
+}
+
+// freeSlot returns the first slot for the kind of place, from now on, during
+// the trip and overlapping none of its activities. It returns false when
+// there is none left.
+func freeSlot(trip pgstore.Trip, activities []pgstore.Activity, kind places.Kind, now time.Time) (time.Time, time.Time, bool) {
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	from := trip.StartsAt.Time
+	if now.After(from) {
+		from = now
+	}
+
+	first := from.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); !day.After(trip.EndsAt.Time); day = day.AddDate(0, 0, 1) {
+		for _, slot := range visitSlots[kind] {
+			startsAt := time.Date(day.Year(), day.Month(), day.Day(), slot.hour, slot.minute, 0, 0, loc)
+			endsAt := startsAt.Add(slot.duration)
+
+			if startsAt.Before(from) || !occursDuringTrip(trip, startsAt, &endsAt) {
+				continue
+			}
+
+			if len(overlappingActivities(activities, uuid.Nil, startsAt, &endsAt)) == 0 {
+				return startsAt.UTC(), endsAt.UTC(), true
+			}
+		}
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+func suggestionKindResponse(kind places.Kind) spec.SuggestionKind {
+	switch kind {
+	case places.Attraction:
+		return spec.SuggestionKindAttraction
+	case places.Restaurant:
+		return spec.SuggestionKindRestaurant
+	}
+	return spec.UnknownSuggestionKind
+}
+
+// suggestionCategory is the category of the activity added for a place.
+func suggestionCategory(kind places.Kind) *spec.ActivityCategory {
+	category := spec.ActivityCategorySightseeing
+	if kind == places.Restaurant {
+		category = spec.ActivityCategoryFood
+	}
+	return &category
+}
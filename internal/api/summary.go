@@ -0,0 +1,43 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Get a trip along with its participants, activities and links.
+// (GET /trips/{tripId}/summary)
+func (api *API) GetTripsTripIDSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSummaryJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	summary, err := api.store.GetTripWithRelations(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDSummaryJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip with relations", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSummaryJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participants := make([]spec.GetTripParticipantsResponseArray, len(summary.Participants))
+	for i, participant := range summary.Participants {
+		participants[i] = participantResponse(participant)
+	}
+
+	// The counts would take queries of their own, the summary leaves them out.
+	return spec.GetTripsTripIDSummaryJSON200Response(spec.GetTripSummaryResponse{
+		Trip:         tripResponse(summary.Trip),
+		Participants: participants,
+		Activities:   activitiesResponse(summary.Activities, nil, nil, nil, time.UTC),
+		Links:        linksResponse(summary.Links, nil),
+	})
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Get all tags.
+// (GET /tags)
+func (api *API) GetTags(w http.ResponseWriter, r *http.Request) *spec.Response {
+	tags, err := api.store.GetTags(r.Context())
+	if err != nil {
+		api.logger.Error("failed to get tags", zap.Error(err))
+		return spec.GetTagsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTagsJSON200Response(spec.GetTagsResponse{Tags: tagsResponse(tags)})
+}
+
+// Create a tag.
+// (POST /tags)
+func (api *API) PostTags(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.CreateTagRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTagsJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = normalizeTagName(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTagsJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	tagID, err := api.store.CreateTag(r.Context(), body.Name)
+	if err != nil {
+		if e, ok := constraintError(err); ok && isUniqueViolation(err) {
+			return spec.PostTagsJSON409Response(e)
+		}
+		api.logger.Error("failed to create tag", zap.Error(err), zap.String("name", body.Name))
+		return spec.PostTagsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTagsJSON201Response(spec.CreateTagResponse{TagID: tagID.String()})
+}
+
+// Rename a tag.
+// (PUT /tags/{tagId})
+func (api *API) PutTagsTagID(w http.ResponseWriter, r *http.Request, tagID string) *spec.Response {
+	id, err := uuid.Parse(tagID)
+	if err != nil {
+		return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTag(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "tag não encontrada"})
+		}
+		return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	var body spec.UpdateTagRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Name = normalizeTagName(body.Name)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	if err := api.store.UpdateTag(r.Context(), pgstore.UpdateTagParams{
+		Name: body.Name,
+		ID:   id,
+	}); err != nil {
+		if e, ok := constraintError(err); ok && isUniqueViolation(err) {
+			return spec.PutTagsTagIDJSON409Response(e)
+		}
+		api.logger.Error("failed to update tag", zap.Error(err), zap.String("tag_id", tagID))
+		return spec.PutTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PutTagsTagIDJSON204Response(nil)
+}
+
+// Delete a tag.
+// (DELETE /tags/{tagId})
+func (api *API) DeleteTagsTagID(w http.ResponseWriter, r *http.Request, tagID string) *spec.Response {
+	id, err := uuid.Parse(tagID)
+	if err != nil {
+		return spec.DeleteTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := api.store.DeleteTag(r.Context(), id); err != nil {
+		api.logger.Error("failed to delete tag", zap.Error(err), zap.String("tag_id", tagID))
+		return spec.DeleteTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.DeleteTagsTagIDJSON204Response(nil)
+}
+
+// Get a trip tags.
+// (GET /trips/{tripId}/tags)
+func (api *API) GetTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDTagsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tags, err := api.store.GetTripTags(r.Context(), id)
+	if err != nil {
+		return spec.GetTripsTripIDTagsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDTagsJSON200Response(spec.GetTagsResponse{Tags: tagsResponse(tags)})
+}
+
+// Add a tag to a trip.
+// (PUT /trips/{tripId}/tags/{tagId})
+func (api *API) PutTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request, tripID string, tagID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tID, err := uuid.Parse(tagID)
+	if err != nil {
+		return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if _, err := api.store.GetTag(r.Context(), tID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "tag não encontrada"})
+		}
+		return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if err := api.store.AddTagToTrip(r.Context(), pgstore.AddTagToTripParams{
+		TripID: id,
+		TagID:  tID,
+	}); err != nil {
+		api.logger.Error("failed to add tag to trip", zap.Error(err), zap.String("trip_id", tripID), zap.String("tag_id", tagID))
+		return spec.PutTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PutTripsTripIDTagsTagIDJSON204Response(nil)
+}
+
+// Remove a tag from a trip.
+// (DELETE /trips/{tripId}/tags/{tagId})
+func (api *API) DeleteTripsTripIDTagsTagID(w http.ResponseWriter, r *http.Request, tripID string, tagID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tID, err := uuid.Parse(tagID)
+	if err != nil {
+		return spec.DeleteTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if err := api.store.RemoveTagFromTrip(r.Context(), pgstore.RemoveTagFromTripParams{
+		TripID: id,
+		TagID:  tID,
+	}); err != nil {
+		api.logger.Error("failed to remove tag from trip", zap.Error(err), zap.String("trip_id", tripID), zap.String("tag_id", tagID))
+		return spec.DeleteTripsTripIDTagsTagIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.DeleteTripsTripIDTagsTagIDJSON204Response(nil)
+}
+
+// normalizeTagName lowercases and trims a tag name so "Beach" and " beach "
+// end up as the same tag.
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func tagsResponse(tags []pgstore.Tag) []spec.GetTagsResponseArray {
+	tagsRes := make([]spec.GetTagsResponseArray, len(tags))
+
+	for i, tag := range tags {
+		tagsRes[i] = spec.GetTagsResponseArray{
+			ID:   tag.ID.String(),
+			Name: tag.Name,
+		}
+	}
+
+	return tagsRes
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Get the tasks of a trip.
+// (GET /trips/{tripId}/tasks)
+func (api *API) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDTasksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tasks, err := api.store.GetTripTasks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get tasks", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTasksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	now := time.Now().UTC()
+	response := spec.GetTasksResponse{
+		Tasks: make([]spec.GetTasksResponseArray, len(tasks)),
+	}
+
+	for i, task := range tasks {
+		response.Tasks[i] = spec.GetTasksResponseArray{
+			ID:        task.ID.String(),
+			Title:     task.Title,
+			Done:      task.Done,
+			Overdue:   !task.Done && task.DueAt.Valid && task.DueAt.Time.Before(now),
+			CreatedAt: task.CreatedAt.Time,
+		}
+		if task.AssigneeID.Valid {
+			assigneeID := uuid.UUID(task.AssigneeID.Bytes).String()
+			response.Tasks[i].AssigneeID = &assigneeID
+		}
+		if task.DueAt.Valid {
+			response.Tasks[i].DueAt = &task.DueAt.Time
+		}
+	}
+
+	return spec.GetTripsTripIDTasksJSON200Response(response)
+}
+
+// Add a task to a trip.
+// (POST /trips/{tripId}/tasks)
+func (api *API) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDTasksParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PostTripsTripIDTasksJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.CreateTaskRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Title = strings.TrimSpace(body.Title)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	taskID, err := api.store.CreateTask(r.Context(), pgstore.CreateTaskParams{
+		TripID:     id,
+		Title:      body.Title,
+		AssigneeID: optionalUUID(body.AssigneeID),
+		DueAt:      optionalTimestamp(body.DueAt),
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PostTripsTripIDTasksJSON422Response(e)
+		}
+		api.logger.Error("failed to create task", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDTasksJSON201Response(spec.CreateTaskResponse{TaskID: taskID.String()})
+}
+
+// Update a task of a trip.
+// (PUT /trips/{tripId}/tasks/{taskId})
+func (api *API) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params spec.PutTripsTripIDTasksTaskIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.PutTripsTripIDTasksTaskIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	var body spec.UpdateTaskRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	body.Title = strings.TrimSpace(body.Title)
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	updated, err := api.store.UpdateTask(r.Context(), pgstore.UpdateTaskParams{
+		Title:      body.Title,
+		AssigneeID: optionalUUID(body.AssigneeID),
+		DueAt:      optionalTimestamp(body.DueAt),
+		Done:       body.Done,
+		ID:         tID,
+		TripID:     id,
+	})
+	if err != nil {
+		if e, ok := constraintError(err); ok {
+			return spec.PutTripsTripIDTasksTaskIDJSON422Response(e)
+		}
+		api.logger.Error("failed to update task", zap.Error(err), zap.String("task_id", taskID))
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if updated == 0 {
+		return spec.PutTripsTripIDTasksTaskIDJSON404Response(spec.Error{Message: "tarefa não encontrada"})
+	}
+
+	return spec.PutTripsTripIDTasksTaskIDJSON204Response(nil)
+}
+
+// Delete a task of a trip.
+// (DELETE /trips/{tripId}/tasks/{taskId})
+func (api *API) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string, params spec.DeleteTripsTripIDTasksTaskIDParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tID, err := uuid.Parse(taskID)
+	if err != nil {
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	isParticipant, err := api.isTripParticipant(r.Context(), id, participantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.XParticipantID))
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isParticipant {
+		return spec.DeleteTripsTripIDTasksTaskIDJSON404Response(spec.Error{Message: "participante não encontrado nesta viagem"})
+	}
+
+	deleted, err := api.store.DeleteTask(r.Context(), pgstore.DeleteTaskParams{ID: tID, TripID: id})
+	if err != nil {
+		api.logger.Error("failed to delete task", zap.Error(err), zap.String("task_id", taskID))
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDTasksTaskIDJSON404Response(spec.Error{Message: "tarefa não encontrada"})
+	}
+
+	return spec.DeleteTripsTripIDTasksTaskIDJSON204Response(nil)
+}
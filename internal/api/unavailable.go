@@ -0,0 +1,35 @@
+package api
+
+import (
+	"net/http"
+	"travel-api/internal/pgstore"
+)
+
+// ServiceUnavailable answers 503 instead of the generic 400 of the handlers
+// when a database query of the request timed out or was canceled, so clients
+// retry the request rather than change it.
+func ServiceUnavailable(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(pgstore.TrackUnavailable(r.Context()))
+		next.ServeHTTP(unavailableWriter{w, r}, r)
+	})
+}
+
+type unavailableWriter struct {
+	http.ResponseWriter
+	r *http.Request
+}
+
+func (w unavailableWriter) WriteHeader(code int) {
+	if code == http.StatusBadRequest && pgstore.Unavailable(w.r.Context()) {
+		w.Header().Set("Retry-After", "1")
+		code = http.StatusServiceUnavailable
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Unwrap lets http.ResponseController reach the writer of the server.
+func (w unavailableWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
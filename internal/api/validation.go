@@ -0,0 +1,17 @@
+package api
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// registerValidations adds the custom rules referenced by the validate tags
+// of the spec request bodies.
+func registerValidations(v *validator.Validate) {
+	// future passes when a time.Time field is after the current time.
+	v.RegisterValidation("future", func(fl validator.FieldLevel) bool {
+		t, ok := fl.Field().Interface().(time.Time)
+		return ok && t.After(time.Now())
+	})
+}
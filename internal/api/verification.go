@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// emailVerificationTTL is how long a verification code can be used.
+const emailVerificationTTL = 24 * time.Hour
+
+// maxEmailVerificationAttempts is how many wrong codes are accepted before a
+// new code must be requested, so codes cannot be guessed.
+const maxEmailVerificationAttempts = 5
+
+// Confirms the e-mail of a participant with the verification code.
+// (POST /participants/{participantId}/verify-email)
+func (api *API) PostParticipantsParticipantIDVerifyEmail(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.VerifyParticipantEmailRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// The attempt is counted before the code is compared, in the same
+	// statement checking the limit, so concurrent guesses cannot exceed it.
+	verification, err := api.store.ClaimEmailVerificationAttempt(r.Context(), pgstore.ClaimEmailVerificationAttemptParams{
+		ParticipantID: id,
+		MaxAttempts:   maxEmailVerificationAttempts,
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			api.logger.Error("failed to count verification attempt", zap.Error(err), zap.String("participant_id", participantID))
+			return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+
+		pending, err := api.hasPendingEmailVerification(r.Context(), id)
+		if err != nil {
+			api.logger.Error("failed to get email verification", zap.Error(err), zap.String("participant_id", participantID))
+			return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+		}
+		if !pending {
+			return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "nenhuma verificação pendente para este participante"})
+		}
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON429Response(spec.Error{Message: "muitas tentativas, peça um novo código ao organizador da viagem"})
+	}
+
+	if time.Now().UTC().After(verification.ExpiresAt.Time) {
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "código expirado, peça um novo código ao organizador da viagem"})
+	}
+
+	if subtle.ConstantTimeCompare([]byte(body.Code), []byte(verification.Code)) != 1 {
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "código inválido"})
+	}
+
+	if err := api.store.VerifyParticipantEmailTx(r.Context(), api.pool, id, pgstore.InvitationEmail{
+		TripID: participant.TripID,
+		Email:  participant.Email,
+	}); err != nil {
+		api.logger.Error("failed to verify participant email", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDVerifyEmailJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostParticipantsParticipantIDVerifyEmailJSON204Response(nil)
+}
+
+// hasPendingEmailVerification tells whether the participant was invited with
+// verification and has not confirmed the code yet.
+func (api *API) hasPendingEmailVerification(ctx context.Context, participantID uuid.UUID) (bool, error) {
+	if _, err := api.store.GetEmailVerification(ctx, participantID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// newEmailVerification draws the 6 digit code sent to email before its
+// invitation to the trip.
+func newEmailVerification(tripID uuid.UUID, email string) (pgstore.CreateEmailVerificationParams, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return pgstore.CreateEmailVerificationParams{}, err
+	}
+
+	return pgstore.CreateEmailVerificationParams{
+		TripID:    tripID,
+		Email:     email,
+		Code:      fmt.Sprintf("%06d", n.Int64()),
+		ExpiresAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(emailVerificationTTL)},
+	}, nil
+}
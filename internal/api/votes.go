@@ -0,0 +1,85 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Upvote a proposed activity.
+// (POST /activities/{activityId}/votes)
+func (api *API) PostActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request, activityID string, params spec.PostActivitiesActivityIDVotesParams) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	tripID, err := api.store.GetActivityTripID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "atividade não encontrada"})
+		}
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if participant.TripID != tripID {
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "participante não faz parte desta viagem"})
+	}
+
+	if err := api.store.VoteActivity(r.Context(), pgstore.VoteActivityParams{
+		ActivityID:    id,
+		ParticipantID: participantID,
+	}); err != nil {
+		api.logger.Error("failed to vote activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostActivitiesActivityIDVotesJSON204Response(nil)
+}
+
+// Remove the participant vote from an activity.
+// (DELETE /activities/{activityId}/votes)
+func (api *API) DeleteActivitiesActivityIDVotes(w http.ResponseWriter, r *http.Request, activityID string, params spec.DeleteActivitiesActivityIDVotesParams) *spec.Response {
+	id, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participantID, err := uuid.Parse(params.XParticipantID)
+	if err != nil {
+		return spec.DeleteActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	deleted, err := api.store.UnvoteActivity(r.Context(), pgstore.UnvoteActivityParams{
+		ActivityID:    id,
+		ParticipantID: participantID,
+	})
+	if err != nil {
+		api.logger.Error("failed to remove activity vote", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteActivitiesActivityIDVotesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if deleted == 0 {
+		return spec.DeleteActivitiesActivityIDVotesJSON404Response(spec.Error{Message: "voto não encontrado"})
+	}
+
+	return spec.DeleteActivitiesActivityIDVotesJSON204Response(nil)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+	"travel-api/internal/api/spec"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Get a trip waitlist.
+// (GET /trips/{tripId}/waitlist)
+func (api *API) GetTripsTripIDWaitlist(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDWaitlistJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	waitlist, err := api.store.GetTripWaitlist(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip waitlist", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWaitlistJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	waitlistRes := make([]spec.GetTripWaitlistResponseArray, len(waitlist))
+
+	for i, entry := range waitlist {
+		waitlistRes[i] = spec.GetTripWaitlistResponseArray{
+			Email:     openapi_types.Email(entry.Email),
+			CreatedAt: entry.CreatedAt.Time,
+		}
+	}
+
+	return spec.GetTripsTripIDWaitlistJSON200Response(spec.GetTripWaitlistResponse{
+		Waitlist: waitlistRes,
+	})
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/weather"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Get the weather forecast of a trip.
+// (GET /trips/{tripId}/weather)
+func (api *API) GetTripsTripIDWeather(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDWeatherJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDWeatherJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWeatherJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if api.forecasts == nil {
+		return spec.GetTripsTripIDWeatherJSON503Response(spec.Error{Message: "previsão do tempo indisponível"})
+	}
+
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	days, err := api.forecasts.Forecast(r.Context(), trip.Destination, trip.StartsAt.Time.In(loc), trip.EndsAt.Time.In(loc))
+	if err != nil {
+		api.logger.Warn("failed to get weather forecast", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWeatherJSON503Response(spec.Error{Message: "previsão do tempo indisponível"})
+	}
+
+	response := spec.GetTripWeatherResponse{
+		Destination: trip.Destination,
+		Days:        make([]spec.GetTripWeatherResponseArray, len(days)),
+	}
+
+	for i, day := range days {
+		response.Days[i] = spec.GetTripWeatherResponseArray{
+			Date:                     openapi_types.Date{Time: day.Date},
+			Condition:                weatherConditionResponse(day.Condition),
+			MinTemperature:           float32(day.MinTemperature),
+			MaxTemperature:           float32(day.MaxTemperature),
+			PrecipitationProbability: day.PrecipitationProbability,
+		}
+	}
+
+	return spec.GetTripsTripIDWeatherJSON200Response(response)
+}
+
+func weatherConditionResponse(condition weather.Condition) spec.WeatherCondition {
+	switch condition {
+	case weather.Clear:
+		return spec.WeatherConditionClear
+	case weather.PartlyCloudy:
+		return spec.WeatherConditionPartlyCloudy
+	case weather.Cloudy:
+		return spec.WeatherConditionCloudy
+	case weather.Fog:
+		return spec.WeatherConditionFog
+	case weather.Rain:
+		return spec.WeatherConditionRain
+	case weather.Snow:
+		return spec.WeatherConditionSnow
+	case weather.Storm:
+		return spec.WeatherConditionStorm
+	}
+	return spec.UnknownWeatherCondition
+}
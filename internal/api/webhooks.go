@@ -0,0 +1,312 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"travel-api/internal/actionlink"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/events"
+	"travel-api/internal/linkpreview"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// defaultDeliveriesLimit and maxDeliveriesLimit bound how many deliveries of
+// a webhook are returned.
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 100
+)
+
+// Get the webhooks of a trip.
+// (GET /trips/{tripId}/webhooks)
+func (api *API) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDWebhooksParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionManageWebhooks, id, false); !ok {
+		return spec.GetTripsTripIDWebhooksJSON403Response(spec.Error{Message: msg})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.GetTripsTripIDWebhooksJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	webhooks, err := api.store.GetTripWebhooks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get webhooks", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.GetTripsTripIDWebhooksJSON200Response(spec.GetWebhooksResponse{Webhooks: webhooksResponse(webhooks)})
+}
+
+// Register a webhook for the events of a trip.
+// (POST /trips/{tripId}/webhooks)
+func (api *API) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDWebhooksParams) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionManageWebhooks, id, false); !ok {
+		return spec.PostTripsTripIDWebhooksJSON403Response(spec.Error{Message: msg})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDWebhooksJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), id, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.PostTripsTripIDWebhooksJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	var body spec.CreateWebhookRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "JSON inválido"})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Invalid input:" + err.Error()})
+	}
+
+	public, err := isPublicURL(r.Context(), body.URL)
+	if err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "não foi possível resolver o endereço do webhook"})
+	}
+	if !public {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "o webhook deve apontar para um endereço público"})
+	}
+
+	webhook, err := api.createWebhook(r.Context(), pgstore.CreateWebhookParams{
+		TripID: id,
+		Url:    body.URL,
+	})
+	if err != nil {
+		api.logger.Error("failed to create webhook", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.PostTripsTripIDWebhooksJSON201Response(webhook)
+}
+
+// Delete a webhook.
+// (DELETE /webhooks/{webhookId})
+func (api *API) DeleteWebhooksWebhookID(w http.ResponseWriter, r *http.Request, webhookID string, params spec.DeleteWebhooksWebhookIDParams) *spec.Response {
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		return spec.DeleteWebhooksWebhookIDJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	webhook, err := api.store.GetWebhook(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteWebhooksWebhookIDJSON404Response(spec.Error{Message: "webhook não encontrado"})
+		}
+		api.logger.Error("failed to get webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.DeleteWebhooksWebhookIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionManageWebhooks, webhook.TripID, false); !ok {
+		return spec.DeleteWebhooksWebhookIDJSON403Response(spec.Error{Message: msg})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), webhook.TripID, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.DeleteWebhooksWebhookIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.DeleteWebhooksWebhookIDJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	if _, err := api.store.DeleteWebhook(r.Context(), id); err != nil {
+		api.logger.Error("failed to delete webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.DeleteWebhooksWebhookIDJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	return spec.DeleteWebhooksWebhookIDJSON204Response(nil)
+}
+
+// Get the latest deliveries of a webhook, newest first.
+// (GET /webhooks/{webhookId}/deliveries)
+func (api *API) GetWebhooksWebhookIDDeliveries(w http.ResponseWriter, r *http.Request, webhookID string, params spec.GetWebhooksWebhookIDDeliveriesParams) *spec.Response {
+	id, err := uuid.Parse(webhookID)
+	if err != nil {
+		return spec.GetWebhooksWebhookIDDeliveriesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	limit := defaultDeliveriesLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxDeliveriesLimit {
+			return spec.GetWebhooksWebhookIDDeliveriesJSON400Response(spec.Error{Message: "limite inválido"})
+		}
+		limit = *params.Limit
+	}
+
+	webhook, err := api.store.GetWebhook(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetWebhooksWebhookIDDeliveriesJSON404Response(spec.Error{Message: "webhook não encontrado"})
+		}
+		api.logger.Error("failed to get webhook", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.GetWebhooksWebhookIDDeliveriesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	if msg, ok := api.checkActionToken(&params.Token, actionlink.ActionManageWebhooks, webhook.TripID, false); !ok {
+		return spec.GetWebhooksWebhookIDDeliveriesJSON403Response(spec.Error{Message: msg})
+	}
+
+	isOwner, err := api.isTripOwner(r.Context(), webhook.TripID, params.XOwnerEmail)
+	if err != nil {
+		api.logger.Error("failed to check trip owner", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.GetWebhooksWebhookIDDeliveriesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+	if !isOwner {
+		return spec.GetWebhooksWebhookIDDeliveriesJSON403Response(spec.Error{Message: errNotTripOwner})
+	}
+
+	deliveries, err := api.store.GetWebhookDeliveries(r.Context(), pgstore.GetWebhookDeliveriesParams{
+		WebhookID: id,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		api.logger.Error("failed to get webhook deliveries", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.GetWebhooksWebhookIDDeliveriesJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	response := spec.GetWebhookDeliveriesResponse{
+		Deliveries: make([]spec.GetWebhookDeliveriesResponseArray, len(deliveries)),
+	}
+
+	for i, delivery := range deliveries {
+		var event spec.WebhookEvent
+		_ = event.FromValue(events.WebhookEvents[delivery.Kind])
+
+		var status spec.WebhookDeliveryStatus
+		_ = status.FromValue(string(delivery.Status))
+
+		response.Deliveries[i] = spec.GetWebhookDeliveriesResponseArray{
+			ID:            delivery.ID.String(),
+			EventID:       delivery.EventID.String(),
+			Event:         event,
+			TripID:        delivery.TripID.String(),
+			Status:        status,
+			Attempts:      int(delivery.Attempts),
+			NextAttemptAt: delivery.NextAttemptAt.Time,
+			CreatedAt:     delivery.CreatedAt.Time,
+		}
+		if delivery.ResponseStatus.Valid {
+			responseStatus := int(delivery.ResponseStatus.Int32)
+			response.Deliveries[i].ResponseStatus = &responseStatus
+		}
+		if delivery.LastError.Valid {
+			response.Deliveries[i].LastError = &delivery.LastError.String
+		}
+		if delivery.DeliveredAt.Valid {
+			response.Deliveries[i].DeliveredAt = &delivery.DeliveredAt.Time
+		}
+	}
+
+	return spec.GetWebhooksWebhookIDDeliveriesJSON200Response(response)
+}
+
+// createWebhook creates the webhook with a new secret, returned only then.
+func (api *API) createWebhook(ctx context.Context, arg pgstore.CreateWebhookParams) (spec.CreateWebhookResponse, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return spec.CreateWebhookResponse{}, err
+	}
+	arg.Secret = secret
+
+	webhook, err := api.store.CreateWebhook(ctx, arg)
+	if err != nil {
+		return spec.CreateWebhookResponse{}, err
+	}
+
+	response := webhookResponse(webhook)
+
+	return spec.CreateWebhookResponse{
+		ID:        response.ID,
+		TripID:    response.TripID,
+		URL:       response.URL,
+		Secret:    webhook.Secret,
+		CreatedAt: response.CreatedAt,
+	}, nil
+}
+
+// isPublicURL reports whether the host of rawURL only resolves to public
+// addresses. The posts are guarded when they connect too, as the host may
+// resolve elsewhere later.
+func isPublicURL(ctx context.Context, rawURL string) (bool, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false, err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return false, err
+	}
+
+	for _, addr := range addrs {
+		if !linkpreview.IsPublic(addr.IP) {
+			return false, nil
+		}
+	}
+
+	return len(addrs) > 0, nil
+}
+
+// newWebhookSecret returns a random secret to sign the payloads with.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func webhookResponse(webhook pgstore.Webhook) spec.GetWebhooksResponseArray {
+	return spec.GetWebhooksResponseArray{
+		ID:        webhook.ID.String(),
+		TripID:    webhook.TripID.String(),
+		URL:       webhook.Url,
+		CreatedAt: webhook.CreatedAt.Time,
+	}
+}
+
+func webhooksResponse(webhooks []pgstore.Webhook) []spec.GetWebhooksResponseArray {
+	response := make([]spec.GetWebhooksResponseArray, len(webhooks))
+	for i, webhook := range webhooks {
+		response[i] = webhookResponse(webhook)
+	}
+
+	return response
+}
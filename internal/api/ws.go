@@ -0,0 +1,115 @@
+package api
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/live"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+// wsEvent is a message of the WebSocket of a trip, and of its events stream:
+// a change, named like the events of the live stream, or a resync or ping
+// without one.
+type wsEvent struct {
+	Event string `json:"event"`
+	*live.Change
+}
+
+func newWSEvent(change live.Change) wsEvent {
+	event := wsEvent{Event: changeEvent(change)}
+	if change != live.Resync {
+		event.Change = &change
+	}
+
+	return event
+}
+
+// Stream the changes of a trip over a WebSocket.
+// (GET /trips/{tripId}/ws)
+func (api *API) GetTripsTripIDWs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDWsJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	if _, err := api.store.GetTrip(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDWsJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWsJSON400Response(spec.Error{Message: "Algo deu errado, tente novamente"})
+	}
+
+	// Without a Handshake the origin is not checked: any page may watch a
+	// trip, like it may fetch it.
+	server := websocket.Server{
+		Handler: func(ws *websocket.Conn) {
+			api.streamChanges(ws, id)
+		},
+	}
+	server.ServeHTTP(hijacker{w}, r)
+
+	return nil
+}
+
+// streamChanges sends the changes of the trip over ws until the client goes
+// away.
+func (api *API) streamChanges(ws *websocket.Conn, tripID uuid.UUID) {
+	// The connection outlives the deadlines of the request it was hijacked
+	// from.
+	if err := ws.SetDeadline(time.Time{}); err != nil {
+		api.logger.Error("failed to disable websocket deadline", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+
+	changes, unsubscribe := api.changes.Subscribe(tripID)
+	defer unsubscribe()
+
+	// Clients only send the close of the connection, which ends the reads.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+
+		var discard []byte
+		for websocket.Message.Receive(ws, &discard) == nil {
+		}
+	}()
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		var event wsEvent
+
+		select {
+		case <-closed:
+			return
+		case <-heartbeat.C:
+			event = wsEvent{Event: "ping"}
+		case change := <-changes:
+			event = newWSEvent(change)
+		}
+
+		if err := websocket.JSON.Send(ws, event); err != nil {
+			return
+		}
+	}
+}
+
+// hijacker lets the WebSocket server take over the connection through the
+// writers wrapping it, which it expects to implement http.Hijacker.
+type hijacker struct {
+	http.ResponseWriter
+}
+
+func (h hijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(h.ResponseWriter).Hijack()
+}
@@ -0,0 +1,169 @@
+// Package cache keeps the trips, their activities and their participants in
+// Redis, in front of the queries the trip details page of the frontend
+// hammers.
+//
+// The API drops the entries of a trip as soon as it changes the trip. The
+// changes made elsewhere, by the background jobs or by another server, drop
+// them when Postgres notifies them on trip_changes. Entries expire after their
+// TTL regardless, which bounds how stale they get if a notification is missed.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+	"travel-api/internal/live"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// keyPrefix starts every key of the cache. Bump its version when the cached
+// models change, for the entries of the previous models to be ignored.
+const keyPrefix = "travel:v2:"
+
+// The kinds of cached entries.
+const (
+	KindTrip         = "trip"
+	KindActivities   = "activities"
+	KindParticipants = "participants"
+)
+
+// TTLs are how long the entries of each kind are kept.
+type TTLs struct {
+	Trip         time.Duration
+	Activities   time.Duration
+	Participants time.Duration
+}
+
+// Stats counts the lookups of a kind of entry.
+type Stats struct {
+	Hits   int64
+	Misses int64
+	// Errors counts the lookups Redis failed, which fell back to the
+	// database.
+	Errors int64
+}
+
+type counters struct {
+	hits, misses, errors atomic.Int64
+}
+
+// Cache is the Redis cache of the trips.
+type Cache struct {
+	redis  *Redis
+	ttls   TTLs
+	logger *zap.Logger
+	stats  map[string]*counters
+}
+
+func New(redis *Redis, ttls TTLs, logger *zap.Logger) *Cache {
+	return &Cache{
+		redis:  redis,
+		ttls:   ttls,
+		logger: logger,
+		stats: map[string]*counters{
+			KindTrip:         {},
+			KindActivities:   {},
+			KindParticipants: {},
+		},
+	}
+}
+
+// Stats returns the stats of each kind of entry since the cache was created.
+func (c *Cache) Stats() map[string]Stats {
+	stats := make(map[string]Stats, len(c.stats))
+	for kind, counters := range c.stats {
+		stats[kind] = Stats{
+			Hits:   counters.hits.Load(),
+			Misses: counters.misses.Load(),
+			Errors: counters.errors.Load(),
+		}
+	}
+
+	return stats
+}
+
+func key(kind string, tripID uuid.UUID) string {
+	return keyPrefix + kind + ":" + tripID.String()
+}
+
+// cached returns the entry of kind for the trip, loading and caching it on a
+// miss. Redis failing is logged and the entry loaded, never failing the read.
+func cached[T any](ctx context.Context, c *Cache, kind string, tripID uuid.UUID, ttl time.Duration, load func() (T, error)) (T, error) {
+	counters := c.stats[kind]
+	k := key(kind, tripID)
+
+	data, ok, err := c.redis.Get(ctx, k)
+	if err != nil {
+		counters.errors.Add(1)
+		c.logger.Warn("failed to read cache", zap.Error(err), zap.String("key", k))
+		return load()
+	}
+
+	if ok {
+		var v T
+		if err := json.Unmarshal(data, &v); err == nil {
+			counters.hits.Add(1)
+			return v, nil
+		}
+	}
+
+	counters.misses.Add(1)
+
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	if data, err := json.Marshal(v); err == nil {
+		if err := c.redis.Set(ctx, k, data, ttl); err != nil {
+			counters.errors.Add(1)
+			c.logger.Warn("failed to write cache", zap.Error(err), zap.String("key", k))
+		}
+	}
+
+	return v, nil
+}
+
+// Invalidate drops the entries of the trip. The change that made them stale
+// is already written, so Redis failing is logged rather than returned, the
+// entries then expiring with their TTL.
+func (c *Cache) Invalidate(ctx context.Context, tripID uuid.UUID) {
+	keys := make([]string, 0, len(c.stats))
+	for kind := range c.stats {
+		keys = append(keys, key(kind, tripID))
+	}
+
+	if err := c.redis.Del(ctx, keys...); err != nil {
+		c.logger.Error("failed to invalidate cache", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+// Run drops the entries of the trips changes notifies, until ctx is done.
+func (c *Cache) Run(ctx context.Context, changes interface {
+	Subscribe(tripID uuid.UUID) (<-chan live.Change, func())
+}) {
+	// Subscribing to uuid.Nil gets the changes of every trip.
+	notified, unsubscribe := changes.Subscribe(uuid.Nil)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-notified:
+			if change == live.Resync {
+				// The changes missed meanwhile are left to the TTLs.
+				continue
+			}
+			if change.Table == "links" || change.Table == "messages" || change.Table == "packing_items" || change.Table == "tasks" || change.Table == "bookings" || change.Table == "transport_segments" || change.Table == "photos" {
+				// None is cached.
+				continue
+			}
+
+			c.Invalidate(ctx, change.TripID)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxIdleConns is how many connections to Redis are kept open between
+// commands.
+const maxIdleConns = 16
+
+// Redis is a client of the few Redis commands the cache needs, spoken over
+// RESP with a small pool of connections.
+type Redis struct {
+	addr     string
+	username string
+	password string
+	db       int
+	timeout  time.Duration
+	idle     chan *redisConn
+}
+
+type redisConn struct {
+	net.Conn
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+// redisError is an error answered by Redis, which leaves the connection
+// usable.
+type redisError string
+
+func (e redisError) Error() string {
+	return "cache: redis: " + string(e)
+}
+
+// NewRedis returns a client of the Redis at rawURL, such as
+// redis://:password@localhost:6379/0. Commands taking longer than timeout
+// fail.
+func NewRedis(rawURL string, timeout time.Duration) (*Redis, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("cache: invalid redis url: %w", err)
+	}
+
+	if u.Scheme != "redis" {
+		return nil, fmt.Errorf("cache: unsupported redis url scheme: %q", u.Scheme)
+	}
+
+	r := &Redis{
+		addr:    u.Host,
+		timeout: timeout,
+		idle:    make(chan *redisConn, maxIdleConns),
+	}
+
+	if u.Port() == "" {
+		r.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+
+	if u.User != nil {
+		r.username = u.User.Username()
+		r.password, _ = u.User.Password()
+	}
+
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if r.db, err = strconv.Atoi(db); err != nil || r.db < 0 {
+			return nil, fmt.Errorf("cache: invalid redis database: %q", db)
+		}
+	}
+
+	return r, nil
+}
+
+// Ping checks Redis answers.
+func (r *Redis) Ping(ctx context.Context) error {
+	_, err := r.do(ctx, "PING")
+	return err
+}
+
+// Get returns the value of key, and whether it is set.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.do(ctx, "GET", key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("cache: unexpected redis reply to GET: %T", reply)
+	}
+
+	return value, true, nil
+}
+
+// Set sets key to value for ttl.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := r.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// Del deletes keys.
+func (r *Redis) Del(ctx context.Context, keys ...string) error {
+	_, err := r.do(ctx, append([]string{"DEL"}, keys...)...)
+	return err
+}
+
+// do runs a command and returns its reply: a string, an int64, a []byte or
+// nil.
+func (r *Redis) do(ctx context.Context, args ...string) (any, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.do(ctx, r.timeout, args)
+
+	var redisErr redisError
+	if err != nil && !errors.As(err, &redisErr) {
+		_ = conn.Close()
+		return nil, fmt.Errorf("cache: redis %s: %w", args[0], err)
+	}
+
+	select {
+	case r.idle <- conn:
+	default:
+		_ = conn.Close()
+	}
+
+	return reply, err
+}
+
+// conn returns an idle connection, or a new one.
+func (r *Redis) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-r.idle:
+		return conn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: r.timeout}
+	c, err := dialer.DialContext(ctx, "tcp", r.addr)
+	if err != nil {
+		return nil, fmt.Errorf("cache: failed to connect to redis: %w", err)
+	}
+
+	conn := &redisConn{c, bufio.NewReader(c), bufio.NewWriter(c)}
+
+	if r.password != "" {
+		auth := []string{"AUTH", r.password}
+		if r.username != "" {
+			auth = []string{"AUTH", r.username, r.password}
+		}
+
+		if _, err := conn.do(ctx, r.timeout, auth); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("cache: failed to authenticate to redis: %w", err)
+		}
+	}
+
+	if r.db != 0 {
+		if _, err := conn.do(ctx, r.timeout, []string{"SELECT", strconv.Itoa(r.db)}); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("cache: failed to select redis database: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (c *redisConn) do(ctx context.Context, timeout time.Duration, args []string) (any, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(c.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.w, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return c.read()
+}
+
+// read reads a RESP2 reply. Arrays are not read, since none of the commands
+// above answers one.
+func (c *redisConn) read() (any, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("empty redis reply")
+	}
+
+	switch kind, rest := line[0], line[1:]; kind {
+	case '+':
+		return rest, nil
+	case '-':
+		return nil, redisError(rest)
+	case ':':
+		return strconv.ParseInt(rest, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+
+		value := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, value); err != nil {
+			return nil, err
+		}
+
+		if string(value[n:]) != "\r\n" {
+			return nil, errors.New("unterminated redis bulk reply")
+		}
+
+		return value[:n], nil
+	default:
+		return nil, fmt.Errorf("unexpected redis reply: %q", line)
+	}
+}
@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRedisConnRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		want    any
+		wantErr bool
+	}{
+		{name: "simple string", reply: "+OK\r\n", want: "OK"},
+		{name: "integer", reply: ":2\r\n", want: int64(2)},
+		{name: "bulk string", reply: "$5\r\nhello\r\n", want: []byte("hello")},
+		{name: "bulk string with crlf", reply: "$7\r\nhel\r\nlo\r\n", want: []byte("hel\r\nlo")},
+		{name: "empty bulk string", reply: "$0\r\n\r\n", want: []byte{}},
+		{name: "null bulk string", reply: "$-1\r\n", want: nil},
+		{name: "error", reply: "-WRONGTYPE wrong kind of value\r\n", wantErr: true},
+		{name: "invalid integer", reply: ":two\r\n", wantErr: true},
+		{name: "invalid bulk length", reply: "$five\r\nhello\r\n", wantErr: true},
+		{name: "short bulk string", reply: "$5\r\nhel", wantErr: true},
+		{name: "unterminated bulk string", reply: "$5\r\nhello!!", wantErr: true},
+		{name: "array", reply: "*1\r\n+OK\r\n", wantErr: true},
+		{name: "empty reply", reply: "\r\n", wantErr: true},
+		{name: "closed connection", reply: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &redisConn{r: bufio.NewReader(strings.NewReader(tt.reply))}
+
+			got, err := c.read()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("read() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("read() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedisConnReadError(t *testing.T) {
+	c := &redisConn{r: bufio.NewReader(strings.NewReader("-ERR unknown command\r\n+OK\r\n"))}
+
+	_, err := c.read()
+
+	var redisErr redisError
+	if !errors.As(err, &redisErr) || string(redisErr) != "ERR unknown command" {
+		t.Fatalf("read() error = %v, want redisError", err)
+	}
+
+	// The connection is left at the next reply.
+	if got, err := c.read(); err != nil || got != "OK" {
+		t.Errorf("read() after error = %#v, %v, want OK", got, err)
+	}
+}
+
+// fakeRedis answers the commands sent on a single connection with the
+// replies, in order, and records them.
+func fakeRedis(t *testing.T, replies ...string) (string, <-chan []string) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	commands := make(chan []string, len(replies))
+
+	go func() {
+		defer close(commands)
+
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for _, reply := range replies {
+			command, err := readCommand(r)
+			if err != nil {
+				return
+			}
+			commands <- command
+
+			if _, err := io.WriteString(conn, reply); err != nil {
+				return
+			}
+		}
+	}()
+
+	return l.Addr().String(), commands
+}
+
+// readCommand reads a command as sent by redisConn.do.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	c := &redisConn{r: r}
+
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "*"), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	command := make([]string, n)
+	for i := range command {
+		arg, err := c.read()
+		if err != nil {
+			return nil, err
+		}
+		command[i] = string(arg.([]byte))
+	}
+
+	return command, nil
+}
+
+func TestRedis(t *testing.T) {
+	addr, commands := fakeRedis(t,
+		"+OK\r\n",
+		"+OK\r\n",
+		"+OK\r\n",
+		"$5\r\nvalue\r\n",
+		"$-1\r\n",
+		":1\r\n",
+	)
+
+	r, err := NewRedis("redis://user:secret@"+addr+"/2", time.Second)
+	if err != nil {
+		t.Fatalf("NewRedis() error = %v", err)
+	}
+
+	ctx := context.Background()
+
+	if err := r.Set(ctx, "key", []byte("value"), time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	value, ok, err := r.Get(ctx, "key")
+	if err != nil || !ok || string(value) != "value" {
+		t.Errorf("Get() = %q, %v, %v, want value, true", value, ok, err)
+	}
+
+	if _, ok, err := r.Get(ctx, "missing"); err != nil || ok {
+		t.Errorf("Get() of a missing key = %v, %v, want false", ok, err)
+	}
+
+	if err := r.Del(ctx, "key", "missing"); err != nil {
+		t.Errorf("Del() error = %v", err)
+	}
+
+	want := [][]string{
+		{"AUTH", "user", "secret"},
+		{"SELECT", "2"},
+		{"SET", "key", "value", "PX", "60000"},
+		{"GET", "key"},
+		{"GET", "missing"},
+		{"DEL", "key", "missing"},
+	}
+
+	for _, w := range want {
+		if got := <-commands; !reflect.DeepEqual(got, w) {
+			t.Errorf("command = %q, want %q", got, w)
+		}
+	}
+}
+
+func TestNewRedis(t *testing.T) {
+	tests := []struct {
+		url     string
+		addr    string
+		db      int
+		wantErr bool
+	}{
+		{url: "redis://localhost", addr: "localhost:6379"},
+		{url: "redis://:secret@cache:6380/3", addr: "cache:6380", db: 3},
+		{url: "rediss://localhost", wantErr: true},
+		{url: "redis://localhost/db", wantErr: true},
+		{url: "redis://localhost/-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			r, err := NewRedis(tt.url, time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRedis() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if r.addr != tt.addr || r.db != tt.db {
+				t.Errorf("NewRedis() = %s db %d, want %s db %d", r.addr, r.db, tt.addr, tt.db)
+			}
+		})
+	}
+}
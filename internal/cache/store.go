@@ -0,0 +1,205 @@
+package cache
+
+import (
+	"context"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// Store reads the trips, their unfiltered activities and their participants
+// through the cache, and drops the entries of a trip after changing it.
+type Store struct {
+	*pgstore.Store
+	cache *Cache
+}
+
+// Store returns s behind the cache.
+func (c *Cache) Store(s *pgstore.Store) *Store {
+	return &Store{s, c}
+}
+
+func (s *Store) GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	return cached(ctx, s.cache, KindTrip, id, s.cache.ttls.Trip, func() (pgstore.Trip, error) {
+		return s.Store.GetTrip(ctx, id)
+	})
+}
+
+func (s *Store) GetTripActivities(ctx context.Context, arg pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error) {
+	// Only the whole itinerary is cached, the filtered ones being rarer.
+	if arg != (pgstore.GetTripActivitiesParams{TripID: arg.TripID}) {
+		return s.Store.GetTripActivities(ctx, arg)
+	}
+
+	return cached(ctx, s.cache, KindActivities, arg.TripID, s.cache.ttls.Activities, func() ([]pgstore.Activity, error) {
+		return s.Store.GetTripActivities(ctx, arg)
+	})
+}
+
+func (s *Store) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	return cached(ctx, s.cache, KindParticipants, tripID, s.cache.ttls.Participants, func() ([]pgstore.Participant, error) {
+		return s.Store.GetParticipants(ctx, tripID)
+	})
+}
+
+// invalidate drops the entries of the trip once a change of it succeeded.
+func (s *Store) invalidate(ctx context.Context, tripID uuid.UUID, err error) {
+	if err == nil {
+		s.cache.Invalidate(ctx, tripID)
+	}
+}
+
+// invalidateParticipant drops the entries of the trip of the participant once
+// a change of the participant succeeded.
+func (s *Store) invalidateParticipant(ctx context.Context, participantID uuid.UUID, err error) {
+	if err != nil {
+		return
+	}
+
+	participant, err := s.Queries.GetParticipant(ctx, participantID)
+	if err != nil {
+		s.cache.logger.Error("failed to get participant to invalidate cache", zap.Error(err), zap.String("participant_id", participantID.String()))
+		return
+	}
+
+	s.cache.Invalidate(ctx, participant.TripID)
+}
+
+func (s *Store) UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) (int64, error) {
+	updated, err := s.Store.UpdateTrip(ctx, arg)
+	s.invalidate(ctx, arg.ID, err)
+	return updated, err
+}
+
+func (s *Store) UpdateTripPartialTx(ctx context.Context, pool *pgxpool.Pool, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	updated, err := s.Store.UpdateTripPartialTx(ctx, pool, arg)
+	s.invalidate(ctx, arg.ID, err)
+	return updated, err
+}
+
+func (s *Store) UpdateTripStatus(ctx context.Context, arg pgstore.UpdateTripStatusParams) error {
+	err := s.Store.UpdateTripStatus(ctx, arg)
+	s.invalidate(ctx, arg.ID, err)
+	return err
+}
+
+func (s *Store) UpdateTripPlace(ctx context.Context, arg pgstore.UpdateTripPlaceParams) error {
+	err := s.Store.UpdateTripPlace(ctx, arg)
+	s.invalidate(ctx, arg.ID, err)
+	return err
+}
+
+func (s *Store) ConfirmTripTx(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, from, to pgstore.TripStatus) (bool, error) {
+	confirmed, err := s.Store.ConfirmTripTx(ctx, pool, tripID, from, to)
+	s.invalidate(ctx, tripID, err)
+	return confirmed, err
+}
+
+func (s *Store) ApplyPollTx(ctx context.Context, pool *pgxpool.Pool, pollID uuid.UUID, update pgstore.UpdateTripPartialParams) (bool, error) {
+	applied, err := s.Store.ApplyPollTx(ctx, pool, pollID, update)
+	s.invalidate(ctx, update.ID, err)
+	return applied, err
+}
+
+func (s *Store) CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	id, err := s.Store.CreateActivity(ctx, arg)
+	s.invalidate(ctx, arg.TripID, err)
+	return id, err
+}
+
+func (s *Store) CreateActivitiesTx(ctx context.Context, pool *pgxpool.Pool, params []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	ids, err := s.Store.CreateActivitiesTx(ctx, pool, params)
+	if len(params) > 0 {
+		s.invalidate(ctx, params[0].TripID, err)
+	}
+	return ids, err
+}
+
+func (s *Store) UpdateActivity(ctx context.Context, arg pgstore.UpdateActivityParams) error {
+	if err := s.Store.UpdateActivity(ctx, arg); err != nil {
+		return err
+	}
+
+	tripID, err := s.Queries.GetActivityTripID(ctx, arg.ID)
+	if err != nil {
+		s.cache.logger.Error("failed to get activity trip to invalidate cache", zap.Error(err), zap.String("activity_id", arg.ID.String()))
+		return nil
+	}
+
+	s.cache.Invalidate(ctx, tripID)
+	return nil
+}
+
+func (s *Store) DeleteActivity(ctx context.Context, arg pgstore.DeleteActivityParams) (int64, error) {
+	deleted, err := s.Store.DeleteActivity(ctx, arg)
+	s.invalidate(ctx, arg.TripID, err)
+	return deleted, err
+}
+
+func (s *Store) RestoreActivity(ctx context.Context, arg pgstore.RestoreActivityParams) (int64, error) {
+	restored, err := s.Store.RestoreActivity(ctx, arg)
+	s.invalidate(ctx, arg.TripID, err)
+	return restored, err
+}
+
+func (s *Store) ReorderActivitiesTx(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, activityIDs []uuid.UUID) error {
+	err := s.Store.ReorderActivitiesTx(ctx, pool, tripID, activityIDs)
+	s.invalidate(ctx, tripID, err)
+	return err
+}
+
+func (s *Store) InviteParticipantsTx(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, participants []pgstore.InviteParticipantsToTripParams, verifications []pgstore.CreateEmailVerificationParams) ([]string, error) {
+	waitlisted, err := s.Store.InviteParticipantsTx(ctx, pool, tripID, participants, verifications)
+	s.invalidate(ctx, tripID, err)
+	return waitlisted, err
+}
+
+func (s *Store) JoinTripTx(ctx context.Context, pool *pgxpool.Pool, arg pgstore.InsertParticipantParams) (bool, error) {
+	waitlisted, err := s.Store.JoinTripTx(ctx, pool, arg)
+	s.invalidate(ctx, arg.TripID, err)
+	return waitlisted, err
+}
+
+func (s *Store) UpdateParticipantProfile(ctx context.Context, arg pgstore.UpdateParticipantProfileParams) error {
+	err := s.Store.UpdateParticipantProfile(ctx, arg)
+	s.invalidateParticipant(ctx, arg.ID, err)
+	return err
+}
+
+func (s *Store) ConfirmParticipantTx(ctx context.Context, pool *pgxpool.Pool, arg pgstore.ConfirmParticipantParams) error {
+	err := s.Store.ConfirmParticipantTx(ctx, pool, arg)
+	s.invalidateParticipant(ctx, arg.ID, err)
+	return err
+}
+
+func (s *Store) UnconfirmParticipantTx(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, reason string) error {
+	err := s.Store.UnconfirmParticipantTx(ctx, pool, participantID, reason)
+	s.invalidateParticipant(ctx, participantID, err)
+	return err
+}
+
+func (s *Store) DeclineParticipantTx(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, arg pgstore.DeclineParticipantParams) error {
+	err := s.Store.DeclineParticipantTx(ctx, pool, tripID, arg)
+	s.invalidate(ctx, tripID, err)
+	return err
+}
+
+func (s *Store) ReinviteParticipantTx(ctx context.Context, pool *pgxpool.Pool, arg pgstore.MarkParticipantReinvitedParams, invitation pgstore.InvitationEmail) (int64, error) {
+	updated, err := s.Store.ReinviteParticipantTx(ctx, pool, arg, invitation)
+	s.invalidate(ctx, invitation.TripID, err)
+	return updated, err
+}
+
+func (s *Store) ResendEmailVerificationTx(ctx context.Context, pool *pgxpool.Pool, arg pgstore.MarkParticipantReinvitedParams, verification pgstore.CreateEmailVerificationParams) (int64, error) {
+	updated, err := s.Store.ResendEmailVerificationTx(ctx, pool, arg, verification)
+	s.invalidate(ctx, verification.TripID, err)
+	return updated, err
+}
+
+func (s *Store) VerifyParticipantEmailTx(ctx context.Context, pool *pgxpool.Pool, participantID uuid.UUID, invitation pgstore.InvitationEmail) error {
+	err := s.Store.VerifyParticipantEmailTx(ctx, pool, participantID, invitation)
+	s.invalidate(ctx, invitation.TripID, err)
+	return err
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ActivityCategory groups activities by what kind of plan they are.
+type ActivityCategory string
+
+const (
+	ActivityCategoryFood        ActivityCategory = "food"
+	ActivityCategoryTransport   ActivityCategory = "transport"
+	ActivityCategorySightseeing ActivityCategory = "sightseeing"
+	ActivityCategoryLodging     ActivityCategory = "lodging"
+	ActivityCategoryOther       ActivityCategory = "other"
+)
+
+var ErrUnknownActivityCategory = errors.New("unknown activity category")
+
+// ParseActivityCategory converts s into an ActivityCategory, failing with
+// ErrUnknownActivityCategory when s is not one of the known categories.
+func ParseActivityCategory(s string) (ActivityCategory, error) {
+	switch c := ActivityCategory(s); c {
+	case ActivityCategoryFood,
+		ActivityCategoryTransport,
+		ActivityCategorySightseeing,
+		ActivityCategoryLodging,
+		ActivityCategoryOther:
+		return c, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownActivityCategory, s)
+}
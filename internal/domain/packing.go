@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PackingSuggestion is an item suggested for the packing list of a trip.
+type PackingSuggestion struct {
+	Name     string
+	Quantity int
+}
+
+// maxClothesDays is how many days of clothes are suggested at most, longer
+// trips being expected to do laundry.
+const maxClothesDays = 7
+
+// laundryDays is the length of trip from which laundry items are suggested.
+const laundryDays = 10
+
+// packingTheme suggests items for the destinations matching its keywords.
+type packingTheme struct {
+	keywords []string
+	items    []string
+}
+
+// packingThemes are matched against the words of the destination, without
+// their accents and in lower case.
+var packingThemes = []packingTheme{
+	{
+		keywords: []string{"praia", "beach", "playa", "ilha", "island", "isla", "litoral", "coast", "costa", "caribe", "caribbean", "nordeste"},
+		items:    []string{"Protetor solar", "Roupa de banho", "Chinelo", "Óculos de sol", "Toalha de praia", "Chapéu"},
+	},
+	{
+		keywords: []string{"neve", "snow", "nieve", "ski", "esqui", "alpes", "alps", "bariloche", "patagonia", "andes", "aspen", "inverno", "winter"},
+		items:    []string{"Casaco impermeável", "Luvas", "Gorro", "Cachecol", "Roupa térmica", "Botas"},
+	},
+	{
+		keywords: []string{"montanha", "mountain", "montana", "serra", "trilha", "trail", "trek", "camping", "acampamento", "chapada", "parque nacional", "national park"},
+		items:    []string{"Tênis de trilha", "Mochila de ataque", "Lanterna", "Repelente", "Garrafa de água", "Casaco corta-vento"},
+	},
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "ã", "a", "â", "a", "é", "e", "ê", "e", "í", "i",
+	"ó", "o", "õ", "o", "ô", "o", "ú", "u", "ü", "u", "ç", "c", "ñ", "n",
+)
+
+// SuggestPacking returns the items suggested for a trip to destination
+// between startsAt and endsAt: the essentials, the clothes for as many days as
+// the trip lasts, up to maxClothesDays, and the items of the themes the
+// destination matches, such as beaches or snow.
+func SuggestPacking(destination string, startsAt, endsAt time.Time) []PackingSuggestion {
+	days := tripDays(startsAt, endsAt)
+	clothes := min(days, maxClothesDays)
+
+	suggestions := []PackingSuggestion{
+		{"Documento de identidade ou passaporte", 1},
+		{"Carregador de celular", 1},
+		{"Escova de dentes", 1},
+		{"Pasta de dente", 1},
+		{"Remédios de uso pessoal", 1},
+		{"Roupa íntima", clothes},
+		{"Meias", clothes},
+		{"Camisetas", clothes},
+		{"Calças ou bermudas", (clothes + 2) / 3},
+		{"Pijama", 1},
+	}
+
+	if days >= laundryDays {
+		suggestions = append(suggestions,
+			PackingSuggestion{"Sabão para lavar roupa", 1},
+			PackingSuggestion{"Saco para roupa suja", 1},
+		)
+	}
+
+	place := words(destination)
+	seen := make(map[string]bool)
+	for _, theme := range packingThemes {
+		if !matchesAny(place, theme.keywords) {
+			continue
+		}
+
+		for _, item := range theme.items {
+			if !seen[item] {
+				seen[item] = true
+				suggestions = append(suggestions, PackingSuggestion{item, 1})
+			}
+		}
+	}
+
+	return suggestions
+}
+
+// tripDays returns how many calendar days a trip lasts, at least one.
+func tripDays(startsAt, endsAt time.Time) int {
+	start := time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(endsAt.Year(), endsAt.Month(), endsAt.Day(), 0, 0, 0, 0, time.UTC)
+
+	return max(int(end.Sub(start).Hours()/24)+1, 1)
+}
+
+// words returns the words of s without their accents and in lower case,
+// separated and surrounded by single spaces for keywords to be matched whole.
+func words(s string) string {
+	fields := strings.FieldsFunc(accents.Replace(strings.ToLower(s)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return " " + strings.Join(fields, " ") + " "
+}
+
+func matchesAny(words string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(words, " "+keyword+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package domain
+
+import "sort"
+
+// Transfer is a payment settling a debt: From owes To Amount, in the minor
+// unit of their currency.
+type Transfer struct {
+	From   string
+	To     string
+	Amount int64
+}
+
+// Split divides amount evenly between n people. The cents that cannot be
+// divided go to the first ones, one each, so the shares always add up to
+// amount.
+func Split(amount int64, n int) []int64 {
+	shares := make([]int64, n)
+	if n == 0 {
+		return shares
+	}
+
+	share, rest := amount/int64(n), amount%int64(n)
+	for i := range shares {
+		shares[i] = share
+		if int64(i) < rest {
+			shares[i]++
+		}
+	}
+
+	return shares
+}
+
+// Settle returns the transfers evening out balances, which are what each
+// person paid less what they owe and must add up to zero. The largest debt
+// is repeatedly paid to the largest credit, which settles everyone in at most
+// one transfer less than there are people with a balance.
+func Settle(balances map[string]int64) []Transfer {
+	type balance struct {
+		id     string
+		amount int64
+	}
+
+	var debtors, creditors []balance
+	for id, amount := range balances {
+		switch {
+		case amount < 0:
+			debtors = append(debtors, balance{id, -amount})
+		case amount > 0:
+			creditors = append(creditors, balance{id, amount})
+		}
+	}
+
+	// Ties are broken by ID for the same balances to always give the same
+	// transfers.
+	byAmount := func(b []balance) func(i, j int) bool {
+		return func(i, j int) bool {
+			if b[i].amount != b[j].amount {
+				return b[i].amount > b[j].amount
+			}
+			return b[i].id < b[j].id
+		}
+	}
+
+	var transfers []Transfer
+	for len(debtors) > 0 && len(creditors) > 0 {
+		sort.Slice(debtors, byAmount(debtors))
+		sort.Slice(creditors, byAmount(creditors))
+
+		debtor, creditor := &debtors[0], &creditors[0]
+		amount := min(debtor.amount, creditor.amount)
+		transfers = append(transfers, Transfer{From: debtor.id, To: creditor.id, Amount: amount})
+
+		debtor.amount -= amount
+		creditor.amount -= amount
+		if debtor.amount == 0 {
+			debtors = debtors[1:]
+		}
+		if creditor.amount == 0 {
+			creditors = creditors[1:]
+		}
+	}
+
+	return transfers
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TripStatus is the lifecycle status of a trip.
+type TripStatus string
+
+const (
+	TripStatusDraft     TripStatus = "draft"
+	TripStatusConfirmed TripStatus = "confirmed"
+	TripStatusOngoing   TripStatus = "ongoing"
+	TripStatusCompleted TripStatus = "completed"
+	TripStatusCancelled TripStatus = "cancelled"
+)
+
+var (
+	ErrUnknownTripStatus = errors.New("unknown trip status")
+	ErrInvalidTransition = errors.New("invalid trip status transition")
+)
+
+// tripTransitions lists, for every status, the statuses a trip can move to.
+// Completed and cancelled trips are final.
+var tripTransitions = map[TripStatus][]TripStatus{
+	TripStatusDraft:     {TripStatusConfirmed, TripStatusCancelled},
+	TripStatusConfirmed: {TripStatusOngoing, TripStatusCancelled},
+	TripStatusOngoing:   {TripStatusCompleted, TripStatusCancelled},
+	TripStatusCompleted: {},
+	TripStatusCancelled: {},
+}
+
+// ParseTripStatus converts s into a TripStatus, failing with
+// ErrUnknownTripStatus when s is not one of the known statuses.
+func ParseTripStatus(s string) (TripStatus, error) {
+	status := TripStatus(s)
+	if _, ok := tripTransitions[status]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownTripStatus, s)
+	}
+	return status, nil
+}
+
+// CanTransitionTo reports whether a trip in status s can move to next.
+func (s TripStatus) CanTransitionTo(next TripStatus) bool {
+	for _, allowed := range tripTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition validates moving a trip from status from to status to, returning
+// ErrInvalidTransition when the lifecycle does not allow it.
+func Transition(from, to TripStatus) (TripStatus, error) {
+	if !from.CanTransitionTo(to) {
+		return from, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	return to, nil
+}
+
+// IsConfirmed reports whether a trip in status s has been confirmed by its
+// owner, i.e. it went past draft and was not cancelled.
+func (s TripStatus) IsConfirmed() bool {
+	switch s {
+	case TripStatusConfirmed, TripStatusOngoing, TripStatusCompleted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package emailevents
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// maxBodySize caps the notifications read, SendGrid batching events up to a
+// few hundred kilobytes.
+const maxBodySize = 1 << 20
+
+type store interface {
+	MarkEmailUndeliverable(context.Context, pgstore.MarkEmailUndeliverableParams) error
+}
+
+// event is an address the provider will not deliver to anymore.
+type event struct {
+	email  string
+	issue  pgstore.EmailIssue
+	detail string
+}
+
+// Handler ingests the bounce and complaint notifications of the email
+// provider, sent by Amazon SES through SNS or by the SendGrid event webhook,
+// and marks the addresses as undeliverable. Providers are configured with
+// the URL of the handler carrying the token as the "token" query parameter.
+type Handler struct {
+	store  store
+	logger *zap.Logger
+	token  string
+	client *http.Client
+}
+
+func NewHandler(pool *pgxpool.Pool, logger *zap.Logger, token string) Handler {
+	return Handler{pgstore.New(pool), logger, token, &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if h.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+		http.Error(w, "token inválido", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "falha ao ler a notificação", http.StatusBadRequest)
+		return
+	}
+
+	var events []event
+	if r.Header.Get("X-Amz-Sns-Message-Type") != "" {
+		events, err = h.parseSNS(r.Context(), body)
+	} else {
+		events, err = parseSendGrid(body)
+	}
+	if err != nil {
+		h.logger.Warn("failed to parse email events", zap.Error(err))
+		http.Error(w, "notificação inválida", http.StatusBadRequest)
+		return
+	}
+
+	for _, e := range events {
+		if err := h.store.MarkEmailUndeliverable(r.Context(), pgstore.MarkEmailUndeliverableParams{
+			Email:  strings.ToLower(strings.TrimSpace(e.email)),
+			Issue:  e.issue,
+			Detail: pgtype.Text{Valid: e.detail != "", String: e.detail},
+		}); err != nil {
+			h.logger.Error("failed to mark email undeliverable", zap.Error(err), zap.String("issue", string(e.issue)))
+			// Providers retry the notification on server errors.
+			http.Error(w, "Algo deu errado, tente novamente", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
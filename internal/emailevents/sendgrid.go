@@ -0,0 +1,50 @@
+package emailevents
+
+import (
+	"fmt"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+)
+
+// sendGridEvent is one of the events batched by the SendGrid event webhook.
+type sendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// parseSendGrid returns the bounces and spam reports of a SendGrid batch,
+// ignoring every other event.
+func parseSendGrid(body []byte) ([]event, error) {
+	var batch []sendGridEvent
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return nil, fmt.Errorf("emailevents: failed to decode sendgrid events: %w", err)
+	}
+
+	var events []event
+
+	for _, e := range batch {
+		switch e.Event {
+		case "bounce":
+			// Blocked messages are soft bounces, the address may work later.
+			if e.Type == "blocked" {
+				continue
+			}
+			events = append(events, event{e.Email, pgstore.EmailIssueBounce, e.Reason})
+		case "dropped":
+			// SendGrid drops the messages to addresses it already suppressed.
+			switch e.Reason {
+			case "Bounced Address":
+				events = append(events, event{e.Email, pgstore.EmailIssueBounce, e.Reason})
+			case "Spam Reporting Address":
+				events = append(events, event{e.Email, pgstore.EmailIssueComplaint, e.Reason})
+			}
+		case "spamreport":
+			events = append(events, event{e.Email, pgstore.EmailIssueComplaint, e.Reason})
+		}
+	}
+
+	return events, nil
+}
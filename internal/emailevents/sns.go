@@ -0,0 +1,118 @@
+package emailevents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"travel-api/internal/pgstore"
+
+	"github.com/goccy/go-json"
+)
+
+// snsMessage is the envelope SNS posts to HTTP subscriptions.
+type snsMessage struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// sesNotification is the SES bounce or complaint carried by an SNS message.
+// Feedback notifications name the kind notificationType, event publishing
+// names it eventType.
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// parseSNS returns the events of an SNS message, confirming the subscription
+// when SNS asks to.
+func (h Handler) parseSNS(ctx context.Context, body []byte) ([]event, error) {
+	var msg snsMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, fmt.Errorf("emailevents: failed to decode sns message: %w", err)
+	}
+
+	switch msg.Type {
+	case "SubscriptionConfirmation":
+		return nil, h.confirmSubscription(ctx, msg.SubscribeURL)
+	case "Notification":
+	default:
+		return nil, nil
+	}
+
+	var n sesNotification
+	if err := json.Unmarshal([]byte(msg.Message), &n); err != nil {
+		return nil, fmt.Errorf("emailevents: failed to decode ses notification: %w", err)
+	}
+
+	kind := n.NotificationType
+	if kind == "" {
+		kind = n.EventType
+	}
+
+	var events []event
+
+	switch kind {
+	case "Bounce":
+		// Transient bounces, such as a full mailbox, may still be delivered
+		// later.
+		if n.Bounce.BounceType != "Permanent" {
+			return nil, nil
+		}
+		for _, r := range n.Bounce.BouncedRecipients {
+			detail := r.DiagnosticCode
+			if detail == "" {
+				detail = n.Bounce.BounceSubType
+			}
+			events = append(events, event{r.EmailAddress, pgstore.EmailIssueBounce, detail})
+		}
+	case "Complaint":
+		for _, r := range n.Complaint.ComplainedRecipients {
+			events = append(events, event{r.EmailAddress, pgstore.EmailIssueComplaint, n.Complaint.ComplaintFeedbackType})
+		}
+	}
+
+	return events, nil
+}
+
+// confirmSubscription visits the URL SNS sent to confirm the subscription,
+// which must be an AWS endpoint.
+func (h Handler) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	u, err := url.Parse(subscribeURL)
+	if err != nil || u.Scheme != "https" || !strings.HasSuffix(u.Hostname(), ".amazonaws.com") {
+		return fmt.Errorf("emailevents: unexpected subscribe url %q", subscribeURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("emailevents: failed to create subscription request: %w", err)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("emailevents: failed to confirm subscription: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("emailevents: unexpected status %d confirming subscription", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Package events publishes the domain events written by the transactions of
+// the app, such as a trip being created or a participant confirming, to the
+// webhook and the message bus, and to the webhooks the owners register for
+// their trips. The emails the same changes trigger keep going through the
+// email outbox, written in the same transactions.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	// dispatchBatch is how many events are claimed at once.
+	dispatchBatch = 50
+	// dispatchLease hides a claimed event from other dispatchers while it is
+	// published. If the dispatcher dies, the event is retried once the lease
+	// expires.
+	dispatchLease = 5 * time.Minute
+	// maxAttempts is how many times an event is tried before being moved to
+	// the dead state.
+	maxAttempts = 10
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+	// publishTimeout bounds the publishing of an event, well within
+	// dispatchLease so the event is not claimed again while still being
+	// published.
+	publishTimeout = time.Minute
+)
+
+// Event is a domain event as handed to the publishers.
+type Event struct {
+	ID         uuid.UUID               `json:"id"`
+	Kind       pgstore.DomainEventKind `json:"kind"`
+	TripID     uuid.UUID               `json:"trip_id"`
+	Payload    json.RawMessage         `json:"payload"`
+	OccurredAt time.Time               `json:"occurred_at"`
+}
+
+// Publisher delivers the events to one destination. Events are delivered at
+// least once, consumers telling duplicates apart by their ID.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type store interface {
+	ClaimDueDomainEvents(context.Context, pgstore.ClaimDueDomainEventsParams) ([]pgstore.DomainEvent, error)
+	MarkDomainEventPublished(context.Context, pgstore.MarkDomainEventPublishedParams) error
+	MarkDomainEventFailed(context.Context, pgstore.MarkDomainEventFailedParams) error
+}
+
+// Dispatcher publishes the pending events to every publisher. An event
+// failing on one publisher is retried on all of them with exponential backoff
+// and, after maxAttempts, left in the dead state for someone to look at.
+type Dispatcher struct {
+	store      store
+	publishers []Publisher
+	logger     *zap.Logger
+	interval   time.Duration
+}
+
+func NewDispatcher(pool *pgxpool.Pool, logger *zap.Logger, interval time.Duration, publishers ...Publisher) Dispatcher {
+	return Dispatcher{pgstore.New(pool), publishers, logger, interval}
+}
+
+// Run publishes the due events every interval until ctx is done.
+func (d Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.publishDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishDue publishes the due events, batch after batch, until none is left.
+func (d Dispatcher) publishDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		if d.publishBatch(ctx) < dispatchBatch {
+			return
+		}
+	}
+}
+
+// publishBatch publishes a batch of due events and returns how many were
+// claimed.
+func (d Dispatcher) publishBatch(ctx context.Context) int {
+	now := time.Now().UTC()
+	events, err := d.store.ClaimDueDomainEvents(ctx, pgstore.ClaimDueDomainEventsParams{
+		LeaseUntil: pgtype.Timestamp{Valid: true, Time: now.Add(dispatchLease)},
+		Now:        pgtype.Timestamp{Valid: true, Time: now},
+		Limit:      dispatchBatch,
+	})
+	if err != nil {
+		d.logger.Error("failed to claim domain events", zap.Error(err))
+		return 0
+	}
+
+	for _, event := range events {
+		if err := d.publish(ctx, event); err != nil {
+			d.fail(ctx, event, err)
+			continue
+		}
+
+		if err := d.store.MarkDomainEventPublished(ctx, pgstore.MarkDomainEventPublishedParams{
+			PublishedAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+			ID:          event.ID,
+		}); err != nil {
+			d.logger.Error("failed to mark domain event as published",
+				zap.Error(err),
+				zap.String("event_id", event.ID.String()),
+			)
+		}
+	}
+
+	return len(events)
+}
+
+func (d Dispatcher) publish(ctx context.Context, row pgstore.DomainEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	event := Event{
+		ID:         row.ID,
+		Kind:       row.Kind,
+		TripID:     row.TripID,
+		Payload:    row.Payload,
+		OccurredAt: row.OccurredAt.Time,
+	}
+
+	var errs []error
+	for _, p := range d.publishers {
+		if err := p.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// fail schedules the next attempt of event, or gives up on it once it
+// reaches maxAttempts.
+func (d Dispatcher) fail(ctx context.Context, event pgstore.DomainEvent, publishErr error) {
+	attempts := event.Attempts + 1
+	status := pgstore.DomainEventStatusPending
+	if attempts >= maxAttempts {
+		status = pgstore.DomainEventStatusDead
+	}
+
+	d.logger.Error("failed to publish domain event",
+		zap.Error(publishErr),
+		zap.String("event_id", event.ID.String()),
+		zap.String("kind", string(event.Kind)),
+		zap.Int32("attempts", attempts),
+		zap.String("status", string(status)),
+	)
+
+	if err := d.store.MarkDomainEventFailed(ctx, pgstore.MarkDomainEventFailedParams{
+		Status:        status,
+		NextAttemptAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(backoff(attempts))},
+		LastError:     pgtype.Text{Valid: true, String: publishErr.Error()},
+		ID:            event.ID,
+	}); err != nil {
+		d.logger.Error("failed to mark domain event as failed",
+			zap.Error(err),
+			zap.String("event_id", event.ID.String()),
+		)
+	}
+}
+
+// backoff doubles the wait between attempts, starting at baseBackoff and
+// capped at maxBackoff.
+func backoff(attempts int32) time.Duration {
+	b := baseBackoff
+	for i := int32(1); i < attempts && b < maxBackoff; i++ {
+		b *= 2
+	}
+	return min(b, maxBackoff)
+}
+
+func marshal(event Event) ([]byte, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("events: failed to encode event %s: %w", event.ID, err)
+	}
+	return b, nil
+}
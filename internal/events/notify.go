@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// maxNotifyPayload is the largest payload Postgres accepts in a notification.
+const maxNotifyPayload = 8000
+
+// Notify publishes the events as JSON on a Postgres LISTEN/NOTIFY channel,
+// the message bus of the app: other services LISTEN on the channel of the
+// database they already use, without another broker to run.
+type Notify struct {
+	pool    *pgxpool.Pool
+	channel string
+}
+
+func NewNotify(pool *pgxpool.Pool, channel string) Notify {
+	return Notify{pool, channel}
+}
+
+func (n Notify) Publish(ctx context.Context, event Event) error {
+	body, err := marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if len(body) >= maxNotifyPayload {
+		return fmt.Errorf("events: event %s is too large to notify: %d bytes", event.ID, len(body))
+	}
+
+	if _, err := n.pool.Exec(ctx, "SELECT pg_notify($1, $2)", n.channel, string(body)); err != nil {
+		return fmt.Errorf("events: failed to notify %s: %w", n.channel, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Webhook posts the events as JSON to a URL. The unix time of the post and the
+// body are signed with an HMAC SHA-256 of the secret, sent in the
+// X-Travel-Signature header as t=<time>,v1=<hex signature>, so the receiver
+// can check the events come from the app and reject replayed posts.
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func NewWebhook(url string, secret []byte, timeout time.Duration) (Webhook, error) {
+	if len(secret) == 0 {
+		return Webhook{}, fmt.Errorf("events: webhook signing secret must not be empty")
+	}
+
+	return Webhook{url, secret, &http.Client{Timeout: timeout}}, nil
+}
+
+func (w Webhook) Publish(ctx context.Context, event Event) error {
+	body, err := marshal(event)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	header.Set("X-Travel-Event-ID", event.ID.String())
+	header.Set("X-Travel-Event-Kind", string(event.Kind))
+
+	_, err = post(ctx, w.client, w.url, w.secret, body, header)
+	return err
+}
+
+// post posts the signed body to url and returns the status it was answered
+// with, 0 if it was not answered. The signature covers the time of the post
+// followed by a dot and the body.
+func post(ctx context.Context, client *http.Client, url string, secret []byte, body []byte, header http.Header) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("events: failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Travel-Signature", "t="+timestamp+",v1="+hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("events: failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("events: webhook answered %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
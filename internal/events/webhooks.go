@@ -0,0 +1,212 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+	"travel-api/internal/linkpreview"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// WebhookEvents names the events posted to the webhooks the owners register,
+// the other domain events are not posted to them.
+var WebhookEvents = map[pgstore.DomainEventKind]string{
+	pgstore.DomainEventKindTripCreated:          "trip.created",
+	pgstore.DomainEventKindTripConfirmed:        "trip.confirmed",
+	pgstore.DomainEventKindParticipantConfirmed: "participant.confirmed",
+	pgstore.DomainEventKindActivityCreated:      "activity.created",
+}
+
+// webhookPayload is the body of the posts to the webhooks.
+type webhookPayload struct {
+	ID         uuid.UUID       `json:"id"`
+	Event      string          `json:"event"`
+	TripID     uuid.UUID       `json:"trip_id"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+type webhooksStore interface {
+	EnqueueWebhookDeliveries(context.Context, pgstore.EnqueueWebhookDeliveriesParams) (int64, error)
+	ClaimDueWebhookDeliveries(context.Context, pgstore.ClaimDueWebhookDeliveriesParams) ([]pgstore.ClaimDueWebhookDeliveriesRow, error)
+	MarkWebhookDelivered(context.Context, pgstore.MarkWebhookDeliveredParams) error
+	MarkWebhookDeliveryFailed(context.Context, pgstore.MarkWebhookDeliveryFailedParams) error
+}
+
+// Webhooks delivers the events to the webhooks of their trip. As a Publisher
+// it queues a delivery per webhook, which Run then posts, retrying each
+// delivery on its own like the Dispatcher retries the events: a webhook being
+// down does not hold back the others.
+type Webhooks struct {
+	store    webhooksStore
+	client   *http.Client
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+// NewWebhooks returns the Webhooks posting every interval, giving up on a post
+// after timeout. The URLs are registered by the owners, so the posts only
+// connect to public addresses and do not follow redirects, keeping them from
+// reaching services on the internal network.
+func NewWebhooks(pool *pgxpool.Pool, logger *zap.Logger, interval time.Duration, timeout time.Duration) Webhooks {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: linkpreview.Control,
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return Webhooks{pgstore.New(pool), client, logger, interval}
+}
+
+// Publish queues the deliveries of event. Queuing them again when the event
+// is retried is a no-op.
+func (w Webhooks) Publish(ctx context.Context, event Event) error {
+	if _, ok := WebhookEvents[event.Kind]; !ok {
+		return nil
+	}
+
+	if _, err := w.store.EnqueueWebhookDeliveries(ctx, pgstore.EnqueueWebhookDeliveriesParams{
+		EventID: event.ID,
+		TripID:  event.TripID,
+	}); err != nil {
+		return fmt.Errorf("events: failed to queue webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// Run posts the due deliveries every interval until ctx is done.
+func (w Webhooks) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue posts the due deliveries, batch after batch, until none is left.
+func (w Webhooks) deliverDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		if w.deliverBatch(ctx) < dispatchBatch {
+			return
+		}
+	}
+}
+
+// deliverBatch posts a batch of due deliveries and returns how many were
+// claimed.
+func (w Webhooks) deliverBatch(ctx context.Context) int {
+	now := time.Now().UTC()
+	deliveries, err := w.store.ClaimDueWebhookDeliveries(ctx, pgstore.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamp{Valid: true, Time: now.Add(dispatchLease)},
+		Now:        pgtype.Timestamp{Valid: true, Time: now},
+		Limit:      dispatchBatch,
+	})
+	if err != nil {
+		w.logger.Error("failed to claim webhook deliveries", zap.Error(err))
+		return 0
+	}
+
+	for _, delivery := range deliveries {
+		status, err := w.deliver(ctx, delivery)
+		if err != nil {
+			w.fail(ctx, delivery, status, err)
+			continue
+		}
+
+		if err := w.store.MarkWebhookDelivered(ctx, pgstore.MarkWebhookDeliveredParams{
+			ResponseStatus: pgtype.Int4{Valid: true, Int32: int32(status)},
+			DeliveredAt:    pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+			ID:             delivery.ID,
+		}); err != nil {
+			w.logger.Error("failed to mark webhook delivery as delivered",
+				zap.Error(err),
+				zap.String("delivery_id", delivery.ID.String()),
+			)
+		}
+	}
+
+	return len(deliveries)
+}
+
+func (w Webhooks) deliver(ctx context.Context, delivery pgstore.ClaimDueWebhookDeliveriesRow) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	event := WebhookEvents[delivery.Kind]
+
+	body, err := json.Marshal(webhookPayload{
+		ID:         delivery.EventID,
+		Event:      event,
+		TripID:     delivery.TripID,
+		Payload:    delivery.Payload,
+		OccurredAt: delivery.OccurredAt.Time,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("events: failed to encode event %s: %w", delivery.EventID, err)
+	}
+
+	header := http.Header{}
+	header.Set("X-Travel-Delivery-ID", delivery.ID.String())
+	header.Set("X-Travel-Event-ID", delivery.EventID.String())
+	header.Set("X-Travel-Event", event)
+
+	return post(ctx, w.client, delivery.Url, []byte(delivery.Secret), body, header)
+}
+
+// fail schedules the next attempt of delivery, or gives up on it once it
+// reaches maxAttempts.
+func (w Webhooks) fail(ctx context.Context, delivery pgstore.ClaimDueWebhookDeliveriesRow, responseStatus int, deliverErr error) {
+	attempts := delivery.Attempts + 1
+	status := pgstore.WebhookDeliveryStatusPending
+	if attempts >= maxAttempts {
+		status = pgstore.WebhookDeliveryStatusDead
+	}
+
+	w.logger.Error("failed to deliver webhook",
+		zap.Error(deliverErr),
+		zap.String("delivery_id", delivery.ID.String()),
+		zap.String("event_id", delivery.EventID.String()),
+		zap.Int32("attempts", attempts),
+		zap.String("status", string(status)),
+	)
+
+	if err := w.store.MarkWebhookDeliveryFailed(ctx, pgstore.MarkWebhookDeliveryFailedParams{
+		Status:         status,
+		NextAttemptAt:  pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(backoff(attempts))},
+		ResponseStatus: pgtype.Int4{Valid: responseStatus != 0, Int32: int32(responseStatus)},
+		LastError:      pgtype.Text{Valid: true, String: deliverErr.Error()},
+		ID:             delivery.ID,
+	}); err != nil {
+		w.logger.Error("failed to mark webhook delivery as failed",
+			zap.Error(err),
+			zap.String("delivery_id", delivery.ID.String()),
+		)
+	}
+}
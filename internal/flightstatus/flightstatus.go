@@ -0,0 +1,146 @@
+package flightstatus
+
+import (
+	"context"
+	"errors"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// ErrNotFound is returned by providers not knowing the flight, such as flights
+// too far ahead to be scheduled yet.
+var ErrNotFound = errors.New("flightstatus: flight not found")
+
+// Status is what a provider knows of a flight on its departure day.
+type Status struct {
+	// DepartureAt is the estimated departure, in UTC, the scheduled one
+	// while the flight is on time.
+	DepartureAt time.Time
+	// Gate is the departure gate, empty until it is announced.
+	Gate string
+}
+
+// Provider looks up the status of flights, number being the flight
+// designator, such as "LA3050", and date its scheduled departure.
+type Provider interface {
+	Status(ctx context.Context, number string, date time.Time) (Status, error)
+}
+
+type store interface {
+	GetTrackedFlights(context.Context, pgstore.GetTrackedFlightsParams) ([]pgstore.GetTrackedFlightsRow, error)
+	UpsertFlightStatus(context.Context, pgstore.UpsertFlightStatusParams) error
+	QueueFlightUpdateTx(context.Context, *pgxpool.Pool, pgstore.FlightUpdate) error
+}
+
+// Tracker periodically checks the flights departing within lead with the
+// provider, from the segments with a flight number, until they depart. The
+// passengers of a flight are notified, by email unless they opted out and in
+// the notifications feed, when its departure is delayed or brought forward
+// and when its gate changes.
+type Tracker struct {
+	store    store
+	pool     *pgxpool.Pool
+	logger   *zap.Logger
+	provider Provider
+	lead     time.Duration
+	interval time.Duration
+}
+
+func NewTracker(pool *pgxpool.Pool, logger *zap.Logger, provider Provider, lead, interval time.Duration) Tracker {
+	return Tracker{pgstore.New(pool), pool, logger, provider, lead, interval}
+}
+
+// Run checks the flights every interval until ctx is done.
+func (t Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		t.check(ctx, time.Now().UTC())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t Tracker) check(ctx context.Context, now time.Time) {
+	flights, err := t.store.GetTrackedFlights(ctx, pgstore.GetTrackedFlightsParams{
+		FromTime: pgtype.Timestamp{Valid: true, Time: now},
+		ToTime:   pgtype.Timestamp{Valid: true, Time: now.Add(t.lead)},
+	})
+	if err != nil {
+		t.logger.Error("failed to get tracked flights", zap.Error(err))
+		return
+	}
+
+	for _, flight := range flights {
+		status, err := t.provider.Status(ctx, flight.Number.String, flight.DepartureAt.Time)
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				t.logger.Warn("failed to get flight status",
+					zap.Error(err),
+					zap.String("segment_id", flight.SegmentID.String()),
+					zap.String("number", flight.Number.String),
+				)
+			}
+			continue
+		}
+
+		if err := t.record(ctx, flight, status); err != nil {
+			t.logger.Error("failed to record flight status",
+				zap.Error(err),
+				zap.String("segment_id", flight.SegmentID.String()),
+			)
+		}
+	}
+}
+
+// record stores the status of the flight, notifying its passengers of what
+// changed since the last check. The first check compares the departure with
+// the scheduled one, a gate being only a change once one was known.
+func (t Tracker) record(ctx context.Context, flight pgstore.GetTrackedFlightsRow, status Status) error {
+	departureAt := flight.DepartureAt.Time
+	if flight.EstimatedDepartureAt.Valid {
+		departureAt = flight.EstimatedDepartureAt.Time
+	}
+	if status.DepartureAt.IsZero() {
+		status.DepartureAt = departureAt
+	}
+	if status.Gate == "" {
+		status.Gate = flight.Gate.String
+	}
+
+	rescheduled := !status.DepartureAt.Equal(departureAt)
+	gateChanged := flight.Gate.Valid && status.Gate != flight.Gate.String
+
+	params := pgstore.UpsertFlightStatusParams{
+		SegmentID:   flight.SegmentID,
+		DepartureAt: pgtype.Timestamp{Valid: true, Time: status.DepartureAt.UTC()},
+		Gate:        pgtype.Text{Valid: status.Gate != "", String: status.Gate},
+	}
+
+	if !rescheduled && !gateChanged {
+		return t.store.UpsertFlightStatus(ctx, params)
+	}
+
+	return t.store.QueueFlightUpdateTx(ctx, t.pool, pgstore.FlightUpdate{
+		SegmentID:           flight.SegmentID,
+		TripID:              flight.TripID,
+		Carrier:             flight.Carrier,
+		Number:              flight.Number.String,
+		DeparturePlace:      flight.DeparturePlace,
+		ArrivalPlace:        flight.ArrivalPlace,
+		ScheduledAt:         flight.DepartureAt.Time,
+		PreviousDepartureAt: departureAt,
+		DepartureAt:         params.DepartureAt.Time,
+		PreviousGate:        flight.Gate.String,
+		Gate:                status.Gate,
+	})
+}
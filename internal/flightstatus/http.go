@@ -0,0 +1,85 @@
+package flightstatus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// maxResponseSize caps the status read, a flight being a few hundred bytes.
+const maxResponseSize = 1 << 16
+
+// HTTP looks the flights up from a JSON API, as
+//
+//	GET <url>?number=LA3050&date=2024-07-01
+//	Authorization: Bearer <key>
+//
+// answered with {"departure_at": "2024-07-01T13:05:00Z", "gate": "B12"}, the
+// estimated departure being optional and a 404 meaning an unknown flight.
+// Providers with another API are put behind a small adapter speaking it.
+type HTTP struct {
+	url    string
+	key    string
+	client *http.Client
+}
+
+func NewHTTP(url, key string, timeout time.Duration) HTTP {
+	return HTTP{url, key, &http.Client{Timeout: timeout}}
+}
+
+type httpStatus struct {
+	DepartureAt *time.Time `json:"departure_at"`
+	Gate        string     `json:"gate"`
+}
+
+func (h HTTP) Status(ctx context.Context, number string, date time.Time) (Status, error) {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return Status{}, fmt.Errorf("flightstatus: invalid provider url: %w", err)
+	}
+
+	query := u.Query()
+	query.Set("number", number)
+	query.Set("date", date.Format(time.DateOnly))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Status{}, fmt.Errorf("flightstatus: failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if h.key != "" {
+		req.Header.Set("Authorization", "Bearer "+h.key)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return Status{}, fmt.Errorf("flightstatus: failed to get status: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return Status{}, ErrNotFound
+	default:
+		return Status{}, fmt.Errorf("flightstatus: unexpected status %d", res.StatusCode)
+	}
+
+	var body httpStatus
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(&body); err != nil {
+		return Status{}, fmt.Errorf("flightstatus: failed to decode status: %w", err)
+	}
+
+	status := Status{Gate: body.Gate}
+	if body.DepartureAt != nil {
+		status.DepartureAt = body.DepartureAt.UTC()
+	}
+
+	return status, nil
+}
@@ -0,0 +1,25 @@
+package geocoding
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned for the queries matching no place.
+var ErrNotFound = errors.New("geocoding: place not found")
+
+// Place is where a query, such as the destination of a trip or the address of
+// an activity, was resolved to.
+type Place struct {
+	// Name is the canonical name of the place, such as "Lisboa, Portugal"
+	// for "lisbon".
+	Name      string
+	Latitude  float64
+	Longitude float64
+}
+
+// Provider resolves free text places into coordinates, the best match being
+// kept when there are many.
+type Provider interface {
+	Geocode(ctx context.Context, query string) (Place, error)
+}
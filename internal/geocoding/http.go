@@ -0,0 +1,84 @@
+package geocoding
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// maxResponseSize caps the place read, a place being a few hundred bytes.
+const maxResponseSize = 1 << 16
+
+// HTTP geocodes the places with a JSON API, as
+//
+//	GET <url>?q=lisbon
+//	Authorization: Bearer <key>
+//
+// answered with {"name": "Lisboa, Portugal", "latitude": 38.72, "longitude":
+// -9.14}, a 404 meaning an unknown place. Providers with another API are put
+// behind a small adapter speaking it.
+type HTTP struct {
+	url    string
+	key    string
+	client *http.Client
+}
+
+func NewHTTP(url, key string, timeout time.Duration) HTTP {
+	return HTTP{url, key, &http.Client{Timeout: timeout}}
+}
+
+type httpPlace struct {
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (h HTTP) Geocode(ctx context.Context, query string) (Place, error) {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return Place{}, fmt.Errorf("geocoding: invalid provider url: %w", err)
+	}
+
+	values := u.Query()
+	values.Set("q", query)
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return Place{}, fmt.Errorf("geocoding: failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if h.key != "" {
+		req.Header.Set("Authorization", "Bearer "+h.key)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return Place{}, fmt.Errorf("geocoding: failed to geocode: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return Place{}, ErrNotFound
+	default:
+		return Place{}, fmt.Errorf("geocoding: unexpected status %d", res.StatusCode)
+	}
+
+	var body httpPlace
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(&body); err != nil {
+		return Place{}, fmt.Errorf("geocoding: failed to decode place: %w", err)
+	}
+
+	if body.Latitude < -90 || body.Latitude > 90 || body.Longitude < -180 || body.Longitude > 180 {
+		return Place{}, fmt.Errorf("geocoding: invalid coordinates %v, %v", body.Latitude, body.Longitude)
+	}
+
+	return Place{body.Name, body.Latitude, body.Longitude}, nil
+}
@@ -0,0 +1,136 @@
+package ics
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of the files written by Calendar.Encode.
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineLength is the most octets a content line may have, line break
+// excluded, before being folded.
+const maxLineLength = 75
+
+const dateTimeFormat = "20060102T150405Z"
+
+// Event is a VEVENT. UID must stay the same across updates of the event so
+// calendars replace it instead of adding a copy. Empty fields are left out.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	// Updated is when the event last changed, sent as its DTSTAMP.
+	Updated time.Time
+}
+
+// Calendar is a VCALENDAR holding events.
+type Calendar struct {
+	// Name is shown by the clients subscribing to the calendar.
+	Name string
+	// Refresh is how often the clients subscribing to the calendar should
+	// fetch it again. Zero leaves it to them.
+	Refresh time.Duration
+	Events  []Event
+}
+
+// Encode returns the calendar in the iCalendar format.
+func (c Calendar) Encode() []byte {
+	var b bytes.Buffer
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//plann.er//Travel API//PT")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escape(c.Name))
+	}
+	if c.Refresh > 0 {
+		// REFRESH-INTERVAL is RFC 7986, X-PUBLISHED-TTL its older equivalent
+		// some clients still read.
+		writeLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:"+duration(c.Refresh))
+		writeLine(&b, "X-PUBLISHED-TTL:"+duration(c.Refresh))
+	}
+
+	for _, e := range c.Events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+e.UID)
+		writeLine(&b, "DTSTAMP:"+e.Updated.UTC().Format(dateTimeFormat))
+		writeLine(&b, "DTSTART:"+e.Start.UTC().Format(dateTimeFormat))
+		if !e.End.IsZero() {
+			writeLine(&b, "DTEND:"+e.End.UTC().Format(dateTimeFormat))
+		}
+		writeLine(&b, "SUMMARY:"+escape(e.Summary))
+		if e.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escape(e.Description))
+		}
+		if e.Location != "" {
+			writeLine(&b, "LOCATION:"+escape(e.Location))
+		}
+		if e.URL != "" {
+			writeLine(&b, "URL:"+e.URL)
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+
+	return b.Bytes()
+}
+
+// duration formats d as a DURATION value to the minute, such as PT1H30M.
+func duration(d time.Duration) string {
+	minutes := max(int(d.Minutes()), 1)
+
+	s := "PT"
+	if minutes >= 60 {
+		s += fmt.Sprintf("%dH", minutes/60)
+	}
+	if minutes%60 != 0 {
+		s += fmt.Sprintf("%dM", minutes%60)
+	}
+	return s
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape makes s safe to use as a TEXT value.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// writeLine writes line ended by CRLF, folding it every maxLineLength octets
+// without splitting UTF-8 characters.
+func writeLine(b *bytes.Buffer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space of the continuation counts towards its length.
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
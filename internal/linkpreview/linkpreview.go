@@ -0,0 +1,230 @@
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// maxPageSize caps how much of a page is read looking for its metadata, which
+// lives in the head anyway.
+const maxPageSize = 1 << 20
+
+const maxRedirects = 5
+
+// ErrForbiddenAddress is returned when a page, or one of its redirects, points
+// to a loopback, private or otherwise internal address.
+var ErrForbiddenAddress = errors.New("linkpreview: address is not publicly routable")
+
+// Preview is the metadata of a page used to render a link card. Fields the
+// page does not provide are left empty.
+type Preview struct {
+	Title       string
+	Description string
+	FaviconURL  string
+	ImageURL    string
+}
+
+// Fetcher downloads pages to build their Preview. It only connects to public
+// addresses, so user supplied links cannot be used to reach services on the
+// internal network.
+type Fetcher struct {
+	client *http.Client
+}
+
+// NewFetcher returns a Fetcher giving up on a page after timeout, redirects
+// included.
+func NewFetcher(timeout time.Duration) Fetcher {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: Control,
+	}
+
+	return Fetcher{&http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("linkpreview: stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("linkpreview: redirect to unsupported scheme %q", req.URL.Scheme)
+			}
+			return nil
+		},
+	}}
+}
+
+// Control refuses to connect to addresses that are not publicly routable. Set
+// as the Control of a net.Dialer it runs after DNS resolution, so it also
+// covers hosts resolving to internal addresses and every redirect hop.
+func Control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !IsPublic(ip) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// Fetch downloads the page at rawURL and extracts its title, description,
+// favicon and og:image. Relative URLs are resolved against the final page URL.
+func (f Fetcher) Fetch(ctx context.Context, rawURL string) (Preview, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return Preview{}, fmt.Errorf("linkpreview: failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/html")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return Preview{}, fmt.Errorf("linkpreview: failed to fetch page: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Preview{}, fmt.Errorf("linkpreview: unexpected status %d", res.StatusCode)
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type")); mediaType != "text/html" {
+		return Preview{}, fmt.Errorf("linkpreview: unsupported content type %q", mediaType)
+	}
+
+	preview := parse(io.LimitReader(res.Body, maxPageSize))
+
+	preview.ImageURL = resolve(res.Request.URL, preview.ImageURL)
+	if preview.FaviconURL == "" {
+		preview.FaviconURL = "/favicon.ico"
+	}
+	preview.FaviconURL = resolve(res.Request.URL, preview.FaviconURL)
+
+	return preview, nil
+}
+
+// parse reads the metadata out of the head of an HTML page, preferring the
+// Open Graph tags over the plain ones.
+func parse(r io.Reader) Preview {
+	z := html.NewTokenizer(r)
+
+	var (
+		preview             Preview
+		title, description  string
+		ogTitle, ogDesc     string
+		inTitle, titleFound bool
+	)
+
+loop:
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			break loop
+		case html.TextToken:
+			if inTitle {
+				title += string(z.Text())
+			}
+		case html.EndTagToken:
+			switch name, _ := z.TagName(); string(name) {
+			case "title":
+				inTitle, titleFound = false, true
+			case "head":
+				// The metadata is over, no need to read the body.
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			attrs := map[string]string{}
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				attrs[string(key)] = string(val)
+			}
+
+			switch string(name) {
+			case "title":
+				inTitle = !titleFound
+			case "meta":
+				switch strings.ToLower(firstNonEmpty(attrs["property"], attrs["name"])) {
+				case "og:title":
+					ogTitle = attrs["content"]
+				case "og:description":
+					ogDesc = attrs["content"]
+				case "og:image":
+					preview.ImageURL = attrs["content"]
+				case "description":
+					description = attrs["content"]
+				}
+			case "link":
+				if preview.FaviconURL == "" && isIconRel(attrs["rel"]) {
+					preview.FaviconURL = attrs["href"]
+				}
+			case "body":
+				break loop
+			}
+		}
+	}
+
+	preview.Title = strings.TrimSpace(firstNonEmpty(ogTitle, title))
+	preview.Description = strings.TrimSpace(firstNonEmpty(ogDesc, description))
+
+	return preview
+}
+
+func isIconRel(rel string) bool {
+	for _, r := range strings.Fields(strings.ToLower(rel)) {
+		if r == "icon" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve makes ref absolute against base, dropping it unless it is an
+// http(s) URL.
+func resolve(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+
+	u, err := base.Parse(strings.TrimSpace(ref))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+
+	return u.String()
+}
+
+// IsPublic reports whether ip is publicly routable.
+func IsPublic(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast())
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,290 @@
+// Package live streams the changes of the trips to the clients watching them.
+// Postgres triggers notify every change of a trip, its activities, its
+// participants, its links, its messages, its packing list, its tasks, its
+// bookings, its transport segments and its photos on the trip_changes channel,
+// and a single connection listens to it for the whole server.
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// channel is the Postgres channel the triggers notify.
+const channel = "trip_changes"
+
+const (
+	// subscriberBuffer is how many changes a slow client may fall behind
+	// before missing some, which it is then told to resync.
+	subscriberBuffer = 32
+	// recentChanges is how many of the last changes of all the trips are
+	// kept for the clients resuming their stream.
+	recentChanges = 1024
+	// baseRetry and maxRetry bound the wait before listening again after the
+	// connection is lost.
+	baseRetry = time.Second
+	maxRetry  = time.Minute
+)
+
+// Change is a change of a trip, or of an activity, a participant, a link or a
+// message of the trip. It only says what changed, clients fetch the change
+// themselves.
+type Change struct {
+	// Table is trips, activities, participants, links, messages,
+	// packing_items, tasks, bookings, transport_segments or photos.
+	Table string `json:"table"`
+	// Op is insert, update or delete.
+	Op     string    `json:"op"`
+	TripID uuid.UUID `json:"trip_id"`
+	ID     uuid.UUID `json:"id"`
+	// EventID tells the change apart for the clients resuming their stream
+	// after it. It is empty for Resync.
+	EventID string `json:"-"`
+}
+
+// Resync is sent instead of the changes a client may have missed, while the
+// connection to Postgres was lost or the client was too slow, for it to fetch
+// the whole trip again.
+var Resync = Change{Table: "resync"}
+
+type subscriber chan Change
+
+// published is a change with its place among the changes published.
+type published struct {
+	seq    uint64
+	change Change
+}
+
+// Hub fans the changes out to the clients watching their trip.
+type Hub struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+	// epoch prefixes the event IDs, for those of a previous run of the server
+	// not to be resumed from.
+	epoch string
+
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[subscriber]struct{}
+	seq         uint64
+	// recent are the last changes published, oldest first.
+	recent []published
+	// resynced is the seq of the last change published when the clients were
+	// last told to resync. Streams cannot be resumed from it or before.
+	resynced uint64
+}
+
+func NewHub(pool *pgxpool.Pool, logger *zap.Logger) *Hub {
+	return &Hub{
+		pool:        pool,
+		logger:      logger,
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		subscribers: make(map[uuid.UUID]map[subscriber]struct{}),
+	}
+}
+
+// Subscribe returns the changes of the trip, until the returned function is
+// called. Subscribing to uuid.Nil returns the changes of every trip.
+func (h *Hub) Subscribe(tripID uuid.UUID) (<-chan Change, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.subscribe(tripID)
+}
+
+// Resume is Subscribe for a client that got the changes up to the one with
+// lastEventID, which first gets the changes it missed since. It gets Resync
+// instead when they are no longer known.
+func (h *Hub) Resume(tripID uuid.UUID, lastEventID string) (<-chan Change, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub, unsubscribe := h.subscribe(tripID)
+
+	missed, ok := h.since(lastEventID)
+	if !ok {
+		send(sub, Resync)
+		return sub, unsubscribe
+	}
+
+	for _, p := range missed {
+		if tripID == uuid.Nil || p.change.TripID == tripID {
+			send(sub, p.change)
+		}
+	}
+
+	return sub, unsubscribe
+}
+
+// subscribe adds a subscriber to the changes of the trip. The caller holds
+// the lock.
+func (h *Hub) subscribe(tripID uuid.UUID) (subscriber, func()) {
+	sub := make(subscriber, subscriberBuffer)
+
+	if h.subscribers[tripID] == nil {
+		h.subscribers[tripID] = make(map[subscriber]struct{})
+	}
+	h.subscribers[tripID][sub] = struct{}{}
+
+	return sub, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.subscribers[tripID], sub)
+		if len(h.subscribers[tripID]) == 0 {
+			delete(h.subscribers, tripID)
+		}
+	}
+}
+
+// since returns the changes published after the one with eventID, and false
+// when some of them are no longer known. The caller holds the lock.
+func (h *Hub) since(eventID string) ([]published, bool) {
+	epoch, seqText, ok := strings.Cut(eventID, "-")
+	if !ok || epoch != h.epoch {
+		return nil, false
+	}
+
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil || seq <= h.resynced || seq > h.seq {
+		return nil, false
+	}
+
+	// The changes right after seq were dropped from recent.
+	if len(h.recent) > 0 && seq+1 < h.recent[0].seq {
+		return nil, false
+	}
+
+	i := len(h.recent)
+	for i > 0 && h.recent[i-1].seq > seq {
+		i--
+	}
+
+	return h.recent[i:], true
+}
+
+// publish hands the change to the clients watching its trip. A client that
+// fell behind gets Resync in place of the change.
+func (h *Hub) publish(change Change) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	change.EventID = fmt.Sprintf("%s-%d", h.epoch, h.seq)
+
+	h.recent = append(h.recent, published{h.seq, change})
+	if len(h.recent) > recentChanges {
+		h.recent = h.recent[len(h.recent)-recentChanges:]
+	}
+
+	for sub := range h.subscribers[change.TripID] {
+		send(sub, change)
+	}
+	for sub := range h.subscribers[uuid.Nil] {
+		send(sub, change)
+	}
+}
+
+// resyncAll tells every client to fetch its trip again.
+func (h *Hub) resyncAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.resynced = h.seq
+
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			send(sub, Resync)
+		}
+	}
+}
+
+// send hands change to sub without blocking. When sub is full, its oldest
+// change is replaced by Resync, which covers it.
+func send(sub subscriber, change Change) {
+	select {
+	case sub <- change:
+		return
+	default:
+	}
+
+	select {
+	case <-sub:
+	default:
+	}
+
+	select {
+	case sub <- Resync:
+	default:
+	}
+}
+
+// Run listens to the changes until ctx is done, listening again with
+// exponential backoff when the connection is lost.
+func (h *Hub) Run(ctx context.Context) {
+	retry := baseRetry
+	for {
+		listened, err := h.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		h.logger.Error("lost trip changes listener", zap.Error(err))
+
+		if listened {
+			retry = baseRetry
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+
+		retry = min(retry*2, maxRetry)
+	}
+}
+
+// listen publishes the notifications of the channel until the connection is
+// lost, and reports whether it got to listen.
+func (h *Hub) listen(ctx context.Context) (bool, error) {
+	conn, err := h.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// The connection listens for as long as the server runs, it is taken out
+	// of the pool rather than handed back to it still listening.
+	pgConn := conn.Hijack()
+	defer func() { _ = pgConn.Close(context.Background()) }()
+
+	if _, err := pgConn.Exec(ctx, "LISTEN "+channel); err != nil {
+		return false, err
+	}
+
+	// Changes may have been missed while not listening.
+	h.resyncAll()
+
+	for {
+		notification, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		var change Change
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			h.logger.Error("failed to decode trip change", zap.Error(err), zap.String("payload", notification.Payload))
+			continue
+		}
+
+		h.publish(change)
+	}
+}
@@ -0,0 +1,79 @@
+package logmailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"travel-api/internal/mailer"
+
+	"go.uber.org/zap"
+)
+
+// LogMailer is the mailer.Driver for development and tests, which logs the
+// emails instead of sending them. When dir is set, each email is also written
+// there as an .eml file, which email clients open as is.
+type LogMailer struct {
+	logger *zap.Logger
+	dir    string
+}
+
+// NewLogMailer creates dir if needed. The zero dir only logs the emails.
+func NewLogMailer(logger *zap.Logger, dir string) (LogMailer, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return LogMailer{}, fmt.Errorf("logmailer: failed to create email dir: %w", err)
+		}
+	}
+
+	return LogMailer{logger, dir}, nil
+}
+
+// Send logs msgs and writes them to dir.
+func (l LogMailer) Send(ctx context.Context, msgs ...mailer.Message) error {
+	for _, m := range msgs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		fields := []zap.Field{
+			zap.String("from", m.From),
+			zap.String("to", m.To),
+			zap.String("subject", m.Subject),
+			zap.String("text", m.Text),
+			zap.Int("attachments", len(m.Attachments)),
+		}
+
+		if l.dir != "" {
+			path, err := l.write(m)
+			if err != nil {
+				return err
+			}
+			fields = append(fields, zap.String("file", path))
+		}
+
+		l.logger.Info("email not sent, logged instead", fields...)
+	}
+
+	return nil
+}
+
+// write saves m as an .eml file in dir and returns its path.
+func (l LogMailer) write(m mailer.Message) (string, error) {
+	msg, err := m.MIME()
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp(l.dir, time.Now().UTC().Format("20060102T150405")+"-*.eml")
+	if err != nil {
+		return "", fmt.Errorf("logmailer: failed to create email file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := msg.WriteTo(f); err != nil {
+		return "", fmt.Errorf("logmailer: failed to write email file: %w", err)
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,846 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+	"travel-api/internal/actionlink"
+	"travel-api/internal/ics"
+	"travel-api/internal/pgstore"
+	"travel-api/internal/unsubscribe"
+	"travel-api/internal/weather"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/wneessen/go-mail"
+)
+
+// Message is a rendered email, ready to be handed to a Driver.
+type Message struct {
+	From        string
+	To          string
+	Subject     string
+	Text        string
+	HTML        string
+	Attachments []Attachment
+	// Unsubscribe is the one-click unsubscribe URL of notification emails,
+	// sent in the List-Unsubscribe header. Transactional emails leave it
+	// empty.
+	Unsubscribe string
+}
+
+// Attachment is a file sent along with a Message.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// MIME builds the multipart message sent over SMTP, or as raw email to the
+// providers taking one.
+func (m Message) MIME() (*mail.Msg, error) {
+	msg := mail.NewMsg()
+	if err := msg.From(m.From); err != nil {
+		return nil, fmt.Errorf("mailer: failed to set From: %w", err)
+	}
+
+	if err := msg.To(m.To); err != nil {
+		return nil, fmt.Errorf("mailer: failed to set To: %w", err)
+	}
+
+	msg.Subject(m.Subject)
+	if m.Unsubscribe != "" {
+		msg.SetGenHeader(mail.HeaderListUnsubscribe, "<"+m.Unsubscribe+">")
+		msg.SetGenHeader(mail.HeaderListUnsubscribePost, "List-Unsubscribe=One-Click")
+	}
+	msg.SetBodyString(mail.TypeTextPlain, m.Text)
+	msg.AddAlternativeString(mail.TypeTextHTML, m.HTML)
+
+	for _, a := range m.Attachments {
+		if err := msg.AttachReader(a.Filename, bytes.NewReader(a.Data), mail.WithFileContentType(mail.ContentType(a.ContentType))); err != nil {
+			return nil, fmt.Errorf("mailer: failed to attach %s: %w", a.Filename, err)
+		}
+	}
+
+	return msg, nil
+}
+
+// Driver delivers messages through an email provider. Implementations live in
+// the subpackages of mailer.
+type Driver interface {
+	Send(context.Context, ...Message) error
+}
+
+type store interface {
+	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	GetTripOwners(context.Context, uuid.UUID) ([]pgstore.TripOwner, error)
+	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	GetParticipantByEmail(context.Context, pgstore.GetParticipantByEmailParams) (pgstore.Participant, error)
+	GetTripActivities(context.Context, pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error)
+	GetTripLinks(context.Context, uuid.UUID) ([]pgstore.Link, error)
+	GetTripTransportSegments(context.Context, uuid.UUID) ([]pgstore.TransportSegment, error)
+	GetTripTransportSegmentParticipants(context.Context, uuid.UUID) ([]pgstore.TransportSegmentParticipant, error)
+	GetEmailVerification(context.Context, uuid.UUID) (pgstore.EmailVerification, error)
+	GetPoll(context.Context, uuid.UUID) (pgstore.Poll, error)
+	GetTripPollOptions(context.Context, uuid.UUID) ([]pgstore.GetTripPollOptionsRow, error)
+	IsOptedOutOfNotification(context.Context, pgstore.IsOptedOutOfNotificationParams) (bool, error)
+}
+
+// Config holds the settings shared by every driver.
+type Config struct {
+	From string
+	// PublicURL is the address of the API, linked from the emails and their
+	// calendar events.
+	PublicURL string
+	// FrontendURL is where the buttons acting on a trip or participant point
+	// to, with the token the frontend passes back to the API.
+	FrontendURL string
+	// Actions signs the tokens of those buttons.
+	Actions actionlink.Tokens
+	// Unsubscribe signs the unsubscribe links of the notification emails.
+	Unsubscribe unsubscribe.Links
+	// Weather forecasts the day of the daily digests. They go without the
+	// weather when it is nil or fails.
+	Weather weather.Provider
+}
+
+// Mailer writes the emails of the app and sends them through its Driver.
+type Mailer struct {
+	store  store
+	driver Driver
+	cfg    Config
+}
+
+func New(pool *pgxpool.Pool, driver Driver, cfg Config) Mailer {
+	return Mailer{pgstore.New(pool), driver, cfg}
+}
+
+// NewWithStore returns a Mailer reading the trips from s rather than from
+// Postgres.
+func NewWithStore(s store, driver Driver, cfg Config) Mailer {
+	return Mailer{s, driver, cfg}
+}
+
+// SendConfirmTripEmailToTripOwner sends the trip confirmation email to every
+// owner of the trip, so co-owners are notified as well.
+func (m Mailer) SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendConfirmTripToTripOwner: %w", err)
+	}
+
+	owners, err := m.store.GetTripOwners(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip owners for SendConfirmTripToTripOwner: %w", err)
+	}
+
+	segments, err := m.store.GetTripTransportSegments(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get transport segments for SendConfirmTripToTripOwner: %w", err)
+	}
+
+	msgs := make([]Message, 0, len(owners))
+
+	tripURL := m.url("/trips/%s", trip.ID)
+	confirmURL := m.actionURL("/trips/%s/confirm", actionlink.ActionConfirmTrip, trip.ID, trip.StartsAt.Time)
+	webhooksURL := m.actionURL("/trips/%s/webhooks", actionlink.ActionManageWebhooks, trip.ID, trip.EndsAt.Time)
+
+	for _, owner := range owners {
+		msg, err := m.message(owner.Locale, owner.Email, "confirm_trip", confirmTripEmail{
+			Name:        owner.Name,
+			Trip:        newTripDetails(trip, owner.Locale),
+			ConfirmURL:  confirmURL,
+			WebhooksURL: webhooksURL,
+		})
+		if err != nil {
+			return fmt.Errorf("mailer: failed to render email SendConfirmTripToTripOwner: %w", err)
+		}
+
+		calendar, err := tripCalendar(trip, segments, tripURL, owner.Locale)
+		if err != nil {
+			return fmt.Errorf("mailer: failed to render calendar SendConfirmTripToTripOwner: %w", err)
+		}
+		msg.Attachments = []Attachment{calendar}
+
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := m.driver.Send(ctx, msgs...); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendConfirmTripToTripOwner: %w", err)
+	}
+
+	return nil
+}
+
+func (m Mailer) SendInvitationToParticipant(ctx context.Context, email string, tripID uuid.UUID) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendInvitationToParticipant: %w", err)
+	}
+
+	participant, err := m.store.GetParticipantByEmail(ctx, pgstore.GetParticipantByEmailParams{
+		TripID: tripID,
+		Email:  email,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendInvitationToParticipant: %w", err)
+	}
+
+	invitationURL := m.url("/participants/%s", participant.ID)
+	msg, err := m.message(participant.Locale, email, "invitation", invitationEmail{
+		Name:       participant.Name.String,
+		Trip:       newTripDetails(trip, participant.Locale),
+		ConfirmURL: m.actionURL("/participants/%s/confirm", actionlink.ActionConfirmParticipant, participant.ID, trip.StartsAt.Time),
+		DeclineURL: m.actionURL("/participants/%s/decline", actionlink.ActionDeclineParticipant, participant.ID, trip.StartsAt.Time),
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendInvitationToParticipant: %w", err)
+	}
+
+	segments, err := m.participantSegments(ctx, tripID, participant.ID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get transport segments for SendInvitationToParticipant: %w", err)
+	}
+
+	calendar, err := tripCalendar(trip, segments, invitationURL, participant.Locale)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render calendar SendInvitationToParticipant: %w", err)
+	}
+	msg.Attachments = []Attachment{calendar}
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendInvitationToParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// SendEmailVerification sends the code confirming the address of a
+// participant invited with verification. Nothing is sent once the address is
+// verified.
+func (m Mailer) SendEmailVerification(ctx context.Context, email string, tripID uuid.UUID) error {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendEmailVerification: %w", err)
+	}
+
+	participant, err := m.store.GetParticipantByEmail(ctx, pgstore.GetParticipantByEmailParams{
+		TripID: tripID,
+		Email:  email,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendEmailVerification: %w", err)
+	}
+
+	verification, err := m.store.GetEmailVerification(ctx, participant.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("mailer: failed to get verification for SendEmailVerification: %w", err)
+	}
+
+	msg, err := m.message(participant.Locale, email, "email_verification", emailVerificationEmail{
+		Name:      participant.Name.String,
+		Trip:      newTripDetails(trip, participant.Locale),
+		Code:      verification.Code,
+		ExpiresAt: formatDateTime(participant.Locale, verification.ExpiresAt.Time),
+		URL:       m.url("/participants/%s/verify-email", participant.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendEmailVerification: %w", err)
+	}
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendEmailVerification: %w", err)
+	}
+
+	return nil
+}
+
+// SendUnconfirmationToTripOwner tells every owner of the trip that a
+// participant took back their confirmation, and why.
+func (m Mailer) SendUnconfirmationToTripOwner(ctx context.Context, participantID uuid.UUID, reason string) error {
+	participant, err := m.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendUnconfirmationToTripOwner: %w", err)
+	}
+
+	trip, err := m.store.GetTrip(ctx, participant.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendUnconfirmationToTripOwner: %w", err)
+	}
+
+	owners, err := m.store.GetTripOwners(ctx, participant.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip owners for SendUnconfirmationToTripOwner: %w", err)
+	}
+
+	who := participant.Email
+	if participant.Name.Valid && participant.Name.String != "" {
+		who = fmt.Sprintf("%s (%s)", participant.Name.String, participant.Email)
+	}
+
+	msgs := make([]Message, 0, len(owners))
+
+	for _, owner := range owners {
+		msg, err := m.message(owner.Locale, owner.Email, "unconfirmation", unconfirmationEmail{
+			Name:        owner.Name,
+			Participant: who,
+			Reason:      reason,
+			Trip:        newTripDetails(trip, owner.Locale),
+			URL:         m.url("/trips/%s/participants", trip.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("mailer: failed to render email SendUnconfirmationToTripOwner: %w", err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := m.driver.Send(ctx, msgs...); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendUnconfirmationToTripOwner: %w", err)
+	}
+
+	return nil
+}
+
+// SendActivityReminder warns a participant that an activity is about to
+// start.
+func (m Mailer) SendActivityReminder(ctx context.Context, reminder pgstore.GetDueActivityRemindersRow) error {
+	optedOut, err := m.optedOut(ctx, reminder.ParticipantID, pgstore.NotificationKindActivityReminder)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendActivityReminder: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	unsubscribeURL := m.cfg.Unsubscribe.URL(reminder.ParticipantID, pgstore.NotificationKindActivityReminder)
+	msg, err := m.message(reminder.Locale, reminder.Email, "activity_reminder", activityReminderEmail{
+		Name:           reminder.Name.String,
+		Activity:       reminder.Title,
+		OccursAt:       formatDateTime(reminder.Locale, reminder.OccursAt.Time),
+		Trip:           tripDetails{Destination: reminder.Destination},
+		URL:            m.url("/participants/%s", reminder.ParticipantID),
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendActivityReminder: %w", err)
+	}
+	msg.Unsubscribe = unsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendActivityReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendRSVPReminder asks a participant who has not answered the invitation yet
+// to do so before the trip RSVP deadline.
+func (m Mailer) SendRSVPReminder(ctx context.Context, reminder pgstore.GetDueRSVPRemindersRow) error {
+	optedOut, err := m.optedOut(ctx, reminder.ParticipantID, pgstore.NotificationKindRsvpReminder)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendRSVPReminder: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	unsubscribeURL := m.cfg.Unsubscribe.URL(reminder.ParticipantID, pgstore.NotificationKindRsvpReminder)
+	msg, err := m.message(reminder.Locale, reminder.Email, "rsvp_reminder", rsvpReminderEmail{
+		Name:           reminder.Name.String,
+		Deadline:       formatDateTime(reminder.Locale, reminder.RsvpDeadline.Time),
+		Trip:           tripDetails{Destination: reminder.Destination},
+		URL:            m.url("/participants/%s", reminder.ParticipantID),
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendRSVPReminder: %w", err)
+	}
+	msg.Unsubscribe = unsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendRSVPReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendTaskReminder nudges the assignee of a task of the trip that is past its
+// due date.
+func (m Mailer) SendTaskReminder(ctx context.Context, reminder pgstore.GetDueTaskRemindersRow) error {
+	optedOut, err := m.optedOut(ctx, reminder.ParticipantID, pgstore.NotificationKindTaskReminder)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendTaskReminder: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	unsubscribeURL := m.cfg.Unsubscribe.URL(reminder.ParticipantID, pgstore.NotificationKindTaskReminder)
+	msg, err := m.message(reminder.Locale, reminder.Email, "task_reminder", taskReminderEmail{
+		Name:           reminder.Name.String,
+		Task:           reminder.Title,
+		DueAt:          formatDateTime(reminder.Locale, reminder.DueAt.Time),
+		Trip:           tripDetails{Destination: reminder.Destination},
+		URL:            m.url("/participants/%s", reminder.ParticipantID),
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendTaskReminder: %w", err)
+	}
+	msg.Unsubscribe = unsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendTaskReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendFlightUpdate tells a passenger of a flight that its departure or gate
+// changed, the times being shown in the trip timezone.
+func (m Mailer) SendFlightUpdate(ctx context.Context, update pgstore.FlightUpdateEmail) error {
+	optedOut, err := m.optedOut(ctx, update.ParticipantID, pgstore.NotificationKindFlightUpdate)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendFlightUpdate: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	participant, err := m.store.GetParticipant(ctx, update.ParticipantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendFlightUpdate: %w", err)
+	}
+
+	if participant.DeclinedAt.Valid {
+		return nil
+	}
+
+	trip, err := m.store.GetTrip(ctx, update.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendFlightUpdate: %w", err)
+	}
+
+	loc, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to load timezone for SendFlightUpdate: %w", err)
+	}
+
+	data := flightUpdateEmail{
+		Name:           participant.Name.String,
+		Flight:         update.Carrier + " " + update.Number,
+		From:           update.DeparturePlace,
+		To:             update.ArrivalPlace,
+		DepartureAt:    formatDateTime(participant.Locale, update.DepartureAt.In(loc)),
+		Gate:           update.Gate,
+		Trip:           tripDetails{Destination: trip.Destination},
+		URL:            m.url("/participants/%s", participant.ID),
+		UnsubscribeURL: m.cfg.Unsubscribe.URL(participant.ID, pgstore.NotificationKindFlightUpdate),
+	}
+	if !update.DepartureAt.Equal(update.PreviousDepartureAt) {
+		data.PreviousDepartureAt = formatDateTime(participant.Locale, update.PreviousDepartureAt.In(loc))
+	}
+	if update.PreviousGate != update.Gate {
+		data.PreviousGate = update.PreviousGate
+	}
+
+	msg, err := m.message(participant.Locale, participant.Email, "flight_update", data)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendFlightUpdate: %w", err)
+	}
+	msg.Unsubscribe = data.UnsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendFlightUpdate: %w", err)
+	}
+
+	return nil
+}
+
+// SendPreTripReminder sends a confirmed participant the agenda of the first
+// day of the trip and its links, ahead of the trip.
+func (m Mailer) SendPreTripReminder(ctx context.Context, reminder pgstore.GetDuePreTripRemindersRow) error {
+	optedOut, err := m.optedOut(ctx, reminder.ParticipantID, pgstore.NotificationKindPreTripReminder)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendPreTripReminder: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	trip, err := m.store.GetTrip(ctx, reminder.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendPreTripReminder: %w", err)
+	}
+
+	agenda, tripLinks, err := m.dayOne(ctx, trip, reminder.Locale)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get agenda for SendPreTripReminder: %w", err)
+	}
+
+	unsubscribeURL := m.cfg.Unsubscribe.URL(reminder.ParticipantID, pgstore.NotificationKindPreTripReminder)
+	msg, err := m.message(reminder.Locale, reminder.Email, "pre_trip_reminder", preTripReminderEmail{
+		Name:           reminder.Name.String,
+		Trip:           newTripDetails(trip, reminder.Locale),
+		Agenda:         agenda,
+		Links:          tripLinks,
+		URL:            m.url("/participants/%s", reminder.ParticipantID),
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendPreTripReminder: %w", err)
+	}
+	msg.Unsubscribe = unsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendPreTripReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendDailyDigest sends a confirmed participant the activities of a day of the
+// trip. Days without activities are skipped.
+func (m Mailer) SendDailyDigest(ctx context.Context, digest pgstore.GetDueDailyDigestsRow) error {
+	optedOut, err := m.optedOut(ctx, digest.ParticipantID, pgstore.NotificationKindDailyDigest)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get preference for SendDailyDigest: %w", err)
+	}
+
+	if optedOut {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(digest.Timezone)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to load timezone for SendDailyDigest: %w", err)
+	}
+
+	// Activities are stored in UTC, the day starts at midnight in the trip
+	// timezone.
+	day := digest.Day.Time
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	activities, err := m.store.GetTripActivities(ctx, pgstore.GetTripActivitiesParams{
+		TripID:   digest.TripID,
+		FromTime: pgtype.Timestamp{Valid: true, Time: start.UTC()},
+		ToTime:   pgtype.Timestamp{Valid: true, Time: start.AddDate(0, 0, 1).Add(-time.Microsecond).UTC()},
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get activities for SendDailyDigest: %w", err)
+	}
+
+	if len(activities) == 0 {
+		return nil
+	}
+
+	agenda := make([]agendaItem, len(activities))
+	for i, activity := range activities {
+		agenda[i] = agendaItem{
+			Time:    formatTime(digest.Locale, activity.OccursAt.Time.In(loc)),
+			Title:   activity.Title,
+			Address: activity.Address.String,
+		}
+	}
+
+	unsubscribeURL := m.cfg.Unsubscribe.URL(digest.ParticipantID, pgstore.NotificationKindDailyDigest)
+	msg, err := m.message(digest.Locale, digest.Email, "daily_digest", dailyDigestEmail{
+		Name:           digest.Name.String,
+		Destination:    digest.Destination,
+		Day:            formatDate(digest.Locale, day),
+		Weather:        m.weatherHint(ctx, digest.Destination, day, digest.Locale),
+		Agenda:         agenda,
+		URL:            m.url("/participants/%s", digest.ParticipantID),
+		UnsubscribeURL: unsubscribeURL,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendDailyDigest: %w", err)
+	}
+	msg.Unsubscribe = unsubscribeURL
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendDailyDigest: %w", err)
+	}
+
+	return nil
+}
+
+// weatherHint returns the forecast of day at the destination, nil when there
+// is none. The digest is worth sending without it, so failures are ignored.
+func (m Mailer) weatherHint(ctx context.Context, destination string, day time.Time, locale pgstore.Locale) *weatherHint {
+	if m.cfg.Weather == nil {
+		return nil
+	}
+
+	days, err := m.cfg.Weather.Forecast(ctx, destination, day, day)
+	if err != nil || len(days) == 0 {
+		return nil
+	}
+
+	return &weatherHint{
+		Condition:                formatCondition(locale, days[0].Condition),
+		MinTemperature:           int(math.Round(days[0].MinTemperature)),
+		MaxTemperature:           int(math.Round(days[0].MaxTemperature)),
+		PrecipitationProbability: days[0].PrecipitationProbability,
+	}
+}
+
+// SendPollInvitation sends a participant the link to vote on each option of a
+// poll. Nothing is sent once the poll is applied or the participant declined
+// the trip.
+func (m Mailer) SendPollInvitation(ctx context.Context, pollID, participantID uuid.UUID) error {
+	poll, err := m.store.GetPoll(ctx, pollID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get poll for SendPollInvitation: %w", err)
+	}
+
+	participant, err := m.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get participant for SendPollInvitation: %w", err)
+	}
+
+	if poll.AppliedAt.Valid || participant.DeclinedAt.Valid {
+		return nil
+	}
+
+	trip, err := m.store.GetTrip(ctx, poll.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get trip for SendPollInvitation: %w", err)
+	}
+
+	options, err := m.store.GetTripPollOptions(ctx, poll.TripID)
+	if err != nil {
+		return fmt.Errorf("mailer: failed to get poll options for SendPollInvitation: %w", err)
+	}
+
+	var choices []pollChoice
+	for _, option := range options {
+		if option.PollID != poll.ID {
+			continue
+		}
+
+		choice := pollChoice{
+			Destination: option.Destination.String,
+			URL:         m.voteURL(poll.ID, option.ID, participant.ID, trip.StartsAt.Time),
+		}
+		if poll.Kind == pgstore.PollKindDates {
+			choice.StartsAt = formatDate(participant.Locale, option.StartsAt.Time)
+			choice.EndsAt = formatDate(participant.Locale, option.EndsAt.Time)
+		}
+
+		choices = append(choices, choice)
+	}
+
+	msg, err := m.message(participant.Locale, participant.Email, "poll_invitation", pollInvitationEmail{
+		Name:     participant.Name.String,
+		Question: poll.Question,
+		Trip:     newTripDetails(trip, participant.Locale),
+		Options:  choices,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: failed to render email SendPollInvitation: %w", err)
+	}
+
+	if err := m.driver.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer: failed to send email to SendPollInvitation: %w", err)
+	}
+
+	return nil
+}
+
+// dayOne returns the agenda of the first day of the trip and its links, as
+// sent before the trip.
+func (m Mailer) dayOne(ctx context.Context, trip pgstore.Trip, locale pgstore.Locale) ([]agendaItem, []tripLink, error) {
+	dayOne := trip.StartsAt.Time.Truncate(24 * time.Hour)
+	activities, err := m.store.GetTripActivities(ctx, pgstore.GetTripActivitiesParams{
+		TripID:   trip.ID,
+		FromTime: pgtype.Timestamp{Valid: true, Time: dayOne},
+		ToTime:   pgtype.Timestamp{Valid: true, Time: dayOne.Add(24*time.Hour - time.Microsecond)},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links, err := m.store.GetTripLinks(ctx, trip.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	agenda := make([]agendaItem, len(activities))
+	for i, activity := range activities {
+		agenda[i] = agendaItem{
+			Time:    formatTime(locale, activity.OccursAt.Time),
+			Title:   activity.Title,
+			Address: activity.Address.String,
+		}
+	}
+
+	tripLinks := make([]tripLink, len(links))
+	for i, link := range links {
+		tripLinks[i] = tripLink{link.Title, link.Url}
+	}
+
+	return agenda, tripLinks, nil
+}
+
+// optedOut tells whether the participant turned off the kind of notification,
+// which may have happened after the email was queued.
+func (m Mailer) optedOut(ctx context.Context, participantID uuid.UUID, kind pgstore.NotificationKind) (bool, error) {
+	return m.store.IsOptedOutOfNotification(ctx, pgstore.IsOptedOutOfNotificationParams{
+		ParticipantID: participantID,
+		Kind:          kind,
+	})
+}
+
+// message renders the name templates of locale with data into an email to
+// the given address.
+func (m Mailer) message(locale pgstore.Locale, to, name string, data any) (Message, error) {
+	subject, text, html, err := render(locale, name, data)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		From:    m.cfg.From,
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	}, nil
+}
+
+func newTripDetails(trip pgstore.Trip, locale pgstore.Locale) tripDetails {
+	return tripDetails{
+		Destination: trip.Destination,
+		StartsAt:    formatDate(locale, trip.StartsAt.Time),
+		EndsAt:      formatDate(locale, trip.EndsAt.Time),
+	}
+}
+
+// tripCalendar is the .ics file attached to the trip emails, so the trip and
+// its transport segments can be added to a calendar in one click. url is
+// where the presence is confirmed.
+func tripCalendar(trip pgstore.Trip, segments []pgstore.TransportSegment, url string, locale pgstore.Locale) (Attachment, error) {
+	summary, description, err := renderCalendar(locale, calendarText{trip.Destination, url})
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	now := time.Now()
+	calendar := ics.Calendar{Events: []ics.Event{{
+		UID:         fmt.Sprintf("trip-%s@plann.er", trip.ID),
+		Start:       trip.StartsAt.Time,
+		End:         trip.EndsAt.Time,
+		Summary:     summary,
+		Description: description,
+		Location:    trip.Destination,
+		URL:         url,
+		Updated:     now,
+	}}}
+
+	for _, segment := range segments {
+		calendar.Events = append(calendar.Events, segmentEvent(segment, now))
+	}
+
+	return Attachment{
+		Filename:    "viagem.ics",
+		ContentType: ics.ContentType,
+		Data:        calendar.Encode(),
+	}, nil
+}
+
+// participantSegments lists the transport segments of the trip the
+// participant takes.
+func (m Mailer) participantSegments(ctx context.Context, tripID, participantID uuid.UUID) ([]pgstore.TransportSegment, error) {
+	segments, err := m.store.GetTripTransportSegments(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	passengers, err := m.store.GetTripTransportSegmentParticipants(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[uuid.UUID]bool)
+	for _, p := range passengers {
+		if p.ParticipantID == participantID {
+			taken[p.SegmentID] = true
+		}
+	}
+
+	var res []pgstore.TransportSegment
+	for _, segment := range segments {
+		if taken[segment.ID] {
+			res = append(res, segment)
+		}
+	}
+
+	return res, nil
+}
+
+// segmentEvent is the calendar event of a transport segment, from its
+// departure to its arrival, such as "LATAM LA3040: GRU → LIS".
+func segmentEvent(segment pgstore.TransportSegment, updated time.Time) ics.Event {
+	carrier := segment.Carrier
+	if segment.Number.Valid {
+		carrier += " " + segment.Number.String
+	}
+
+	return ics.Event{
+		UID:      fmt.Sprintf("segment-%s@plann.er", segment.ID),
+		Start:    segment.DepartureAt.Time,
+		End:      segment.ArrivalAt.Time,
+		Summary:  fmt.Sprintf("%s: %s → %s", carrier, segment.DeparturePlace, segment.ArrivalPlace),
+		Location: segment.DeparturePlace,
+		Updated:  updated,
+	}
+}
+
+// url links to path on the public address of the API.
+func (m Mailer) url(format string, a ...any) string {
+	return strings.TrimSuffix(m.cfg.PublicURL, "/") + fmt.Sprintf(format, a...)
+}
+
+// actionURL links to the frontend page of the trip or participant id at
+// format, with a token allowing action on it until expiresAt.
+func (m Mailer) actionURL(format string, action actionlink.Action, id uuid.UUID, expiresAt time.Time) string {
+	q := url.Values{}
+	q.Set("token", m.cfg.Actions.Token(action, id, expiresAt))
+
+	return strings.TrimSuffix(m.cfg.FrontendURL, "/") + fmt.Sprintf(format, id) + "?" + q.Encode()
+}
+
+// voteURL links to the frontend page voting for the option of the poll as
+// the participant, with a token allowing it until expiresAt.
+func (m Mailer) voteURL(pollID, optionID, participantID uuid.UUID, expiresAt time.Time) string {
+	q := url.Values{}
+	q.Set("participant", participantID.String())
+	q.Set("option", optionID.String())
+	q.Set("token", m.cfg.Actions.Token(actionlink.ActionVotePoll, participantID, expiresAt))
+
+	return strings.TrimSuffix(m.cfg.FrontendURL, "/") + fmt.Sprintf("/polls/%s/vote", pollID) + "?" + q.Encode()
+}
@@ -2,98 +2,145 @@ package mailpit
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
-	"travel-api/internal/pgstore"
+	"travel-api/internal/mailer"
 
-	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/wneessen/go-mail"
 )
 
-type store interface {
-	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+// Config holds the SMTP server the emails are sent through. The zero
+// Username disables authentication, as Mailpit does not need it.
+type Config struct {
+	Host      string
+	Port      int
+	TLSPolicy mail.TLSPolicy
+	Username  string
+	Password  string
 }
 
+// idleTimeout is how long the SMTP connection is kept open without sending,
+// servers dropping idle connections after a few minutes anyway.
+const idleTimeout = time.Minute
+
+// Mailpit is the SMTP mailer.Driver. Mailpit catches the emails in
+// development, any SMTP provider can be used in production.
 type Mailpit struct {
-	store store
+	cfg  Config
+	conn *conn
 }
 
-func NewMailpit(pool *pgxpool.Pool) Mailpit {
-	return Mailpit{pgstore.New(pool)}
+// conn is the SMTP connection shared by every Send. It is dialed on first use
+// and again whenever the server dropped it, and closed once idle.
+type conn struct {
+	mu     sync.Mutex
+	client *mail.Client
+	idle   *time.Timer
 }
 
-func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
-	ctx := context.Background()
-	trip, err := mp.store.GetTrip(ctx, tripID)
-	if err != nil {
-		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripToTripOwner: %w", err)
-	}
-
-	msg := mail.NewMsg()
-	if err := msg.From("mailpit@travel.com"); err != nil {
-		return fmt.Errorf("mailpit: failed to set From in email SendConfirmTripToTripOwner: %w", err)
-	}
+func NewMailpit(cfg Config) Mailpit {
+	return Mailpit{cfg, &conn{}}
+}
 
-	if err := msg.To(trip.OwnerEmail); err != nil {
-		return fmt.Errorf("mailpit: failed to set To in email SendConfirmTripToTripOwner: %w", err)
+// ParseTLSPolicy maps the "none", "opportunistic" and "mandatory" settings to
+// their TLSPolicy.
+func ParseTLSPolicy(policy string) (mail.TLSPolicy, error) {
+	switch strings.ToLower(policy) {
+	case "none":
+		return mail.NoTLS, nil
+	case "opportunistic":
+		return mail.TLSOpportunistic, nil
+	case "mandatory":
+		return mail.TLSMandatory, nil
+	default:
+		return 0, fmt.Errorf("mailpit: unknown TLS policy %q", policy)
 	}
+}
 
-	msg.Subject("Confirmação de viagem")
-	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
-		Olá, %s!
+// Send delivers msgs over the shared SMTP connection, so the emails of a trip
+// do not open one connection each.
+func (mp Mailpit) Send(ctx context.Context, msgs ...mailer.Message) error {
+	mails := make([]*mail.Msg, 0, len(msgs))
 
-		A sua viagem para %s que começa no dia %s foi confirmada com sucesso!
-		Clique no botão abaixo para ver mais detalhes sobre a sua viagem e confirmar sua presença.
-		`, trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
-	))
+	for _, m := range msgs {
+		msg, err := m.MIME()
+		if err != nil {
+			return err
+		}
 
-	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-	if err != nil {
-		return fmt.Errorf("mailpit: failed to create email client to SendConfirmTripToTripOwner: %w", err)
+		mails = append(mails, msg)
 	}
 
-	if err := client.DialAndSend(msg); err != nil {
-		return fmt.Errorf("mailpit: failed to send email to SendConfirmTripToTripOwner: %w", err)
+	mp.conn.mu.Lock()
+	defer mp.conn.mu.Unlock()
+
+	if err := mp.send(ctx, mails); err != nil {
+		return fmt.Errorf("mailpit: failed to send emails: %w", err)
 	}
 
 	return nil
 }
 
-func (mp Mailpit) SendInvitationToParticipant(email string, tripID uuid.UUID) error {
-	ctx := context.Background()
-	trip, err := mp.store.GetTrip(ctx, tripID)
-	if err != nil {
-		return fmt.Errorf("mailpit: failed to get trip for SendInvitationToParticipant: %w", err)
+// send delivers mails over the open connection, dialing a new one when there
+// is none or it no longer answers. Must be called with mp.conn.mu held.
+func (mp Mailpit) send(ctx context.Context, mails []*mail.Msg) error {
+	c := mp.conn
+	if c.idle != nil {
+		c.idle.Stop()
 	}
-	msg := mail.NewMsg()
-
-	if err := msg.From("mailpit@travel.com"); err != nil {
-		return fmt.Errorf("mailpit: failed to set From email in SendInvitationToParticipant: %w", err)
+	defer func() { c.idle = time.AfterFunc(idleTimeout, mp.closeIdle) }()
+
+	if c.client != nil {
+		// Send checks the connection with a NOOP before anything else, so
+		// nothing was sent when that check fails.
+		err := c.client.Send(mails...)
+		var sendErr *mail.SendError
+		if err == nil || !errors.As(err, &sendErr) || sendErr.Reason != mail.ErrConnCheck {
+			return err
+		}
+		_ = c.client.Close()
+		c.client = nil
 	}
 
-	if err := msg.To(email); err != nil {
-		return fmt.Errorf("mailpit: failed to set To email in SendInvitationToParticipant: %w", err)
+	client, err := mp.newClient()
+	if err != nil {
+		return fmt.Errorf("failed to create email client: %w", err)
 	}
 
-	msg.Subject("Convite para viagem!")
-	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
-		Olás!
+	if err := client.DialWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to dial: %w", err)
+	}
+	c.client = client
 
-		Você foi convidado para participar da viagem para %s que começa no dia %s.
+	return client.Send(mails...)
+}
 
-		Clique no botão abaixo para ver mais detalhes sobre a viagem e confirmar sua presença.
-		`, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
-	))
+// closeIdle closes the connection once no email was sent for idleTimeout.
+func (mp Mailpit) closeIdle() {
+	mp.conn.mu.Lock()
+	defer mp.conn.mu.Unlock()
 
-	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-	if err != nil {
-		return fmt.Errorf("mailpit: failed to create email client to SendInvitationToParticipant: %w", err)
+	if mp.conn.client != nil {
+		_ = mp.conn.client.Close()
+		mp.conn.client = nil
 	}
+}
 
-	if err := client.DialAndSend(msg); err != nil {
-		return fmt.Errorf("mailpit: failed to send email to SendInvitationToParticipant: %w", err)
+func (mp Mailpit) newClient() (*mail.Client, error) {
+	opts := []mail.Option{
+		mail.WithPort(mp.cfg.Port),
+		mail.WithTLSPolicy(mp.cfg.TLSPolicy),
+	}
+	if mp.cfg.Username != "" {
+		opts = append(opts,
+			mail.WithSMTPAuth(mail.SMTPAuthPlain),
+			mail.WithUsername(mp.cfg.Username),
+			mail.WithPassword(mp.cfg.Password),
+		)
 	}
 
-	return nil
+	return mail.NewClient(mp.cfg.Host, opts...)
 }
@@ -0,0 +1,229 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	// outboxBatch is how many emails are claimed at once.
+	outboxBatch = 20
+	// outboxLease hides a claimed email from other workers while it is sent.
+	// If the worker dies, the email is retried once the lease expires.
+	outboxLease = 5 * time.Minute
+	// maxAttempts is how many times an email is tried before being moved to
+	// the dead state.
+	maxAttempts = 8
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+	// sendTimeout bounds the sending of an email, well within outboxLease so
+	// the email is not claimed again while still being sent.
+	sendTimeout = time.Minute
+)
+
+type outboxStore interface {
+	ClaimDueEmails(context.Context, pgstore.ClaimDueEmailsParams) ([]pgstore.EmailOutbox, error)
+	MarkEmailSent(context.Context, pgstore.MarkEmailSentParams) error
+	MarkEmailFailed(context.Context, pgstore.MarkEmailFailedParams) error
+}
+
+// Outbox sends the emails written to the outbox by the transactions of the
+// app. Failed emails are retried with exponential backoff and, after
+// maxAttempts, left in the dead state for someone to look at.
+type Outbox struct {
+	store    outboxStore
+	mailer   Mailer
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewOutbox(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer, interval time.Duration) Outbox {
+	return Outbox{pgstore.New(pool), mailer, logger, interval}
+}
+
+// NewOutboxWithStore returns an Outbox sending the emails of the outbox of s
+// rather than of Postgres.
+func NewOutboxWithStore(s outboxStore, logger *zap.Logger, mailer Mailer, interval time.Duration) Outbox {
+	return Outbox{s, mailer, logger, interval}
+}
+
+// Run sends the due emails every interval until ctx is done.
+func (o Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		o.sendDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDue sends the due emails, batch after batch, until none is left.
+func (o Outbox) sendDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		if o.sendBatch(ctx) < outboxBatch {
+			return
+		}
+	}
+}
+
+// sendBatch sends a batch of due emails and returns how many were claimed.
+func (o Outbox) sendBatch(ctx context.Context) int {
+	now := time.Now().UTC()
+	emails, err := o.store.ClaimDueEmails(ctx, pgstore.ClaimDueEmailsParams{
+		LeaseUntil: pgtype.Timestamp{Valid: true, Time: now.Add(outboxLease)},
+		Now:        pgtype.Timestamp{Valid: true, Time: now},
+		Limit:      outboxBatch,
+	})
+	if err != nil {
+		o.logger.Error("failed to claim outbox emails", zap.Error(err))
+		return 0
+	}
+
+	for _, email := range emails {
+		if err := o.send(ctx, email); err != nil {
+			o.fail(ctx, email, err)
+			continue
+		}
+
+		if err := o.store.MarkEmailSent(ctx, pgstore.MarkEmailSentParams{
+			SentAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+			ID:     email.ID,
+		}); err != nil {
+			o.logger.Error("failed to mark outbox email as sent",
+				zap.Error(err),
+				zap.String("email_id", email.ID.String()),
+			)
+		}
+	}
+
+	return len(emails)
+}
+
+func (o Outbox) send(ctx context.Context, email pgstore.EmailOutbox) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	switch email.Kind {
+	case pgstore.EmailKindConfirmTrip:
+		var p pgstore.ConfirmTripEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendConfirmTripEmailToTripOwner(ctx, p.TripID)
+	case pgstore.EmailKindInvitation:
+		var p pgstore.InvitationEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendInvitationToParticipant(ctx, p.Email, p.TripID)
+	case pgstore.EmailKindEmailVerification:
+		var p pgstore.EmailVerificationEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendEmailVerification(ctx, p.Email, p.TripID)
+	case pgstore.EmailKindUnconfirmation:
+		var p pgstore.UnconfirmationEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendUnconfirmationToTripOwner(ctx, p.ParticipantID, p.Reason)
+	case pgstore.EmailKindActivityReminder:
+		var p pgstore.GetDueActivityRemindersRow
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendActivityReminder(ctx, p)
+	case pgstore.EmailKindRsvpReminder:
+		var p pgstore.GetDueRSVPRemindersRow
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendRSVPReminder(ctx, p)
+	case pgstore.EmailKindPreTripReminder:
+		var p pgstore.GetDuePreTripRemindersRow
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendPreTripReminder(ctx, p)
+	case pgstore.EmailKindDailyDigest:
+		var p pgstore.GetDueDailyDigestsRow
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendDailyDigest(ctx, p)
+	case pgstore.EmailKindTaskReminder:
+		var p pgstore.GetDueTaskRemindersRow
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendTaskReminder(ctx, p)
+	case pgstore.EmailKindFlightUpdate:
+		var p pgstore.FlightUpdateEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendFlightUpdate(ctx, p)
+	case pgstore.EmailKindPollInvitation:
+		var p pgstore.PollInvitationEmail
+		if err := json.Unmarshal(email.Payload, &p); err != nil {
+			return err
+		}
+		return o.mailer.SendPollInvitation(ctx, p.PollID, p.ParticipantID)
+	default:
+		return fmt.Errorf("mailer: unknown email kind %q", email.Kind)
+	}
+}
+
+// fail schedules the next attempt of email, or gives up on it once it
+// reaches maxAttempts.
+func (o Outbox) fail(ctx context.Context, email pgstore.EmailOutbox, sendErr error) {
+	attempts := email.Attempts + 1
+	status := pgstore.EmailStatusPending
+	if attempts >= maxAttempts {
+		status = pgstore.EmailStatusDead
+	}
+
+	o.logger.Error("failed to send outbox email",
+		zap.Error(sendErr),
+		zap.String("email_id", email.ID.String()),
+		zap.String("kind", string(email.Kind)),
+		zap.Int32("attempts", attempts),
+		zap.String("status", string(status)),
+	)
+
+	if err := o.store.MarkEmailFailed(ctx, pgstore.MarkEmailFailedParams{
+		Status:        status,
+		NextAttemptAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(backoff(attempts))},
+		LastError:     pgtype.Text{Valid: true, String: sendErr.Error()},
+		ID:            email.ID,
+	}); err != nil {
+		o.logger.Error("failed to mark outbox email as failed",
+			zap.Error(err),
+			zap.String("email_id", email.ID.String()),
+		)
+	}
+}
+
+// backoff doubles the wait between attempts, starting at baseBackoff and
+// capped at maxBackoff.
+func backoff(attempts int32) time.Duration {
+	d := baseBackoff
+	for i := int32(1); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
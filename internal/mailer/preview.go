@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+// previewCode is the verification code shown in previews.
+const previewCode = "123456"
+
+// Preview renders the HTML of the name email of the trip as participants
+// receive it. Values that depend on the participant are left out, such as
+// their name, or filled with samples, such as the verification code. The
+// links carrying action tokens point nowhere, the preview being shown to
+// anyone sending an owner email.
+func (m Mailer) Preview(ctx context.Context, tripID uuid.UUID, name string, locale pgstore.Locale) (string, error) {
+	trip, err := m.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return "", fmt.Errorf("mailer: failed to get trip for Preview: %w", err)
+	}
+
+	details := newTripDetails(trip, locale)
+	tripURL := m.url("/trips/%s", trip.ID)
+
+	var data any
+	switch name {
+	case "invitation":
+		data = invitationEmail{Trip: details, ConfirmURL: "#", DeclineURL: "#"}
+	case "email_verification":
+		data = emailVerificationEmail{
+			Trip:      details,
+			Code:      previewCode,
+			ExpiresAt: formatDateTime(locale, time.Now().Add(24*time.Hour)),
+			URL:       tripURL,
+		}
+	case "rsvp_reminder":
+		deadline := trip.StartsAt.Time
+		if trip.RsvpDeadline.Valid {
+			deadline = trip.RsvpDeadline.Time
+		}
+		data = rsvpReminderEmail{
+			Deadline:       formatDateTime(locale, deadline),
+			Trip:           tripDetails{Destination: trip.Destination},
+			URL:            tripURL,
+			UnsubscribeURL: "#",
+		}
+	case "pre_trip_reminder":
+		agenda, links, err := m.dayOne(ctx, trip, locale)
+		if err != nil {
+			return "", fmt.Errorf("mailer: failed to get agenda for Preview: %w", err)
+		}
+		data = preTripReminderEmail{
+			Trip:           details,
+			Agenda:         agenda,
+			Links:          links,
+			URL:            tripURL,
+			UnsubscribeURL: "#",
+		}
+	case "confirm_trip":
+		data = confirmTripEmail{Trip: details, ConfirmURL: "#", WebhooksURL: "#"}
+	default:
+		return "", fmt.Errorf("mailer: no preview for the %q email", name)
+	}
+
+	_, _, html, err := render(locale, name, data)
+	if err != nil {
+		return "", fmt.Errorf("mailer: failed to render email for Preview: %w", err)
+	}
+
+	return html, nil
+}
@@ -0,0 +1,103 @@
+package resend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+	"travel-api/internal/mailer"
+
+	"github.com/goccy/go-json"
+)
+
+// endpoint sends a single email. The batch endpoint does not take
+// attachments.
+const endpoint = "https://api.resend.com/emails"
+
+// Resend is the mailer.Driver sending emails through the Resend HTTP API.
+type Resend struct {
+	client *http.Client
+	apiKey string
+}
+
+func NewResend(apiKey string) Resend {
+	return Resend{&http.Client{Timeout: 30 * time.Second}, apiKey}
+}
+
+type email struct {
+	From        string            `json:"from"`
+	To          []string          `json:"to"`
+	Subject     string            `json:"subject"`
+	Text        string            `json:"text"`
+	HTML        string            `json:"html"`
+	Attachments []attachment      `json:"attachments,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+}
+
+type attachment struct {
+	Filename string `json:"filename"`
+	// Content is base64 encoded by json.Marshal.
+	Content     []byte `json:"content"`
+	ContentType string `json:"content_type"`
+}
+
+// Send delivers msgs one by one.
+func (r Resend) Send(ctx context.Context, msgs ...mailer.Message) error {
+	for _, m := range msgs {
+		if err := r.send(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r Resend) send(ctx context.Context, m mailer.Message) error {
+	e := email{
+		From:    m.From,
+		To:      []string{m.To},
+		Subject: m.Subject,
+		Text:    m.Text,
+		HTML:    m.HTML,
+	}
+	if m.Unsubscribe != "" {
+		e.Headers = map[string]string{
+			"List-Unsubscribe":      "<" + m.Unsubscribe + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		}
+	}
+	for _, a := range m.Attachments {
+		e.Attachments = append(e.Attachments, attachment{
+			Filename:    a.Filename,
+			Content:     a.Data,
+			ContentType: a.ContentType,
+		})
+	}
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("resend: failed to encode email: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("resend: failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+r.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("resend: failed to send email: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		return fmt.Errorf("resend: unexpected status %d: %s", res.StatusCode, msg)
+	}
+
+	return nil
+}
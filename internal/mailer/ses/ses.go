@@ -0,0 +1,148 @@
+package ses
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+	"travel-api/internal/mailer"
+
+	"github.com/goccy/go-json"
+)
+
+const path = "/v2/email/outbound-emails"
+
+// Config holds the AWS region and credentials SES is called with. SessionToken
+// is only set for temporary credentials.
+type Config struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// SES is the mailer.Driver sending emails through the Amazon SES v2 API.
+type SES struct {
+	client *http.Client
+	cfg    Config
+}
+
+func NewSES(cfg Config) SES {
+	return SES{&http.Client{Timeout: 30 * time.Second}, cfg}
+}
+
+// sendEmailInput sends the email as raw MIME, which unlike simple content
+// carries the attachments.
+type sendEmailInput struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Raw struct {
+			// Data is base64 encoded by json.Marshal.
+			Data []byte `json:"Data"`
+		} `json:"Raw"`
+	} `json:"Content"`
+}
+
+// Send delivers msgs one by one, as SES takes a single email per request.
+func (s SES) Send(ctx context.Context, msgs ...mailer.Message) error {
+	for _, m := range msgs {
+		if err := s.send(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s SES) send(ctx context.Context, m mailer.Message) error {
+	msg, err := m.MIME()
+	if err != nil {
+		return err
+	}
+
+	var raw bytes.Buffer
+	if _, err := msg.WriteTo(&raw); err != nil {
+		return fmt.Errorf("ses: failed to write email: %w", err)
+	}
+
+	var in sendEmailInput
+	in.FromEmailAddress = m.From
+	in.Destination.ToAddresses = []string{m.To}
+	in.Content.Raw.Data = raw.Bytes()
+
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("ses: failed to encode email: %w", err)
+	}
+
+	host := fmt.Sprintf("email.%s.amazonaws.com", s.cfg.Region)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("ses: failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, host, body, time.Now().UTC())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("ses: failed to send email: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		return fmt.Errorf("ses: unexpected status %d: %s", res.StatusCode, msg)
+	}
+
+	return nil
+}
+
+// sign adds the AWS Signature Version 4 headers to req.
+func (s SES) sign(req *http.Request, host string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	signedHeaders := "content-type;host;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("content-type:%s\nhost:%s\nx-amz-date:%s\n",
+		req.Header.Get("Content-Type"), host, amzDate)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += "x-amz-security-token:" + s.cfg.SessionToken + "\n"
+	}
+
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := fmt.Sprintf("%s\n%s\n\n%s\n%s\n%s",
+		req.Method, path, canonicalHeaders, signedHeaders, hex.EncodeToString(payloadHash[:]))
+
+	scope := fmt.Sprintf("%s/%s/ses/aws4_request", date, s.cfg.Region)
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := fmt.Sprintf("AWS4-HMAC-SHA256\n%s\n%s\n%s",
+		amzDate, scope, hex.EncodeToString(requestHash[:]))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "ses")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
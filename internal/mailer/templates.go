@@ -0,0 +1,361 @@
+package mailer
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+	"time"
+	"travel-api/internal/pgstore"
+	"travel-api/internal/weather"
+)
+
+// Every locale has a directory under templates holding a <name>.txt and a
+// <name>.html template per email, which drivers send together as a
+// multipart/alternative message. The .txt template also defines the
+// "subject" of the email, and layout.* holds the blocks shared by the emails of
+// the locale.
+//
+//go:embed templates
+var templatesFS embed.FS
+
+// fallbackLocale is used for the locales, and emails, without templates.
+const fallbackLocale = pgstore.LocalePtBR
+
+type templateSet struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// templates holds the template set of every email by locale and name, as
+// layout blocks are redefined by each locale.
+var templates = mustParseTemplates()
+
+// funcs lets templates build their button, keeping its label next to the
+// rest of the text.
+var funcs = map[string]any{
+	"button": func(label, url string) button { return button{label, url} },
+}
+
+func mustParseTemplates() map[pgstore.Locale]map[string]templateSet {
+	sets := make(map[pgstore.Locale]map[string]templateSet)
+
+	locales, err := fs.ReadDir(templatesFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, dir := range locales {
+		locale := pgstore.Locale(dir.Name())
+		sets[locale] = make(map[string]templateSet)
+
+		names, err := fs.Glob(templatesFS, path.Join("templates", dir.Name(), "*.txt"))
+		if err != nil {
+			panic(err)
+		}
+
+		for _, file := range names {
+			name := strings.TrimSuffix(path.Base(file), ".txt")
+			if name == "layout" {
+				// Blocks used outside of the emails, such as the calendar
+				// text, are rendered from the layout alone.
+				sets[locale][name] = templateSet{text: texttemplate.Must(texttemplate.ParseFS(templatesFS, file))}
+				continue
+			}
+
+			dir := path.Dir(file)
+			sets[locale][name] = templateSet{
+				text: texttemplate.Must(texttemplate.New(name+".txt").Funcs(funcs).
+					ParseFS(templatesFS, path.Join(dir, name+".txt"), path.Join(dir, "layout.txt"))),
+				html: htmltemplate.Must(htmltemplate.New(name+".html").Funcs(funcs).
+					ParseFS(templatesFS, path.Join(dir, name+".html"), path.Join(dir, "layout.html"))),
+			}
+		}
+	}
+
+	return sets
+}
+
+// lookup returns the name templates of locale, or of fallbackLocale when
+// locale has no translation of them.
+func lookup(locale pgstore.Locale, name string) (templateSet, error) {
+	if set, ok := templates[locale][name]; ok {
+		return set, nil
+	}
+
+	if set, ok := templates[fallbackLocale][name]; ok {
+		return set, nil
+	}
+
+	return templateSet{}, fmt.Errorf("mailer: missing templates for %q", name)
+}
+
+// layouts holds how each locale writes dates and times.
+var layouts = map[pgstore.Locale]struct{ date, dateTime, clock string }{
+	pgstore.LocalePtBR: {"02/01/2006", "02/01/2006 15:04", "15:04"},
+	pgstore.LocaleEn:   {"01/02/2006", "01/02/2006 3:04 PM", "3:04 PM"},
+	pgstore.LocaleEs:   {"02/01/2006", "02/01/2006 15:04", "15:04"},
+}
+
+func formatDate(locale pgstore.Locale, t time.Time) string {
+	l, ok := layouts[locale]
+	if !ok {
+		l = layouts[fallbackLocale]
+	}
+	return t.Format(l.date)
+}
+
+func formatDateTime(locale pgstore.Locale, t time.Time) string {
+	l, ok := layouts[locale]
+	if !ok {
+		l = layouts[fallbackLocale]
+	}
+	return t.Format(l.dateTime)
+}
+
+// conditions name the weather conditions in each locale.
+var conditions = map[pgstore.Locale]map[weather.Condition]string{
+	pgstore.LocalePtBR: {
+		weather.Clear:        "Céu limpo",
+		weather.PartlyCloudy: "Parcialmente nublado",
+		weather.Cloudy:       "Nublado",
+		weather.Fog:          "Neblina",
+		weather.Rain:         "Chuva",
+		weather.Snow:         "Neve",
+		weather.Storm:        "Tempestade",
+	},
+	pgstore.LocaleEn: {
+		weather.Clear:        "Clear",
+		weather.PartlyCloudy: "Partly cloudy",
+		weather.Cloudy:       "Cloudy",
+		weather.Fog:          "Fog",
+		weather.Rain:         "Rain",
+		weather.Snow:         "Snow",
+		weather.Storm:        "Storm",
+	},
+	pgstore.LocaleEs: {
+		weather.Clear:        "Despejado",
+		weather.PartlyCloudy: "Parcialmente nublado",
+		weather.Cloudy:       "Nublado",
+		weather.Fog:          "Niebla",
+		weather.Rain:         "Lluvia",
+		weather.Snow:         "Nieve",
+		weather.Storm:        "Tormenta",
+	},
+}
+
+// formatCondition names condition in locale, empty for the conditions it
+// does not know.
+func formatCondition(locale pgstore.Locale, condition weather.Condition) string {
+	names, ok := conditions[locale]
+	if !ok {
+		names = conditions[fallbackLocale]
+	}
+	return names[condition]
+}
+
+func formatTime(locale pgstore.Locale, t time.Time) string {
+	l, ok := layouts[locale]
+	if !ok {
+		l = layouts[fallbackLocale]
+	}
+	return t.Format(l.clock)
+}
+
+type tripDetails struct {
+	Destination string
+	StartsAt    string
+	EndsAt      string
+}
+
+type button struct {
+	Label string
+	URL   string
+}
+
+type confirmTripEmail struct {
+	Name        string
+	Trip        tripDetails
+	ConfirmURL  string
+	WebhooksURL string
+}
+
+type invitationEmail struct {
+	Name       string
+	Trip       tripDetails
+	ConfirmURL string
+	DeclineURL string
+}
+
+type emailVerificationEmail struct {
+	Name      string
+	Trip      tripDetails
+	Code      string
+	ExpiresAt string
+	URL       string
+}
+
+type unconfirmationEmail struct {
+	Name        string
+	Participant string
+	Reason      string
+	Trip        tripDetails
+	URL         string
+}
+
+type activityReminderEmail struct {
+	Name           string
+	Activity       string
+	OccursAt       string
+	Trip           tripDetails
+	URL            string
+	UnsubscribeURL string
+}
+
+type rsvpReminderEmail struct {
+	Name           string
+	Deadline       string
+	Trip           tripDetails
+	URL            string
+	UnsubscribeURL string
+}
+
+type taskReminderEmail struct {
+	Name           string
+	Task           string
+	DueAt          string
+	Trip           tripDetails
+	URL            string
+	UnsubscribeURL string
+}
+
+// flightUpdateEmail tells what changed of a flight, the previous departure
+// and gate being left empty when they did not.
+type flightUpdateEmail struct {
+	Name                string
+	Flight              string
+	From                string
+	To                  string
+	DepartureAt         string
+	PreviousDepartureAt string
+	Gate                string
+	PreviousGate        string
+	Trip                tripDetails
+	URL                 string
+	UnsubscribeURL      string
+}
+
+type agendaItem struct {
+	Time    string
+	Title   string
+	Address string
+}
+
+type tripLink struct {
+	Title string
+	URL   string
+}
+
+type preTripReminderEmail struct {
+	Name           string
+	Trip           tripDetails
+	Agenda         []agendaItem
+	Links          []tripLink
+	URL            string
+	UnsubscribeURL string
+}
+
+// weatherHint is the forecast of the day of a daily digest, the temperatures
+// being rounded to whole degrees Celsius.
+type weatherHint struct {
+	Condition                string
+	MinTemperature           int
+	MaxTemperature           int
+	PrecipitationProbability int
+}
+
+type dailyDigestEmail struct {
+	Name           string
+	Destination    string
+	Day            string
+	Weather        *weatherHint
+	Agenda         []agendaItem
+	URL            string
+	UnsubscribeURL string
+}
+
+// pollChoice is an option of a poll, with the link voting for it. Options of
+// destination polls set the destination, the others their dates.
+type pollChoice struct {
+	Destination string
+	StartsAt    string
+	EndsAt      string
+	URL         string
+}
+
+type pollInvitationEmail struct {
+	Name     string
+	Question string
+	Trip     tripDetails
+	Options  []pollChoice
+}
+
+// calendarText is the summary and description of the trip event, written in
+// the language of the email it is attached to.
+type calendarText struct {
+	Destination string
+	URL         string
+}
+
+// render executes the name templates of locale with data, returning the
+// subject of the email, its plain text body and its HTML alternative.
+func render(locale pgstore.Locale, name string, data any) (subject, text, html string, err error) {
+	set, err := lookup(locale, name)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var b strings.Builder
+	if err := set.text.ExecuteTemplate(&b, "subject", data); err != nil {
+		return "", "", "", err
+	}
+	subject = strings.TrimSpace(b.String())
+
+	b.Reset()
+	if err := set.text.Execute(&b, data); err != nil {
+		return "", "", "", err
+	}
+	text = b.String()
+
+	b.Reset()
+	if err := set.html.Execute(&b, data); err != nil {
+		return "", "", "", err
+	}
+
+	return subject, text, b.String(), nil
+}
+
+// renderCalendar returns the summary and description of the trip event in
+// locale.
+func renderCalendar(locale pgstore.Locale, data calendarText) (summary, description string, err error) {
+	set, err := lookup(locale, "layout")
+	if err != nil {
+		return "", "", err
+	}
+
+	var b strings.Builder
+	if err := set.text.ExecuteTemplate(&b, "calendar_summary", data); err != nil {
+		return "", "", err
+	}
+	summary = b.String()
+
+	b.Reset()
+	if err := set.text.ExecuteTemplate(&b, "calendar_description", data); err != nil {
+		return "", "", err
+	}
+
+	return summary, b.String(), nil
+}
@@ -0,0 +1,442 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// checkActivity checks the constraints of the activities table.
+func checkActivity(occursAt, endsAt pgtype.Timestamp, latitude, longitude pgtype.Float8) error {
+	if endsAt.Valid && !endsAt.Time.After(occursAt.Time) {
+		return checkViolation("activities_ends_after_start")
+	}
+	if latitude.Valid && (latitude.Float64 < -90 || latitude.Float64 > 90) {
+		return checkViolation("activities_latitude_range")
+	}
+	if longitude.Valid && (longitude.Float64 < -180 || longitude.Float64 > 180) {
+		return checkViolation("activities_longitude_range")
+	}
+
+	return nil
+}
+
+// checkNewActivity checks the activity can be added. The caller holds the
+// lock.
+func (s *Store) checkNewActivity(arg pgstore.CreateActivityParams) error {
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("activities_trip_id_fkey")
+	}
+
+	return checkActivity(arg.OccursAt, arg.EndsAt, arg.Latitude, arg.Longitude)
+}
+
+// insertActivity adds the activity. The caller holds the lock and checked
+// the constraints.
+func (s *Store) insertActivity(arg pgstore.CreateActivityParams) uuid.UUID {
+	a := pgstore.Activity{
+		ID:        uuid.New(),
+		TripID:    arg.TripID,
+		Title:     arg.Title,
+		OccursAt:  arg.OccursAt,
+		EndsAt:    arg.EndsAt,
+		Address:   arg.Address,
+		Latitude:  arg.Latitude,
+		Longitude: arg.Longitude,
+		Category:  arg.Category,
+		PlaceName: arg.PlaceName,
+		CreatedAt: now(),
+	}
+	s.activities[a.ID] = a
+	s.notifyParticipants(a.TripID, pgstore.NotificationEventActivityCreated, a.ID, uuid.Nil)
+
+	return a.ID
+}
+
+// activeActivity returns the activity unless it was deleted. The caller holds
+// the lock.
+func (s *Store) activeActivity(id uuid.UUID) (pgstore.Activity, bool) {
+	a, ok := s.activities[id]
+	return a, ok && !a.DeletedAt.Valid
+}
+
+func (s *Store) CreateActivity(_ context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkNewActivity(arg); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return s.insertActivity(arg), nil
+}
+
+func (s *Store) CreateActivitiesTx(_ context.Context, _ *pgxpool.Pool, params []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range params {
+		if err := s.checkNewActivity(p); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]uuid.UUID, len(params))
+	for i, p := range params {
+		ids[i] = s.insertActivity(p)
+	}
+
+	return ids, nil
+}
+
+func (s *Store) GetTripActivities(_ context.Context, arg pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var activities []pgstore.Activity
+	for _, a := range s.activities {
+		if a.TripID != arg.TripID || a.DeletedAt.Valid {
+			continue
+		}
+		if arg.Category.Valid && a.Category != arg.Category.ActivityCategory {
+			continue
+		}
+		if arg.FromTime.Valid && a.OccursAt.Time.Before(arg.FromTime.Time) {
+			continue
+		}
+		if arg.ToTime.Valid && a.OccursAt.Time.After(arg.ToTime.Time) {
+			continue
+		}
+
+		activities = append(activities, a)
+	}
+
+	sort.Slice(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if !a.OccursAt.Time.Equal(b.OccursAt.Time) {
+			return a.OccursAt.Time.Before(b.OccursAt.Time)
+		}
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		return a.ID.String() < b.ID.String()
+	})
+
+	return activities, nil
+}
+
+func (s *Store) GetActivity(_ context.Context, arg pgstore.GetActivityParams) (pgstore.Activity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.activeActivity(arg.ID)
+	if !ok || a.TripID != arg.TripID {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+
+	return a, nil
+}
+
+func (s *Store) GetActivityTripID(_ context.Context, id uuid.UUID) (uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.activeActivity(id)
+	if !ok {
+		return uuid.UUID{}, pgx.ErrNoRows
+	}
+
+	return a.TripID, nil
+}
+
+func (s *Store) UpdateActivity(_ context.Context, arg pgstore.UpdateActivityParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.activeActivity(arg.ID)
+	if !ok {
+		return nil
+	}
+
+	if err := checkActivity(arg.OccursAt, arg.EndsAt, arg.Latitude, arg.Longitude); err != nil {
+		return err
+	}
+
+	a.Title = arg.Title
+	a.OccursAt = arg.OccursAt
+	a.EndsAt = arg.EndsAt
+	a.Address = arg.Address
+	a.Latitude = arg.Latitude
+	a.Longitude = arg.Longitude
+	a.Category = arg.Category
+	a.PlaceName = arg.PlaceName
+	s.activities[arg.ID] = a
+
+	return nil
+}
+
+func (s *Store) DeleteActivity(_ context.Context, arg pgstore.DeleteActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.activeActivity(arg.ID)
+	if !ok || a.TripID != arg.TripID {
+		return 0, nil
+	}
+
+	a.DeletedAt = now()
+	s.activities[arg.ID] = a
+
+	return 1, nil
+}
+
+func (s *Store) RestoreActivity(_ context.Context, arg pgstore.RestoreActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.activities[arg.ID]
+	if !ok || a.TripID != arg.TripID || !a.DeletedAt.Valid {
+		return 0, nil
+	}
+
+	a.DeletedAt = pgtype.Timestamp{}
+	s.activities[arg.ID] = a
+
+	return 1, nil
+}
+
+func (s *Store) ReorderActivitiesTx(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, activityIDs []uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range activityIDs {
+		if a, ok := s.activeActivity(id); !ok || a.TripID != tripID {
+			return fmt.Errorf("%w: %s", pgstore.ErrActivityNotInTrip, id)
+		}
+	}
+
+	for i, id := range activityIDs {
+		a := s.activities[id]
+		a.Position = int32(i)
+		s.activities[id] = a
+	}
+
+	return nil
+}
+
+// checkParticipantActivity checks the foreign keys of a row of the
+// participant about the activity. The caller holds the lock.
+func (s *Store) checkParticipantActivity(table string, activityID, participantID uuid.UUID) error {
+	if _, ok := s.activities[activityID]; !ok {
+		return foreignKeyViolation(table + "_activity_id_fkey")
+	}
+	if _, ok := s.participants[participantID]; !ok {
+		return foreignKeyViolation(table + "_participant_id_fkey")
+	}
+
+	return nil
+}
+
+func (s *Store) UpsertActivityRSVP(_ context.Context, arg pgstore.UpsertActivityRSVPParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkParticipantActivity("activity_attendees", arg.ActivityID, arg.ParticipantID); err != nil {
+		return err
+	}
+
+	s.attendees[participantActivity{arg.ActivityID, arg.ParticipantID}] = arg.Attending
+
+	return nil
+}
+
+func (s *Store) GetTripActivityAttendeeCounts(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityAttendeeCountsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[uuid.UUID]int64)
+	for key, attending := range s.attendees {
+		if a, ok := s.activeActivity(key.activityID); ok && a.TripID == tripID && attending {
+			counts[key.activityID]++
+		}
+	}
+
+	rows := make([]pgstore.GetTripActivityAttendeeCountsRow, 0, len(counts))
+	for activityID, attendees := range counts {
+		rows = append(rows, pgstore.GetTripActivityAttendeeCountsRow{ActivityID: activityID, Attendees: attendees})
+	}
+
+	return rows, nil
+}
+
+func (s *Store) VoteActivity(_ context.Context, arg pgstore.VoteActivityParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkParticipantActivity("activity_votes", arg.ActivityID, arg.ParticipantID); err != nil {
+		return err
+	}
+
+	s.votes[participantActivity{arg.ActivityID, arg.ParticipantID}] = true
+
+	return nil
+}
+
+func (s *Store) UnvoteActivity(_ context.Context, arg pgstore.UnvoteActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := participantActivity{arg.ActivityID, arg.ParticipantID}
+	if !s.votes[key] {
+		return 0, nil
+	}
+
+	delete(s.votes, key)
+
+	return 1, nil
+}
+
+func (s *Store) GetTripActivityVoteCounts(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityVoteCountsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[uuid.UUID]int64)
+	for key := range s.votes {
+		if a, ok := s.activeActivity(key.activityID); ok && a.TripID == tripID {
+			counts[key.activityID]++
+		}
+	}
+
+	rows := make([]pgstore.GetTripActivityVoteCountsRow, 0, len(counts))
+	for activityID, votes := range counts {
+		rows = append(rows, pgstore.GetTripActivityVoteCountsRow{ActivityID: activityID, Votes: votes})
+	}
+
+	return rows, nil
+}
+
+func (s *Store) CreateActivityComment(_ context.Context, arg pgstore.CreateActivityCommentParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkParticipantActivity("activity_comments", arg.ActivityID, arg.ParticipantID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	c := comment{
+		GetActivityCommentsRow: pgstore.GetActivityCommentsRow{
+			ID:            uuid.New(),
+			ParticipantID: arg.ParticipantID,
+			Body:          arg.Body,
+			CreatedAt:     now(),
+		},
+		activityID: arg.ActivityID,
+	}
+	s.comments[c.ID] = c
+
+	return c.ID, nil
+}
+
+func (s *Store) GetActivityComments(_ context.Context, activityID uuid.UUID) ([]pgstore.GetActivityCommentsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetActivityCommentsRow
+	for _, c := range s.comments {
+		if c.activityID != activityID || c.deletedAt.Valid {
+			continue
+		}
+
+		row := c.GetActivityCommentsRow
+		row.Email = s.participants[c.ParticipantID].Email
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].CreatedAt.Time.Before(rows[j].CreatedAt.Time) })
+
+	return rows, nil
+}
+
+// setCommentDeleted deletes or restores the comment of the participant about
+// the activity, and returns the number of comments changed. The caller holds
+// the lock.
+func (s *Store) setCommentDeleted(id, activityID, participantID uuid.UUID, deleted bool) int64 {
+	c, ok := s.comments[id]
+	if !ok || c.activityID != activityID || c.ParticipantID != participantID || c.deletedAt.Valid == deleted {
+		return 0
+	}
+
+	c.deletedAt = pgtype.Timestamp{}
+	if deleted {
+		c.deletedAt = now()
+	}
+	s.comments[id] = c
+
+	return 1
+}
+
+func (s *Store) DeleteActivityComment(_ context.Context, arg pgstore.DeleteActivityCommentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.setCommentDeleted(arg.ID, arg.ActivityID, arg.ParticipantID, true), nil
+}
+
+func (s *Store) RestoreActivityComment(_ context.Context, arg pgstore.RestoreActivityCommentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.setCommentDeleted(arg.ID, arg.ActivityID, arg.ParticipantID, false), nil
+}
+
+func (s *Store) CreateActivityAttachment(_ context.Context, arg pgstore.CreateActivityAttachmentParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.activities[arg.ActivityID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("activity_attachments_activity_id_fkey")
+	}
+
+	for _, other := range s.attachments {
+		if other.StorageKey == arg.StorageKey {
+			return uuid.UUID{}, uniqueViolation("activity_attachments_storage_key_key")
+		}
+	}
+
+	attachment := pgstore.ActivityAttachment{
+		ID:          uuid.New(),
+		ActivityID:  arg.ActivityID,
+		FileName:    arg.FileName,
+		ContentType: arg.ContentType,
+		Size:        arg.Size,
+		StorageKey:  arg.StorageKey,
+		CreatedAt:   now(),
+	}
+	s.attachments[attachment.ID] = attachment
+
+	return attachment.ID, nil
+}
+
+func (s *Store) GetActivityAttachments(_ context.Context, activityID uuid.UUID) ([]pgstore.ActivityAttachment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var attachments []pgstore.ActivityAttachment
+	for _, attachment := range s.attachments {
+		if attachment.ActivityID == activityID {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].CreatedAt.Time.Before(attachments[j].CreatedAt.Time)
+	})
+
+	return attachments, nil
+}
@@ -0,0 +1,120 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Store) CreateBooking(_ context.Context, arg pgstore.CreateBookingParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("bookings_trip_id_fkey")
+	}
+	if err := checkBooking(arg.CheckInAt, arg.CheckOutAt, arg.Price, arg.Currency); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	booking := pgstore.Booking{
+		ID:               uuid.New(),
+		TripID:           arg.TripID,
+		Type:             arg.Type,
+		Name:             arg.Name,
+		CheckInAt:        arg.CheckInAt,
+		CheckOutAt:       arg.CheckOutAt,
+		Address:          arg.Address,
+		ConfirmationCode: arg.ConfirmationCode,
+		Price:            arg.Price,
+		Currency:         arg.Currency,
+		Url:              arg.Url,
+		CreatedAt:        now(),
+	}
+	s.bookings[booking.ID] = booking
+
+	return booking.ID, nil
+}
+
+// GetTripBookings lists the bookings of the trip by check-in.
+func (s *Store) GetTripBookings(_ context.Context, tripID uuid.UUID) ([]pgstore.Booking, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var bookings []pgstore.Booking
+	for _, booking := range s.bookings {
+		if booking.TripID == tripID {
+			bookings = append(bookings, booking)
+		}
+	}
+
+	sort.Slice(bookings, func(i, j int) bool {
+		a, b := bookings[i], bookings[j]
+		if !a.CheckInAt.Time.Equal(b.CheckInAt.Time) {
+			return a.CheckInAt.Time.Before(b.CheckInAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return bookings, nil
+}
+
+func (s *Store) UpdateBooking(_ context.Context, arg pgstore.UpdateBookingParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[arg.ID]
+	if !ok || booking.TripID != arg.TripID {
+		return 0, nil
+	}
+	if err := checkBooking(arg.CheckInAt, arg.CheckOutAt, arg.Price, arg.Currency); err != nil {
+		return 0, err
+	}
+
+	booking.Type = arg.Type
+	booking.Name = arg.Name
+	booking.CheckInAt = arg.CheckInAt
+	booking.CheckOutAt = arg.CheckOutAt
+	booking.Address = arg.Address
+	booking.ConfirmationCode = arg.ConfirmationCode
+	booking.Price = arg.Price
+	booking.Currency = arg.Currency
+	booking.Url = arg.Url
+	s.bookings[booking.ID] = booking
+
+	return 1, nil
+}
+
+func (s *Store) DeleteBooking(_ context.Context, arg pgstore.DeleteBookingParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[arg.ID]
+	if !ok || booking.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.bookings, booking.ID)
+
+	return 1, nil
+}
+
+// checkBooking checks the constraints of the bookings table.
+func checkBooking(checkInAt, checkOutAt pgtype.Timestamp, price pgtype.Int8, currency pgtype.Text) error {
+	if !checkOutAt.Time.After(checkInAt.Time) {
+		return checkViolation("bookings_check_out_at_check")
+	}
+	if price.Valid && price.Int64 <= 0 {
+		return checkViolation("bookings_price_check")
+	}
+	if currency.Valid && !currencyCode.MatchString(currency.String) {
+		return checkViolation("bookings_currency_check")
+	}
+	if price.Valid != currency.Valid {
+		return checkViolation("bookings_price_currency_check")
+	}
+	return nil
+}
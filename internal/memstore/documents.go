@@ -0,0 +1,102 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Store) CreateDocument(_ context.Context, arg pgstore.CreateDocumentParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("documents_trip_id_fkey")
+	}
+	if arg.ParticipantID.Valid {
+		if _, ok := s.participants[arg.ParticipantID.Bytes]; !ok {
+			return uuid.UUID{}, foreignKeyViolation("documents_participant_id_fkey")
+		}
+	}
+	if arg.Size <= 0 {
+		return uuid.UUID{}, checkViolation("documents_size_check")
+	}
+
+	for _, other := range s.documents {
+		if other.StorageKey == arg.StorageKey {
+			return uuid.UUID{}, uniqueViolation("documents_storage_key_key")
+		}
+	}
+
+	document := pgstore.Document{
+		ID:            uuid.New(),
+		TripID:        arg.TripID,
+		ParticipantID: arg.ParticipantID,
+		Kind:          arg.Kind,
+		FileName:      arg.FileName,
+		ContentType:   arg.ContentType,
+		Size:          arg.Size,
+		StorageKey:    arg.StorageKey,
+		CreatedAt:     now(),
+	}
+	s.documents[document.ID] = document
+
+	return document.ID, nil
+}
+
+// GetTripDocuments lists the documents of the whole trip and the ones of the
+// participant, the oldest first.
+func (s *Store) GetTripDocuments(_ context.Context, arg pgstore.GetTripDocumentsParams) ([]pgstore.Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var documents []pgstore.Document
+	for _, document := range s.documents {
+		if document.TripID != arg.TripID {
+			continue
+		}
+		if document.ParticipantID.Valid && (!arg.ParticipantID.Valid || document.ParticipantID.Bytes != arg.ParticipantID.Bytes) {
+			continue
+		}
+		documents = append(documents, document)
+	}
+
+	sort.Slice(documents, func(i, j int) bool {
+		a, b := documents[i], documents[j]
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return documents, nil
+}
+
+func (s *Store) GetDocument(_ context.Context, arg pgstore.GetDocumentParams) (pgstore.Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	document, ok := s.documents[arg.ID]
+	if !ok || document.TripID != arg.TripID {
+		return pgstore.Document{}, pgx.ErrNoRows
+	}
+
+	return document, nil
+}
+
+func (s *Store) DeleteDocument(_ context.Context, arg pgstore.DeleteDocumentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	document, ok := s.documents[arg.ID]
+	if !ok || document.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.documents, document.ID)
+
+	return 1, nil
+}
@@ -0,0 +1,245 @@
+package memstore
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// currencyCode is the check constraint of the currency of the expenses.
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// checkExpense checks the constraints of the expenses table. The caller holds
+// the lock.
+func (s *Store) checkExpense(tripID, payerID uuid.UUID, activityID pgtype.UUID, amount int64, currency string) error {
+	if _, ok := s.trips[tripID]; !ok {
+		return foreignKeyViolation("expenses_trip_id_fkey")
+	}
+	if p, ok := s.participants[payerID]; !ok || p.TripID != tripID {
+		return foreignKeyViolation("expenses_payer_id_fkey")
+	}
+	if activityID.Valid {
+		if a, ok := s.activities[activityID.Bytes]; !ok || a.TripID != tripID {
+			return foreignKeyViolation("expenses_activity_id_fkey")
+		}
+	}
+	if amount <= 0 {
+		return checkViolation("expenses_amount_check")
+	}
+	if !currencyCode.MatchString(currency) {
+		return checkViolation("expenses_currency_check")
+	}
+
+	return nil
+}
+
+func (s *Store) CreateTripExpense(_ context.Context, arg pgstore.CreateTripExpenseParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkExpense(arg.TripID, arg.PayerID, arg.ActivityID, arg.Amount, arg.Currency); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	e := pgstore.Expense{
+		ID:          uuid.New(),
+		TripID:      arg.TripID,
+		PayerID:     arg.PayerID,
+		ActivityID:  arg.ActivityID,
+		Description: arg.Description,
+		Amount:      arg.Amount,
+		Currency:    arg.Currency,
+		Category:    arg.Category,
+		SpentAt:     arg.SpentAt,
+		CreatedAt:   now(),
+	}
+	s.expenses[e.ID] = e
+
+	return e.ID, nil
+}
+
+func (s *Store) GetTripExpenses(_ context.Context, tripID uuid.UUID) ([]pgstore.Expense, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var expenses []pgstore.Expense
+	for _, e := range s.expenses {
+		if e.TripID == tripID {
+			expenses = append(expenses, e)
+		}
+	}
+
+	sort.Slice(expenses, func(i, j int) bool {
+		a, b := expenses[i], expenses[j]
+		if !a.SpentAt.Time.Equal(b.SpentAt.Time) {
+			return a.SpentAt.Time.Before(b.SpentAt.Time)
+		}
+		return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+	})
+
+	return expenses, nil
+}
+
+func (s *Store) UpdateTripExpense(_ context.Context, arg pgstore.UpdateTripExpenseParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.expenses[arg.ID]
+	if !ok || e.TripID != arg.TripID {
+		return 0, nil
+	}
+
+	if err := s.checkExpense(arg.TripID, arg.PayerID, arg.ActivityID, arg.Amount, arg.Currency); err != nil {
+		return 0, err
+	}
+
+	e.PayerID = arg.PayerID
+	e.ActivityID = arg.ActivityID
+	e.Description = arg.Description
+	e.Amount = arg.Amount
+	e.Currency = arg.Currency
+	e.Category = arg.Category
+	e.SpentAt = arg.SpentAt
+	s.expenses[e.ID] = e
+
+	return 1, nil
+}
+
+func (s *Store) DeleteTripExpense(_ context.Context, arg pgstore.DeleteTripExpenseParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.expenses[arg.ID]
+	if !ok || e.TripID != arg.TripID {
+		return 0, nil
+	}
+
+	delete(s.expenses, e.ID)
+
+	return 1, nil
+}
+
+func (s *Store) GetTripExpenseTotals(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseTotalsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type group struct {
+		currency string
+		category pgstore.ExpenseCategory
+		payerID  uuid.UUID
+	}
+
+	totals := make(map[group]int64)
+	for _, e := range s.expenses {
+		if e.TripID == tripID {
+			totals[group{e.Currency, e.Category, e.PayerID}] += e.Amount
+		}
+	}
+
+	rows := make([]pgstore.GetTripExpenseTotalsRow, 0, len(totals))
+	for g, total := range totals {
+		rows = append(rows, pgstore.GetTripExpenseTotalsRow{
+			Currency: g.currency,
+			Category: g.category,
+			PayerID:  g.payerID,
+			Total:    total,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Currency != b.Currency {
+			return a.Currency < b.Currency
+		}
+		if a.Category != b.Category {
+			return expenseCategoryOrder[a.Category] < expenseCategoryOrder[b.Category]
+		}
+		return a.PayerID.String() < b.PayerID.String()
+	})
+
+	return rows, nil
+}
+
+// expenseCategoryOrder is the order of the values of the expense_category
+// enum, which Postgres sorts them by.
+var expenseCategoryOrder = map[pgstore.ExpenseCategory]int{
+	pgstore.ExpenseCategoryFood:      0,
+	pgstore.ExpenseCategoryTransport: 1,
+	pgstore.ExpenseCategoryLodging:   2,
+	pgstore.ExpenseCategoryTickets:   3,
+	pgstore.ExpenseCategoryShopping:  4,
+	pgstore.ExpenseCategoryOther:     5,
+}
+
+func (s *Store) CreateSettlementPayment(_ context.Context, arg pgstore.CreateSettlementPaymentParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("settlement_payments_trip_id_fkey")
+	}
+	if p, ok := s.participants[arg.FromParticipantID]; !ok || p.TripID != arg.TripID {
+		return uuid.UUID{}, foreignKeyViolation("settlement_payments_from_participant_id_fkey")
+	}
+	if p, ok := s.participants[arg.ToParticipantID]; !ok || p.TripID != arg.TripID {
+		return uuid.UUID{}, foreignKeyViolation("settlement_payments_to_participant_id_fkey")
+	}
+	if arg.Amount <= 0 {
+		return uuid.UUID{}, checkViolation("settlement_payments_amount_check")
+	}
+	if !currencyCode.MatchString(arg.Currency) {
+		return uuid.UUID{}, checkViolation("settlement_payments_currency_check")
+	}
+	if arg.FromParticipantID == arg.ToParticipantID {
+		return uuid.UUID{}, checkViolation("settlement_payments_distinct_participants")
+	}
+
+	p := pgstore.SettlementPayment{
+		ID:                uuid.New(),
+		TripID:            arg.TripID,
+		FromParticipantID: arg.FromParticipantID,
+		ToParticipantID:   arg.ToParticipantID,
+		Amount:            arg.Amount,
+		Currency:          arg.Currency,
+		PaidAt:            now(),
+	}
+	s.payments[p.ID] = p
+
+	return p.ID, nil
+}
+
+func (s *Store) GetTripSettlementPayments(_ context.Context, tripID uuid.UUID) ([]pgstore.SettlementPayment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var payments []pgstore.SettlementPayment
+	for _, p := range s.payments {
+		if p.TripID == tripID {
+			payments = append(payments, p)
+		}
+	}
+
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].PaidAt.Time.Before(payments[j].PaidAt.Time)
+	})
+
+	return payments, nil
+}
+
+func (s *Store) DeleteSettlementPayment(_ context.Context, arg pgstore.DeleteSettlementPaymentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.payments[arg.ID]
+	if !ok || p.TripID != arg.TripID {
+		return 0, nil
+	}
+
+	delete(s.payments, p.ID)
+
+	return 1, nil
+}
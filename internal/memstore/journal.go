@@ -0,0 +1,113 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateJournalEntry(_ context.Context, arg pgstore.CreateJournalEntryParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("journal_entries_trip_id_fkey")
+	}
+	if _, ok := s.participants[arg.ParticipantID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("journal_entries_participant_id_fkey")
+	}
+
+	entry := pgstore.JournalEntry{
+		ID:            uuid.New(),
+		TripID:        arg.TripID,
+		ParticipantID: arg.ParticipantID,
+		Day:           arg.Day,
+		Title:         arg.Title,
+		Body:          arg.Body,
+		Visibility:    arg.Visibility,
+		CreatedAt:     now(),
+		UpdatedAt:     now(),
+	}
+	s.journal[entry.ID] = entry
+
+	return entry.ID, nil
+}
+
+// GetTripJournalEntries lists the shared entries of the trip and the private
+// ones of the participant, by day and then by creation.
+func (s *Store) GetTripJournalEntries(_ context.Context, arg pgstore.GetTripJournalEntriesParams) ([]pgstore.GetTripJournalEntriesRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetTripJournalEntriesRow
+	for _, entry := range s.journal {
+		if entry.TripID != arg.TripID {
+			continue
+		}
+		if entry.Visibility != pgstore.JournalVisibilityShared && entry.ParticipantID != arg.ParticipantID {
+			continue
+		}
+
+		author := s.participants[entry.ParticipantID]
+		rows = append(rows, pgstore.GetTripJournalEntriesRow{
+			ID:            entry.ID,
+			ParticipantID: entry.ParticipantID,
+			Name:          author.Name,
+			Email:         author.Email,
+			Day:           entry.Day,
+			Title:         entry.Title,
+			Body:          entry.Body,
+			Visibility:    entry.Visibility,
+			CreatedAt:     entry.CreatedAt,
+			UpdatedAt:     entry.UpdatedAt,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if !a.Day.Time.Equal(b.Day.Time) {
+			return a.Day.Time.Before(b.Day.Time)
+		}
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return rows, nil
+}
+
+func (s *Store) UpdateJournalEntry(_ context.Context, arg pgstore.UpdateJournalEntryParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.journal[arg.ID]
+	if !ok || entry.TripID != arg.TripID || entry.ParticipantID != arg.ParticipantID {
+		return 0, nil
+	}
+
+	entry.Day = arg.Day
+	entry.Title = arg.Title
+	entry.Body = arg.Body
+	entry.Visibility = arg.Visibility
+	entry.UpdatedAt = now()
+	s.journal[entry.ID] = entry
+
+	return 1, nil
+}
+
+func (s *Store) DeleteJournalEntry(_ context.Context, arg pgstore.DeleteJournalEntryParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.journal[arg.ID]
+	if !ok || entry.TripID != arg.TripID || entry.ParticipantID != arg.ParticipantID {
+		return 0, nil
+	}
+	delete(s.journal, entry.ID)
+
+	return 1, nil
+}
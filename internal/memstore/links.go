@@ -0,0 +1,250 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// activeLink returns the link of the trip unless it was deleted. The caller
+// holds the lock.
+func (s *Store) activeLink(id, tripID uuid.UUID) (pgstore.Link, bool) {
+	l, ok := s.links[id]
+	return l, ok && l.TripID == tripID && !l.DeletedAt.Valid
+}
+
+// urlTaken tells whether another link of the trip, not deleted, has the URL,
+// as links_trip_id_url_key forbids. The caller holds the lock.
+func (s *Store) urlTaken(tripID, id uuid.UUID, url string) bool {
+	for _, l := range s.links {
+		if l.ID != id && l.TripID == tripID && l.Url == url && !l.DeletedAt.Valid {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) CreateTripLink(_ context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("links_trip_id_fkey")
+	}
+	if s.urlTaken(arg.TripID, uuid.Nil, arg.Url) {
+		return uuid.UUID{}, uniqueViolation("links_trip_id_url_key")
+	}
+
+	l := pgstore.Link{
+		ID:       uuid.New(),
+		TripID:   arg.TripID,
+		Title:    arg.Title,
+		Url:      arg.Url,
+		Category: arg.Category,
+		Pinned:   arg.Pinned,
+	}
+	s.links[l.ID] = l
+
+	return l.ID, nil
+}
+
+func (s *Store) GetTripLinks(_ context.Context, tripID uuid.UUID) ([]pgstore.Link, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var links []pgstore.Link
+	for _, l := range s.links {
+		if l.TripID == tripID && !l.DeletedAt.Valid {
+			links = append(links, l)
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		a, b := links[i], links[j]
+		if a.Category != b.Category {
+			return linkCategoryOrder[a.Category] < linkCategoryOrder[b.Category]
+		}
+		if a.Position != b.Position {
+			return a.Position < b.Position
+		}
+		return a.Title < b.Title
+	})
+
+	return links, nil
+}
+
+// linkCategoryOrder is the order of the values of the link_category enum,
+// which Postgres sorts them by.
+var linkCategoryOrder = map[pgstore.LinkCategory]int{
+	pgstore.LinkCategoryLodging:   0,
+	pgstore.LinkCategoryTransport: 1,
+	pgstore.LinkCategoryTickets:   2,
+	pgstore.LinkCategoryDocs:      3,
+	pgstore.LinkCategoryOther:     4,
+}
+
+func (s *Store) UpdateTripLink(_ context.Context, arg pgstore.UpdateTripLinkParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.activeLink(arg.ID, arg.TripID)
+	if !ok {
+		return 0, nil
+	}
+	if s.urlTaken(arg.TripID, arg.ID, arg.Url) {
+		return 0, uniqueViolation("links_trip_id_url_key")
+	}
+
+	l.Title = arg.Title
+	l.Url = arg.Url
+	l.Category = arg.Category
+	s.links[arg.ID] = l
+
+	return 1, nil
+}
+
+func (s *Store) UpdateTripLinkPartial(_ context.Context, arg pgstore.UpdateTripLinkPartialParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.activeLink(arg.ID, arg.TripID)
+	if !ok {
+		return 0, nil
+	}
+
+	if arg.Url.Valid && s.urlTaken(arg.TripID, arg.ID, arg.Url.String) {
+		return 0, uniqueViolation("links_trip_id_url_key")
+	}
+
+	if arg.Title.Valid {
+		l.Title = arg.Title.String
+	}
+	if arg.Url.Valid {
+		l.Url = arg.Url.String
+	}
+	if arg.Category.Valid {
+		l.Category = arg.Category.LinkCategory
+	}
+	if arg.Pinned.Valid {
+		l.Pinned = arg.Pinned.Bool
+	}
+	s.links[arg.ID] = l
+
+	return 1, nil
+}
+
+func (s *Store) SetLinkPreview(_ context.Context, arg pgstore.SetLinkPreviewParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.links[arg.ID]
+	if !ok {
+		return nil
+	}
+
+	l.PreviewTitle = arg.PreviewTitle
+	l.PreviewDescription = arg.PreviewDescription
+	l.PreviewFaviconUrl = arg.PreviewFaviconUrl
+	l.PreviewImageUrl = arg.PreviewImageUrl
+	l.PreviewFetchedAt = now()
+	s.links[arg.ID] = l
+
+	return nil
+}
+
+func (s *Store) ReorderLinksTx(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, linkIDs []uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range linkIDs {
+		if _, ok := s.activeLink(id, tripID); !ok {
+			return fmt.Errorf("%w: %s", pgstore.ErrLinkNotInTrip, id)
+		}
+	}
+
+	for i, id := range linkIDs {
+		l := s.links[id]
+		l.Position = int32(i)
+		s.links[id] = l
+	}
+
+	return nil
+}
+
+func (s *Store) GetLinkURL(_ context.Context, id uuid.UUID) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	l, ok := s.links[id]
+	if !ok || l.DeletedAt.Valid {
+		return "", pgx.ErrNoRows
+	}
+
+	return l.Url, nil
+}
+
+func (s *Store) RecordLinkClick(_ context.Context, linkID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.links[linkID]; !ok {
+		return foreignKeyViolation("link_clicks_link_id_fkey")
+	}
+
+	s.linkClicks[linkID]++
+
+	return nil
+}
+
+func (s *Store) GetTripLinkClickCounts(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripLinkClickCountsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetTripLinkClickCountsRow
+	for linkID, clicks := range s.linkClicks {
+		if _, ok := s.activeLink(linkID, tripID); ok {
+			rows = append(rows, pgstore.GetTripLinkClickCountsRow{LinkID: linkID, Clicks: clicks})
+		}
+	}
+
+	return rows, nil
+}
+
+func (s *Store) DeleteTripLink(_ context.Context, arg pgstore.DeleteTripLinkParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.activeLink(arg.ID, arg.TripID)
+	if !ok {
+		return 0, nil
+	}
+
+	l.DeletedAt = now()
+	s.links[arg.ID] = l
+
+	return 1, nil
+}
+
+func (s *Store) RestoreTripLink(_ context.Context, arg pgstore.RestoreTripLinkParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.links[arg.ID]
+	if !ok || l.TripID != arg.TripID || !l.DeletedAt.Valid {
+		return 0, nil
+	}
+	if s.urlTaken(arg.TripID, arg.ID, l.Url) {
+		return 0, uniqueViolation("links_trip_id_url_key")
+	}
+
+	l.DeletedAt = pgtype.Timestamp{}
+	s.links[arg.ID] = l
+
+	return 1, nil
+}
@@ -0,0 +1,858 @@
+// Package memstore keeps the trips in memory, behind the same methods the API
+// calls on pgstore, for running the API without Postgres in demos and for the
+// handler tests.
+//
+// It answers like Postgres would: pgx.ErrNoRows for missing rows and a
+// *pgconn.PgError for the unique, foreign key and check constraints of the
+// schema. It sends no emails and records no domain events, those being left to
+// the background jobs of the Postgres store.
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// defaultPreTripReminderDays matches the default of the trips table.
+const defaultPreTripReminderDays = 2
+
+type share struct {
+	tripID  uuid.UUID
+	revoked bool
+}
+
+type participantActivity struct {
+	activityID    uuid.UUID
+	participantID uuid.UUID
+}
+
+// pollParticipant keys the vote of a participant on a poll.
+type pollParticipant struct {
+	pollID        uuid.UUID
+	participantID uuid.UUID
+}
+
+type comment struct {
+	pgstore.GetActivityCommentsRow
+	activityID uuid.UUID
+	deletedAt  pgtype.Timestamp
+}
+
+// Store is the in-memory store. Its zero value is not usable, use New.
+type Store struct {
+	mu sync.RWMutex
+
+	trips        map[uuid.UUID]pgstore.Trip
+	owners       map[uuid.UUID][]pgstore.TripOwner
+	tags         map[uuid.UUID]pgstore.Tag
+	tripTags     map[uuid.UUID]map[uuid.UUID]bool
+	shares       map[string]share
+	joinCodes    map[string]uuid.UUID
+	participants map[uuid.UUID]pgstore.Participant
+	// statusChanges, verifications and optOuts are by participant.
+	statusChanges map[uuid.UUID][]pgstore.ParticipantStatusChange
+	verifications map[uuid.UUID]pgstore.EmailVerification
+	optOuts       map[uuid.UUID]map[pgstore.NotificationKind]bool
+	waitlist      map[uuid.UUID][]pgstore.TripWaitlist
+	activities    map[uuid.UUID]pgstore.Activity
+	attendees     map[participantActivity]bool
+	votes         map[participantActivity]bool
+	comments      map[uuid.UUID]comment
+	attachments   map[uuid.UUID]pgstore.ActivityAttachment
+	links         map[uuid.UUID]pgstore.Link
+	linkClicks    map[uuid.UUID]int64
+	expenses      map[uuid.UUID]pgstore.Expense
+	payments      map[uuid.UUID]pgstore.SettlementPayment
+	polls         map[uuid.UUID]pgstore.Poll
+	pollOptions   map[uuid.UUID]pgstore.PollOption
+	pollVotes     map[pollParticipant]pgstore.PollVote
+	messages      map[uuid.UUID]pgstore.Message
+	// messageReads are by participant.
+	messageReads  map[uuid.UUID]pgstore.MessageRead
+	notifications map[uuid.UUID]pgstore.Notification
+	webhooks      map[uuid.UUID]pgstore.Webhook
+	packingItems  map[uuid.UUID]pgstore.PackingItem
+	tasks         map[uuid.UUID]pgstore.Task
+	bookings      map[uuid.UUID]pgstore.Booking
+	segments      map[uuid.UUID]pgstore.TransportSegment
+	// passengers, the participants taking a segment, are by segment.
+	passengers map[uuid.UUID]map[uuid.UUID]bool
+	documents  map[uuid.UUID]pgstore.Document
+	photos     map[uuid.UUID]pgstore.Photo
+	// photoComments are by photo.
+	photoComments map[uuid.UUID][]pgstore.PhotoComment
+	journal       map[uuid.UUID]pgstore.JournalEntry
+}
+
+func New() *Store {
+	return &Store{
+		trips:         make(map[uuid.UUID]pgstore.Trip),
+		owners:        make(map[uuid.UUID][]pgstore.TripOwner),
+		tags:          make(map[uuid.UUID]pgstore.Tag),
+		tripTags:      make(map[uuid.UUID]map[uuid.UUID]bool),
+		shares:        make(map[string]share),
+		joinCodes:     make(map[string]uuid.UUID),
+		participants:  make(map[uuid.UUID]pgstore.Participant),
+		statusChanges: make(map[uuid.UUID][]pgstore.ParticipantStatusChange),
+		verifications: make(map[uuid.UUID]pgstore.EmailVerification),
+		optOuts:       make(map[uuid.UUID]map[pgstore.NotificationKind]bool),
+		waitlist:      make(map[uuid.UUID][]pgstore.TripWaitlist),
+		activities:    make(map[uuid.UUID]pgstore.Activity),
+		attendees:     make(map[participantActivity]bool),
+		votes:         make(map[participantActivity]bool),
+		comments:      make(map[uuid.UUID]comment),
+		attachments:   make(map[uuid.UUID]pgstore.ActivityAttachment),
+		links:         make(map[uuid.UUID]pgstore.Link),
+		linkClicks:    make(map[uuid.UUID]int64),
+		expenses:      make(map[uuid.UUID]pgstore.Expense),
+		payments:      make(map[uuid.UUID]pgstore.SettlementPayment),
+		polls:         make(map[uuid.UUID]pgstore.Poll),
+		pollOptions:   make(map[uuid.UUID]pgstore.PollOption),
+		pollVotes:     make(map[pollParticipant]pgstore.PollVote),
+		messages:      make(map[uuid.UUID]pgstore.Message),
+		messageReads:  make(map[uuid.UUID]pgstore.MessageRead),
+		notifications: make(map[uuid.UUID]pgstore.Notification),
+		webhooks:      make(map[uuid.UUID]pgstore.Webhook),
+		packingItems:  make(map[uuid.UUID]pgstore.PackingItem),
+		tasks:         make(map[uuid.UUID]pgstore.Task),
+		bookings:      make(map[uuid.UUID]pgstore.Booking),
+		segments:      make(map[uuid.UUID]pgstore.TransportSegment),
+		passengers:    make(map[uuid.UUID]map[uuid.UUID]bool),
+		documents:     make(map[uuid.UUID]pgstore.Document),
+		photos:        make(map[uuid.UUID]pgstore.Photo),
+		photoComments: make(map[uuid.UUID][]pgstore.PhotoComment),
+		journal:       make(map[uuid.UUID]pgstore.JournalEntry),
+	}
+}
+
+// now returns the current time as Postgres stores it, to the microsecond.
+func now() pgtype.Timestamp {
+	return pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Truncate(time.Microsecond)}
+}
+
+// newer tells whether the row at cursor a comes before the one at cursor b in
+// a list shown newest first. IDs break the ties the way Postgres compares
+// uuids.
+func newer(a, b pgstore.Cursor) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	return bytes.Compare(a.ID[:], b.ID[:]) > 0
+}
+
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23505",
+		Message:        fmt.Sprintf("duplicate key value violates unique constraint %q", constraint),
+		ConstraintName: constraint,
+	}
+}
+
+func foreignKeyViolation(constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23503",
+		Message:        fmt.Sprintf("insert or update violates foreign key constraint %q", constraint),
+		ConstraintName: constraint,
+	}
+}
+
+func checkViolation(constraint string) error {
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           "23514",
+		Message:        fmt.Sprintf("new row violates check constraint %q", constraint),
+		ConstraintName: constraint,
+	}
+}
+
+func (s *Store) CreateTripTx(_ context.Context, _ *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip := pgstore.Trip{
+		ID:                  uuid.New(),
+		Destination:         params.Destination,
+		OwnerEmail:          string(params.OwnerEmail),
+		OwnerName:           params.OwnerName,
+		StartsAt:            pgtype.Timestamp{Valid: true, Time: params.StartsAt},
+		EndsAt:              pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Status:              pgstore.TripStatusDraft,
+		PreTripReminderDays: defaultPreTripReminderDays,
+		Timezone:            "UTC",
+		Version:             1,
+		CreatedAt:           now(),
+	}
+
+	if params.Description != nil {
+		trip.Description = *params.Description
+	}
+	if params.RsvpDeadline != nil {
+		trip.RsvpDeadline = pgtype.Timestamp{Valid: true, Time: *params.RsvpDeadline}
+	}
+	if params.MaxGuests != nil {
+		trip.MaxGuests = int32(*params.MaxGuests)
+	}
+	if params.MaxParticipants != nil {
+		trip.MaxParticipants = pgtype.Int4{Valid: true, Int32: int32(*params.MaxParticipants)}
+	}
+	if params.PreTripReminderDays != nil {
+		trip.PreTripReminderDays = int32(*params.PreTripReminderDays)
+	}
+	if params.Timezone != nil {
+		trip.Timezone = *params.Timezone
+	}
+
+	if trip.MaxGuests < 0 {
+		return uuid.UUID{}, checkViolation("trips_max_guests_check")
+	}
+	if trip.MaxParticipants.Valid && trip.MaxParticipants.Int32 <= 0 {
+		return uuid.UUID{}, checkViolation("trips_max_participants_check")
+	}
+
+	s.trips[trip.ID] = trip
+
+	// The invitations are written in the language of the owner.
+	locale := pgstore.LocalePtBR
+	if params.OwnerLocale != nil && *params.OwnerLocale != spec.UnknownLocale {
+		locale = pgstore.Locale(params.OwnerLocale.ToValue())
+	}
+
+	s.owners[trip.ID] = []pgstore.TripOwner{{
+		TripID:    trip.ID,
+		Email:     strings.ToLower(string(params.OwnerEmail)),
+		Name:      params.OwnerName,
+		CreatedAt: trip.CreatedAt,
+		Locale:    locale,
+	}}
+
+	seen := make(map[string]bool, len(params.EmailsToInvite))
+	for _, emailToInvite := range params.EmailsToInvite {
+		email := strings.ToLower(string(emailToInvite))
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		// Invitations beyond the trip capacity go to the waitlist.
+		if trip.MaxParticipants.Valid && len(seen) > int(trip.MaxParticipants.Int32) {
+			s.addToWaitlist(trip.ID, email)
+			continue
+		}
+
+		s.insertParticipant(trip.ID, email, locale)
+	}
+
+	return trip.ID, nil
+}
+
+func (s *Store) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	trip, ok := s.trips[id]
+	if !ok {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+
+	return trip, nil
+}
+
+// GetTripWithRelations has no round trip to save here, it reads the trip and
+// its relations one after the other.
+func (s *Store) GetTripWithRelations(ctx context.Context, id uuid.UUID) (pgstore.TripWithRelations, error) {
+	trip, err := s.GetTrip(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	participants, err := s.GetParticipants(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	activities, err := s.GetTripActivities(ctx, pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	links, err := s.GetTripLinks(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	return pgstore.TripWithRelations{
+		Trip:         trip,
+		Participants: participants,
+		Activities:   activities,
+		Links:        links,
+	}, nil
+}
+
+func (s *Store) UpdateTrip(_ context.Context, arg pgstore.UpdateTripParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[arg.ID]
+	if !ok || trip.Version != arg.Version {
+		return 0, nil
+	}
+
+	old := trip
+	trip.Destination = arg.Destination
+	trip.EndsAt = arg.EndsAt
+	trip.StartsAt = arg.StartsAt
+	trip.Description = arg.Description
+	trip.Version++
+	s.trips[arg.ID] = trip
+	s.notifyTripUpdated(old, trip)
+
+	return 1, nil
+}
+
+func (s *Store) UpdateTripPartial(_ context.Context, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.updateTripPartial(arg)
+}
+
+// UpdateTripPartialTx updates the fields of the trip set in arg, clearing the
+// RSVP reminders of its participants when it sets the deadline and promoting
+// people from the waitlist when it sets or clears the maximum number of
+// participants.
+func (s *Store) UpdateTripPartialTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var promoted []pgstore.TripWaitlist
+	if trip, ok := s.trips[arg.ID]; ok && trip.Version == arg.Version && (arg.MaxParticipants.Valid || arg.ClearMaxParticipants) {
+		trip.MaxParticipants = arg.MaxParticipants
+
+		var err error
+		promoted, err = s.waitlistToPromote(trip, s.countActive(arg.ID))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	updated, err := s.updateTripPartial(arg)
+	if err != nil || updated == 0 {
+		return updated, err
+	}
+
+	s.promote(arg.ID, promoted)
+
+	if arg.RsvpDeadline.Valid {
+		for id, p := range s.participants {
+			if p.TripID == arg.ID {
+				p.RsvpRemindedAt = pgtype.Timestamp{}
+				p.NoResponseAt = pgtype.Timestamp{}
+				s.participants[id] = p
+			}
+		}
+	}
+
+	return updated, nil
+}
+
+// updateTripPartial updates the fields of the trip set in arg. The caller
+// holds the lock.
+func (s *Store) updateTripPartial(arg pgstore.UpdateTripPartialParams) (int64, error) {
+	trip, ok := s.trips[arg.ID]
+	if !ok || trip.Version != arg.Version {
+		return 0, nil
+	}
+
+	old := trip
+	if arg.Destination.Valid {
+		trip.Destination = arg.Destination.String
+	}
+	if arg.EndsAt.Valid {
+		trip.EndsAt = arg.EndsAt
+	}
+	if arg.StartsAt.Valid {
+		trip.StartsAt = arg.StartsAt
+	}
+	if arg.Description.Valid {
+		trip.Description = arg.Description.String
+	}
+	if arg.ClearRsvpDeadline {
+		trip.RsvpDeadline = pgtype.Timestamp{}
+	} else if arg.RsvpDeadline.Valid {
+		trip.RsvpDeadline = arg.RsvpDeadline
+	}
+	if arg.MaxGuests.Valid {
+		if arg.MaxGuests.Int32 < 0 {
+			return 0, checkViolation("trips_max_guests_check")
+		}
+		trip.MaxGuests = arg.MaxGuests.Int32
+	}
+	if arg.ClearMaxParticipants {
+		trip.MaxParticipants = pgtype.Int4{}
+	} else if arg.MaxParticipants.Valid {
+		if arg.MaxParticipants.Int32 <= 0 {
+			return 0, checkViolation("trips_max_participants_check")
+		}
+		trip.MaxParticipants = arg.MaxParticipants
+	}
+	if arg.PreTripReminderDays.Valid {
+		trip.PreTripReminderDays = arg.PreTripReminderDays.Int32
+	}
+	if arg.Timezone.Valid {
+		trip.Timezone = arg.Timezone.String
+	}
+	trip.Version++
+	s.trips[arg.ID] = trip
+	s.notifyTripUpdated(old, trip)
+
+	return 1, nil
+}
+
+func (s *Store) UpdateTripStatus(_ context.Context, arg pgstore.UpdateTripStatusParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if trip, ok := s.trips[arg.ID]; ok {
+		trip.Status = arg.Status
+		trip.Version++
+		s.trips[arg.ID] = trip
+	}
+
+	return nil
+}
+
+func (s *Store) UpdateTripPlace(_ context.Context, arg pgstore.UpdateTripPlaceParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if trip, ok := s.trips[arg.ID]; ok {
+		trip.Latitude = arg.Latitude
+		trip.Longitude = arg.Longitude
+		trip.PlaceName = arg.PlaceName
+		s.trips[arg.ID] = trip
+	}
+
+	return nil
+}
+
+// ConfirmTripTx moves the trip from status from to status to. It returns
+// false, changing nothing, when the trip is no longer in status from.
+func (s *Store) ConfirmTripTx(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, from, to pgstore.TripStatus) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok || trip.Status != from {
+		return false, nil
+	}
+
+	trip.Status = to
+	trip.Version++
+	s.trips[tripID] = trip
+
+	return true, nil
+}
+
+func (s *Store) ListTrips(_ context.Context, arg pgstore.ListTripsParams) ([]pgstore.Trip, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if arg.Status.Valid && trip.Status != arg.Status.TripStatus {
+			continue
+		}
+		if arg.Tag.Valid && !s.hasTag(trip.ID, arg.Tag.String) {
+			continue
+		}
+		if arg.Query.Valid && !matches(arg.Query.String, trip.Destination, trip.Description) {
+			continue
+		}
+
+		trips = append(trips, trip)
+	}
+
+	sort.Slice(trips, func(i, j int) bool {
+		return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time)
+	})
+
+	return trips, nil
+}
+
+func (s *Store) hasTag(tripID uuid.UUID, name string) bool {
+	for tagID := range s.tripTags[tripID] {
+		if s.tags[tagID].Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SearchTrip matches the query against the activities, like the full-text
+// search of Postgres would, and the pattern against the links and the
+// participants.
+func (s *Store) SearchTrip(_ context.Context, arg pgstore.SearchTripParams) ([]pgstore.SearchTripRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pattern := likePattern(arg.Pattern)
+
+	var rows []pgstore.SearchTripRow
+	for _, a := range s.activities {
+		if a.TripID == arg.TripID && !a.DeletedAt.Valid && matches(arg.Query, a.Title, a.Address.String) {
+			rows = append(rows, pgstore.SearchTripRow{Kind: "activity", ID: a.ID, Match: a.Title})
+		}
+	}
+	for _, l := range s.links {
+		if l.TripID == arg.TripID && !l.DeletedAt.Valid && pattern.MatchString(l.Title) {
+			rows = append(rows, pgstore.SearchTripRow{Kind: "link", ID: l.ID, Match: l.Title})
+		}
+	}
+	for _, p := range s.participants {
+		if p.TripID == arg.TripID && pattern.MatchString(p.Email) {
+			rows = append(rows, pgstore.SearchTripRow{Kind: "participant", ID: p.ID, Match: p.Email})
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Kind != rows[j].Kind {
+			return rows[i].Kind < rows[j].Kind
+		}
+		return rows[i].Match < rows[j].Match
+	})
+
+	if len(rows) > int(arg.MaxResults) {
+		rows = rows[:arg.MaxResults]
+	}
+
+	return rows, nil
+}
+
+// matches tells whether the texts contain every word of the websearch query
+// and none of its words starting with -, ignoring case. It stands in for the
+// full-text search of Postgres, without its stemming.
+func matches(query string, texts ...string) bool {
+	words := strings.Fields(strings.ToLower(strings.Join(texts, " ")))
+	has := func(term string) bool {
+		for _, word := range words {
+			if strings.HasPrefix(word, term) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, term := range strings.Fields(strings.ToLower(strings.ReplaceAll(query, `"`, " "))) {
+		if term == "or" {
+			continue
+		}
+
+		if excluded, ok := strings.CutPrefix(term, "-"); ok {
+			if excluded != "" && has(excluded) {
+				return false
+			}
+			continue
+		}
+
+		if !has(term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// likePattern compiles an ILIKE pattern, with \ escaping the wildcards.
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString(`(?is)^`)
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '%':
+			b.WriteString(`.*`)
+		case '_':
+			b.WriteString(`.`)
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString(`$`)
+	return regexp.MustCompile(b.String())
+}
+
+func (s *Store) CreateTag(_ context.Context, name string) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range s.tags {
+		if tag.Name == name {
+			return tag.ID, nil
+		}
+	}
+
+	tag := pgstore.Tag{ID: uuid.New(), Name: name}
+	s.tags[tag.ID] = tag
+
+	return tag.ID, nil
+}
+
+func (s *Store) GetTag(_ context.Context, id uuid.UUID) (pgstore.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tag, ok := s.tags[id]
+	if !ok {
+		return pgstore.Tag{}, pgx.ErrNoRows
+	}
+
+	return tag, nil
+}
+
+func (s *Store) GetTags(_ context.Context) ([]pgstore.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tags := make([]pgstore.Tag, 0, len(s.tags))
+	for _, tag := range s.tags {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	return tags, nil
+}
+
+func (s *Store) UpdateTag(_ context.Context, arg pgstore.UpdateTagParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tag, ok := s.tags[arg.ID]
+	if !ok {
+		return nil
+	}
+
+	for _, other := range s.tags {
+		if other.ID != arg.ID && other.Name == arg.Name {
+			return uniqueViolation("tags_name_key")
+		}
+	}
+
+	tag.Name = arg.Name
+	s.tags[arg.ID] = tag
+
+	return nil
+}
+
+func (s *Store) DeleteTag(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tags, id)
+	for _, tags := range s.tripTags {
+		delete(tags, id)
+	}
+
+	return nil
+}
+
+func (s *Store) AddTagToTrip(_ context.Context, arg pgstore.AddTagToTripParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("trip_tags_trip_id_fkey")
+	}
+	if _, ok := s.tags[arg.TagID]; !ok {
+		return foreignKeyViolation("trip_tags_tag_id_fkey")
+	}
+
+	if s.tripTags[arg.TripID] == nil {
+		s.tripTags[arg.TripID] = make(map[uuid.UUID]bool)
+	}
+	s.tripTags[arg.TripID][arg.TagID] = true
+
+	return nil
+}
+
+func (s *Store) RemoveTagFromTrip(_ context.Context, arg pgstore.RemoveTagFromTripParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tripTags[arg.TripID], arg.TagID)
+
+	return nil
+}
+
+func (s *Store) GetTripTags(_ context.Context, tripID uuid.UUID) ([]pgstore.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tags []pgstore.Tag
+	for tagID := range s.tripTags[tripID] {
+		tags = append(tags, s.tags[tagID])
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	return tags, nil
+}
+
+func (s *Store) CreateTripShare(_ context.Context, arg pgstore.CreateTripShareParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("trip_shares_trip_id_fkey")
+	}
+	if _, ok := s.shares[arg.Slug]; ok {
+		return uniqueViolation("trip_shares_pkey")
+	}
+
+	s.shares[arg.Slug] = share{tripID: arg.TripID}
+
+	return nil
+}
+
+func (s *Store) GetTripIDByShareSlug(_ context.Context, slug string) (uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	share, ok := s.shares[slug]
+	if !ok || share.revoked {
+		return uuid.UUID{}, pgx.ErrNoRows
+	}
+
+	return share.tripID, nil
+}
+
+func (s *Store) RevokeTripShares(_ context.Context, tripID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for slug, share := range s.shares {
+		if share.tripID == tripID {
+			share.revoked = true
+			s.shares[slug] = share
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) SetTripJoinCode(_ context.Context, arg pgstore.SetTripJoinCodeParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("trip_join_codes_trip_id_fkey")
+	}
+	if tripID, ok := s.joinCodes[arg.Code]; ok && tripID != arg.TripID {
+		return uniqueViolation("trip_join_codes_pkey")
+	}
+
+	for code, tripID := range s.joinCodes {
+		if tripID == arg.TripID {
+			delete(s.joinCodes, code)
+		}
+	}
+	s.joinCodes[arg.Code] = arg.TripID
+
+	return nil
+}
+
+func (s *Store) GetTripIDByJoinCode(_ context.Context, code string) (uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tripID, ok := s.joinCodes[code]
+	if !ok {
+		return uuid.UUID{}, pgx.ErrNoRows
+	}
+
+	return tripID, nil
+}
+
+func (s *Store) AddTripOwner(_ context.Context, arg pgstore.AddTripOwnerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("trip_owners_trip_id_fkey")
+	}
+
+	for _, owner := range s.owners[arg.TripID] {
+		if owner.Email == arg.Email {
+			return nil
+		}
+	}
+
+	s.owners[arg.TripID] = append(s.owners[arg.TripID], pgstore.TripOwner{
+		TripID:    arg.TripID,
+		Email:     arg.Email,
+		Name:      arg.Name,
+		CreatedAt: now(),
+		Locale:    arg.Locale,
+	})
+
+	return nil
+}
+
+func (s *Store) GetTripOwners(_ context.Context, tripID uuid.UUID) ([]pgstore.TripOwner, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Owners are appended as they are added, in created_at order.
+	return append([]pgstore.TripOwner(nil), s.owners[tripID]...), nil
+}
+
+func (s *Store) IsTripOwner(_ context.Context, arg pgstore.IsTripOwnerParams) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, owner := range s.owners[arg.TripID] {
+		if owner.Email == arg.Email {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Store) RemoveTripOwner(_ context.Context, arg pgstore.RemoveTripOwnerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	owners := s.owners[arg.TripID][:0]
+	for _, owner := range s.owners[arg.TripID] {
+		if owner.Email != arg.Email {
+			owners = append(owners, owner)
+		}
+	}
+	s.owners[arg.TripID] = owners
+
+	return nil
+}
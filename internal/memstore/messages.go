@@ -0,0 +1,107 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateMessage(_ context.Context, arg pgstore.CreateMessageParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("messages_trip_id_fkey")
+	}
+	if p, ok := s.participants[arg.ParticipantID]; !ok || p.TripID != arg.TripID {
+		return uuid.UUID{}, foreignKeyViolation("messages_participant_id_fkey")
+	}
+
+	m := pgstore.Message{
+		ID:            uuid.New(),
+		TripID:        arg.TripID,
+		ParticipantID: arg.ParticipantID,
+		Body:          arg.Body,
+		CreatedAt:     now(),
+	}
+	s.messages[m.ID] = m
+
+	return m.ID, nil
+}
+
+// TripMessagesPage lists up to size messages of the trip after the cursor,
+// newest first.
+func (s *Store) TripMessagesPage(_ context.Context, tripID uuid.UUID, after pgstore.Cursor, size int32) (pgstore.Page[pgstore.GetTripMessagesPageRow], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetTripMessagesPageRow
+	for _, m := range s.messages {
+		if m.TripID != tripID {
+			continue
+		}
+		if after != (pgstore.Cursor{}) && !newer(after, pgstore.Cursor{CreatedAt: m.CreatedAt.Time, ID: m.ID}) {
+			continue
+		}
+
+		author := s.participants[m.ParticipantID]
+		rows = append(rows, pgstore.GetTripMessagesPageRow{
+			ID:            m.ID,
+			ParticipantID: m.ParticipantID,
+			Name:          author.Name,
+			Email:         author.Email,
+			Body:          m.Body,
+			CreatedAt:     m.CreatedAt,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return newer(
+			pgstore.Cursor{CreatedAt: rows[i].CreatedAt.Time, ID: rows[i].ID},
+			pgstore.Cursor{CreatedAt: rows[j].CreatedAt.Time, ID: rows[j].ID},
+		)
+	})
+
+	return pgstore.NewPage(rows, size, func(m pgstore.GetTripMessagesPageRow) pgstore.Cursor {
+		return pgstore.Cursor{CreatedAt: m.CreatedAt.Time, ID: m.ID}
+	}), nil
+}
+
+func (s *Store) MarkMessagesRead(_ context.Context, arg pgstore.MarkMessagesReadParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.participants[arg.ParticipantID]; !ok || p.TripID != arg.TripID {
+		return foreignKeyViolation("message_reads_participant_id_fkey")
+	}
+
+	s.messageReads[arg.ParticipantID] = pgstore.MessageRead{
+		ParticipantID: arg.ParticipantID,
+		TripID:        arg.TripID,
+		ReadAt:        now(),
+	}
+
+	return nil
+}
+
+func (s *Store) CountUnreadMessages(_ context.Context, arg pgstore.CountUnreadMessagesParams) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	read, readOnce := s.messageReads[arg.ParticipantID]
+
+	var count int64
+	for _, m := range s.messages {
+		if m.TripID != arg.TripID || m.ParticipantID == arg.ParticipantID {
+			continue
+		}
+		if readOnce && !m.CreatedAt.Time.After(read.ReadAt.Time) {
+			continue
+		}
+		count++
+	}
+
+	return count, nil
+}
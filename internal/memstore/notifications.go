@@ -0,0 +1,94 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+// notifyParticipants notifies the participants of the trip who did not
+// decline it, but except, of the event about subjectID, like the triggers of
+// the Postgres schema. The caller holds the lock.
+func (s *Store) notifyParticipants(tripID uuid.UUID, event pgstore.NotificationEvent, subjectID, except uuid.UUID) {
+	for _, p := range s.participants {
+		if p.TripID != tripID || p.DeclinedAt.Valid || p.ID == except {
+			continue
+		}
+
+		n := pgstore.Notification{
+			ID:            uuid.New(),
+			ParticipantID: p.ID,
+			TripID:        tripID,
+			Event:         event,
+			SubjectID:     subjectID,
+			CreatedAt:     now(),
+		}
+		s.notifications[n.ID] = n
+	}
+}
+
+// notifyTripUpdated notifies the participants of the trip when the update
+// changed what they see of it. The caller holds the lock.
+func (s *Store) notifyTripUpdated(old, trip pgstore.Trip) {
+	if old.Destination == trip.Destination && old.StartsAt == trip.StartsAt && old.EndsAt == trip.EndsAt && old.Description == trip.Description {
+		return
+	}
+
+	s.notifyParticipants(trip.ID, pgstore.NotificationEventTripUpdated, trip.ID, uuid.Nil)
+}
+
+func (s *Store) GetParticipantNotifications(_ context.Context, arg pgstore.GetParticipantNotificationsParams) ([]pgstore.Notification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var notifications []pgstore.Notification
+	for _, n := range s.notifications {
+		if n.ParticipantID != arg.ParticipantID || (arg.UnreadOnly && n.ReadAt.Valid) {
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+
+	sort.Slice(notifications, func(i, j int) bool {
+		return newer(
+			pgstore.Cursor{CreatedAt: notifications[i].CreatedAt.Time, ID: notifications[i].ID},
+			pgstore.Cursor{CreatedAt: notifications[j].CreatedAt.Time, ID: notifications[j].ID},
+		)
+	})
+
+	return notifications, nil
+}
+
+func (s *Store) MarkNotificationRead(_ context.Context, arg pgstore.MarkNotificationReadParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.notifications[arg.ID]
+	if !ok || n.ParticipantID != arg.ParticipantID {
+		return 0, nil
+	}
+
+	if !n.ReadAt.Valid {
+		n.ReadAt = now()
+		s.notifications[n.ID] = n
+	}
+
+	return 1, nil
+}
+
+func (s *Store) MarkAllNotificationsRead(_ context.Context, participantID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	readAt := now()
+	for id, n := range s.notifications {
+		if n.ParticipantID == participantID && !n.ReadAt.Valid {
+			n.ReadAt = readAt
+			s.notifications[id] = n
+		}
+	}
+
+	return nil
+}
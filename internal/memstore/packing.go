@@ -0,0 +1,104 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Store) CreatePackingItem(_ context.Context, arg pgstore.CreatePackingItemParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("packing_items_trip_id_fkey")
+	}
+	if err := s.checkPackingItem(arg.TripID, arg.Quantity, arg.AssigneeID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	item := pgstore.PackingItem{
+		ID:         uuid.New(),
+		TripID:     arg.TripID,
+		Name:       arg.Name,
+		Quantity:   arg.Quantity,
+		AssigneeID: arg.AssigneeID,
+		CreatedAt:  now(),
+	}
+	s.packingItems[item.ID] = item
+
+	return item.ID, nil
+}
+
+// GetTripPackingItems lists the packing items of the trip, oldest first.
+func (s *Store) GetTripPackingItems(_ context.Context, tripID uuid.UUID) ([]pgstore.PackingItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var items []pgstore.PackingItem
+	for _, item := range s.packingItems {
+		if item.TripID == tripID {
+			items = append(items, item)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return newer(
+			pgstore.Cursor{CreatedAt: items[j].CreatedAt.Time, ID: items[j].ID},
+			pgstore.Cursor{CreatedAt: items[i].CreatedAt.Time, ID: items[i].ID},
+		)
+	})
+
+	return items, nil
+}
+
+func (s *Store) UpdatePackingItem(_ context.Context, arg pgstore.UpdatePackingItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.packingItems[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	if err := s.checkPackingItem(arg.TripID, arg.Quantity, arg.AssigneeID); err != nil {
+		return 0, err
+	}
+
+	item.Name = arg.Name
+	item.Quantity = arg.Quantity
+	item.AssigneeID = arg.AssigneeID
+	item.Checked = arg.Checked
+	s.packingItems[item.ID] = item
+
+	return 1, nil
+}
+
+func (s *Store) DeletePackingItem(_ context.Context, arg pgstore.DeletePackingItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.packingItems[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.packingItems, item.ID)
+
+	return 1, nil
+}
+
+// checkPackingItem checks the constraints of the packing_items table. The
+// caller holds the lock.
+func (s *Store) checkPackingItem(tripID uuid.UUID, quantity int32, assigneeID pgtype.UUID) error {
+	if quantity <= 0 {
+		return checkViolation("packing_items_quantity_check")
+	}
+	if assigneeID.Valid {
+		if p, ok := s.participants[assigneeID.Bytes]; !ok || p.TripID != tripID {
+			return foreignKeyViolation("packing_items_assignee_id_fkey")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,574 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// insertParticipant adds a participant with the defaults of the participants
+// table. The caller holds the lock and checked the constraints.
+func (s *Store) insertParticipant(tripID uuid.UUID, email string, locale pgstore.Locale) uuid.UUID {
+	p := pgstore.Participant{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		Email:     email,
+		InvitedAt: now(),
+		Locale:    locale,
+		CreatedAt: now(),
+	}
+	s.participants[p.ID] = p
+
+	return p.ID
+}
+
+// participantByEmail returns the participant of the trip with the email. The
+// caller holds the lock.
+func (s *Store) participantByEmail(tripID uuid.UUID, email string) (pgstore.Participant, bool) {
+	for _, p := range s.participants {
+		if p.TripID == tripID && p.Email == email {
+			return p, true
+		}
+	}
+
+	return pgstore.Participant{}, false
+}
+
+// checkNewParticipant checks the participant can be added to the trip. The
+// caller holds the lock.
+func (s *Store) checkNewParticipant(tripID uuid.UUID, email string) error {
+	if _, ok := s.trips[tripID]; !ok {
+		return foreignKeyViolation("participants_trip_id_fkey")
+	}
+	if _, ok := s.participantByEmail(tripID, email); ok {
+		return uniqueViolation("participants_trip_id_email_key")
+	}
+
+	return nil
+}
+
+func (s *Store) recordStatusChange(participantID uuid.UUID, status pgstore.ParticipantStatus, reason pgtype.Text) {
+	s.statusChanges[participantID] = append(s.statusChanges[participantID], pgstore.ParticipantStatusChange{
+		ID:            uuid.New(),
+		ParticipantID: participantID,
+		Status:        status,
+		Reason:        reason,
+		CreatedAt:     now(),
+	})
+}
+
+func (s *Store) GetParticipant(_ context.Context, id uuid.UUID) (pgstore.Participant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.participants[id]
+	if !ok {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+
+	return p, nil
+}
+
+func (s *Store) GetParticipantByEmail(_ context.Context, arg pgstore.GetParticipantByEmailParams) (pgstore.Participant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.participantByEmail(arg.TripID, arg.Email)
+	if !ok {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+
+	return p, nil
+}
+
+func (s *Store) GetParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var participants []pgstore.Participant
+	for _, p := range s.participants {
+		if p.TripID == tripID {
+			participants = append(participants, p)
+		}
+	}
+
+	// Postgres returns them in no particular order, in insertion order in
+	// practice.
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].CreatedAt.Time.Before(participants[j].CreatedAt.Time)
+	})
+
+	return participants, nil
+}
+
+func (s *Store) UpdateParticipantProfile(_ context.Context, arg pgstore.UpdateParticipantProfileParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.participants[arg.ID]
+	if !ok {
+		return nil
+	}
+
+	if arg.Name.Valid {
+		p.Name = arg.Name
+	}
+	if arg.Phone.Valid {
+		p.Phone = arg.Phone
+	}
+	if arg.AvatarUrl.Valid {
+		p.AvatarUrl = arg.AvatarUrl
+	}
+	if arg.Locale.Valid {
+		p.Locale = arg.Locale.Locale
+	}
+	s.participants[arg.ID] = p
+
+	return nil
+}
+
+// ConfirmParticipantTx confirms a participant and records the change in the
+// participant status history.
+func (s *Store) ConfirmParticipantTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.ConfirmParticipantParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.participants[arg.ID]
+	if !ok {
+		return foreignKeyViolation("participant_status_changes_participant_id_fkey")
+	}
+	if arg.Guests < 0 {
+		return checkViolation("participants_guests_check")
+	}
+
+	confirmed := p.IsConfirmed
+	p.IsConfirmed = true
+	p.Guests = arg.Guests
+	p.DeclinedAt = pgtype.Timestamp{}
+	p.DeclineReason = pgtype.Text{}
+	p.NoResponseAt = pgtype.Timestamp{}
+	s.participants[arg.ID] = p
+	if !confirmed {
+		s.notifyParticipants(p.TripID, pgstore.NotificationEventParticipantConfirmed, p.ID, p.ID)
+	}
+
+	s.recordStatusChange(arg.ID, pgstore.ParticipantStatusConfirmed, pgtype.Text{})
+
+	return nil
+}
+
+// UnconfirmParticipantTx takes back the confirmation of a participant and
+// records the reason in the participant status history.
+func (s *Store) UnconfirmParticipantTx(_ context.Context, _ *pgxpool.Pool, participantID uuid.UUID, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.participants[participantID]
+	if !ok {
+		return foreignKeyViolation("participant_status_changes_participant_id_fkey")
+	}
+
+	p.IsConfirmed = false
+	p.Guests = 0
+	s.participants[participantID] = p
+
+	s.recordStatusChange(participantID, pgstore.ParticipantStatusUnconfirmed, pgtype.Text{Valid: true, String: reason})
+
+	return nil
+}
+
+// DeclineParticipantTx declines the invitation of a participant of the trip
+// and, when a seat is now free, promotes the first person of the waitlist to
+// participant.
+func (s *Store) DeclineParticipantTx(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, arg pgstore.DeclineParticipantParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.participants[arg.ID]
+	if !ok {
+		return foreignKeyViolation("participant_status_changes_participant_id_fkey")
+	}
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+
+	active := s.countActive(tripID)
+	if p.TripID == tripID && !p.DeclinedAt.Valid {
+		active--
+	}
+
+	promoted, err := s.waitlistToPromote(trip, active)
+	if err != nil {
+		return err
+	}
+
+	p.IsConfirmed = false
+	p.DeclinedAt = now()
+	p.DeclineReason = arg.DeclineReason
+	s.participants[arg.ID] = p
+
+	s.recordStatusChange(arg.ID, pgstore.ParticipantStatusDeclined, arg.DeclineReason)
+
+	s.promote(tripID, promoted)
+
+	return nil
+}
+
+// waitlistToPromote returns the oldest waitlist entries of the trip that fit
+// below its maximum number of participants, given its active participants, or
+// all of them when it has no maximum. The caller holds the lock.
+func (s *Store) waitlistToPromote(trip pgstore.Trip, active int64) ([]pgstore.TripWaitlist, error) {
+	waitlist := s.waitlist[trip.ID]
+
+	seats := len(waitlist)
+	if trip.MaxParticipants.Valid {
+		seats = max(int(int64(trip.MaxParticipants.Int32)-active), 0)
+	}
+	promoted := waitlist[:min(seats, len(waitlist))]
+
+	for _, entry := range promoted {
+		if _, ok := s.participantByEmail(trip.ID, entry.Email); ok {
+			return nil, uniqueViolation("participants_trip_id_email_key")
+		}
+	}
+
+	return promoted, nil
+}
+
+// promote turns the waitlist entries returned by waitlistToPromote into
+// participants. The caller holds the lock.
+func (s *Store) promote(tripID uuid.UUID, promoted []pgstore.TripWaitlist) {
+	s.waitlist[tripID] = s.waitlist[tripID][len(promoted):]
+	for _, entry := range promoted {
+		s.insertParticipant(tripID, entry.Email, pgstore.LocalePtBR)
+	}
+}
+
+// ReinviteParticipantTx marks the participant invited again unless they were
+// invited after arg.InvitedAt. It returns the number of participants
+// reinvited, 0 or 1.
+func (s *Store) ReinviteParticipantTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.MarkParticipantReinvitedParams, _ pgstore.InvitationEmail) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.markReinvited(arg), nil
+}
+
+func (s *Store) markReinvited(arg pgstore.MarkParticipantReinvitedParams) int64 {
+	p, ok := s.participants[arg.ID]
+	if !ok || p.InvitedAt.Time.After(arg.InvitedAt.Time) {
+		return 0
+	}
+
+	p.InvitedAt = now()
+	s.participants[arg.ID] = p
+
+	return 1
+}
+
+// ResendEmailVerificationTx replaces the verification code unless the
+// participant was invited after arg.InvitedAt. It returns the number of
+// participants given a code, 0 or 1.
+func (s *Store) ResendEmailVerificationTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.MarkParticipantReinvitedParams, verification pgstore.CreateEmailVerificationParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updated := s.markReinvited(arg)
+	if updated == 0 {
+		return 0, nil
+	}
+
+	s.createEmailVerification(verification)
+
+	return updated, nil
+}
+
+// createEmailVerification gives the participant of the trip with the email a
+// new code, when there is such a participant. The caller holds the lock.
+func (s *Store) createEmailVerification(arg pgstore.CreateEmailVerificationParams) {
+	p, ok := s.participantByEmail(arg.TripID, arg.Email)
+	if !ok {
+		return
+	}
+
+	s.verifications[p.ID] = pgstore.EmailVerification{
+		ParticipantID: p.ID,
+		Code:          arg.Code,
+		ExpiresAt:     arg.ExpiresAt,
+		CreatedAt:     now(),
+	}
+}
+
+func (s *Store) GetParticipantStatusChanges(_ context.Context, participantID uuid.UUID) ([]pgstore.ParticipantStatusChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Changes are appended as they happen, in created_at order.
+	return append([]pgstore.ParticipantStatusChange(nil), s.statusChanges[participantID]...), nil
+}
+
+func (s *Store) GetNotificationOptOuts(_ context.Context, participantID uuid.UUID) ([]pgstore.NotificationKind, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var kinds []pgstore.NotificationKind
+	for kind := range s.optOuts[participantID] {
+		kinds = append(kinds, kind)
+	}
+
+	return kinds, nil
+}
+
+func (s *Store) IsOptedOutOfNotification(_ context.Context, arg pgstore.IsOptedOutOfNotificationParams) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.optOuts[arg.ParticipantID][arg.Kind], nil
+}
+
+// UpdateNotificationPreferencesTx turns each kind of notification in enabled
+// on or off for the participant. Kinds left out are not changed.
+func (s *Store) UpdateNotificationPreferencesTx(_ context.Context, _ *pgxpool.Pool, participantID uuid.UUID, enabled map[pgstore.NotificationKind]bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.participants[participantID]; !ok {
+		for _, on := range enabled {
+			if !on {
+				return foreignKeyViolation("notification_opt_outs_participant_id_fkey")
+			}
+		}
+		return nil
+	}
+
+	if s.optOuts[participantID] == nil {
+		s.optOuts[participantID] = make(map[pgstore.NotificationKind]bool)
+	}
+
+	for kind, on := range enabled {
+		if on {
+			delete(s.optOuts[participantID], kind)
+		} else {
+			s.optOuts[participantID][kind] = true
+		}
+	}
+
+	return nil
+}
+
+// InviteParticipantsTx adds the participants to the trip, and gives the ones
+// with a verification its code. The participants beyond the maximum of the
+// trip go to its waitlist instead. It returns the emails added to the
+// waitlist.
+func (s *Store) InviteParticipantsTx(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, participants []pgstore.InviteParticipantsToTripParams, verifications []pgstore.CreateEmailVerificationParams) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	var waitlisted []string
+	if trip.MaxParticipants.Valid {
+		seats := max(int(int64(trip.MaxParticipants.Int32)-s.countActive(tripID)), 0)
+		if len(participants) > seats {
+			for _, p := range participants[seats:] {
+				waitlisted = append(waitlisted, p.Email)
+			}
+			participants = participants[:seats]
+		}
+	}
+
+	seen := make(map[pgstore.GetParticipantByEmailParams]bool, len(participants))
+	for _, p := range participants {
+		key := pgstore.GetParticipantByEmailParams{TripID: p.TripID, Email: p.Email}
+		if err := s.checkNewParticipant(p.TripID, p.Email); err != nil {
+			return nil, err
+		}
+		if seen[key] {
+			return nil, uniqueViolation("participants_trip_id_email_key")
+		}
+		seen[key] = true
+	}
+
+	for _, email := range waitlisted {
+		s.addToWaitlist(tripID, email)
+	}
+
+	invited := make(map[string]bool, len(participants))
+	for _, p := range participants {
+		s.insertParticipant(p.TripID, p.Email, p.Locale)
+		invited[p.Email] = true
+	}
+
+	for _, v := range verifications {
+		if invited[v.Email] {
+			s.createEmailVerification(v)
+		}
+	}
+
+	return waitlisted, nil
+}
+
+// JoinTripTx adds a participant to the trip, or to its waitlist when it is
+// full. It returns whether they were added to the waitlist.
+func (s *Store) JoinTripTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.InsertParticipantParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[arg.TripID]
+	if !ok {
+		return false, pgx.ErrNoRows
+	}
+
+	if trip.MaxParticipants.Valid && s.countActive(arg.TripID) >= int64(trip.MaxParticipants.Int32) {
+		s.addToWaitlist(arg.TripID, arg.Email)
+		return true, nil
+	}
+
+	if err := s.checkNewParticipant(arg.TripID, arg.Email); err != nil {
+		return false, err
+	}
+
+	s.insertParticipant(arg.TripID, arg.Email, pgstore.LocalePtBR)
+	return false, nil
+}
+
+func (s *Store) GetEmailVerification(_ context.Context, participantID uuid.UUID) (pgstore.EmailVerification, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.verifications[participantID]
+	if !ok {
+		return pgstore.EmailVerification{}, pgx.ErrNoRows
+	}
+
+	return v, nil
+}
+
+func (s *Store) ClaimEmailVerificationAttempt(_ context.Context, arg pgstore.ClaimEmailVerificationAttemptParams) (pgstore.ClaimEmailVerificationAttemptRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.verifications[arg.ParticipantID]
+	if !ok || v.Attempts >= arg.MaxAttempts {
+		return pgstore.ClaimEmailVerificationAttemptRow{}, pgx.ErrNoRows
+	}
+
+	v.Attempts++
+	s.verifications[arg.ParticipantID] = v
+
+	return pgstore.ClaimEmailVerificationAttemptRow{Code: v.Code, ExpiresAt: v.ExpiresAt}, nil
+}
+
+// VerifyParticipantEmailTx marks the address of the participant as verified.
+func (s *Store) VerifyParticipantEmailTx(_ context.Context, _ *pgxpool.Pool, participantID uuid.UUID, _ pgstore.InvitationEmail) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.participants[participantID]; ok {
+		p.EmailVerifiedAt = now()
+		s.participants[participantID] = p
+	}
+	delete(s.verifications, participantID)
+
+	return nil
+}
+
+// GetTripUndeliverableEmails returns nothing, the bounces and complaints
+// being reported by the email provider to the Postgres store only.
+func (s *Store) GetTripUndeliverableEmails(_ context.Context, _ uuid.UUID) ([]pgstore.GetTripUndeliverableEmailsRow, error) {
+	return nil, nil
+}
+
+func (s *Store) GetTripParticipantStats(_ context.Context, tripID uuid.UUID) (pgstore.GetTripParticipantStatsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var stats pgstore.GetTripParticipantStatsRow
+	for _, p := range s.participants {
+		if p.TripID != tripID {
+			continue
+		}
+
+		stats.Invited++
+		if p.IsConfirmed {
+			stats.Confirmed++
+			stats.Guests += int64(p.Guests)
+		}
+		if p.DeclinedAt.Valid {
+			stats.Declined++
+		}
+		if p.NoResponseAt.Valid {
+			stats.NoResponse++
+		}
+	}
+
+	return stats, nil
+}
+
+func (s *Store) CountActiveTripParticipants(_ context.Context, tripID uuid.UUID) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.countActive(tripID), nil
+}
+
+// countActive counts the participants of the trip who did not decline. The
+// caller holds the lock.
+func (s *Store) countActive(tripID uuid.UUID) int64 {
+	var active int64
+	for _, p := range s.participants {
+		if p.TripID == tripID && !p.DeclinedAt.Valid {
+			active++
+		}
+	}
+
+	return active
+}
+
+func (s *Store) AddToTripWaitlist(_ context.Context, arg pgstore.AddToTripWaitlistParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return foreignKeyViolation("trip_waitlist_trip_id_fkey")
+	}
+
+	s.addToWaitlist(arg.TripID, arg.Email)
+
+	return nil
+}
+
+// addToWaitlist adds the email to the end of the waitlist of the trip, unless
+// it is already in it. The caller holds the lock.
+func (s *Store) addToWaitlist(tripID uuid.UUID, email string) {
+	for _, entry := range s.waitlist[tripID] {
+		if entry.Email == email {
+			return
+		}
+	}
+
+	s.waitlist[tripID] = append(s.waitlist[tripID], pgstore.TripWaitlist{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		Email:     email,
+		CreatedAt: now(),
+	})
+}
+
+func (s *Store) GetTripWaitlist(_ context.Context, tripID uuid.UUID) ([]pgstore.TripWaitlist, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Entries are appended as they are added, in created_at order.
+	return append([]pgstore.TripWaitlist(nil), s.waitlist[tripID]...), nil
+}
@@ -0,0 +1,252 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Store) CreatePhoto(_ context.Context, arg pgstore.CreatePhotoParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("photos_trip_id_fkey")
+	}
+	if _, ok := s.participants[arg.ParticipantID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("photos_participant_id_fkey")
+	}
+	if arg.Size <= 0 {
+		return uuid.UUID{}, checkViolation("photos_size_check")
+	}
+
+	for _, other := range s.photos {
+		if other.StorageKey == arg.StorageKey {
+			return uuid.UUID{}, uniqueViolation("photos_storage_key_key")
+		}
+	}
+
+	photo := pgstore.Photo{
+		ID:                     uuid.New(),
+		TripID:                 arg.TripID,
+		ParticipantID:          arg.ParticipantID,
+		Caption:                arg.Caption,
+		FileName:               arg.FileName,
+		ContentType:            arg.ContentType,
+		Size:                   arg.Size,
+		StorageKey:             arg.StorageKey,
+		ThumbnailStatus:        pgstore.ThumbnailStatusPending,
+		ThumbnailNextAttemptAt: now(),
+		CreatedAt:              now(),
+	}
+	s.photos[photo.ID] = photo
+
+	return photo.ID, nil
+}
+
+// TripPhotosPage lists up to size photos of the trip after the cursor, newest
+// first.
+func (s *Store) TripPhotosPage(_ context.Context, tripID uuid.UUID, after pgstore.Cursor, size int32) (pgstore.Page[pgstore.GetTripPhotosPageRow], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetTripPhotosPageRow
+	for _, p := range s.photos {
+		if p.TripID != tripID {
+			continue
+		}
+		if after != (pgstore.Cursor{}) && !newer(after, pgstore.Cursor{CreatedAt: p.CreatedAt.Time, ID: p.ID}) {
+			continue
+		}
+
+		author := s.participants[p.ParticipantID]
+		rows = append(rows, pgstore.GetTripPhotosPageRow{
+			ID:              p.ID,
+			ParticipantID:   p.ParticipantID,
+			Name:            author.Name,
+			Email:           author.Email,
+			Caption:         p.Caption,
+			FileName:        p.FileName,
+			ContentType:     p.ContentType,
+			Size:            p.Size,
+			StorageKey:      p.StorageKey,
+			Width:           p.Width,
+			Height:          p.Height,
+			ThumbnailKey:    p.ThumbnailKey,
+			ThumbnailStatus: p.ThumbnailStatus,
+			CreatedAt:       p.CreatedAt,
+			Comments:        int64(len(s.photoComments[p.ID])),
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return newer(
+			pgstore.Cursor{CreatedAt: rows[i].CreatedAt.Time, ID: rows[i].ID},
+			pgstore.Cursor{CreatedAt: rows[j].CreatedAt.Time, ID: rows[j].ID},
+		)
+	})
+
+	return pgstore.NewPage(rows, size, func(p pgstore.GetTripPhotosPageRow) pgstore.Cursor {
+		return pgstore.Cursor{CreatedAt: p.CreatedAt.Time, ID: p.ID}
+	}), nil
+}
+
+func (s *Store) GetPhoto(_ context.Context, arg pgstore.GetPhotoParams) (pgstore.Photo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	photo, ok := s.photos[arg.ID]
+	if !ok || photo.TripID != arg.TripID {
+		return pgstore.Photo{}, pgx.ErrNoRows
+	}
+
+	return photo, nil
+}
+
+// DeletePhoto deletes a photo of the participant along with its comments.
+func (s *Store) DeletePhoto(_ context.Context, arg pgstore.DeletePhotoParams) (pgstore.DeletePhotoRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	photo, ok := s.photos[arg.ID]
+	if !ok || photo.TripID != arg.TripID || photo.ParticipantID != arg.ParticipantID {
+		return pgstore.DeletePhotoRow{}, pgx.ErrNoRows
+	}
+	delete(s.photos, photo.ID)
+	delete(s.photoComments, photo.ID)
+
+	return pgstore.DeletePhotoRow{StorageKey: photo.StorageKey, ThumbnailKey: photo.ThumbnailKey}, nil
+}
+
+// ClaimPendingThumbnails leases up to limit photos whose thumbnail is due, the
+// longest waiting first.
+func (s *Store) ClaimPendingThumbnails(_ context.Context, arg pgstore.ClaimPendingThumbnailsParams) ([]pgstore.Photo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []pgstore.Photo
+	for _, p := range s.photos {
+		if p.ThumbnailStatus == pgstore.ThumbnailStatusPending && !p.ThumbnailNextAttemptAt.Time.After(arg.Now.Time) {
+			due = append(due, p)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].ThumbnailNextAttemptAt.Time.Before(due[j].ThumbnailNextAttemptAt.Time)
+	})
+	if len(due) > int(arg.Limit) {
+		due = due[:arg.Limit]
+	}
+
+	for i := range due {
+		due[i].ThumbnailNextAttemptAt = arg.LeaseUntil
+		s.photos[due[i].ID] = due[i]
+	}
+
+	return due, nil
+}
+
+func (s *Store) MarkThumbnailReady(_ context.Context, arg pgstore.MarkThumbnailReadyParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	photo, ok := s.photos[arg.ID]
+	if !ok {
+		return nil
+	}
+	photo.ThumbnailStatus = pgstore.ThumbnailStatusReady
+	photo.ThumbnailAttempts++
+	photo.ThumbnailKey = arg.ThumbnailKey
+	photo.Width = arg.Width
+	photo.Height = arg.Height
+	s.photos[photo.ID] = photo
+
+	return nil
+}
+
+func (s *Store) MarkThumbnailFailed(_ context.Context, arg pgstore.MarkThumbnailFailedParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	photo, ok := s.photos[arg.ID]
+	if !ok {
+		return nil
+	}
+	photo.ThumbnailStatus = arg.ThumbnailStatus
+	photo.ThumbnailAttempts++
+	photo.ThumbnailNextAttemptAt = arg.ThumbnailNextAttemptAt
+	s.photos[photo.ID] = photo
+
+	return nil
+}
+
+func (s *Store) CreatePhotoComment(_ context.Context, arg pgstore.CreatePhotoCommentParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.photos[arg.PhotoID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("photo_comments_photo_id_fkey")
+	}
+	if _, ok := s.participants[arg.ParticipantID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("photo_comments_participant_id_fkey")
+	}
+
+	comment := pgstore.PhotoComment{
+		ID:            uuid.New(),
+		PhotoID:       arg.PhotoID,
+		ParticipantID: arg.ParticipantID,
+		Body:          arg.Body,
+		CreatedAt:     now(),
+	}
+	s.photoComments[arg.PhotoID] = append(s.photoComments[arg.PhotoID], comment)
+
+	return comment.ID, nil
+}
+
+// GetPhotoComments lists the comments of the photo, the oldest first.
+func (s *Store) GetPhotoComments(_ context.Context, photoID uuid.UUID) ([]pgstore.GetPhotoCommentsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var rows []pgstore.GetPhotoCommentsRow
+	for _, c := range s.photoComments[photoID] {
+		author := s.participants[c.ParticipantID]
+		rows = append(rows, pgstore.GetPhotoCommentsRow{
+			ID:            c.ID,
+			ParticipantID: c.ParticipantID,
+			Name:          author.Name,
+			Email:         author.Email,
+			Body:          c.Body,
+			CreatedAt:     c.CreatedAt,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return rows, nil
+}
+
+func (s *Store) DeletePhotoComment(_ context.Context, arg pgstore.DeletePhotoCommentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	comments := s.photoComments[arg.PhotoID]
+	for i, c := range comments {
+		if c.ID == arg.ID && c.ParticipantID == arg.ParticipantID {
+			s.photoComments[arg.PhotoID] = append(comments[:i:i], comments[i+1:]...)
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,163 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CreatePollTx creates the poll with its options, in order. The invitations
+// to vote are not sent.
+func (s *Store) CreatePollTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.CreatePollParams, options []pgstore.CreatePollOptionParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("polls_trip_id_fkey")
+	}
+	for _, option := range options {
+		destination := option.Destination.Valid && !option.StartsAt.Valid && !option.EndsAt.Valid
+		dates := !option.Destination.Valid && option.StartsAt.Valid && option.EndsAt.Valid &&
+			option.EndsAt.Time.After(option.StartsAt.Time)
+		if !destination && !dates {
+			return uuid.UUID{}, checkViolation("poll_options_candidate_check")
+		}
+	}
+
+	poll := pgstore.Poll{
+		ID:        uuid.New(),
+		TripID:    arg.TripID,
+		Kind:      arg.Kind,
+		Question:  arg.Question,
+		CreatedAt: now(),
+	}
+	s.polls[poll.ID] = poll
+
+	for i, option := range options {
+		o := pgstore.PollOption{
+			ID:          uuid.New(),
+			PollID:      poll.ID,
+			Position:    int32(i),
+			Destination: option.Destination,
+			StartsAt:    option.StartsAt,
+			EndsAt:      option.EndsAt,
+		}
+		s.pollOptions[o.ID] = o
+	}
+
+	return poll.ID, nil
+}
+
+func (s *Store) GetPoll(_ context.Context, id uuid.UUID) (pgstore.Poll, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	poll, ok := s.polls[id]
+	if !ok {
+		return pgstore.Poll{}, pgx.ErrNoRows
+	}
+
+	return poll, nil
+}
+
+func (s *Store) GetTripPolls(_ context.Context, tripID uuid.UUID) ([]pgstore.Poll, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var polls []pgstore.Poll
+	for _, poll := range s.polls {
+		if poll.TripID == tripID {
+			polls = append(polls, poll)
+		}
+	}
+
+	sort.Slice(polls, func(i, j int) bool {
+		return polls[i].CreatedAt.Time.Before(polls[j].CreatedAt.Time)
+	})
+
+	return polls, nil
+}
+
+func (s *Store) GetTripPollOptions(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripPollOptionsRow, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	votes := make(map[uuid.UUID]int64)
+	for _, vote := range s.pollVotes {
+		votes[vote.OptionID]++
+	}
+
+	var rows []pgstore.GetTripPollOptionsRow
+	for _, option := range s.pollOptions {
+		if s.polls[option.PollID].TripID != tripID {
+			continue
+		}
+		rows = append(rows, pgstore.GetTripPollOptionsRow{
+			ID:          option.ID,
+			PollID:      option.PollID,
+			Position:    option.Position,
+			Destination: option.Destination,
+			StartsAt:    option.StartsAt,
+			EndsAt:      option.EndsAt,
+			Votes:       votes[option.ID],
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.PollID != b.PollID {
+			return a.PollID.String() < b.PollID.String()
+		}
+		return a.Position < b.Position
+	})
+
+	return rows, nil
+}
+
+func (s *Store) VotePoll(_ context.Context, arg pgstore.VotePollParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if option, ok := s.pollOptions[arg.OptionID]; !ok || option.PollID != arg.PollID {
+		return foreignKeyViolation("poll_votes_option_id_fkey")
+	}
+	if _, ok := s.participants[arg.ParticipantID]; !ok {
+		return foreignKeyViolation("poll_votes_participant_id_fkey")
+	}
+
+	s.pollVotes[pollParticipant{arg.PollID, arg.ParticipantID}] = pgstore.PollVote{
+		PollID:        arg.PollID,
+		ParticipantID: arg.ParticipantID,
+		OptionID:      arg.OptionID,
+		VotedAt:       now(),
+	}
+
+	return nil
+}
+
+// ApplyPollTx updates the trip with the winning option of the poll and closes
+// the poll. It returns false, changing nothing, when the trip is no longer at
+// the version of update or the poll was already applied.
+func (s *Store) ApplyPollTx(_ context.Context, _ *pgxpool.Pool, pollID uuid.UUID, update pgstore.UpdateTripPartialParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	poll, ok := s.polls[pollID]
+	if !ok || poll.AppliedAt.Valid {
+		return false, nil
+	}
+
+	updated, err := s.updateTripPartial(update)
+	if err != nil || updated == 0 {
+		return false, err
+	}
+
+	poll.AppliedAt = now()
+	s.polls[pollID] = poll
+
+	return true, nil
+}
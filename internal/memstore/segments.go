@@ -0,0 +1,160 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CreateTransportSegmentTx creates the segment along with the participants
+// taking it.
+func (s *Store) CreateTransportSegmentTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.CreateTransportSegmentParams, participantIDs []uuid.UUID) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("transport_segments_trip_id_fkey")
+	}
+	if err := s.checkTransportSegment(arg.TripID, arg.DepartureAt, arg.ArrivalAt, participantIDs); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	segment := pgstore.TransportSegment{
+		ID:             uuid.New(),
+		TripID:         arg.TripID,
+		Mode:           arg.Mode,
+		Carrier:        arg.Carrier,
+		Number:         arg.Number,
+		DeparturePlace: arg.DeparturePlace,
+		DepartureAt:    arg.DepartureAt,
+		ArrivalPlace:   arg.ArrivalPlace,
+		ArrivalAt:      arg.ArrivalAt,
+		CreatedAt:      now(),
+	}
+	s.segments[segment.ID] = segment
+	s.setPassengers(segment.ID, participantIDs)
+
+	return segment.ID, nil
+}
+
+// GetTripTransportSegments lists the segments of the trip by departure.
+func (s *Store) GetTripTransportSegments(_ context.Context, tripID uuid.UUID) ([]pgstore.TransportSegment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var segments []pgstore.TransportSegment
+	for _, segment := range s.segments {
+		if segment.TripID == tripID {
+			segments = append(segments, segment)
+		}
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		a, b := segments[i], segments[j]
+		if !a.DepartureAt.Time.Equal(b.DepartureAt.Time) {
+			return a.DepartureAt.Time.Before(b.DepartureAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return segments, nil
+}
+
+func (s *Store) GetTripTransportSegmentParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.TransportSegmentParticipant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var participants []pgstore.TransportSegmentParticipant
+	for segmentID, passengers := range s.passengers {
+		if s.segments[segmentID].TripID != tripID {
+			continue
+		}
+		for participantID := range passengers {
+			participants = append(participants, pgstore.TransportSegmentParticipant{
+				SegmentID:     segmentID,
+				TripID:        tripID,
+				ParticipantID: participantID,
+			})
+		}
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		a, b := participants[i], participants[j]
+		if a.SegmentID != b.SegmentID {
+			return bytes.Compare(a.SegmentID[:], b.SegmentID[:]) < 0
+		}
+		return bytes.Compare(a.ParticipantID[:], b.ParticipantID[:]) < 0
+	})
+
+	return participants, nil
+}
+
+// UpdateTransportSegmentTx updates the segment and replaces the participants
+// taking it.
+func (s *Store) UpdateTransportSegmentTx(_ context.Context, _ *pgxpool.Pool, arg pgstore.UpdateTransportSegmentParams, participantIDs []uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segment, ok := s.segments[arg.ID]
+	if !ok || segment.TripID != arg.TripID {
+		return 0, nil
+	}
+	if err := s.checkTransportSegment(arg.TripID, arg.DepartureAt, arg.ArrivalAt, participantIDs); err != nil {
+		return 0, err
+	}
+
+	segment.Mode = arg.Mode
+	segment.Carrier = arg.Carrier
+	segment.Number = arg.Number
+	segment.DeparturePlace = arg.DeparturePlace
+	segment.DepartureAt = arg.DepartureAt
+	segment.ArrivalPlace = arg.ArrivalPlace
+	segment.ArrivalAt = arg.ArrivalAt
+	s.segments[segment.ID] = segment
+	s.setPassengers(segment.ID, participantIDs)
+
+	return 1, nil
+}
+
+func (s *Store) DeleteTransportSegment(_ context.Context, arg pgstore.DeleteTransportSegmentParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segment, ok := s.segments[arg.ID]
+	if !ok || segment.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.segments, segment.ID)
+	delete(s.passengers, segment.ID)
+
+	return 1, nil
+}
+
+// checkTransportSegment checks the constraints of the transport_segments and
+// transport_segment_participants tables. The caller holds the lock.
+func (s *Store) checkTransportSegment(tripID uuid.UUID, departureAt, arrivalAt pgtype.Timestamp, participantIDs []uuid.UUID) error {
+	if !arrivalAt.Time.After(departureAt.Time) {
+		return checkViolation("transport_segments_arrival_at_check")
+	}
+	for _, participantID := range participantIDs {
+		if p, ok := s.participants[participantID]; !ok || p.TripID != tripID {
+			return foreignKeyViolation("transport_segment_participants_participant_id_fkey")
+		}
+	}
+	return nil
+}
+
+// setPassengers replaces the participants taking the segment. The caller
+// holds the lock.
+func (s *Store) setPassengers(segmentID uuid.UUID, participantIDs []uuid.UUID) {
+	passengers := make(map[uuid.UUID]bool, len(participantIDs))
+	for _, participantID := range participantIDs {
+		passengers[participantID] = true
+	}
+	s.passengers[segmentID] = passengers
+}
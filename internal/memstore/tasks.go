@@ -0,0 +1,120 @@
+package memstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *Store) CreateTask(_ context.Context, arg pgstore.CreateTaskParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return uuid.UUID{}, foreignKeyViolation("tasks_trip_id_fkey")
+	}
+	if err := s.checkTaskAssignee(arg.TripID, arg.AssigneeID); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	task := pgstore.Task{
+		ID:         uuid.New(),
+		TripID:     arg.TripID,
+		Title:      arg.Title,
+		AssigneeID: arg.AssigneeID,
+		DueAt:      arg.DueAt,
+		CreatedAt:  now(),
+	}
+	s.tasks[task.ID] = task
+
+	return task.ID, nil
+}
+
+// GetTripTasks lists the tasks of the trip, those left to do first, the
+// soonest due first and those without a due date last.
+func (s *Store) GetTripTasks(_ context.Context, tripID uuid.UUID) ([]pgstore.Task, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tasks []pgstore.Task
+	for _, task := range s.tasks {
+		if task.TripID == tripID {
+			tasks = append(tasks, task)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if a.Done != b.Done {
+			return !a.Done
+		}
+		if a.DueAt.Valid != b.DueAt.Valid {
+			return a.DueAt.Valid
+		}
+		if !a.DueAt.Time.Equal(b.DueAt.Time) {
+			return a.DueAt.Time.Before(b.DueAt.Time)
+		}
+		if !a.CreatedAt.Time.Equal(b.CreatedAt.Time) {
+			return a.CreatedAt.Time.Before(b.CreatedAt.Time)
+		}
+		return bytes.Compare(a.ID[:], b.ID[:]) < 0
+	})
+
+	return tasks, nil
+}
+
+func (s *Store) UpdateTask(_ context.Context, arg pgstore.UpdateTaskParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks[arg.ID]
+	if !ok || task.TripID != arg.TripID {
+		return 0, nil
+	}
+	if err := s.checkTaskAssignee(arg.TripID, arg.AssigneeID); err != nil {
+		return 0, err
+	}
+
+	// The assignee is nudged again about the task once its due date or
+	// assignee change.
+	if task.AssigneeID != arg.AssigneeID || task.DueAt.Valid != arg.DueAt.Valid || !task.DueAt.Time.Equal(arg.DueAt.Time) {
+		task.RemindedAt = pgtype.Timestamp{}
+	}
+
+	task.Title = arg.Title
+	task.AssigneeID = arg.AssigneeID
+	task.DueAt = arg.DueAt
+	task.Done = arg.Done
+	s.tasks[task.ID] = task
+
+	return 1, nil
+}
+
+func (s *Store) DeleteTask(_ context.Context, arg pgstore.DeleteTaskParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks[arg.ID]
+	if !ok || task.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.tasks, task.ID)
+
+	return 1, nil
+}
+
+// checkTaskAssignee checks the assignee of a task is one of its trip. The
+// caller holds the lock.
+func (s *Store) checkTaskAssignee(tripID uuid.UUID, assigneeID pgtype.UUID) error {
+	if !assigneeID.Valid {
+		return nil
+	}
+	if p, ok := s.participants[assigneeID.Bytes]; !ok || p.TripID != tripID {
+		return foreignKeyViolation("tasks_assignee_id_fkey")
+	}
+	return nil
+}
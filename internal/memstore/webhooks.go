@@ -0,0 +1,89 @@
+package memstore
+
+import (
+	"context"
+	"sort"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Store) CreateWebhook(_ context.Context, arg pgstore.CreateWebhookParams) (pgstore.Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.trips[arg.TripID]; !ok {
+		return pgstore.Webhook{}, foreignKeyViolation("webhooks_trip_id_fkey")
+	}
+
+	w := pgstore.Webhook{
+		ID:        uuid.New(),
+		TripID:    arg.TripID,
+		Url:       arg.Url,
+		Secret:    arg.Secret,
+		CreatedAt: now(),
+	}
+	s.webhooks[w.ID] = w
+
+	return w, nil
+}
+
+func (s *Store) GetWebhook(_ context.Context, id uuid.UUID) (pgstore.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	w, ok := s.webhooks[id]
+	if !ok {
+		return pgstore.Webhook{}, pgx.ErrNoRows
+	}
+
+	return w, nil
+}
+
+func (s *Store) GetTripWebhooks(_ context.Context, tripID uuid.UUID) ([]pgstore.Webhook, error) {
+	return s.webhooksWhere(func(w pgstore.Webhook) bool {
+		return w.TripID == tripID
+	}), nil
+}
+
+// webhooksWhere returns the webhooks matching keep, oldest first.
+func (s *Store) webhooksWhere(keep func(pgstore.Webhook) bool) []pgstore.Webhook {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var webhooks []pgstore.Webhook
+	for _, w := range s.webhooks {
+		if keep(w) {
+			webhooks = append(webhooks, w)
+		}
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return newer(
+			pgstore.Cursor{CreatedAt: webhooks[j].CreatedAt.Time, ID: webhooks[j].ID},
+			pgstore.Cursor{CreatedAt: webhooks[i].CreatedAt.Time, ID: webhooks[i].ID},
+		)
+	})
+
+	return webhooks
+}
+
+func (s *Store) DeleteWebhook(_ context.Context, id uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[id]; !ok {
+		return 0, nil
+	}
+
+	delete(s.webhooks, id)
+
+	return 1, nil
+}
+
+// GetWebhookDeliveries returns no delivery: the store records no domain
+// events to deliver.
+func (s *Store) GetWebhookDeliveries(_ context.Context, _ pgstore.GetWebhookDeliveriesParams) ([]pgstore.GetWebhookDeliveriesRow, error) {
+	return nil, nil
+}
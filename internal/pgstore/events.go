@@ -0,0 +1,62 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The payloads of the domain events. They are stored as JSON and published
+// as is, so their fields are part of the contract with the consumers.
+type (
+	TripCreatedEvent struct {
+		TripID      uuid.UUID `json:"trip_id"`
+		Destination string    `json:"destination"`
+		OwnerEmail  string    `json:"owner_email"`
+		StartsAt    time.Time `json:"starts_at"`
+		EndsAt      time.Time `json:"ends_at"`
+	}
+
+	TripConfirmedEvent struct {
+		TripID uuid.UUID  `json:"trip_id"`
+		Status TripStatus `json:"status"`
+	}
+
+	ParticipantInvitedEvent struct {
+		TripID uuid.UUID `json:"trip_id"`
+		Email  string    `json:"email"`
+	}
+
+	ParticipantConfirmedEvent struct {
+		TripID uuid.UUID `json:"trip_id"`
+		Email  string    `json:"email"`
+		Guests int32     `json:"guests"`
+	}
+
+	// ActivityCreatedEvent is recorded by a trigger of the activities, as
+	// they are created by many queries.
+	ActivityCreatedEvent struct {
+		TripID     uuid.UUID `json:"trip_id"`
+		ActivityID uuid.UUID `json:"activity_id"`
+		Title      string    `json:"title"`
+		OccursAt   time.Time `json:"occurs_at"`
+	}
+)
+
+// recordEvent writes a domain event of the trip. Called with the Queries of a
+// transaction, the event is only published if the transaction commits.
+func (q *Queries) recordEvent(ctx context.Context, kind DomainEventKind, tripID uuid.UUID, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", kind, err)
+	}
+
+	return q.RecordDomainEvent(ctx, RecordDomainEventParams{
+		Kind:    kind,
+		TripID:  tripID,
+		Payload: b,
+	})
+}
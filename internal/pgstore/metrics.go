@@ -0,0 +1,269 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// QueryBuckets are the upper bounds of the buckets of the query duration
+// histograms. A last bucket counts the queries slower than all of them.
+var QueryBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+}
+
+// QueryStats are the runs of a query.
+type QueryStats struct {
+	Count  int64
+	Errors int64
+	Total  time.Duration
+	// Buckets counts the runs by duration, Buckets[i] those that took up to
+	// QueryBuckets[i] and not less than the previous bound, the last one
+	// those slower than every bound.
+	Buckets []int64
+}
+
+type histogram struct {
+	count, errors, total atomic.Int64
+	buckets              []atomic.Int64
+}
+
+// QueryMetrics records the duration of the queries, by their sqlc name, and
+// logs the slow ones.
+type QueryMetrics struct {
+	logger *zap.Logger
+	slow   time.Duration
+
+	mu      sync.RWMutex
+	queries map[string]*histogram
+}
+
+// NewQueryMetrics returns metrics logging the queries running longer than
+// slow, a zero slow logging none.
+func NewQueryMetrics(logger *zap.Logger, slow time.Duration) *QueryMetrics {
+	return &QueryMetrics{
+		logger:  logger,
+		slow:    slow,
+		queries: make(map[string]*histogram),
+	}
+}
+
+// Stats returns the stats of each query since the metrics were created.
+func (m *QueryMetrics) Stats() map[string]QueryStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	stats := make(map[string]QueryStats, len(m.queries))
+	for name, h := range m.queries {
+		s := QueryStats{
+			Count:   h.count.Load(),
+			Errors:  h.errors.Load(),
+			Total:   time.Duration(h.total.Load()),
+			Buckets: make([]int64, len(h.buckets)),
+		}
+		for i := range h.buckets {
+			s.Buckets[i] = h.buckets[i].Load()
+		}
+		stats[name] = s
+	}
+
+	return stats
+}
+
+func (m *QueryMetrics) histogram(name string) *histogram {
+	m.mu.RLock()
+	h, ok := m.queries[name]
+	m.mu.RUnlock()
+	if ok {
+		return h
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if h, ok := m.queries[name]; ok {
+		return h
+	}
+
+	h = &histogram{buckets: make([]atomic.Int64, len(QueryBuckets)+1)}
+	m.queries[name] = h
+
+	return h
+}
+
+// record adds a run of the query to its histogram. Not finding a row is not
+// counted as an error, being how sqlc reports it.
+func (m *QueryMetrics) record(name string, args []any, took time.Duration, err error) {
+	h := m.histogram(name)
+	h.count.Add(1)
+	h.total.Add(int64(took))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		h.errors.Add(1)
+	}
+
+	bucket := len(QueryBuckets)
+	for i, bound := range QueryBuckets {
+		if took <= bound {
+			bucket = i
+			break
+		}
+	}
+	h.buckets[bucket].Add(1)
+
+	if m.slow > 0 && took >= m.slow {
+		m.logger.Warn(
+			"slow query",
+			zap.String("query", name),
+			zap.Duration("duration", took),
+			zap.Strings("args", redact(args)),
+			zap.Error(err),
+		)
+	}
+}
+
+// queryName returns the name sqlc gives a query in its leading comment.
+func queryName(sql string) string {
+	rest, ok := strings.CutPrefix(sql, "-- name: ")
+	if !ok {
+		return "unnamed"
+	}
+
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
+
+// redact returns the arguments of a query to be logged. The IDs, numbers and
+// times, needed to reproduce a slow query, are kept while the other values,
+// which may be personal data such as emails, are hidden.
+func redact(args []any) []string {
+	redacted := make([]string, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case nil:
+			redacted[i] = "NULL"
+		case uuid.UUID, bool, int, int32, int64, float64:
+			redacted[i] = fmt.Sprint(v)
+		case time.Time:
+			redacted[i] = v.Format(time.RFC3339Nano)
+		case pgtype.Timestamp:
+			if v.Valid {
+				redacted[i] = v.Time.Format(time.RFC3339Nano)
+			} else {
+				redacted[i] = "NULL"
+			}
+		case pgtype.Int4:
+			if v.Valid {
+				redacted[i] = fmt.Sprint(v.Int32)
+			} else {
+				redacted[i] = "NULL"
+			}
+		default:
+			redacted[i] = "[redacted]"
+		}
+	}
+
+	return redacted
+}
+
+// metricsDB records the duration of the queries run on db. A query returning
+// rows is timed until they are closed, and a single row until it is scanned.
+type metricsDB struct {
+	db      DBTX
+	metrics *QueryMetrics
+}
+
+// WithMetrics returns db recording the queries in metrics. The queries of the
+// transactions, run on the transaction itself, are not recorded.
+func WithMetrics(db DBTX, metrics *QueryMetrics) DBTX {
+	return metricsDB{db, metrics}
+}
+
+func (m metricsDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+
+	tag, err := m.db.Exec(ctx, sql, args...)
+	m.metrics.record(queryName(sql), args, time.Since(start), err)
+
+	return tag, err
+}
+
+func (m metricsDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+
+	rows, err := m.db.Query(ctx, sql, args...)
+	if err != nil {
+		m.metrics.record(queryName(sql), args, time.Since(start), err)
+		return nil, err
+	}
+
+	return &metricsRows{Rows: rows, metrics: m.metrics, name: queryName(sql), args: args, start: start}, nil
+}
+
+func (m metricsDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return metricsRow{m.db.QueryRow(ctx, sql, args...), m.metrics, queryName(sql), args, time.Now()}
+}
+
+func (m metricsDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	start := time.Now()
+
+	n, err := m.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+	// COPY has no sqlc comment, it is named after its table.
+	m.metrics.record("COPY "+strings.Join(tableName, "."), nil, time.Since(start), err)
+
+	return n, err
+}
+
+// metricsRows records its query once closed.
+type metricsRows struct {
+	pgx.Rows
+	metrics *QueryMetrics
+	name    string
+	args    []any
+	start   time.Time
+	closed  bool
+}
+
+func (r *metricsRows) Close() {
+	r.Rows.Close()
+
+	// Close may be called more than once.
+	if !r.closed {
+		r.closed = true
+		r.metrics.record(r.name, r.args, time.Since(r.start), r.Rows.Err())
+	}
+}
+
+// metricsRow records its query once scanned.
+type metricsRow struct {
+	row     pgx.Row
+	metrics *QueryMetrics
+	name    string
+	args    []any
+	start   time.Time
+}
+
+func (r metricsRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	r.metrics.record(r.name, r.args, time.Since(r.start), err)
+
+	return err
+}
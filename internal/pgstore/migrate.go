@@ -0,0 +1,164 @@
+package pgstore
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// migrationFiles are the tern migrations, embedded so the server binary can
+// migrate the database without the tern CLI.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationSeparator splits a tern migration into its up and down statements.
+const migrationSeparator = "---- create above / drop below ----"
+
+// migrationLockID is the advisory lock held while migrating, so instances
+// starting together do not apply the same migration twice.
+const migrationLockID = 7_211_042_113
+
+// Migration is one of the embedded migrations.
+type Migration struct {
+	Version int32
+	Name    string
+	up      string
+}
+
+// Migrations returns the embedded migrations in the order they are applied.
+func Migrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to read migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("pgstore: migration %s has no version prefix", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(prefix, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: migration %s has an invalid version: %w", entry.Name(), err)
+		}
+
+		sql, err := fs.ReadFile(migrationFiles, path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		up, _, _ := strings.Cut(string(sql), migrationSeparator)
+		migrations = append(migrations, Migration{
+			Version: int32(version),
+			Name:    strings.TrimSuffix(entry.Name(), ".sql"),
+			up:      up,
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	// tern numbers migrations from 1 without gaps and so does the schema
+	// version, a gap would skip or repeat a migration.
+	for i, m := range migrations {
+		if m.Version != int32(i+1) {
+			return nil, fmt.Errorf("pgstore: migration %s is out of sequence, expected version %d", m.Name, i+1)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Migrate applies the embedded migrations the database is missing, each in
+// its own transaction, and returns the ones it applied. The version is kept in
+// tern's schema_version table, so databases migrated with the tern CLI carry
+// on from where they are.
+func Migrate(ctx context.Context, pool *pgxpool.Pool) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to acquire connection for Migrate: %w", err)
+	}
+
+	defer conn.Release()
+
+	// Migrations, and waiting for another instance to apply them, may take
+	// longer than the statement_timeout of the pool, the connection getting it
+	// back once released.
+	if _, err := conn.Exec(ctx, "SET statement_timeout = 0"); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to disable statement timeout: %w", err)
+	}
+
+	defer func() { _, _ = conn.Exec(context.Background(), "RESET statement_timeout") }()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock migrations: %w", err)
+	}
+
+	defer func() { _, _ = conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID) }()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS public.schema_version (version int4 NOT NULL);
+		INSERT INTO public.schema_version (version)
+		SELECT 0 WHERE NOT EXISTS (SELECT 1 FROM public.schema_version);
+	`); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to create schema_version: %w", err)
+	}
+
+	var current int32
+	if err := conn.QueryRow(ctx, "SELECT version FROM public.schema_version").Scan(&current); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get schema version: %w", err)
+	}
+
+	if int(current) > len(migrations) {
+		return nil, fmt.Errorf("pgstore: database is at version %d, newer than the %d known migrations", current, len(migrations))
+	}
+
+	applied := migrations[current:]
+	for _, m := range applied {
+		if err := applyMigration(ctx, conn.Conn(), m); err != nil {
+			return nil, err
+		}
+	}
+
+	return applied, nil
+}
+
+func applyMigration(ctx context.Context, conn *pgx.Conn, m Migration) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for migration %s: %w", m.Name, err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Without arguments the statements go through the simple protocol, which
+	// runs a migration of several statements at once.
+	if _, err := tx.Exec(ctx, m.up); err != nil {
+		return fmt.Errorf("pgstore: failed to apply migration %s: %w", m.Name, err)
+	}
+
+	if _, err := tx.Exec(ctx, "UPDATE public.schema_version SET version = $1", m.Version); err != nil {
+		return fmt.Errorf("pgstore: failed to set schema version for migration %s: %w", m.Name, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit migration %s: %w", m.Name, err)
+	}
+
+	return nil
+}
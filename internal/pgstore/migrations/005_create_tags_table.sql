@@ -0,0 +1,25 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS tags (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "name" varchar(50) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS trip_tags (
+    "trip_id" uuid NOT NULL,
+    "tag_id" uuid NOT NULL,
+
+    PRIMARY KEY (trip_id, tag_id),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+
+    FOREIGN KEY (tag_id) REFERENCES tags (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS trip_tags;
+DROP TABLE IF EXISTS tags;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
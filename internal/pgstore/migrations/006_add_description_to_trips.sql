@@ -0,0 +1,8 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "description" text NOT NULL DEFAULT '';
+---- create above / drop below ----
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "description";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
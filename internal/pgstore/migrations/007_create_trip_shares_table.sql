@@ -0,0 +1,15 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS trip_shares (
+    "slug" varchar(32) PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+    "revoked_at" timestamp,
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS trip_shares;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
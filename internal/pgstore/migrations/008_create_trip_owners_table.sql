@@ -0,0 +1,21 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS trip_owners (
+    "trip_id" uuid NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "name" varchar(255) NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (trip_id, email),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+INSERT INTO trip_owners ( "trip_id", "email", "name" )
+SELECT "id", lower("owner_email"), "owner_name" FROM trips
+ON CONFLICT DO NOTHING;
+---- create above / drop below ----
+DROP TABLE IF EXISTS trip_owners;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
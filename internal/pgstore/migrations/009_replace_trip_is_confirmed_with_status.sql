@@ -0,0 +1,28 @@
+-- Write your migrate up statements here
+CREATE TYPE trip_status AS ENUM (
+    'draft',
+    'confirmed',
+    'ongoing',
+    'completed',
+    'cancelled'
+);
+
+ALTER TABLE trips
+    ADD COLUMN "status" trip_status NOT NULL DEFAULT 'draft';
+
+UPDATE trips SET "status" = 'confirmed' WHERE "is_confirmed";
+
+ALTER TABLE trips
+    DROP COLUMN "is_confirmed";
+---- create above / drop below ----
+ALTER TABLE trips
+    ADD COLUMN "is_confirmed" boolean NOT NULL DEFAULT FALSE;
+
+UPDATE trips SET "is_confirmed" = TRUE WHERE "status" IN ('confirmed', 'ongoing', 'completed');
+
+ALTER TABLE trips
+    DROP COLUMN "status";
+
+DROP TYPE IF EXISTS trip_status;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
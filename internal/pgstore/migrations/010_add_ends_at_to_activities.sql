@@ -0,0 +1,10 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "ends_at" timestamp,
+    ADD CONSTRAINT activities_ends_after_start CHECK ("ends_at" IS NULL OR "ends_at" > "occurs_at");
+---- create above / drop below ----
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_ends_after_start,
+    DROP COLUMN IF EXISTS "ends_at";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
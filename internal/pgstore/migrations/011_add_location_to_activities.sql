@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "address" text,
+    ADD COLUMN IF NOT EXISTS "latitude" double precision,
+    ADD COLUMN IF NOT EXISTS "longitude" double precision,
+    ADD CONSTRAINT activities_latitude_range CHECK ("latitude" BETWEEN -90 AND 90),
+    ADD CONSTRAINT activities_longitude_range CHECK ("longitude" BETWEEN -180 AND 180);
+---- create above / drop below ----
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_longitude_range,
+    DROP CONSTRAINT IF EXISTS activities_latitude_range,
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "latitude",
+    DROP COLUMN IF EXISTS "address";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TYPE activity_category AS ENUM (
+    'food',
+    'transport',
+    'sightseeing',
+    'lodging',
+    'other'
+);
+
+ALTER TABLE activities
+    ADD COLUMN "category" activity_category NOT NULL DEFAULT 'other';
+---- create above / drop below ----
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "category";
+
+DROP TYPE IF EXISTS activity_category;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
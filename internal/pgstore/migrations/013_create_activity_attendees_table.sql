@@ -0,0 +1,21 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS activity_attendees (
+    "activity_id" uuid NOT NULL,
+    "participant_id" uuid NOT NULL,
+    "attending" boolean NOT NULL,
+    "updated_at" timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (activity_id, participant_id),
+
+    FOREIGN KEY (activity_id) REFERENCES activities (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS activity_attendees;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS activity_comments (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "activity_id" uuid NOT NULL,
+    "participant_id" uuid NOT NULL,
+    "body" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (activity_id) REFERENCES activities (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS activity_comments;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS activity_attachments (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "activity_id" uuid NOT NULL,
+    "file_name" varchar(255) NOT NULL,
+    "content_type" varchar(255) NOT NULL,
+    "size" bigint NOT NULL,
+    "storage_key" text NOT NULL UNIQUE,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (activity_id) REFERENCES activities (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS activity_attachments;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,8 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "position" integer NOT NULL DEFAULT 0;
+---- create above / drop below ----
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "position";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
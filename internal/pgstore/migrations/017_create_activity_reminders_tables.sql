@@ -0,0 +1,30 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS reminder_opt_outs (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS activity_reminders (
+    "activity_id" uuid NOT NULL,
+    "participant_id" uuid NOT NULL,
+    "sent_at" timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (activity_id, participant_id),
+
+    FOREIGN KEY (activity_id) REFERENCES activities (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS activity_reminders;
+DROP TABLE IF EXISTS reminder_opt_outs;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
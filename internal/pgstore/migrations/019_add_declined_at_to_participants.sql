@@ -0,0 +1,10 @@
+-- Write your migrate up statements here
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "declined_at" timestamp,
+    ADD COLUMN IF NOT EXISTS "decline_reason" text;
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "decline_reason",
+    DROP COLUMN IF EXISTS "declined_at";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
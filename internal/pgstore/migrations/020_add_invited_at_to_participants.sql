@@ -0,0 +1,8 @@
+-- Write your migrate up statements here
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invited_at" timestamp NOT NULL DEFAULT now();
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invited_at";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,79 @@
+-- Write your migrate up statements here
+UPDATE participants SET "email" = lower("email");
+
+-- Of the participants sharing an e-mail in a trip the one kept is the
+-- confirmed one, then the one invited first. What the others answered, wrote
+-- or voted moves to it unless it has its own.
+CREATE TEMPORARY TABLE participant_duplicates AS
+SELECT
+    id, survivor_id
+FROM (
+    SELECT
+        id,
+        first_value(id) OVER (
+            PARTITION BY trip_id, email
+            ORDER BY is_confirmed DESC, invited_at, id
+        ) AS survivor_id
+    FROM participants
+) AS ranked
+WHERE
+    id <> survivor_id;
+
+INSERT INTO activity_attendees
+    ( "activity_id", "participant_id", "attending", "updated_at" )
+SELECT DISTINCT ON (attendee.activity_id, duplicate.survivor_id)
+    attendee.activity_id, duplicate.survivor_id, attendee.attending, attendee.updated_at
+FROM activity_attendees AS attendee
+JOIN participant_duplicates AS duplicate ON duplicate.id = attendee.participant_id
+ORDER BY attendee.activity_id, duplicate.survivor_id, attendee.updated_at DESC
+ON CONFLICT DO NOTHING;
+
+INSERT INTO activity_votes
+    ( "activity_id", "participant_id", "created_at" )
+SELECT DISTINCT ON (vote.activity_id, duplicate.survivor_id)
+    vote.activity_id, duplicate.survivor_id, vote.created_at
+FROM activity_votes AS vote
+JOIN participant_duplicates AS duplicate ON duplicate.id = vote.participant_id
+ORDER BY vote.activity_id, duplicate.survivor_id, vote.created_at
+ON CONFLICT DO NOTHING;
+
+INSERT INTO activity_reminders
+    ( "activity_id", "participant_id", "sent_at" )
+SELECT DISTINCT ON (reminder.activity_id, duplicate.survivor_id)
+    reminder.activity_id, duplicate.survivor_id, reminder.sent_at
+FROM activity_reminders AS reminder
+JOIN participant_duplicates AS duplicate ON duplicate.id = reminder.participant_id
+ORDER BY reminder.activity_id, duplicate.survivor_id, reminder.sent_at
+ON CONFLICT DO NOTHING;
+
+INSERT INTO reminder_opt_outs
+    ( "participant_id", "created_at" )
+SELECT DISTINCT ON (duplicate.survivor_id)
+    duplicate.survivor_id, opt_out.created_at
+FROM reminder_opt_outs AS opt_out
+JOIN participant_duplicates AS duplicate ON duplicate.id = opt_out.participant_id
+ORDER BY duplicate.survivor_id, opt_out.created_at
+ON CONFLICT DO NOTHING;
+
+UPDATE activity_comments
+SET
+    "participant_id" = duplicate.survivor_id
+FROM participant_duplicates AS duplicate
+WHERE
+    activity_comments.participant_id = duplicate.id;
+
+-- The rows left pointing at the duplicates go with them.
+DELETE FROM participants
+USING participant_duplicates AS duplicate
+WHERE
+    participants.id = duplicate.id;
+
+DROP TABLE participant_duplicates;
+
+ALTER TABLE participants
+    ADD CONSTRAINT participants_trip_id_email_key UNIQUE (trip_id, email);
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP CONSTRAINT IF EXISTS participants_trip_id_email_key;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
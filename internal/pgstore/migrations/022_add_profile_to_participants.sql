@@ -0,0 +1,12 @@
+-- Write your migrate up statements here
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "name" varchar(255),
+    ADD COLUMN IF NOT EXISTS "phone" varchar(32),
+    ADD COLUMN IF NOT EXISTS "avatar_url" text;
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "avatar_url",
+    DROP COLUMN IF EXISTS "phone",
+    DROP COLUMN IF EXISTS "name";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "rsvp_deadline" timestamp;
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "rsvp_reminded_at" timestamp,
+    ADD COLUMN IF NOT EXISTS "no_response_at" timestamp;
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "no_response_at",
+    DROP COLUMN IF EXISTS "rsvp_reminded_at";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "rsvp_deadline";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
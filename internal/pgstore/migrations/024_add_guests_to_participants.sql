@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "max_guests" integer NOT NULL DEFAULT 0 CHECK ("max_guests" >= 0);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "guests" integer NOT NULL DEFAULT 0 CHECK ("guests" >= 0);
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "guests";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "max_guests";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,23 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "max_participants" integer CHECK ("max_participants" > 0);
+
+CREATE TABLE IF NOT EXISTS trip_waitlist (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "trip_id" uuid NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    UNIQUE (trip_id, email),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS trip_waitlist;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "max_participants";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
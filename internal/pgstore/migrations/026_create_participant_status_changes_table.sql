@@ -0,0 +1,24 @@
+-- Write your migrate up statements here
+CREATE TYPE participant_status AS ENUM (
+    'confirmed',
+    'declined',
+    'unconfirmed'
+);
+
+CREATE TABLE IF NOT EXISTS participant_status_changes (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "participant_id" uuid NOT NULL,
+    "status" participant_status NOT NULL,
+    "reason" text,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS participant_status_changes;
+
+DROP TYPE IF EXISTS participant_status;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS trip_join_codes (
+    "code" varchar(6) PRIMARY KEY NOT NULL,
+    "trip_id" uuid NOT NULL UNIQUE,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS trip_join_codes;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
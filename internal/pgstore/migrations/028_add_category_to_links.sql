@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TYPE link_category AS ENUM (
+    'lodging',
+    'transport',
+    'tickets',
+    'docs',
+    'other'
+);
+
+ALTER TABLE links
+    ADD COLUMN "category" link_category NOT NULL DEFAULT 'other';
+---- create above / drop below ----
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "category";
+
+DROP TYPE IF EXISTS link_category;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
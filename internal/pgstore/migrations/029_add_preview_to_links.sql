@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "preview_title" text,
+    ADD COLUMN IF NOT EXISTS "preview_description" text,
+    ADD COLUMN IF NOT EXISTS "preview_favicon_url" text,
+    ADD COLUMN IF NOT EXISTS "preview_image_url" text,
+    ADD COLUMN IF NOT EXISTS "preview_fetched_at" timestamp;
+---- create above / drop below ----
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "preview_title",
+    DROP COLUMN IF EXISTS "preview_description",
+    DROP COLUMN IF EXISTS "preview_favicon_url",
+    DROP COLUMN IF EXISTS "preview_image_url",
+    DROP COLUMN IF EXISTS "preview_fetched_at";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,10 @@
+-- Write your migrate up statements here
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "pinned" boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS "position" integer NOT NULL DEFAULT 0;
+---- create above / drop below ----
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "pinned",
+    DROP COLUMN IF EXISTS "position";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS link_clicks (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "link_id" uuid NOT NULL,
+    "clicked_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (link_id) REFERENCES links (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS link_clicks;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
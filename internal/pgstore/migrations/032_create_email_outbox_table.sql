@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+CREATE TYPE email_kind AS ENUM (
+    'confirm_trip',
+    'invitation',
+    'unconfirmation',
+    'activity_reminder',
+    'rsvp_reminder'
+);
+
+CREATE TYPE email_status AS ENUM (
+    'pending',
+    'sent',
+    'dead'
+);
+
+CREATE TABLE IF NOT EXISTS email_outbox (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "kind" email_kind NOT NULL,
+    "payload" jsonb NOT NULL,
+    "status" email_status NOT NULL DEFAULT 'pending',
+    "attempts" int NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamp NOT NULL DEFAULT now(),
+    "last_error" text,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+    "sent_at" timestamp
+);
+
+CREATE INDEX IF NOT EXISTS email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+---- create above / drop below ----
+DROP TABLE IF EXISTS email_outbox;
+
+DROP TYPE IF EXISTS email_status;
+
+DROP TYPE IF EXISTS email_kind;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
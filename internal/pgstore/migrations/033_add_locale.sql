@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TYPE locale AS ENUM (
+    'pt-BR',
+    'en',
+    'es'
+);
+
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS "locale" locale NOT NULL DEFAULT 'pt-BR';
+
+ALTER TABLE trip_owners ADD COLUMN IF NOT EXISTS "locale" locale NOT NULL DEFAULT 'pt-BR';
+---- create above / drop below ----
+ALTER TABLE trip_owners DROP COLUMN IF EXISTS "locale";
+
+ALTER TABLE participants DROP COLUMN IF EXISTS "locale";
+
+DROP TYPE IF EXISTS locale;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
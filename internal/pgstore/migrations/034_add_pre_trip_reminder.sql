@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'pre_trip_reminder';
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "pre_trip_reminder_days" int NOT NULL DEFAULT 2;
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "pre_trip_reminded_at" timestamp;
+---- create above / drop below ----
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "pre_trip_reminded_at";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "pre_trip_reminder_days";
+
+-- Values cannot be dropped from an enum, pre_trip_reminder stays in email_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'daily_digest';
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "timezone" text NOT NULL DEFAULT 'UTC';
+
+CREATE TABLE IF NOT EXISTS digest_opt_outs (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS daily_digests (
+    "participant_id" uuid NOT NULL,
+    "day" date NOT NULL,
+    "sent_at" timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (participant_id, day),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS daily_digests;
+DROP TABLE IF EXISTS digest_opt_outs;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "timezone";
+
+-- Values cannot be dropped from an enum, daily_digest stays in email_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
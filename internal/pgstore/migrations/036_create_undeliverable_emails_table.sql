@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TYPE email_issue AS ENUM (
+    'bounce',
+    'complaint'
+);
+
+CREATE TABLE IF NOT EXISTS undeliverable_emails (
+    "email" text PRIMARY KEY NOT NULL,
+    "issue" email_issue NOT NULL,
+    "detail" text,
+    "reported_at" timestamp NOT NULL DEFAULT now()
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS undeliverable_emails;
+
+DROP TYPE IF EXISTS email_issue;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
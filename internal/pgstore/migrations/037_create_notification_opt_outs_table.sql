@@ -0,0 +1,66 @@
+-- Write your migrate up statements here
+CREATE TYPE notification_kind AS ENUM (
+    'activity_reminder',
+    'rsvp_reminder',
+    'pre_trip_reminder',
+    'daily_digest'
+);
+
+CREATE TABLE IF NOT EXISTS notification_opt_outs (
+    "participant_id" uuid NOT NULL,
+    "kind" notification_kind NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (participant_id, kind),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- Opting out of reminders used to cover the activity and pre-trip reminders.
+INSERT INTO notification_opt_outs ( "participant_id", "kind", "created_at" )
+SELECT participant_id, kind, created_at
+FROM reminder_opt_outs
+CROSS JOIN unnest(ARRAY['activity_reminder', 'pre_trip_reminder']::notification_kind[]) AS kind;
+
+INSERT INTO notification_opt_outs ( "participant_id", "kind", "created_at" )
+SELECT participant_id, 'daily_digest', created_at
+FROM digest_opt_outs;
+
+DROP TABLE IF EXISTS digest_opt_outs;
+DROP TABLE IF EXISTS reminder_opt_outs;
+---- create above / drop below ----
+CREATE TABLE IF NOT EXISTS reminder_opt_outs (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS digest_opt_outs (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+INSERT INTO reminder_opt_outs ( "participant_id", "created_at" )
+SELECT participant_id, created_at
+FROM notification_opt_outs
+WHERE kind = 'activity_reminder';
+
+INSERT INTO digest_opt_outs ( "participant_id", "created_at" )
+SELECT participant_id, created_at
+FROM notification_opt_outs
+WHERE kind = 'daily_digest';
+
+DROP TABLE IF EXISTS notification_opt_outs;
+
+DROP TYPE IF EXISTS notification_kind;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,26 @@
+-- Write your migrate up statements here
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'email_verification';
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "email_verified_at" timestamp;
+
+CREATE TABLE IF NOT EXISTS email_verifications (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "code" text NOT NULL,
+    "attempts" int NOT NULL DEFAULT 0,
+    "expires_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS email_verifications;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "email_verified_at";
+
+-- Values cannot be dropped from an enum, email_verification stays in email_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
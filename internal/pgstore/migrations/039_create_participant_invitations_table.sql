@@ -0,0 +1,25 @@
+-- Write your migrate up statements here
+CREATE TABLE IF NOT EXISTS participant_invitations (
+    "participant_id" uuid PRIMARY KEY NOT NULL,
+    "sent_at" timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- Participants of trips already confirmed have been sent their invitation.
+INSERT INTO participant_invitations
+    ( "participant_id", "sent_at" )
+SELECT
+    participants.id, participants.invited_at
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE
+    trips.status <> 'draft'
+ON CONFLICT (participant_id) DO NOTHING;
+---- create above / drop below ----
+DROP TABLE IF EXISTS participant_invitations;
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
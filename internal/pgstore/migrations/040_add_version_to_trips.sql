@@ -0,0 +1,9 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "version" int NOT NULL DEFAULT 1;
+---- create above / drop below ----
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "version";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
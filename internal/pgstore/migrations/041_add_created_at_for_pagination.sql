@@ -0,0 +1,30 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "created_at" timestamp NOT NULL DEFAULT now();
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "created_at" timestamp NOT NULL DEFAULT now();
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "created_at" timestamp NOT NULL DEFAULT now();
+
+-- Keyset pagination walks these in (created_at, id) order.
+CREATE INDEX IF NOT EXISTS trips_created_at_id_idx ON trips (created_at, id);
+CREATE INDEX IF NOT EXISTS activities_trip_id_created_at_id_idx ON activities (trip_id, created_at, id);
+CREATE INDEX IF NOT EXISTS participants_trip_id_created_at_id_idx ON participants (trip_id, created_at, id);
+---- create above / drop below ----
+DROP INDEX IF EXISTS participants_trip_id_created_at_id_idx;
+DROP INDEX IF EXISTS activities_trip_id_created_at_id_idx;
+DROP INDEX IF EXISTS trips_created_at_id_idx;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "created_at";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "created_at";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "created_at";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
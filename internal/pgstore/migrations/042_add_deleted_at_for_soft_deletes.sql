@@ -0,0 +1,26 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "deleted_at" timestamp;
+
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "deleted_at" timestamp;
+
+ALTER TABLE activity_comments
+    ADD COLUMN IF NOT EXISTS "deleted_at" timestamp;
+---- create above / drop below ----
+-- Soft deleted rows would come back, drop them for good first.
+DELETE FROM activity_comments WHERE deleted_at IS NOT NULL;
+DELETE FROM links WHERE deleted_at IS NOT NULL;
+DELETE FROM activities WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE activity_comments
+    DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "deleted_at";
+
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,38 @@
+-- Write your migrate up statements here
+CREATE TYPE domain_event_kind AS ENUM (
+    'trip_created',
+    'trip_confirmed',
+    'participant_invited',
+    'participant_confirmed'
+);
+
+CREATE TYPE domain_event_status AS ENUM (
+    'pending',
+    'published',
+    'dead'
+);
+
+-- The events are kept after their trip is deleted, consumers may still be
+-- catching up on them.
+CREATE TABLE IF NOT EXISTS domain_events (
+    "id" uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    "kind" domain_event_kind NOT NULL,
+    "trip_id" uuid NOT NULL,
+    "payload" jsonb NOT NULL,
+    "status" domain_event_status NOT NULL DEFAULT 'pending',
+    "attempts" int NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamp NOT NULL DEFAULT now(),
+    "last_error" text,
+    "occurred_at" timestamp NOT NULL DEFAULT now(),
+    "published_at" timestamp
+);
+
+CREATE INDEX IF NOT EXISTS domain_events_pending_idx ON domain_events (next_attempt_at) WHERE status = 'pending';
+---- create above / drop below ----
+DROP TABLE IF EXISTS domain_events;
+
+DROP TYPE IF EXISTS domain_event_status;
+
+DROP TYPE IF EXISTS domain_event_kind;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
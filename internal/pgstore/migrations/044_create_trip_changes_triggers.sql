@@ -0,0 +1,39 @@
+-- Write your migrate up statements here
+-- Notifies the trip_changes channel of every change of a trip, its activities
+-- and its participants, for the server to stream them to connected clients.
+-- The payload only says what changed, clients fetch the change themselves.
+CREATE OR REPLACE FUNCTION notify_trip_change() RETURNS trigger AS $$
+DECLARE
+    changed jsonb := to_jsonb(CASE WHEN TG_OP = 'DELETE' THEN OLD ELSE NEW END);
+BEGIN
+    PERFORM pg_notify('trip_changes', json_build_object(
+        'table', TG_TABLE_NAME,
+        'op', lower(TG_OP),
+        'trip_id', COALESCE(changed->>'trip_id', changed->>'id'),
+        'id', changed->>'id'
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trips_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON trips
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+
+CREATE TRIGGER activities_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON activities
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+
+CREATE TRIGGER participants_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON participants
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TRIGGER IF EXISTS participants_notify_change ON participants;
+
+DROP TRIGGER IF EXISTS activities_notify_change ON activities;
+
+DROP TRIGGER IF EXISTS trips_notify_change ON trips;
+
+DROP FUNCTION IF EXISTS notify_trip_change();
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
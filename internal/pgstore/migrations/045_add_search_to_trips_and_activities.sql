@@ -0,0 +1,30 @@
+-- Write your migrate up statements here
+-- Trips and activities are written in Portuguese or English, both stemmings
+-- are indexed so either language finds them.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "search" tsvector GENERATED ALWAYS AS (
+        to_tsvector('portuguese', "destination" || ' ' || "description")
+        || to_tsvector('english', "destination" || ' ' || "description")
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS trips_search_idx ON trips USING GIN ("search");
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "search" tsvector GENERATED ALWAYS AS (
+        to_tsvector('portuguese', "title" || ' ' || COALESCE("address", ''))
+        || to_tsvector('english', "title" || ' ' || COALESCE("address", ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS activities_search_idx ON activities USING GIN ("search");
+---- create above / drop below ----
+DROP INDEX IF EXISTS activities_search_idx;
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "search";
+
+DROP INDEX IF EXISTS trips_search_idx;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "search";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
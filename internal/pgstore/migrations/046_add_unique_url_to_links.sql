@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+-- The API stores the URLs normalized, so a link added twice has the same URL.
+-- The duplicates already added are deleted, keeping the first of each.
+UPDATE links AS duplicate
+SET
+    "deleted_at" = now()
+FROM links AS original
+WHERE
+    duplicate.trip_id = original.trip_id
+    AND duplicate.url = original.url
+    AND duplicate.deleted_at IS NULL
+    AND original.deleted_at IS NULL
+    AND (duplicate.position, duplicate.ctid) > (original.position, original.ctid);
+
+CREATE UNIQUE INDEX IF NOT EXISTS links_trip_id_url_key ON links (trip_id, url)
+    WHERE deleted_at IS NULL;
+---- create above / drop below ----
+DROP INDEX IF EXISTS links_trip_id_url_key;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
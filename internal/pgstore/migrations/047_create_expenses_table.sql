@@ -0,0 +1,56 @@
+-- Write your migrate up statements here
+CREATE TYPE expense_category AS ENUM (
+    'food',
+    'transport',
+    'lodging',
+    'tickets',
+    'shopping',
+    'other'
+);
+
+-- The payer and the activity of an expense are referenced along with its
+-- trip, for an expense not to point to another trip's participant or
+-- activity.
+ALTER TABLE participants
+    ADD CONSTRAINT participants_id_trip_id_key UNIQUE (id, trip_id);
+
+ALTER TABLE activities
+    ADD CONSTRAINT activities_id_trip_id_key UNIQUE (id, trip_id);
+
+CREATE TABLE IF NOT EXISTS expenses (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    payer_id uuid NOT NULL,
+    activity_id uuid,
+    description varchar(255) NOT NULL,
+    -- In the minor unit of the currency, such as cents.
+    amount bigint NOT NULL CHECK (amount > 0),
+    currency char(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    category expense_category NOT NULL DEFAULT 'other',
+    spent_at timestamp NOT NULL DEFAULT now(),
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT expenses_payer_id_fkey FOREIGN KEY (payer_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT expenses_activity_id_fkey FOREIGN KEY (activity_id, trip_id) REFERENCES activities (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE SET NULL (activity_id)
+);
+
+CREATE INDEX IF NOT EXISTS expenses_trip_id_spent_at_idx ON expenses (trip_id, spent_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS expenses;
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_id_trip_id_key;
+
+ALTER TABLE participants
+    DROP CONSTRAINT IF EXISTS participants_id_trip_id_key;
+
+DROP TYPE IF EXISTS expense_category;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,30 @@
+-- Write your migrate up statements here
+-- The payments participants made to each other to settle the expenses of a
+-- trip, which the balances of the settlement take into account.
+CREATE TABLE IF NOT EXISTS settlement_payments (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    from_participant_id uuid NOT NULL,
+    to_participant_id uuid NOT NULL,
+    -- In the minor unit of the currency, such as cents.
+    amount bigint NOT NULL CHECK (amount > 0),
+    currency char(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    paid_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT settlement_payments_from_participant_id_fkey FOREIGN KEY (from_participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT settlement_payments_to_participant_id_fkey FOREIGN KEY (to_participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT settlement_payments_distinct_participants CHECK (from_participant_id <> to_participant_id)
+);
+
+CREATE INDEX IF NOT EXISTS settlement_payments_trip_id_paid_at_idx ON settlement_payments (trip_id, paid_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS settlement_payments;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
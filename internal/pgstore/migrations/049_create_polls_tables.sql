@@ -0,0 +1,70 @@
+-- Write your migrate up statements here
+-- Polls let the participants of a trip vote on its dates or destination
+-- before the owners settle them.
+CREATE TYPE poll_kind AS ENUM (
+    'dates',
+    'destination'
+);
+
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'poll_invitation';
+
+CREATE TABLE IF NOT EXISTS polls (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    kind poll_kind NOT NULL,
+    question varchar(255) NOT NULL,
+    -- Set once the winning option was applied to the trip, which closes the
+    -- poll.
+    applied_at timestamp,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS polls_trip_id_idx ON polls (trip_id);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    poll_id uuid NOT NULL,
+    position int NOT NULL,
+    -- Destination polls set the destination, dates polls the date range.
+    destination varchar(255),
+    starts_at timestamp,
+    ends_at timestamp,
+
+    FOREIGN KEY (poll_id) REFERENCES polls (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT poll_options_id_poll_id_key UNIQUE (id, poll_id),
+    CONSTRAINT poll_options_candidate_check CHECK (
+        (destination IS NOT NULL AND starts_at IS NULL AND ends_at IS NULL)
+        OR (destination IS NULL AND starts_at IS NOT NULL AND ends_at > starts_at)
+    )
+);
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    poll_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+    option_id uuid NOT NULL,
+    voted_at timestamp NOT NULL DEFAULT now(),
+
+    -- A participant votes once per poll, voting again changes their option.
+    PRIMARY KEY (poll_id, participant_id),
+    CONSTRAINT poll_votes_option_id_fkey FOREIGN KEY (option_id, poll_id) REFERENCES poll_options (
+        id, poll_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
+DROP TYPE IF EXISTS poll_kind;
+
+-- Values cannot be dropped from an enum, poll_invitation stays in email_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
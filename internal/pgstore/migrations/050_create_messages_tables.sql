@@ -0,0 +1,45 @@
+-- Write your migrate up statements here
+-- The message board of a trip, where its participants coordinate.
+CREATE TABLE IF NOT EXISTS messages (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+    body text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    -- The author is referenced along with the trip, for only its
+    -- participants to write on its board.
+    CONSTRAINT messages_participant_id_fkey FOREIGN KEY (participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- The board is read newest first, in (created_at, id) order.
+CREATE INDEX IF NOT EXISTS messages_trip_id_created_at_id_idx ON messages (trip_id, created_at, id);
+
+-- Until when each participant read the board of their trip, the messages
+-- written after it by the others being unread.
+CREATE TABLE IF NOT EXISTS message_reads (
+    participant_id uuid PRIMARY KEY NOT NULL,
+    trip_id uuid NOT NULL,
+    read_at timestamp NOT NULL DEFAULT now(),
+
+    CONSTRAINT message_reads_participant_id_fkey FOREIGN KEY (participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TRIGGER messages_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON messages
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TRIGGER IF EXISTS messages_notify_change ON messages;
+
+DROP TABLE IF EXISTS message_reads;
+
+DROP TABLE IF EXISTS messages;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
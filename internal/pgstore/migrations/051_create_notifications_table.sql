@@ -0,0 +1,98 @@
+-- Write your migrate up statements here
+-- The in-app notifications of the participants, written by triggers for every
+-- way the trips change to notify them.
+CREATE TYPE notification_event AS ENUM (
+    'activity_created',
+    'participant_confirmed',
+    'trip_updated'
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    participant_id uuid NOT NULL,
+    trip_id uuid NOT NULL,
+    event notification_event NOT NULL,
+    -- The activity created, the participant confirmed or the trip updated.
+    subject_id uuid NOT NULL,
+    read_at timestamp,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    CONSTRAINT notifications_participant_id_fkey FOREIGN KEY (participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notifications_participant_id_created_at_idx ON notifications (participant_id, created_at);
+
+-- Notifies the participants of the trip who did not decline it, but the one
+-- the event is about.
+CREATE OR REPLACE FUNCTION notify_participants(trip_id uuid, event notification_event, subject_id uuid, except_id uuid) RETURNS void AS $$
+    INSERT INTO notifications (participant_id, trip_id, event, subject_id)
+    SELECT
+        participants.id, participants.trip_id, $2, $3
+    FROM participants
+    WHERE
+        participants.trip_id = $1
+        AND participants.declined_at IS NULL
+        AND participants.id IS DISTINCT FROM $4;
+$$ LANGUAGE sql;
+
+CREATE OR REPLACE FUNCTION notify_activity_created() RETURNS trigger AS $$
+BEGIN
+    PERFORM notify_participants(NEW.trip_id, 'activity_created', NEW.id, NULL);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION notify_participant_confirmed() RETURNS trigger AS $$
+BEGIN
+    PERFORM notify_participants(NEW.trip_id, 'participant_confirmed', NEW.id, NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION notify_trip_updated() RETURNS trigger AS $$
+BEGIN
+    PERFORM notify_participants(NEW.id, 'trip_updated', NEW.id, NULL);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER activities_notify_participants
+    AFTER INSERT ON activities
+    FOR EACH ROW EXECUTE FUNCTION notify_activity_created();
+
+CREATE TRIGGER participants_notify_participants
+    AFTER UPDATE OF is_confirmed ON participants
+    FOR EACH ROW WHEN (NEW.is_confirmed AND NOT OLD.is_confirmed)
+    EXECUTE FUNCTION notify_participant_confirmed();
+
+-- Only the changes the participants see notify them, not the settings of the
+-- trip nor its status.
+CREATE TRIGGER trips_notify_participants
+    AFTER UPDATE OF destination, starts_at, ends_at, description ON trips
+    FOR EACH ROW WHEN (
+        (OLD.destination, OLD.starts_at, OLD.ends_at, OLD.description)
+        IS DISTINCT FROM (NEW.destination, NEW.starts_at, NEW.ends_at, NEW.description)
+    )
+    EXECUTE FUNCTION notify_trip_updated();
+---- create above / drop below ----
+DROP TRIGGER IF EXISTS trips_notify_participants ON trips;
+
+DROP TRIGGER IF EXISTS participants_notify_participants ON participants;
+
+DROP TRIGGER IF EXISTS activities_notify_participants ON activities;
+
+DROP FUNCTION IF EXISTS notify_trip_updated();
+
+DROP FUNCTION IF EXISTS notify_participant_confirmed();
+
+DROP FUNCTION IF EXISTS notify_activity_created();
+
+DROP FUNCTION IF EXISTS notify_participants(uuid, notification_event, uuid, uuid);
+
+DROP TABLE IF EXISTS notifications;
+
+DROP TYPE IF EXISTS notification_event;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,9 @@
+-- Write your migrate up statements here
+-- Streams the changes of the links of a trip like those of its activities.
+CREATE TRIGGER links_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON links
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TRIGGER IF EXISTS links_notify_change ON links;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,92 @@
+-- Write your migrate up statements here
+ALTER TYPE domain_event_kind ADD VALUE IF NOT EXISTS 'activity_created';
+
+-- Activities are created by many queries, a trigger records the event of
+-- each of them in the transaction creating it.
+CREATE OR REPLACE FUNCTION record_activity_created() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO domain_events (kind, trip_id, payload)
+    VALUES ('activity_created', NEW.trip_id, jsonb_build_object(
+        'trip_id', NEW.trip_id,
+        'activity_id', NEW.id,
+        'title', NEW.title,
+        'occurs_at', to_char(NEW.occurs_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+    ));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER activities_record_created
+    AFTER INSERT ON activities
+    FOR EACH ROW EXECUTE FUNCTION record_activity_created();
+
+-- The URLs the domain events are posted to, either those of a trip or those
+-- of every trip an owner owns.
+CREATE TABLE IF NOT EXISTS webhooks (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid,
+    owner_email varchar(255),
+    url text NOT NULL,
+    -- Signs the payloads, for the receiver to check they come from the app.
+    secret text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT webhooks_scope CHECK ((trip_id IS NULL) <> (owner_email IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS webhooks_trip_id_idx ON webhooks (trip_id);
+
+CREATE INDEX IF NOT EXISTS webhooks_owner_email_idx ON webhooks (owner_email);
+
+CREATE TYPE webhook_delivery_status AS ENUM (
+    'pending',
+    'delivered',
+    'dead'
+);
+
+-- A domain event to post to a webhook, kept as the delivery log of the
+-- webhook.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    webhook_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    status webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamp NOT NULL DEFAULT now(),
+    -- The status the webhook answered the last attempt with, if it answered.
+    response_status int,
+    last_error text,
+    created_at timestamp NOT NULL DEFAULT now(),
+    delivered_at timestamp,
+
+    UNIQUE (webhook_id, event_id),
+
+    FOREIGN KEY (webhook_id) REFERENCES webhooks (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (event_id) REFERENCES domain_events (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_created_at_idx ON webhook_deliveries (webhook_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TYPE IF EXISTS webhook_delivery_status;
+
+DROP TABLE IF EXISTS webhooks;
+
+DROP TRIGGER IF EXISTS activities_record_created ON activities;
+
+DROP FUNCTION IF EXISTS record_activity_created();
+
+-- Values cannot be dropped from an enum, activity_created stays in
+-- domain_event_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,29 @@
+-- Write your migrate up statements here
+-- The packing list of a trip, shared by its participants, who may each take
+-- an item.
+CREATE TABLE IF NOT EXISTS packing_items (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    name varchar(255) NOT NULL,
+    quantity int NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    assignee_id uuid,
+    checked boolean NOT NULL DEFAULT false,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT packing_items_assignee_id_fkey FOREIGN KEY (assignee_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE SET NULL (assignee_id)
+);
+
+CREATE INDEX IF NOT EXISTS packing_items_trip_id_created_at_idx ON packing_items (trip_id, created_at);
+
+CREATE TRIGGER packing_items_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON packing_items
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TABLE IF EXISTS packing_items;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
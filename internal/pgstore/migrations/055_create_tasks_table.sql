@@ -0,0 +1,41 @@
+-- Write your migrate up statements here
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'task_reminder';
+
+ALTER TYPE notification_kind ADD VALUE IF NOT EXISTS 'task_reminder';
+
+-- The things to do before a trip, such as booking the car or buying travel
+-- insurance, shared by its participants, who may each take one.
+CREATE TABLE IF NOT EXISTS tasks (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    title varchar(255) NOT NULL,
+    assignee_id uuid,
+    due_at timestamp,
+    done boolean NOT NULL DEFAULT false,
+    -- Set once the assignee was nudged about the overdue task, and cleared
+    -- when its due date or assignee change for the next one to be nudged.
+    reminded_at timestamp,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT tasks_assignee_id_fkey FOREIGN KEY (assignee_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE SET NULL (assignee_id)
+);
+
+CREATE INDEX IF NOT EXISTS tasks_trip_id_idx ON tasks (trip_id);
+
+CREATE INDEX IF NOT EXISTS tasks_overdue_idx ON tasks (due_at) WHERE NOT done AND reminded_at IS NULL;
+
+CREATE TRIGGER tasks_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TABLE IF EXISTS tasks;
+
+-- Values cannot be dropped from an enum, task_reminder stays in email_kind
+-- and notification_kind.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
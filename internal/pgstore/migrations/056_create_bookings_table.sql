@@ -0,0 +1,40 @@
+-- Write your migrate up statements here
+CREATE TYPE booking_type AS ENUM (
+    'lodging'
+);
+
+-- Where the participants stay during a trip, its check-in and check-out
+-- showing in the itinerary along with the activities.
+CREATE TABLE IF NOT EXISTS bookings (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    type booking_type NOT NULL DEFAULT 'lodging',
+    name varchar(255) NOT NULL,
+    check_in_at timestamp NOT NULL,
+    check_out_at timestamp NOT NULL,
+    address varchar(500),
+    confirmation_code varchar(100),
+    -- In the minor unit of the currency, such as cents.
+    price bigint CHECK (price > 0),
+    currency char(3) CHECK (currency ~ '^[A-Z]{3}$'),
+    url text,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT bookings_check_out_at_check CHECK (check_out_at > check_in_at),
+    CONSTRAINT bookings_price_currency_check CHECK ((price IS NULL) = (currency IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS bookings_trip_id_check_in_at_idx ON bookings (trip_id, check_in_at);
+
+CREATE TRIGGER bookings_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON bookings
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TABLE IF EXISTS bookings;
+
+DROP TYPE IF EXISTS booking_type;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
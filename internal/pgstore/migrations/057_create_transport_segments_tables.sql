@@ -0,0 +1,60 @@
+-- Write your migrate up statements here
+CREATE TYPE transport_mode AS ENUM (
+    'flight',
+    'train',
+    'bus'
+);
+
+-- The flights, trains and buses taken during a trip, shown in the itinerary
+-- on the day they depart.
+CREATE TABLE IF NOT EXISTS transport_segments (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    mode transport_mode NOT NULL,
+    carrier varchar(255) NOT NULL,
+    -- Such as the flight number, LA3040.
+    number varchar(20),
+    departure_place varchar(255) NOT NULL,
+    departure_at timestamp NOT NULL,
+    arrival_place varchar(255) NOT NULL,
+    arrival_at timestamp NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT transport_segments_id_trip_id_key UNIQUE (id, trip_id),
+    CONSTRAINT transport_segments_arrival_at_check CHECK (arrival_at > departure_at)
+);
+
+CREATE INDEX IF NOT EXISTS transport_segments_trip_id_departure_at_idx ON transport_segments (trip_id, departure_at);
+
+-- The participants taking a segment, referenced along with the trip of the
+-- segment.
+CREATE TABLE IF NOT EXISTS transport_segment_participants (
+    segment_id uuid NOT NULL,
+    trip_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+
+    PRIMARY KEY (segment_id, participant_id),
+    FOREIGN KEY (segment_id, trip_id) REFERENCES transport_segments (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT transport_segment_participants_participant_id_fkey FOREIGN KEY (participant_id, trip_id) REFERENCES participants (
+        id, trip_id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS transport_segment_participants_trip_id_idx ON transport_segment_participants (trip_id);
+
+CREATE TRIGGER transport_segments_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON transport_segments
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+---- create above / drop below ----
+DROP TABLE IF EXISTS transport_segment_participants;
+
+DROP TABLE IF EXISTS transport_segments;
+
+DROP TYPE IF EXISTS transport_mode;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
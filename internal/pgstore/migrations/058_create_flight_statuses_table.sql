@@ -0,0 +1,28 @@
+-- Write your migrate up statements here
+ALTER TYPE email_kind ADD VALUE IF NOT EXISTS 'flight_update';
+
+ALTER TYPE notification_kind ADD VALUE IF NOT EXISTS 'flight_update';
+
+ALTER TYPE notification_event ADD VALUE IF NOT EXISTS 'flight_updated';
+
+-- The last status of the flights reported by the flight status provider, the
+-- next one being compared with it to notify the passengers of delays and gate
+-- changes. Cleared when the segment changes, for its new schedule to be the
+-- one compared with.
+CREATE TABLE IF NOT EXISTS flight_statuses (
+    segment_id uuid PRIMARY KEY NOT NULL,
+    departure_at timestamp NOT NULL,
+    gate varchar(20),
+    checked_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (segment_id) REFERENCES transport_segments (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS flight_statuses;
+
+-- Values cannot be dropped from an enum, flight_update stays in email_kind and
+-- notification_kind, and flight_updated in notification_event.
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
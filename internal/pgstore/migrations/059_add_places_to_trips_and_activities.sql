@@ -0,0 +1,25 @@
+-- Write your migrate up statements here
+-- Where the destinations of the trips and the addresses of the activities were
+-- geocoded to, for the maps. Left empty when the geocoding is not set up or
+-- found nothing.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "latitude" double precision,
+    ADD COLUMN IF NOT EXISTS "longitude" double precision,
+    ADD COLUMN IF NOT EXISTS "place_name" varchar(255),
+    ADD CONSTRAINT trips_latitude_range CHECK ("latitude" BETWEEN -90 AND 90),
+    ADD CONSTRAINT trips_longitude_range CHECK ("longitude" BETWEEN -180 AND 180);
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "place_name" varchar(255);
+---- create above / drop below ----
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "place_name";
+
+ALTER TABLE trips
+    DROP CONSTRAINT IF EXISTS trips_longitude_range,
+    DROP CONSTRAINT IF EXISTS trips_latitude_range,
+    DROP COLUMN IF EXISTS "place_name",
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "latitude";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,39 @@
+-- Write your migrate up statements here
+CREATE TYPE document_kind AS ENUM (
+    'ticket',
+    'passport',
+    'visa',
+    'insurance',
+    'other'
+);
+
+-- The files the participants keep for a trip, such as tickets and passports,
+-- the files themselves being in the blob storage. The documents of a
+-- participant are only shown to them, the others to the whole trip. They are
+-- not streamed to the trip, for the private ones not to be announced.
+CREATE TABLE IF NOT EXISTS documents (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    participant_id uuid,
+    kind document_kind NOT NULL DEFAULT 'other',
+    file_name varchar(255) NOT NULL,
+    content_type varchar(255) NOT NULL,
+    size bigint NOT NULL CHECK (size > 0),
+    storage_key text NOT NULL UNIQUE,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS documents_trip_id_created_at_idx ON documents (trip_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS documents;
+
+DROP TYPE IF EXISTS document_kind;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,67 @@
+-- Write your migrate up statements here
+CREATE TYPE thumbnail_status AS ENUM (
+    'pending',
+    'ready',
+    'failed'
+);
+
+-- The photos of the trip gallery, the files themselves being in the blob
+-- storage. Their thumbnail is made by a background job, which claims the
+-- pending ones due by thumbnail_next_attempt_at as the email outbox does.
+CREATE TABLE IF NOT EXISTS photos (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+    caption varchar(500),
+    file_name varchar(255) NOT NULL,
+    content_type varchar(255) NOT NULL,
+    size bigint NOT NULL CHECK (size > 0),
+    storage_key text NOT NULL UNIQUE,
+    width int,
+    height int,
+    thumbnail_key text,
+    thumbnail_status thumbnail_status NOT NULL DEFAULT 'pending',
+    thumbnail_attempts int NOT NULL DEFAULT 0,
+    thumbnail_next_attempt_at timestamp NOT NULL DEFAULT now(),
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS photos_trip_id_created_at_idx ON photos (trip_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS photos_pending_thumbnails_idx ON photos (thumbnail_next_attempt_at) WHERE thumbnail_status = 'pending';
+
+CREATE TRIGGER photos_notify_change
+    AFTER INSERT OR UPDATE OR DELETE ON photos
+    FOR EACH ROW EXECUTE FUNCTION notify_trip_change();
+
+CREATE TABLE IF NOT EXISTS photo_comments (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    photo_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+    body text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (photo_id) REFERENCES photos (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS photo_comments_photo_id_created_at_idx ON photo_comments (photo_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS photo_comments;
+
+DROP TABLE IF EXISTS photos;
+
+DROP TYPE IF EXISTS thumbnail_status;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+CREATE TYPE journal_visibility AS ENUM (
+    'shared',
+    'private'
+);
+
+-- The journal the participants write during a trip, an entry per day or more.
+-- The private entries are only shown to their author, the shared ones to the
+-- whole trip. They are not streamed to the trip, for the private ones not to
+-- be announced.
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    trip_id uuid NOT NULL,
+    participant_id uuid NOT NULL,
+    day date NOT NULL,
+    title varchar(200),
+    body text NOT NULL,
+    visibility journal_visibility NOT NULL DEFAULT 'shared',
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants (
+        id
+    ) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS journal_entries_trip_id_day_idx ON journal_entries (trip_id, day, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS journal_entries;
+
+DROP TYPE IF EXISTS journal_visibility;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
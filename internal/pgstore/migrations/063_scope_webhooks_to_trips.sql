@@ -0,0 +1,23 @@
+-- Write your migrate up statements here
+-- The webhooks of an owner got the events of every trip with that email as an
+-- owner, which anyone could register. A webhook is now always one of a trip,
+-- registered by its verified owners.
+DELETE FROM webhooks WHERE trip_id IS NULL;
+
+ALTER TABLE webhooks DROP CONSTRAINT IF EXISTS webhooks_scope;
+
+DROP INDEX IF EXISTS webhooks_owner_email_idx;
+
+ALTER TABLE webhooks DROP COLUMN IF EXISTS owner_email;
+
+ALTER TABLE webhooks ALTER COLUMN trip_id SET NOT NULL;
+---- create above / drop below ----
+ALTER TABLE webhooks ALTER COLUMN trip_id DROP NOT NULL;
+
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS owner_email varchar(255);
+
+CREATE INDEX IF NOT EXISTS webhooks_owner_email_idx ON webhooks (owner_email);
+
+ALTER TABLE webhooks ADD CONSTRAINT webhooks_scope CHECK ((trip_id IS NULL) <> (owner_email IS NULL));
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
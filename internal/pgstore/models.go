@@ -5,37 +5,1316 @@
 package pgstore
 
 import (
+	"database/sql/driver"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ActivityCategory string
+
+const (
+	ActivityCategoryFood        ActivityCategory = "food"
+	ActivityCategoryTransport   ActivityCategory = "transport"
+	ActivityCategorySightseeing ActivityCategory = "sightseeing"
+	ActivityCategoryLodging     ActivityCategory = "lodging"
+	ActivityCategoryOther       ActivityCategory = "other"
+)
+
+func (e *ActivityCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ActivityCategory(s)
+	case string:
+		*e = ActivityCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ActivityCategory: %T", src)
+	}
+	return nil
+}
+
+type NullActivityCategory struct {
+	ActivityCategory ActivityCategory
+	Valid            bool // Valid is true if ActivityCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullActivityCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.ActivityCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ActivityCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullActivityCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ActivityCategory), nil
+}
+
+type BookingType string
+
+const (
+	BookingTypeLodging BookingType = "lodging"
+)
+
+func (e *BookingType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BookingType(s)
+	case string:
+		*e = BookingType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BookingType: %T", src)
+	}
+	return nil
+}
+
+type NullBookingType struct {
+	BookingType BookingType
+	Valid       bool // Valid is true if BookingType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBookingType) Scan(value interface{}) error {
+	if value == nil {
+		ns.BookingType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BookingType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBookingType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BookingType), nil
+}
+
+type DocumentKind string
+
+const (
+	DocumentKindTicket    DocumentKind = "ticket"
+	DocumentKindPassport  DocumentKind = "passport"
+	DocumentKindVisa      DocumentKind = "visa"
+	DocumentKindInsurance DocumentKind = "insurance"
+	DocumentKindOther     DocumentKind = "other"
+)
+
+func (e *DocumentKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DocumentKind(s)
+	case string:
+		*e = DocumentKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DocumentKind: %T", src)
+	}
+	return nil
+}
+
+type NullDocumentKind struct {
+	DocumentKind DocumentKind
+	Valid        bool // Valid is true if DocumentKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDocumentKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.DocumentKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DocumentKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDocumentKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DocumentKind), nil
+}
+
+type DomainEventKind string
+
+const (
+	DomainEventKindTripCreated          DomainEventKind = "trip_created"
+	DomainEventKindTripConfirmed        DomainEventKind = "trip_confirmed"
+	DomainEventKindParticipantInvited   DomainEventKind = "participant_invited"
+	DomainEventKindParticipantConfirmed DomainEventKind = "participant_confirmed"
+	DomainEventKindActivityCreated      DomainEventKind = "activity_created"
+)
+
+func (e *DomainEventKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DomainEventKind(s)
+	case string:
+		*e = DomainEventKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DomainEventKind: %T", src)
+	}
+	return nil
+}
+
+type NullDomainEventKind struct {
+	DomainEventKind DomainEventKind
+	Valid           bool // Valid is true if DomainEventKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDomainEventKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.DomainEventKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DomainEventKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDomainEventKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DomainEventKind), nil
+}
+
+type DomainEventStatus string
+
+const (
+	DomainEventStatusPending   DomainEventStatus = "pending"
+	DomainEventStatusPublished DomainEventStatus = "published"
+	DomainEventStatusDead      DomainEventStatus = "dead"
+)
+
+func (e *DomainEventStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DomainEventStatus(s)
+	case string:
+		*e = DomainEventStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DomainEventStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDomainEventStatus struct {
+	DomainEventStatus DomainEventStatus
+	Valid             bool // Valid is true if DomainEventStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDomainEventStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DomainEventStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DomainEventStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDomainEventStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DomainEventStatus), nil
+}
+
+type EmailIssue string
+
+const (
+	EmailIssueBounce    EmailIssue = "bounce"
+	EmailIssueComplaint EmailIssue = "complaint"
+)
+
+func (e *EmailIssue) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailIssue(s)
+	case string:
+		*e = EmailIssue(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailIssue: %T", src)
+	}
+	return nil
+}
+
+type NullEmailIssue struct {
+	EmailIssue EmailIssue
+	Valid      bool // Valid is true if EmailIssue is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailIssue) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailIssue, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailIssue.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailIssue) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailIssue), nil
+}
+
+type EmailKind string
+
+const (
+	EmailKindConfirmTrip       EmailKind = "confirm_trip"
+	EmailKindInvitation        EmailKind = "invitation"
+	EmailKindUnconfirmation    EmailKind = "unconfirmation"
+	EmailKindActivityReminder  EmailKind = "activity_reminder"
+	EmailKindRsvpReminder      EmailKind = "rsvp_reminder"
+	EmailKindPreTripReminder   EmailKind = "pre_trip_reminder"
+	EmailKindDailyDigest       EmailKind = "daily_digest"
+	EmailKindEmailVerification EmailKind = "email_verification"
+	EmailKindPollInvitation    EmailKind = "poll_invitation"
+	EmailKindTaskReminder      EmailKind = "task_reminder"
+	EmailKindFlightUpdate      EmailKind = "flight_update"
+)
+
+func (e *EmailKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailKind(s)
+	case string:
+		*e = EmailKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailKind: %T", src)
+	}
+	return nil
+}
+
+type NullEmailKind struct {
+	EmailKind EmailKind
+	Valid     bool // Valid is true if EmailKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailKind), nil
+}
+
+type EmailStatus string
+
+const (
+	EmailStatusPending EmailStatus = "pending"
+	EmailStatusSent    EmailStatus = "sent"
+	EmailStatusDead    EmailStatus = "dead"
+)
+
+func (e *EmailStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailStatus(s)
+	case string:
+		*e = EmailStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEmailStatus struct {
+	EmailStatus EmailStatus
+	Valid       bool // Valid is true if EmailStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailStatus), nil
+}
+
+type ExpenseCategory string
+
+const (
+	ExpenseCategoryFood      ExpenseCategory = "food"
+	ExpenseCategoryTransport ExpenseCategory = "transport"
+	ExpenseCategoryLodging   ExpenseCategory = "lodging"
+	ExpenseCategoryTickets   ExpenseCategory = "tickets"
+	ExpenseCategoryShopping  ExpenseCategory = "shopping"
+	ExpenseCategoryOther     ExpenseCategory = "other"
+)
+
+func (e *ExpenseCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExpenseCategory(s)
+	case string:
+		*e = ExpenseCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExpenseCategory: %T", src)
+	}
+	return nil
+}
+
+type NullExpenseCategory struct {
+	ExpenseCategory ExpenseCategory
+	Valid           bool // Valid is true if ExpenseCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExpenseCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExpenseCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExpenseCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExpenseCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExpenseCategory), nil
+}
+
+type JournalVisibility string
+
+const (
+	JournalVisibilityShared  JournalVisibility = "shared"
+	JournalVisibilityPrivate JournalVisibility = "private"
+)
+
+func (e *JournalVisibility) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JournalVisibility(s)
+	case string:
+		*e = JournalVisibility(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JournalVisibility: %T", src)
+	}
+	return nil
+}
+
+type NullJournalVisibility struct {
+	JournalVisibility JournalVisibility
+	Valid             bool // Valid is true if JournalVisibility is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJournalVisibility) Scan(value interface{}) error {
+	if value == nil {
+		ns.JournalVisibility, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JournalVisibility.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJournalVisibility) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JournalVisibility), nil
+}
+
+type LinkCategory string
+
+const (
+	LinkCategoryLodging   LinkCategory = "lodging"
+	LinkCategoryTransport LinkCategory = "transport"
+	LinkCategoryTickets   LinkCategory = "tickets"
+	LinkCategoryDocs      LinkCategory = "docs"
+	LinkCategoryOther     LinkCategory = "other"
+)
+
+func (e *LinkCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LinkCategory(s)
+	case string:
+		*e = LinkCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LinkCategory: %T", src)
+	}
+	return nil
+}
+
+type NullLinkCategory struct {
+	LinkCategory LinkCategory
+	Valid        bool // Valid is true if LinkCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLinkCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.LinkCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LinkCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLinkCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LinkCategory), nil
+}
+
+type Locale string
+
+const (
+	LocalePtBR Locale = "pt-BR"
+	LocaleEn   Locale = "en"
+	LocaleEs   Locale = "es"
+)
+
+func (e *Locale) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = Locale(s)
+	case string:
+		*e = Locale(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Locale: %T", src)
+	}
+	return nil
+}
+
+type NullLocale struct {
+	Locale Locale
+	Valid  bool // Valid is true if Locale is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLocale) Scan(value interface{}) error {
+	if value == nil {
+		ns.Locale, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.Locale.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLocale) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Locale), nil
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventActivityCreated      NotificationEvent = "activity_created"
+	NotificationEventParticipantConfirmed NotificationEvent = "participant_confirmed"
+	NotificationEventTripUpdated          NotificationEvent = "trip_updated"
+	NotificationEventFlightUpdated        NotificationEvent = "flight_updated"
+)
+
+func (e *NotificationEvent) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationEvent(s)
+	case string:
+		*e = NotificationEvent(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationEvent: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationEvent struct {
+	NotificationEvent NotificationEvent
+	Valid             bool // Valid is true if NotificationEvent is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationEvent) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationEvent, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationEvent.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationEvent) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationEvent), nil
+}
+
+type NotificationKind string
+
+const (
+	NotificationKindActivityReminder NotificationKind = "activity_reminder"
+	NotificationKindRsvpReminder     NotificationKind = "rsvp_reminder"
+	NotificationKindPreTripReminder  NotificationKind = "pre_trip_reminder"
+	NotificationKindDailyDigest      NotificationKind = "daily_digest"
+	NotificationKindTaskReminder     NotificationKind = "task_reminder"
+	NotificationKindFlightUpdate     NotificationKind = "flight_update"
+)
+
+func (e *NotificationKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationKind(s)
+	case string:
+		*e = NotificationKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationKind: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationKind struct {
+	NotificationKind NotificationKind
+	Valid            bool // Valid is true if NotificationKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationKind), nil
+}
+
+type ParticipantStatus string
+
+const (
+	ParticipantStatusConfirmed   ParticipantStatus = "confirmed"
+	ParticipantStatusDeclined    ParticipantStatus = "declined"
+	ParticipantStatusUnconfirmed ParticipantStatus = "unconfirmed"
+)
+
+func (e *ParticipantStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ParticipantStatus(s)
+	case string:
+		*e = ParticipantStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ParticipantStatus: %T", src)
+	}
+	return nil
+}
+
+type NullParticipantStatus struct {
+	ParticipantStatus ParticipantStatus
+	Valid             bool // Valid is true if ParticipantStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullParticipantStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ParticipantStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ParticipantStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullParticipantStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ParticipantStatus), nil
+}
+
+type PollKind string
+
+const (
+	PollKindDates       PollKind = "dates"
+	PollKindDestination PollKind = "destination"
+)
+
+func (e *PollKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PollKind(s)
+	case string:
+		*e = PollKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PollKind: %T", src)
+	}
+	return nil
+}
+
+type NullPollKind struct {
+	PollKind PollKind
+	Valid    bool // Valid is true if PollKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPollKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.PollKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PollKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPollKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PollKind), nil
+}
+
+type ThumbnailStatus string
+
+const (
+	ThumbnailStatusPending ThumbnailStatus = "pending"
+	ThumbnailStatusReady   ThumbnailStatus = "ready"
+	ThumbnailStatusFailed  ThumbnailStatus = "failed"
+)
+
+func (e *ThumbnailStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ThumbnailStatus(s)
+	case string:
+		*e = ThumbnailStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ThumbnailStatus: %T", src)
+	}
+	return nil
+}
+
+type NullThumbnailStatus struct {
+	ThumbnailStatus ThumbnailStatus
+	Valid           bool // Valid is true if ThumbnailStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullThumbnailStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ThumbnailStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ThumbnailStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullThumbnailStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ThumbnailStatus), nil
+}
+
+type TransportMode string
+
+const (
+	TransportModeFlight TransportMode = "flight"
+	TransportModeTrain  TransportMode = "train"
+	TransportModeBus    TransportMode = "bus"
+)
+
+func (e *TransportMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TransportMode(s)
+	case string:
+		*e = TransportMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TransportMode: %T", src)
+	}
+	return nil
+}
+
+type NullTransportMode struct {
+	TransportMode TransportMode
+	Valid         bool // Valid is true if TransportMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTransportMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.TransportMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TransportMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTransportMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TransportMode), nil
+}
+
+type TripStatus string
+
+const (
+	TripStatusDraft     TripStatus = "draft"
+	TripStatusConfirmed TripStatus = "confirmed"
+	TripStatusOngoing   TripStatus = "ongoing"
+	TripStatusCompleted TripStatus = "completed"
+	TripStatusCancelled TripStatus = "cancelled"
+)
+
+func (e *TripStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TripStatus(s)
+	case string:
+		*e = TripStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TripStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTripStatus struct {
+	TripStatus TripStatus
+	Valid      bool // Valid is true if TripStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTripStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TripStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TripStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTripStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TripStatus), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
 type Activity struct {
-	ID       uuid.UUID
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Title     string
+	OccursAt  pgtype.Timestamp
+	EndsAt    pgtype.Timestamp
+	Address   pgtype.Text
+	Latitude  pgtype.Float8
+	Longitude pgtype.Float8
+	Category  ActivityCategory
+	Position  int32
+	CreatedAt pgtype.Timestamp
+	DeletedAt pgtype.Timestamp
+	Search    interface{}
+	PlaceName pgtype.Text
 }
 
-type Link struct {
-	ID     uuid.UUID
-	TripID uuid.UUID
-	Title  string
-	Url    string
+type ActivityAttachment struct {
+	ID          uuid.UUID
+	ActivityID  uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   pgtype.Timestamp
 }
 
-type Participant struct {
+type ActivityAttendee struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	Attending     bool
+	UpdatedAt     pgtype.Timestamp
+}
+
+type ActivityComment struct {
+	ID            uuid.UUID
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+	CreatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+}
+
+type ActivityReminder struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	SentAt        pgtype.Timestamp
+}
+
+type ActivityVote struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	CreatedAt     pgtype.Timestamp
+}
+
+type Booking struct {
+	ID               uuid.UUID
+	TripID           uuid.UUID
+	Type             BookingType
+	Name             string
+	CheckInAt        pgtype.Timestamp
+	CheckOutAt       pgtype.Timestamp
+	Address          pgtype.Text
+	ConfirmationCode pgtype.Text
+	Price            pgtype.Int8
+	Currency         pgtype.Text
+	Url              pgtype.Text
+	CreatedAt        pgtype.Timestamp
+}
+
+type DailyDigest struct {
+	ParticipantID uuid.UUID
+	Day           pgtype.Date
+	SentAt        pgtype.Timestamp
+}
+
+type Document struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Kind          DocumentKind
+	FileName      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+	CreatedAt     pgtype.Timestamp
+}
+
+type DomainEvent struct {
+	ID            uuid.UUID
+	Kind          DomainEventKind
+	TripID        uuid.UUID
+	Payload       []byte
+	Status        DomainEventStatus
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamp
+	LastError     pgtype.Text
+	OccurredAt    pgtype.Timestamp
+	PublishedAt   pgtype.Timestamp
+}
+
+type EmailOutbox struct {
+	ID            uuid.UUID
+	Kind          EmailKind
+	Payload       []byte
+	Status        EmailStatus
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamp
+	LastError     pgtype.Text
+	CreatedAt     pgtype.Timestamp
+	SentAt        pgtype.Timestamp
+}
+
+type EmailVerification struct {
+	ParticipantID uuid.UUID
+	Code          string
+	Attempts      int32
+	ExpiresAt     pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+}
+
+type Expense struct {
 	ID          uuid.UUID
 	TripID      uuid.UUID
-	Email       string
-	IsConfirmed bool
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	Category    ExpenseCategory
+	SpentAt     pgtype.Timestamp
+	CreatedAt   pgtype.Timestamp
 }
 
-type Trip struct {
+type FlightStatus struct {
+	SegmentID   uuid.UUID
+	DepartureAt pgtype.Timestamp
+	Gate        pgtype.Text
+	CheckedAt   pgtype.Timestamp
+}
+
+type JournalEntry struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Day           pgtype.Date
+	Title         pgtype.Text
+	Body          string
+	Visibility    JournalVisibility
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+}
+
+type Link struct {
+	ID                 uuid.UUID
+	TripID             uuid.UUID
+	Title              string
+	Url                string
+	Category           LinkCategory
+	PreviewTitle       pgtype.Text
+	PreviewDescription pgtype.Text
+	PreviewFaviconUrl  pgtype.Text
+	PreviewImageUrl    pgtype.Text
+	PreviewFetchedAt   pgtype.Timestamp
+	Pinned             bool
+	Position           int32
+	DeletedAt          pgtype.Timestamp
+}
+
+type LinkClick struct {
+	ID        uuid.UUID
+	LinkID    uuid.UUID
+	ClickedAt pgtype.Timestamp
+}
+
+type Message struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+	CreatedAt     pgtype.Timestamp
+}
+
+type MessageRead struct {
+	ParticipantID uuid.UUID
+	TripID        uuid.UUID
+	ReadAt        pgtype.Timestamp
+}
+
+type Notification struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	TripID        uuid.UUID
+	Event         NotificationEvent
+	SubjectID     uuid.UUID
+	ReadAt        pgtype.Timestamp
+	CreatedAt     pgtype.Timestamp
+}
+
+type NotificationOptOut struct {
+	ParticipantID uuid.UUID
+	Kind          NotificationKind
+	CreatedAt     pgtype.Timestamp
+}
+
+type PackingItem struct {
+	ID         uuid.UUID
+	TripID     uuid.UUID
+	Name       string
+	Quantity   int32
+	AssigneeID pgtype.UUID
+	Checked    bool
+	CreatedAt  pgtype.Timestamp
+}
+
+type Participant struct {
+	ID                uuid.UUID
+	TripID            uuid.UUID
+	Email             string
+	IsConfirmed       bool
+	DeclinedAt        pgtype.Timestamp
+	DeclineReason     pgtype.Text
+	InvitedAt         pgtype.Timestamp
+	Name              pgtype.Text
+	Phone             pgtype.Text
+	AvatarUrl         pgtype.Text
+	RsvpRemindedAt    pgtype.Timestamp
+	NoResponseAt      pgtype.Timestamp
+	Guests            int32
+	Locale            Locale
+	PreTripRemindedAt pgtype.Timestamp
+	EmailVerifiedAt   pgtype.Timestamp
+	CreatedAt         pgtype.Timestamp
+}
+
+type ParticipantInvitation struct {
+	ParticipantID uuid.UUID
+	SentAt        pgtype.Timestamp
+}
+
+type ParticipantStatusChange struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	Status        ParticipantStatus
+	Reason        pgtype.Text
+	CreatedAt     pgtype.Timestamp
+}
+
+type Photo struct {
+	ID                     uuid.UUID
+	TripID                 uuid.UUID
+	ParticipantID          uuid.UUID
+	Caption                pgtype.Text
+	FileName               string
+	ContentType            string
+	Size                   int64
+	StorageKey             string
+	Width                  pgtype.Int4
+	Height                 pgtype.Int4
+	ThumbnailKey           pgtype.Text
+	ThumbnailStatus        ThumbnailStatus
+	ThumbnailAttempts      int32
+	ThumbnailNextAttemptAt pgtype.Timestamp
+	CreatedAt              pgtype.Timestamp
+}
+
+type PhotoComment struct {
+	ID            uuid.UUID
+	PhotoID       uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+	CreatedAt     pgtype.Timestamp
+}
+
+type Poll struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Kind      PollKind
+	Question  string
+	AppliedAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+}
+
+type PollOption struct {
 	ID          uuid.UUID
-	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	IsConfirmed bool
+	PollID      uuid.UUID
+	Position    int32
+	Destination pgtype.Text
 	StartsAt    pgtype.Timestamp
 	EndsAt      pgtype.Timestamp
 }
+
+type PollVote struct {
+	PollID        uuid.UUID
+	ParticipantID uuid.UUID
+	OptionID      uuid.UUID
+	VotedAt       pgtype.Timestamp
+}
+
+type SettlementPayment struct {
+	ID                uuid.UUID
+	TripID            uuid.UUID
+	FromParticipantID uuid.UUID
+	ToParticipantID   uuid.UUID
+	Amount            int64
+	Currency          string
+	PaidAt            pgtype.Timestamp
+}
+
+type Tag struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type Task struct {
+	ID         uuid.UUID
+	TripID     uuid.UUID
+	Title      string
+	AssigneeID pgtype.UUID
+	DueAt      pgtype.Timestamp
+	Done       bool
+	RemindedAt pgtype.Timestamp
+	CreatedAt  pgtype.Timestamp
+}
+
+type TransportSegment struct {
+	ID             uuid.UUID
+	TripID         uuid.UUID
+	Mode           TransportMode
+	Carrier        string
+	Number         pgtype.Text
+	DeparturePlace string
+	DepartureAt    pgtype.Timestamp
+	ArrivalPlace   string
+	ArrivalAt      pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+}
+
+type TransportSegmentParticipant struct {
+	SegmentID     uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+type Trip struct {
+	ID                  uuid.UUID
+	Destination         string
+	OwnerEmail          string
+	OwnerName           string
+	StartsAt            pgtype.Timestamp
+	EndsAt              pgtype.Timestamp
+	Description         string
+	Status              TripStatus
+	RsvpDeadline        pgtype.Timestamp
+	MaxGuests           int32
+	MaxParticipants     pgtype.Int4
+	PreTripReminderDays int32
+	Timezone            string
+	Version             int32
+	CreatedAt           pgtype.Timestamp
+	Search              interface{}
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	PlaceName           pgtype.Text
+}
+
+type TripJoinCode struct {
+	Code      string
+	TripID    uuid.UUID
+	CreatedAt pgtype.Timestamp
+}
+
+type TripOwner struct {
+	TripID    uuid.UUID
+	Email     string
+	Name      string
+	CreatedAt pgtype.Timestamp
+	Locale    Locale
+}
+
+type TripShare struct {
+	Slug      string
+	TripID    uuid.UUID
+	CreatedAt pgtype.Timestamp
+	RevokedAt pgtype.Timestamp
+}
+
+type TripTag struct {
+	TripID uuid.UUID
+	TagID  uuid.UUID
+}
+
+type TripWaitlist struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Email     string
+	CreatedAt pgtype.Timestamp
+}
+
+type UndeliverableEmail struct {
+	Email      string
+	Issue      EmailIssue
+	Detail     pgtype.Text
+	ReportedAt pgtype.Timestamp
+}
+
+type Webhook struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Url       string
+	Secret    string
+	CreatedAt pgtype.Timestamp
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamp
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	CreatedAt      pgtype.Timestamp
+	DeliveredAt    pgtype.Timestamp
+}
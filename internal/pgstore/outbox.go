@@ -0,0 +1,96 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The payloads of the emails in the outbox. They are stored as JSON and only
+// rendered when the email is sent. Reminders and daily digests use the row
+// they were queued from, such as GetDueRSVPRemindersRow, as payload.
+type (
+	ConfirmTripEmail struct {
+		TripID uuid.UUID `json:"trip_id"`
+	}
+
+	InvitationEmail struct {
+		TripID uuid.UUID `json:"trip_id"`
+		Email  string    `json:"email"`
+	}
+
+	EmailVerificationEmail struct {
+		TripID uuid.UUID `json:"trip_id"`
+		Email  string    `json:"email"`
+	}
+
+	UnconfirmationEmail struct {
+		ParticipantID uuid.UUID `json:"participant_id"`
+		Reason        string    `json:"reason"`
+	}
+
+	PollInvitationEmail struct {
+		PollID        uuid.UUID `json:"poll_id"`
+		ParticipantID uuid.UUID `json:"participant_id"`
+	}
+
+	// FlightUpdate is a change of the departure or the gate of a flight,
+	// reported by the flight status provider. The previous departure is the
+	// scheduled one until the flight is first delayed.
+	FlightUpdate struct {
+		SegmentID           uuid.UUID `json:"segment_id"`
+		TripID              uuid.UUID `json:"trip_id"`
+		Carrier             string    `json:"carrier"`
+		Number              string    `json:"number"`
+		DeparturePlace      string    `json:"departure_place"`
+		ArrivalPlace        string    `json:"arrival_place"`
+		ScheduledAt         time.Time `json:"scheduled_at"`
+		PreviousDepartureAt time.Time `json:"previous_departure_at"`
+		DepartureAt         time.Time `json:"departure_at"`
+		PreviousGate        string    `json:"previous_gate"`
+		Gate                string    `json:"gate"`
+	}
+
+	FlightUpdateEmail struct {
+		ParticipantID uuid.UUID `json:"participant_id"`
+		FlightUpdate
+	}
+)
+
+// enqueueEmail writes an email to the outbox. Called with the Queries of a
+// transaction, the email is only sent if the transaction commits.
+func (q *Queries) enqueueEmail(ctx context.Context, kind EmailKind, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s email: %w", kind, err)
+	}
+
+	return q.EnqueueEmail(ctx, EnqueueEmailParams{
+		Kind:    kind,
+		Payload: b,
+	})
+}
+
+// enqueueInvitation writes the invitation of a participant to the outbox and
+// records it, so confirming the trip does not invite them again, along with
+// the participant_invited event.
+func (q *Queries) enqueueInvitation(ctx context.Context, invitation InvitationEmail) error {
+	if err := q.RecordInvitationSent(ctx, RecordInvitationSentParams{
+		TripID: invitation.TripID,
+		Email:  invitation.Email,
+	}); err != nil {
+		return fmt.Errorf("failed to record invitation: %w", err)
+	}
+
+	if err := q.recordEvent(ctx, DomainEventKindParticipantInvited, invitation.TripID, ParticipantInvitedEvent{
+		TripID: invitation.TripID,
+		Email:  invitation.Email,
+	}); err != nil {
+		return err
+	}
+
+	return q.enqueueEmail(ctx, EmailKindInvitation, invitation)
+}
@@ -0,0 +1,180 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrInvalidCursor = errors.New("pgstore: invalid cursor")
+
+// Cursor is the position of a row in a keyset paginated list, which is
+// ordered by created_at then id, or the other way around for the lists shown
+// newest first. A page starts right after its cursor, and the zero Cursor
+// starts from the first row.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// String encodes the cursor as the opaque token handed to clients.
+func (c Cursor) String() string {
+	b := make([]byte, 8, 8+len(c.ID))
+	binary.BigEndian.PutUint64(b, uint64(c.CreatedAt.UnixMicro()))
+	b = append(b, c.ID[:]...)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseCursor decodes a token returned by Cursor.String. The empty token is
+// the zero Cursor.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) != 8+len(uuid.UUID{}) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	id, err := uuid.FromBytes(b[8:])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{
+		CreatedAt: time.UnixMicro(int64(binary.BigEndian.Uint64(b[:8]))).UTC(),
+		ID:        id,
+	}, nil
+}
+
+func (c Cursor) timestamp() pgtype.Timestamp {
+	return pgtype.Timestamp{Valid: true, Time: c.CreatedAt}
+}
+
+// Page is a page of a keyset paginated list.
+type Page[T any] struct {
+	Items []T
+	// Next is where the following page starts, nil on the last page.
+	Next *Cursor
+}
+
+// NewPage makes the page of rows, fetched with one row more than size to know
+// whether another page follows. The stores not backed by Postgres make
+// their pages with it too.
+func NewPage[T any](rows []T, size int32, cursor func(T) Cursor) Page[T] {
+	if len(rows) <= int(size) {
+		return Page[T]{Items: rows}
+	}
+
+	rows = rows[:size]
+	next := cursor(rows[len(rows)-1])
+
+	return Page[T]{Items: rows, Next: &next}
+}
+
+// TripsPage lists up to size trips matching filter after the cursor, in the
+// order they were created.
+func (q *Queries) TripsPage(ctx context.Context, filter ListTripsParams, after Cursor, size int32) (Page[Trip], error) {
+	trips, err := q.ListTripsPage(ctx, ListTripsPageParams{
+		AfterCreatedAt: after.timestamp(),
+		AfterID:        after.ID,
+		Status:         filter.Status,
+		Tag:            filter.Tag,
+		Query:          filter.Query,
+		PageSize:       size + 1,
+	})
+	if err != nil {
+		return Page[Trip]{}, err
+	}
+
+	return NewPage(trips, size, func(t Trip) Cursor {
+		return Cursor{t.CreatedAt.Time, t.ID}
+	}), nil
+}
+
+// TripActivitiesPage lists up to size activities of the trip after the
+// cursor, in the order they were created.
+func (q *Queries) TripActivitiesPage(ctx context.Context, tripID uuid.UUID, after Cursor, size int32) (Page[Activity], error) {
+	activities, err := q.GetTripActivitiesPage(ctx, GetTripActivitiesPageParams{
+		TripID:         tripID,
+		AfterCreatedAt: after.timestamp(),
+		AfterID:        after.ID,
+		PageSize:       size + 1,
+	})
+	if err != nil {
+		return Page[Activity]{}, err
+	}
+
+	return NewPage(activities, size, func(a Activity) Cursor {
+		return Cursor{a.CreatedAt.Time, a.ID}
+	}), nil
+}
+
+// ParticipantsPage lists up to size participants of the trip after the
+// cursor, in the order they were invited.
+func (q *Queries) ParticipantsPage(ctx context.Context, tripID uuid.UUID, after Cursor, size int32) (Page[Participant], error) {
+	participants, err := q.GetParticipantsPage(ctx, GetParticipantsPageParams{
+		TripID:         tripID,
+		AfterCreatedAt: after.timestamp(),
+		AfterID:        after.ID,
+		PageSize:       size + 1,
+	})
+	if err != nil {
+		return Page[Participant]{}, err
+	}
+
+	return NewPage(participants, size, func(p Participant) Cursor {
+		return Cursor{p.CreatedAt.Time, p.ID}
+	}), nil
+}
+
+// TripMessagesPage lists up to size messages of the trip after the cursor,
+// newest first.
+func (q *Queries) TripMessagesPage(ctx context.Context, tripID uuid.UUID, after Cursor, size int32) (Page[GetTripMessagesPageRow], error) {
+	before := after.timestamp()
+	// The zero cursor starts from the newest message.
+	before.Valid = after != Cursor{}
+
+	messages, err := q.GetTripMessagesPage(ctx, GetTripMessagesPageParams{
+		TripID:          tripID,
+		BeforeCreatedAt: before,
+		BeforeID:        after.ID,
+		PageSize:        size + 1,
+	})
+	if err != nil {
+		return Page[GetTripMessagesPageRow]{}, err
+	}
+
+	return NewPage(messages, size, func(m GetTripMessagesPageRow) Cursor {
+		return Cursor{m.CreatedAt.Time, m.ID}
+	}), nil
+}
+
+// TripPhotosPage lists up to size photos of the trip after the cursor,
+// newest first.
+func (q *Queries) TripPhotosPage(ctx context.Context, tripID uuid.UUID, after Cursor, size int32) (Page[GetTripPhotosPageRow], error) {
+	before := after.timestamp()
+	// The zero cursor starts from the newest photo.
+	before.Valid = after != Cursor{}
+
+	photos, err := q.GetTripPhotosPage(ctx, GetTripPhotosPageParams{
+		TripID:          tripID,
+		BeforeCreatedAt: before,
+		BeforeID:        after.ID,
+		PageSize:        size + 1,
+	})
+	if err != nil {
+		return Page[GetTripPhotosPageRow]{}, err
+	}
+
+	return NewPage(photos, size, func(p GetTripPhotosPageRow) Cursor {
+		return Cursor{p.CreatedAt.Time, p.ID}
+	}), nil
+}
@@ -12,119 +12,122 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const confirmParticipant = `-- name: ConfirmParticipant :exec
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
-WHERE
-    id = $1
+const addTagToTrip = `-- name: AddTagToTrip :exec
+INSERT INTO trip_tags
+    ( "trip_id", "tag_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT DO NOTHING
 `
 
-func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmParticipant, id)
+type AddTagToTripParams struct {
+	TripID uuid.UUID
+	TagID  uuid.UUID
+}
+
+func (q *Queries) AddTagToTrip(ctx context.Context, arg AddTagToTripParams) error {
+	_, err := q.db.Exec(ctx, addTagToTrip, arg.TripID, arg.TagID)
 	return err
 }
 
-const confirmTrip = `-- name: ConfirmTrip :exec
-UPDATE trips
-SET 
-    "is_confirmed" = $1
-WHERE
-    id = $2
+const addToTripWaitlist = `-- name: AddToTripWaitlist :exec
+INSERT INTO trip_waitlist
+    ( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("trip_id", "email") DO NOTHING
 `
 
-type ConfirmTripParams struct {
-	IsConfirmed bool
-	ID          uuid.UUID
+type AddToTripWaitlistParams struct {
+	TripID uuid.UUID
+	Email  string
 }
 
-func (q *Queries) ConfirmTrip(ctx context.Context, arg ConfirmTripParams) error {
-	_, err := q.db.Exec(ctx, confirmTrip, arg.IsConfirmed, arg.ID)
+func (q *Queries) AddToTripWaitlist(ctx context.Context, arg AddToTripWaitlistParams) error {
+	_, err := q.db.Exec(ctx, addToTripWaitlist, arg.TripID, arg.Email)
 	return err
 }
 
-const createActivity = `-- name: CreateActivity :one
-INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
+const addTransportSegmentParticipant = `-- name: AddTransportSegmentParticipant :exec
+INSERT INTO transport_segment_participants
+    ( "segment_id", "trip_id", "participant_id" ) VALUES
     ( $1, $2, $3 )
-RETURNING "id"
 `
 
-type CreateActivityParams struct {
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+type AddTransportSegmentParticipantParams struct {
+	SegmentID     uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
 }
 
-func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) AddTransportSegmentParticipant(ctx context.Context, arg AddTransportSegmentParticipantParams) error {
+	_, err := q.db.Exec(ctx, addTransportSegmentParticipant, arg.SegmentID, arg.TripID, arg.ParticipantID)
+	return err
 }
 
-const createTripLink = `-- name: CreateTripLink :one
-INSERT INTO links
-    ( "trip_id", "title", "url" ) VALUES
-    ( $1, $2, $3 )
-RETURNING "id"
+const addTripOwner = `-- name: AddTripOwner :exec
+INSERT INTO trip_owners
+    ( "trip_id", "email", "name", "locale" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT DO NOTHING
 `
 
-type CreateTripLinkParams struct {
+type AddTripOwnerParams struct {
 	TripID uuid.UUID
-	Title  string
-	Url    string
+	Email  string
+	Name   string
+	Locale Locale
 }
 
-func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) AddTripOwner(ctx context.Context, arg AddTripOwnerParams) error {
+	_, err := q.db.Exec(ctx, addTripOwner,
+		arg.TripID,
+		arg.Email,
+		arg.Name,
+		arg.Locale,
+	)
+	return err
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+const claimDueDomainEvents = `-- name: ClaimDueDomainEvents :many
+UPDATE domain_events
+SET
+    next_attempt_at = $1
 WHERE
-    id = $1
+    id IN (
+        SELECT id FROM domain_events AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= $2
+        ORDER BY due.occurred_at
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "trip_id", "payload", "status", "attempts", "next_attempt_at", "last_error", "occurred_at", "published_at"
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
-	)
-	return i, err
+type ClaimDueDomainEventsParams struct {
+	LeaseUntil pgtype.Timestamp
+	Now        pgtype.Timestamp
+	Limit      int32
 }
 
-const getParticipants = `-- name: GetParticipants :many
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
-WHERE
-    trip_id = $1
-`
-
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+func (q *Queries) ClaimDueDomainEvents(ctx context.Context, arg ClaimDueDomainEventsParams) ([]DomainEvent, error) {
+	rows, err := q.db.Query(ctx, claimDueDomainEvents, arg.LeaseUntil, arg.Now, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Participant
+	var items []DomainEvent
 	for rows.Next() {
-		var i Participant
+		var i DomainEvent
 		if err := rows.Scan(
 			&i.ID,
+			&i.Kind,
 			&i.TripID,
-			&i.Email,
-			&i.IsConfirmed,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.OccurredAt,
+			&i.PublishedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -136,51 +139,115 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 	return items, nil
 }
 
-const getTrip = `-- name: GetTrip :one
-SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
-FROM trips
+const claimDueEmails = `-- name: ClaimDueEmails :many
+UPDATE email_outbox
+SET
+    next_attempt_at = $1
 WHERE
-    id = $1
+    id IN (
+        SELECT id FROM email_outbox AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= $2
+        ORDER BY due.next_attempt_at
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "sent_at"
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
-	)
-	return i, err
+type ClaimDueEmailsParams struct {
+	LeaseUntil pgtype.Timestamp
+	Now        pgtype.Timestamp
+	Limit      int32
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT
-    "id", "trip_id", "title", "occurs_at"
-FROM activities
+func (q *Queries) ClaimDueEmails(ctx context.Context, arg ClaimDueEmailsParams) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, claimDueEmails, arg.LeaseUntil, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET
+    next_attempt_at = $1
+FROM webhooks, domain_events
 WHERE
-    trip_id = $1
+    webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= $2
+        ORDER BY due.next_attempt_at
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+    AND webhooks.id = webhook_deliveries.webhook_id
+    AND domain_events.id = webhook_deliveries.event_id
+RETURNING
+    webhook_deliveries.id, webhook_deliveries.attempts, webhooks.url, webhooks.secret,
+    domain_events.id AS event_id, domain_events.kind, domain_events.trip_id,
+    domain_events.payload, domain_events.occurred_at
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseUntil pgtype.Timestamp
+	Now        pgtype.Timestamp
+	Limit      int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID         uuid.UUID
+	Attempts   int32
+	Url        string
+	Secret     string
+	EventID    uuid.UUID
+	Kind       DomainEventKind
+	TripID     uuid.UUID
+	Payload    []byte
+	OccurredAt pgtype.Timestamp
+}
+
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveries, arg.LeaseUntil, arg.Now, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Activity
+	var items []ClaimDueWebhookDeliveriesRow
 	for rows.Next() {
-		var i Activity
+		var i ClaimDueWebhookDeliveriesRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+			&i.EventID,
+			&i.Kind,
 			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
+			&i.Payload,
+			&i.OccurredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -192,28 +259,78 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT
-    "id", "trip_id", "title", "url"
-FROM links
+const claimEmailVerificationAttempt = `-- name: ClaimEmailVerificationAttempt :one
+UPDATE email_verifications
+SET
+    "attempts" = attempts + 1
 WHERE
-    trip_id = $1
+    participant_id = $1 AND attempts < $2
+RETURNING "code", "expires_at"
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type ClaimEmailVerificationAttemptParams struct {
+	ParticipantID uuid.UUID
+	MaxAttempts   int32
+}
+
+type ClaimEmailVerificationAttemptRow struct {
+	Code      string
+	ExpiresAt pgtype.Timestamp
+}
+
+func (q *Queries) ClaimEmailVerificationAttempt(ctx context.Context, arg ClaimEmailVerificationAttemptParams) (ClaimEmailVerificationAttemptRow, error) {
+	row := q.db.QueryRow(ctx, claimEmailVerificationAttempt, arg.ParticipantID, arg.MaxAttempts)
+	var i ClaimEmailVerificationAttemptRow
+	err := row.Scan(&i.Code, &i.ExpiresAt)
+	return i, err
+}
+
+const claimPendingThumbnails = `-- name: ClaimPendingThumbnails :many
+UPDATE photos
+SET
+    thumbnail_next_attempt_at = $1
+WHERE
+    id IN (
+        SELECT id FROM photos AS due
+        WHERE due.thumbnail_status = 'pending' AND due.thumbnail_next_attempt_at <= $2
+        ORDER BY due.thumbnail_next_attempt_at
+        LIMIT $3
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "width", "height", "thumbnail_key", "thumbnail_status", "thumbnail_attempts", "thumbnail_next_attempt_at", "created_at"
+`
+
+type ClaimPendingThumbnailsParams struct {
+	LeaseUntil pgtype.Timestamp
+	Now        pgtype.Timestamp
+	Limit      int32
+}
+
+func (q *Queries) ClaimPendingThumbnails(ctx context.Context, arg ClaimPendingThumbnailsParams) ([]Photo, error) {
+	rows, err := q.db.Query(ctx, claimPendingThumbnails, arg.LeaseUntil, arg.Now, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []Photo
 	for rows.Next() {
-		var i Link
+		var i Photo
 		if err := rows.Scan(
 			&i.ID,
 			&i.TripID,
-			&i.Title,
-			&i.Url,
+			&i.ParticipantID,
+			&i.Caption,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.Width,
+			&i.Height,
+			&i.ThumbnailKey,
+			&i.ThumbnailStatus,
+			&i.ThumbnailAttempts,
+			&i.ThumbnailNextAttemptAt,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -225,66 +342,5293 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT
-INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+const confirmParticipant = `-- name: ConfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "guests" = $1,
+    "declined_at" = NULL,
+    "decline_reason" = NULL,
+    "no_response_at" = NULL
+WHERE
+    id = $2
+`
+
+type ConfirmParticipantParams struct {
+	Guests int32
+	ID     uuid.UUID
+}
+
+func (q *Queries) ConfirmParticipant(ctx context.Context, arg ConfirmParticipantParams) error {
+	_, err := q.db.Exec(ctx, confirmParticipant, arg.Guests, arg.ID)
+	return err
+}
+
+const countActiveTripParticipants = `-- name: CountActiveTripParticipants :one
+SELECT
+    count(*)
+FROM participants
+WHERE
+    trip_id = $1 AND declined_at IS NULL
+`
+
+func (q *Queries) CountActiveTripParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveTripParticipants, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUnreadMessages = `-- name: CountUnreadMessages :one
+SELECT
+    count(*)
+FROM messages
+WHERE
+    messages.trip_id = $1
+    AND messages.participant_id <> $2
+    AND messages.created_at > COALESCE((
+        SELECT read_at FROM message_reads WHERE message_reads.participant_id = $2
+    ), '-infinity')
+`
+
+type CountUnreadMessagesParams struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) CountUnreadMessages(ctx context.Context, arg CountUnreadMessagesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnreadMessages, arg.TripID, arg.ParticipantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createActivity = `-- name: CreateActivity :one
+INSERT INTO activities
+    ( "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "place_name" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9 )
 RETURNING "id"
 `
 
-type InsertTripParams struct {
-	Destination string
-	OwnerEmail  string
-	OwnerName   string
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+type CreateActivityParams struct {
+	TripID    uuid.UUID
+	Title     string
+	OccursAt  pgtype.Timestamp
+	EndsAt    pgtype.Timestamp
+	Address   pgtype.Text
+	Latitude  pgtype.Float8
+	Longitude pgtype.Float8
+	Category  ActivityCategory
+	PlaceName pgtype.Text
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
+func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
 		arg.EndsAt,
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Category,
+		arg.PlaceName,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID
-	Email  string
+const createActivityAttachment = `-- name: CreateActivityAttachment :one
+INSERT INTO activity_attachments
+    ( "activity_id", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id"
+`
+
+type CreateActivityAttachmentParams struct {
+	ActivityID  uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
-UPDATE trips
-SET 
-    "destination" = $1,
-    "ends_at" = $2,
-    "starts_at" = $3,
-    "is_confirmed" = $4
+func (q *Queries) CreateActivityAttachment(ctx context.Context, arg CreateActivityAttachmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivityAttachment,
+		arg.ActivityID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createActivityComment = `-- name: CreateActivityComment :one
+INSERT INTO activity_comments
+    ( "activity_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateActivityCommentParams struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+}
+
+func (q *Queries) CreateActivityComment(ctx context.Context, arg CreateActivityCommentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivityComment, arg.ActivityID, arg.ParticipantID, arg.Body)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createBooking = `-- name: CreateBooking :one
+INSERT INTO bookings
+    ( "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10 )
+RETURNING "id"
+`
+
+type CreateBookingParams struct {
+	TripID           uuid.UUID
+	Type             BookingType
+	Name             string
+	CheckInAt        pgtype.Timestamp
+	CheckOutAt       pgtype.Timestamp
+	Address          pgtype.Text
+	ConfirmationCode pgtype.Text
+	Price            pgtype.Int8
+	Currency         pgtype.Text
+	Url              pgtype.Text
+}
+
+func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createBooking,
+		arg.TripID,
+		arg.Type,
+		arg.Name,
+		arg.CheckInAt,
+		arg.CheckOutAt,
+		arg.Address,
+		arg.ConfirmationCode,
+		arg.Price,
+		arg.Currency,
+		arg.Url,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createDocument = `-- name: CreateDocument :one
+INSERT INTO documents
+    ( "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id"
+`
+
+type CreateDocumentParams struct {
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Kind          DocumentKind
+	FileName      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+}
+
+func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createDocument,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Kind,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createEmailVerification = `-- name: CreateEmailVerification :exec
+INSERT INTO email_verifications
+    ( "participant_id", "code", "expires_at" )
+SELECT
+    id, $3, $4
+FROM participants
 WHERE
-    id = $5
+    trip_id = $1 AND email = $2
+ON CONFLICT ("participant_id") DO UPDATE
+SET
+    "code" = excluded.code,
+    "attempts" = 0,
+    "expires_at" = excluded.expires_at,
+    "created_at" = now()
 `
 
-type UpdateTripParams struct {
-	Destination string
-	EndsAt      pgtype.Timestamp
-	StartsAt    pgtype.Timestamp
-	IsConfirmed bool
-	ID          uuid.UUID
+type CreateEmailVerificationParams struct {
+	TripID    uuid.UUID
+	Email     string
+	Code      string
+	ExpiresAt pgtype.Timestamp
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
-		arg.Destination,
-		arg.EndsAt,
-		arg.StartsAt,
-		arg.IsConfirmed,
-		arg.ID,
+func (q *Queries) CreateEmailVerification(ctx context.Context, arg CreateEmailVerificationParams) error {
+	_, err := q.db.Exec(ctx, createEmailVerification,
+		arg.TripID,
+		arg.Email,
+		arg.Code,
+		arg.ExpiresAt,
 	)
 	return err
 }
+
+const createFlightUpdatedNotifications = `-- name: CreateFlightUpdatedNotifications :exec
+INSERT INTO notifications (participant_id, trip_id, event, subject_id)
+SELECT
+    participants.id, participants.trip_id, 'flight_updated', transport_segment_participants.segment_id
+FROM transport_segment_participants
+JOIN participants ON participants.id = transport_segment_participants.participant_id
+WHERE
+    transport_segment_participants.segment_id = $1
+    AND participants.declined_at IS NULL
+`
+
+func (q *Queries) CreateFlightUpdatedNotifications(ctx context.Context, segmentID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, createFlightUpdatedNotifications, segmentID)
+	return err
+}
+
+const createJournalEntry = `-- name: CreateJournalEntry :one
+INSERT INTO journal_entries
+    ( "trip_id", "participant_id", "day", "title", "body", "visibility" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id"
+`
+
+type CreateJournalEntryParams struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Day           pgtype.Date
+	Title         pgtype.Text
+	Body          string
+	Visibility    JournalVisibility
+}
+
+func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createJournalEntry,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Day,
+		arg.Title,
+		arg.Body,
+		arg.Visibility,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createMessage = `-- name: CreateMessage :one
+INSERT INTO messages
+    ( "trip_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateMessageParams struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+}
+
+func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createMessage, arg.TripID, arg.ParticipantID, arg.Body)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPackingItem = `-- name: CreatePackingItem :one
+INSERT INTO packing_items
+    ( "trip_id", "name", "quantity", "assignee_id" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreatePackingItemParams struct {
+	TripID     uuid.UUID
+	Name       string
+	Quantity   int32
+	AssigneeID pgtype.UUID
+}
+
+func (q *Queries) CreatePackingItem(ctx context.Context, arg CreatePackingItemParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createPackingItem,
+		arg.TripID,
+		arg.Name,
+		arg.Quantity,
+		arg.AssigneeID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPhoto = `-- name: CreatePhoto :one
+INSERT INTO photos
+    ( "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id"
+`
+
+type CreatePhotoParams struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Caption       pgtype.Text
+	FileName      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+}
+
+func (q *Queries) CreatePhoto(ctx context.Context, arg CreatePhotoParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createPhoto,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Caption,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPhotoComment = `-- name: CreatePhotoComment :one
+INSERT INTO photo_comments
+    ( "photo_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreatePhotoCommentParams struct {
+	PhotoID       uuid.UUID
+	ParticipantID uuid.UUID
+	Body          string
+}
+
+func (q *Queries) CreatePhotoComment(ctx context.Context, arg CreatePhotoCommentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createPhotoComment, arg.PhotoID, arg.ParticipantID, arg.Body)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPoll = `-- name: CreatePoll :one
+INSERT INTO polls
+    ( "trip_id", "kind", "question" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreatePollParams struct {
+	TripID   uuid.UUID
+	Kind     PollKind
+	Question string
+}
+
+func (q *Queries) CreatePoll(ctx context.Context, arg CreatePollParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createPoll, arg.TripID, arg.Kind, arg.Question)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPollOption = `-- name: CreatePollOption :exec
+INSERT INTO poll_options
+    ( "poll_id", "position", "destination", "starts_at", "ends_at" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+`
+
+type CreatePollOptionParams struct {
+	PollID      uuid.UUID
+	Position    int32
+	Destination pgtype.Text
+	StartsAt    pgtype.Timestamp
+	EndsAt      pgtype.Timestamp
+}
+
+func (q *Queries) CreatePollOption(ctx context.Context, arg CreatePollOptionParams) error {
+	_, err := q.db.Exec(ctx, createPollOption,
+		arg.PollID,
+		arg.Position,
+		arg.Destination,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	return err
+}
+
+const createSettlementPayment = `-- name: CreateSettlementPayment :one
+INSERT INTO settlement_payments
+    ( "trip_id", "from_participant_id", "to_participant_id", "amount", "currency" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id"
+`
+
+type CreateSettlementPaymentParams struct {
+	TripID            uuid.UUID
+	FromParticipantID uuid.UUID
+	ToParticipantID   uuid.UUID
+	Amount            int64
+	Currency          string
+}
+
+func (q *Queries) CreateSettlementPayment(ctx context.Context, arg CreateSettlementPaymentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createSettlementPayment,
+		arg.TripID,
+		arg.FromParticipantID,
+		arg.ToParticipantID,
+		arg.Amount,
+		arg.Currency,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTag = `-- name: CreateTag :one
+INSERT INTO tags
+    ( "name" ) VALUES
+    ( $1 )
+ON CONFLICT ("name") DO UPDATE SET "name" = EXCLUDED.name
+RETURNING "id"
+`
+
+func (q *Queries) CreateTag(ctx context.Context, name string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTag, name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTask = `-- name: CreateTask :one
+INSERT INTO tasks
+    ( "trip_id", "title", "assignee_id", "due_at" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreateTaskParams struct {
+	TripID     uuid.UUID
+	Title      string
+	AssigneeID pgtype.UUID
+	DueAt      pgtype.Timestamp
+}
+
+func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTask,
+		arg.TripID,
+		arg.Title,
+		arg.AssigneeID,
+		arg.DueAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTransportSegment = `-- name: CreateTransportSegment :one
+INSERT INTO transport_segments
+    ( "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+RETURNING "id"
+`
+
+type CreateTransportSegmentParams struct {
+	TripID         uuid.UUID
+	Mode           TransportMode
+	Carrier        string
+	Number         pgtype.Text
+	DeparturePlace string
+	DepartureAt    pgtype.Timestamp
+	ArrivalPlace   string
+	ArrivalAt      pgtype.Timestamp
+}
+
+func (q *Queries) CreateTransportSegment(ctx context.Context, arg CreateTransportSegmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTransportSegment,
+		arg.TripID,
+		arg.Mode,
+		arg.Carrier,
+		arg.Number,
+		arg.DeparturePlace,
+		arg.DepartureAt,
+		arg.ArrivalPlace,
+		arg.ArrivalAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTripExpense = `-- name: CreateTripExpense :one
+INSERT INTO expenses
+    ( "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+RETURNING "id"
+`
+
+type CreateTripExpenseParams struct {
+	TripID      uuid.UUID
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	Category    ExpenseCategory
+	SpentAt     pgtype.Timestamp
+}
+
+func (q *Queries) CreateTripExpense(ctx context.Context, arg CreateTripExpenseParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTripExpense,
+		arg.TripID,
+		arg.PayerID,
+		arg.ActivityID,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.Category,
+		arg.SpentAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTripLink = `-- name: CreateTripLink :one
+INSERT INTO links
+    ( "trip_id", "title", "url", "category", "pinned" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id"
+`
+
+type CreateTripLinkParams struct {
+	TripID   uuid.UUID
+	Title    string
+	Url      string
+	Category LinkCategory
+	Pinned   bool
+}
+
+func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTripLink,
+		arg.TripID,
+		arg.Title,
+		arg.Url,
+		arg.Category,
+		arg.Pinned,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTripShare = `-- name: CreateTripShare :exec
+INSERT INTO trip_shares
+    ( "trip_id", "slug" ) VALUES
+    ( $1, $2 )
+`
+
+type CreateTripShareParams struct {
+	TripID uuid.UUID
+	Slug   string
+}
+
+func (q *Queries) CreateTripShare(ctx context.Context, arg CreateTripShareParams) error {
+	_, err := q.db.Exec(ctx, createTripShare, arg.TripID, arg.Slug)
+	return err
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks
+    ( "trip_id", "url", "secret" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id", "trip_id", "url", "secret", "created_at"
+`
+
+type CreateWebhookParams struct {
+	TripID uuid.UUID
+	Url    string
+	Secret string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.TripID,
+		arg.Url,
+		arg.Secret,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Url,
+		&i.Secret,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const declineParticipant = `-- name: DeclineParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "declined_at" = now(),
+    "decline_reason" = $1
+WHERE
+    id = $2
+`
+
+type DeclineParticipantParams struct {
+	DeclineReason pgtype.Text
+	ID            uuid.UUID
+}
+
+func (q *Queries) DeclineParticipant(ctx context.Context, arg DeclineParticipantParams) error {
+	_, err := q.db.Exec(ctx, declineParticipant, arg.DeclineReason, arg.ID)
+	return err
+}
+
+const deleteActivity = `-- name: DeleteActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteActivityComment = `-- name: DeleteActivityComment :execrows
+UPDATE activity_comments
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND activity_id = $2 AND participant_id = $3 AND deleted_at IS NULL
+`
+
+type DeleteActivityCommentParams struct {
+	ID            uuid.UUID
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) DeleteActivityComment(ctx context.Context, arg DeleteActivityCommentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivityComment, arg.ID, arg.ActivityID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteBooking = `-- name: DeleteBooking :execrows
+DELETE FROM bookings
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteBookingParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteBooking(ctx context.Context, arg DeleteBookingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBooking, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDocument = `-- name: DeleteDocument :execrows
+DELETE FROM documents
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteDocumentParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteDocument(ctx context.Context, arg DeleteDocumentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteDocument, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteEmailVerification = `-- name: DeleteEmailVerification :exec
+DELETE FROM email_verifications
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) DeleteEmailVerification(ctx context.Context, participantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteEmailVerification, participantID)
+	return err
+}
+
+const deleteFlightStatus = `-- name: DeleteFlightStatus :exec
+DELETE FROM flight_statuses
+WHERE
+    segment_id = $1
+`
+
+func (q *Queries) DeleteFlightStatus(ctx context.Context, segmentID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteFlightStatus, segmentID)
+	return err
+}
+
+const deleteJournalEntry = `-- name: DeleteJournalEntry :execrows
+DELETE FROM journal_entries
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3
+`
+
+type DeleteJournalEntryParams struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) DeleteJournalEntry(ctx context.Context, arg DeleteJournalEntryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteJournalEntry, arg.ID, arg.TripID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePackingItem = `-- name: DeletePackingItem :execrows
+DELETE FROM packing_items
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeletePackingItemParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeletePackingItem(ctx context.Context, arg DeletePackingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePackingItem, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePhoto = `-- name: DeletePhoto :one
+DELETE FROM photos
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3
+RETURNING "storage_key", "thumbnail_key"
+`
+
+type DeletePhotoParams struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+type DeletePhotoRow struct {
+	StorageKey   string
+	ThumbnailKey pgtype.Text
+}
+
+func (q *Queries) DeletePhoto(ctx context.Context, arg DeletePhotoParams) (DeletePhotoRow, error) {
+	row := q.db.QueryRow(ctx, deletePhoto, arg.ID, arg.TripID, arg.ParticipantID)
+	var i DeletePhotoRow
+	err := row.Scan(&i.StorageKey, &i.ThumbnailKey)
+	return i, err
+}
+
+const deletePhotoComment = `-- name: DeletePhotoComment :execrows
+DELETE FROM photo_comments
+WHERE
+    id = $1 AND photo_id = $2 AND participant_id = $3
+`
+
+type DeletePhotoCommentParams struct {
+	ID            uuid.UUID
+	PhotoID       uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) DeletePhotoComment(ctx context.Context, arg DeletePhotoCommentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePhotoComment, arg.ID, arg.PhotoID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSettlementPayment = `-- name: DeleteSettlementPayment :execrows
+DELETE FROM settlement_payments
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteSettlementPaymentParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteSettlementPayment(ctx context.Context, arg DeleteSettlementPaymentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSettlementPayment, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTag = `-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTag, id)
+	return err
+}
+
+const deleteTask = `-- name: DeleteTask :execrows
+DELETE FROM tasks
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteTaskParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTask(ctx context.Context, arg DeleteTaskParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTask, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTransportSegment = `-- name: DeleteTransportSegment :execrows
+DELETE FROM transport_segments
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteTransportSegmentParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTransportSegment(ctx context.Context, arg DeleteTransportSegmentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTransportSegment, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTransportSegmentParticipants = `-- name: DeleteTransportSegmentParticipants :exec
+DELETE FROM transport_segment_participants
+WHERE
+    segment_id = $1
+`
+
+func (q *Queries) DeleteTransportSegmentParticipants(ctx context.Context, segmentID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransportSegmentParticipants, segmentID)
+	return err
+}
+
+const deleteTripExpense = `-- name: DeleteTripExpense :execrows
+DELETE FROM expenses
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type DeleteTripExpenseParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTripExpense(ctx context.Context, arg DeleteTripExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripExpense, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripLink = `-- name: DeleteTripLink :execrows
+UPDATE links
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTripLink(ctx context.Context, arg DeleteTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueEmail = `-- name: EnqueueEmail :exec
+INSERT INTO email_outbox
+    ( "kind", "payload" ) VALUES
+    ( $1, $2 )
+`
+
+type EnqueueEmailParams struct {
+	Kind    EmailKind
+	Payload []byte
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) error {
+	_, err := q.db.Exec(ctx, enqueueEmail, arg.Kind, arg.Payload)
+	return err
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries
+    ( "webhook_id", "event_id" )
+SELECT
+    webhooks.id, $1
+FROM webhooks
+WHERE
+    webhooks.trip_id = $2
+ON CONFLICT ("webhook_id", "event_id") DO NOTHING
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventID uuid.UUID
+	TripID  uuid.UUID
+}
+
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueWebhookDeliveries, arg.EventID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const flagNoResponseParticipants = `-- name: FlagNoResponseParticipants :execrows
+UPDATE participants
+SET
+    "no_response_at" = now()
+FROM trips, participant_invitations
+WHERE
+    trips.id = participants.trip_id
+    AND participant_invitations.participant_id = participants.id
+    AND trips.rsvp_deadline <= $1
+    AND trips.status NOT IN ('draft', 'cancelled')
+    AND NOT participants.is_confirmed
+    AND participants.declined_at IS NULL
+    AND participants.no_response_at IS NULL
+`
+
+func (q *Queries) FlagNoResponseParticipants(ctx context.Context, rsvpDeadline pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, flagNoResponseParticipants, rsvpDeadline)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
+FROM activities
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+`
+
+type GetActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetActivity(ctx context.Context, arg GetActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.EndsAt,
+		&i.Address,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Category,
+		&i.Position,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Search,
+		&i.PlaceName,
+	)
+	return i, err
+}
+
+const getActivityAttachments = `-- name: GetActivityAttachments :many
+SELECT
+    "id", "activity_id", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM activity_attachments
+WHERE
+    activity_id = $1
+ORDER BY
+    created_at
+`
+
+func (q *Queries) GetActivityAttachments(ctx context.Context, activityID uuid.UUID) ([]ActivityAttachment, error) {
+	rows, err := q.db.Query(ctx, getActivityAttachments, activityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityAttachment
+	for rows.Next() {
+		var i ActivityAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActivityID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityComments = `-- name: GetActivityComments :many
+SELECT
+    activity_comments.id, activity_comments.participant_id, participants.email, activity_comments.body, activity_comments.created_at
+FROM activity_comments
+JOIN participants ON participants.id = activity_comments.participant_id
+WHERE
+    activity_comments.activity_id = $1 AND activity_comments.deleted_at IS NULL
+ORDER BY
+    activity_comments.created_at
+`
+
+type GetActivityCommentsRow struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	Email         string
+	Body          string
+	CreatedAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetActivityComments(ctx context.Context, activityID uuid.UUID) ([]GetActivityCommentsRow, error) {
+	rows, err := q.db.Query(ctx, getActivityComments, activityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActivityCommentsRow
+	for rows.Next() {
+		var i GetActivityCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Email,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityTripID = `-- name: GetActivityTripID :one
+SELECT
+    "trip_id"
+FROM activities
+WHERE
+    id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetActivityTripID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getActivityTripID, id)
+	var trip_id uuid.UUID
+	err := row.Scan(&trip_id)
+	return trip_id, err
+}
+
+const getDocument = `-- name: GetDocument :one
+SELECT
+    "id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM documents
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type GetDocumentParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetDocument(ctx context.Context, arg GetDocumentParams) (Document, error) {
+	row := q.db.QueryRow(ctx, getDocument, arg.ID, arg.TripID)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.Kind,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDueActivityReminders = `-- name: GetDueActivityReminders :many
+SELECT
+    activities.id AS activity_id, activities.title, activities.occurs_at, trips.destination, participants.id AS participant_id, participants.email, participants.name, participants.locale
+FROM activities
+JOIN trips ON trips.id = activities.trip_id
+JOIN participants ON participants.trip_id = activities.trip_id
+WHERE
+    activities.occurs_at > $1 AND activities.occurs_at <= $2
+    AND activities.deleted_at IS NULL
+    AND trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'activity_reminder'
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM activity_reminders
+        WHERE activity_reminders.activity_id = activities.id AND activity_reminders.participant_id = participants.id
+    )
+ORDER BY
+    activities.occurs_at
+`
+
+type GetDueActivityRemindersParams struct {
+	FromTime pgtype.Timestamp
+	ToTime   pgtype.Timestamp
+}
+
+type GetDueActivityRemindersRow struct {
+	ActivityID    uuid.UUID
+	Title         string
+	OccursAt      pgtype.Timestamp
+	Destination   string
+	ParticipantID uuid.UUID
+	Email         string
+	Name          pgtype.Text
+	Locale        Locale
+}
+
+func (q *Queries) GetDueActivityReminders(ctx context.Context, arg GetDueActivityRemindersParams) ([]GetDueActivityRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getDueActivityReminders, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueActivityRemindersRow
+	for rows.Next() {
+		var i GetDueActivityRemindersRow
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Destination,
+			&i.ParticipantID,
+			&i.Email,
+			&i.Name,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueDailyDigests = `-- name: GetDueDailyDigests :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.timezone,
+    ($1::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date AS day
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE
+    trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND ($1::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+        BETWEEN (trips.starts_at AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date AND (trips.ends_at AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+    AND extract(hour FROM $1::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone) >= $2::int
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'daily_digest'
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM daily_digests
+        WHERE daily_digests.participant_id = participants.id
+            AND daily_digests.day = ($1::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+    )
+`
+
+type GetDueDailyDigestsParams struct {
+	Now      pgtype.Timestamp
+	SendHour int32
+}
+
+type GetDueDailyDigestsRow struct {
+	ParticipantID uuid.UUID
+	Email         string
+	Name          pgtype.Text
+	Locale        Locale
+	TripID        uuid.UUID
+	Destination   string
+	Timezone      string
+	Day           pgtype.Date
+}
+
+func (q *Queries) GetDueDailyDigests(ctx context.Context, arg GetDueDailyDigestsParams) ([]GetDueDailyDigestsRow, error) {
+	rows, err := q.db.Query(ctx, getDueDailyDigests, arg.Now, arg.SendHour)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueDailyDigestsRow
+	for rows.Next() {
+		var i GetDueDailyDigestsRow
+		if err := rows.Scan(
+			&i.ParticipantID,
+			&i.Email,
+			&i.Name,
+			&i.Locale,
+			&i.TripID,
+			&i.Destination,
+			&i.Timezone,
+			&i.Day,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDuePreTripReminders = `-- name: GetDuePreTripReminders :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.starts_at
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE
+    trips.starts_at > $1
+    AND trips.starts_at <= $1 + make_interval(days => trips.pre_trip_reminder_days)
+    AND trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND participants.pre_trip_reminded_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'pre_trip_reminder'
+    )
+ORDER BY
+    trips.starts_at
+`
+
+type GetDuePreTripRemindersRow struct {
+	ParticipantID uuid.UUID
+	Email         string
+	Name          pgtype.Text
+	Locale        Locale
+	TripID        uuid.UUID
+	Destination   string
+	StartsAt      pgtype.Timestamp
+}
+
+func (q *Queries) GetDuePreTripReminders(ctx context.Context, now pgtype.Timestamp) ([]GetDuePreTripRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getDuePreTripReminders, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDuePreTripRemindersRow
+	for rows.Next() {
+		var i GetDuePreTripRemindersRow
+		if err := rows.Scan(
+			&i.ParticipantID,
+			&i.Email,
+			&i.Name,
+			&i.Locale,
+			&i.TripID,
+			&i.Destination,
+			&i.StartsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueRSVPReminders = `-- name: GetDueRSVPReminders :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.rsvp_deadline
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+JOIN participant_invitations ON participant_invitations.participant_id = participants.id
+WHERE
+    trips.rsvp_deadline > $1 AND trips.rsvp_deadline <= $2
+    AND trips.status NOT IN ('draft', 'cancelled')
+    AND NOT participants.is_confirmed
+    AND participants.declined_at IS NULL
+    AND participants.rsvp_reminded_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'rsvp_reminder'
+    )
+ORDER BY
+    trips.rsvp_deadline
+`
+
+type GetDueRSVPRemindersParams struct {
+	FromTime pgtype.Timestamp
+	ToTime   pgtype.Timestamp
+}
+
+type GetDueRSVPRemindersRow struct {
+	ParticipantID uuid.UUID
+	Email         string
+	Name          pgtype.Text
+	Locale        Locale
+	TripID        uuid.UUID
+	Destination   string
+	RsvpDeadline  pgtype.Timestamp
+}
+
+func (q *Queries) GetDueRSVPReminders(ctx context.Context, arg GetDueRSVPRemindersParams) ([]GetDueRSVPRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getDueRSVPReminders, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueRSVPRemindersRow
+	for rows.Next() {
+		var i GetDueRSVPRemindersRow
+		if err := rows.Scan(
+			&i.ParticipantID,
+			&i.Email,
+			&i.Name,
+			&i.Locale,
+			&i.TripID,
+			&i.Destination,
+			&i.RsvpDeadline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueTaskReminders = `-- name: GetDueTaskReminders :many
+SELECT
+    tasks.id AS task_id, tasks.title, tasks.due_at, trips.id AS trip_id, trips.destination, participants.id AS participant_id, participants.email, participants.name, participants.locale
+FROM tasks
+JOIN trips ON trips.id = tasks.trip_id
+JOIN participants ON participants.id = tasks.assignee_id
+WHERE
+    tasks.due_at <= $1
+    AND NOT tasks.done
+    AND tasks.reminded_at IS NULL
+    AND trips.status <> 'cancelled'
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'task_reminder'
+    )
+ORDER BY
+    tasks.due_at
+`
+
+type GetDueTaskRemindersRow struct {
+	TaskID        uuid.UUID
+	Title         string
+	DueAt         pgtype.Timestamp
+	TripID        uuid.UUID
+	Destination   string
+	ParticipantID uuid.UUID
+	Email         string
+	Name          pgtype.Text
+	Locale        Locale
+}
+
+func (q *Queries) GetDueTaskReminders(ctx context.Context, now pgtype.Timestamp) ([]GetDueTaskRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getDueTaskReminders, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueTaskRemindersRow
+	for rows.Next() {
+		var i GetDueTaskRemindersRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.Title,
+			&i.DueAt,
+			&i.TripID,
+			&i.Destination,
+			&i.ParticipantID,
+			&i.Email,
+			&i.Name,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEmailVerification = `-- name: GetEmailVerification :one
+SELECT
+    "participant_id", "code", "attempts", "expires_at", "created_at"
+FROM email_verifications
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) GetEmailVerification(ctx context.Context, participantID uuid.UUID) (EmailVerification, error) {
+	row := q.db.QueryRow(ctx, getEmailVerification, participantID)
+	var i EmailVerification
+	err := row.Scan(
+		&i.ParticipantID,
+		&i.Code,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFlightUpdateRecipients = `-- name: GetFlightUpdateRecipients :many
+SELECT
+    participants.id
+FROM transport_segment_participants
+JOIN participants ON participants.id = transport_segment_participants.participant_id
+WHERE
+    transport_segment_participants.segment_id = $1
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'flight_update'
+    )
+ORDER BY
+    participants.id
+`
+
+func (q *Queries) GetFlightUpdateRecipients(ctx context.Context, segmentID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getFlightUpdateRecipients, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLinkURL = `-- name: GetLinkURL :one
+SELECT
+    "url"
+FROM links
+WHERE
+    id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetLinkURL(ctx context.Context, id uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getLinkURL, id)
+	var url string
+	err := row.Scan(&url)
+	return url, err
+}
+
+const getNotificationOptOuts = `-- name: GetNotificationOptOuts :many
+SELECT
+    "kind"
+FROM notification_opt_outs
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) GetNotificationOptOuts(ctx context.Context, participantID uuid.UUID) ([]NotificationKind, error) {
+	rows, err := q.db.Query(ctx, getNotificationOptOuts, participantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationKind
+	for rows.Next() {
+		var kind NotificationKind
+		if err := rows.Scan(&kind); err != nil {
+			return nil, err
+		}
+		items = append(items, kind)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
+FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.DeclinedAt,
+		&i.DeclineReason,
+		&i.InvitedAt,
+		&i.Name,
+		&i.Phone,
+		&i.AvatarUrl,
+		&i.RsvpRemindedAt,
+		&i.NoResponseAt,
+		&i.Guests,
+		&i.Locale,
+		&i.PreTripRemindedAt,
+		&i.EmailVerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getParticipantByEmail = `-- name: GetParticipantByEmail :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
+FROM participants
+WHERE
+    trip_id = $1 AND email = $2
+`
+
+type GetParticipantByEmailParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) GetParticipantByEmail(ctx context.Context, arg GetParticipantByEmailParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipantByEmail, arg.TripID, arg.Email)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.DeclinedAt,
+		&i.DeclineReason,
+		&i.InvitedAt,
+		&i.Name,
+		&i.Phone,
+		&i.AvatarUrl,
+		&i.RsvpRemindedAt,
+		&i.NoResponseAt,
+		&i.Guests,
+		&i.Locale,
+		&i.PreTripRemindedAt,
+		&i.EmailVerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getParticipantNotifications = `-- name: GetParticipantNotifications :many
+SELECT
+    "id", "participant_id", "trip_id", "event", "subject_id", "read_at", "created_at"
+FROM notifications
+WHERE
+    participant_id = $1
+    AND (NOT $2::boolean OR read_at IS NULL)
+ORDER BY
+    created_at DESC, id DESC
+`
+
+type GetParticipantNotificationsParams struct {
+	ParticipantID uuid.UUID
+	UnreadOnly    bool
+}
+
+func (q *Queries) GetParticipantNotifications(ctx context.Context, arg GetParticipantNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, getParticipantNotifications, arg.ParticipantID, arg.UnreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.TripID,
+			&i.Event,
+			&i.SubjectID,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantStatusChanges = `-- name: GetParticipantStatusChanges :many
+SELECT
+    "id", "participant_id", "status", "reason", "created_at"
+FROM participant_status_changes
+WHERE
+    participant_id = $1
+ORDER BY
+    created_at, id
+`
+
+func (q *Queries) GetParticipantStatusChanges(ctx context.Context, participantID uuid.UUID) ([]ParticipantStatusChange, error) {
+	rows, err := q.db.Query(ctx, getParticipantStatusChanges, participantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ParticipantStatusChange
+	for rows.Next() {
+		var i ParticipantStatusChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Status,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.DeclinedAt,
+			&i.DeclineReason,
+			&i.InvitedAt,
+			&i.Name,
+			&i.Phone,
+			&i.AvatarUrl,
+			&i.RsvpRemindedAt,
+			&i.NoResponseAt,
+			&i.Guests,
+			&i.Locale,
+			&i.PreTripRemindedAt,
+			&i.EmailVerifiedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantsPage = `-- name: GetParticipantsPage :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
+FROM participants
+WHERE
+    trip_id = $1
+    AND (created_at, id) > ($2::timestamp, $3::uuid)
+ORDER BY
+    created_at, id
+LIMIT $4::int
+`
+
+type GetParticipantsPageParams struct {
+	TripID         uuid.UUID
+	AfterCreatedAt pgtype.Timestamp
+	AfterID        uuid.UUID
+	PageSize       int32
+}
+
+func (q *Queries) GetParticipantsPage(ctx context.Context, arg GetParticipantsPageParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipantsPage,
+		arg.TripID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.DeclinedAt,
+			&i.DeclineReason,
+			&i.InvitedAt,
+			&i.Name,
+			&i.Phone,
+			&i.AvatarUrl,
+			&i.RsvpRemindedAt,
+			&i.NoResponseAt,
+			&i.Guests,
+			&i.Locale,
+			&i.PreTripRemindedAt,
+			&i.EmailVerifiedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantsToInvite = `-- name: GetParticipantsToInvite :many
+SELECT
+    participants.email
+FROM participants
+WHERE
+    participants.trip_id = $1
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM participant_invitations
+        WHERE participant_invitations.participant_id = participants.id
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM email_verifications
+        WHERE email_verifications.participant_id = participants.id
+    )
+ORDER BY
+    participants.invited_at
+`
+
+func (q *Queries) GetParticipantsToInvite(ctx context.Context, tripID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getParticipantsToInvite, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPhoto = `-- name: GetPhoto :one
+SELECT
+    "id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "width", "height", "thumbnail_key", "thumbnail_status", "thumbnail_attempts", "thumbnail_next_attempt_at", "created_at"
+FROM photos
+WHERE
+    id = $1 AND trip_id = $2
+`
+
+type GetPhotoParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetPhoto(ctx context.Context, arg GetPhotoParams) (Photo, error) {
+	row := q.db.QueryRow(ctx, getPhoto, arg.ID, arg.TripID)
+	var i Photo
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.Caption,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.Width,
+		&i.Height,
+		&i.ThumbnailKey,
+		&i.ThumbnailStatus,
+		&i.ThumbnailAttempts,
+		&i.ThumbnailNextAttemptAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPhotoComments = `-- name: GetPhotoComments :many
+SELECT
+    photo_comments.id, photo_comments.participant_id, participants.name, participants.email, photo_comments.body,
+    photo_comments.created_at
+FROM photo_comments
+JOIN participants ON participants.id = photo_comments.participant_id
+WHERE
+    photo_comments.photo_id = $1
+ORDER BY
+    photo_comments.created_at, photo_comments.id
+`
+
+type GetPhotoCommentsRow struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	Name          pgtype.Text
+	Email         string
+	Body          string
+	CreatedAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetPhotoComments(ctx context.Context, photoID uuid.UUID) ([]GetPhotoCommentsRow, error) {
+	rows, err := q.db.Query(ctx, getPhotoComments, photoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPhotoCommentsRow
+	for rows.Next() {
+		var i GetPhotoCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPoll = `-- name: GetPoll :one
+SELECT
+    "id", "trip_id", "kind", "question", "applied_at", "created_at"
+FROM polls
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetPoll(ctx context.Context, id uuid.UUID) (Poll, error) {
+	row := q.db.QueryRow(ctx, getPoll, id)
+	var i Poll
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Kind,
+		&i.Question,
+		&i.AppliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTag = `-- name: GetTag :one
+SELECT
+    "id", "name"
+FROM tags
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTag(ctx context.Context, id uuid.UUID) (Tag, error) {
+	row := q.db.QueryRow(ctx, getTag, id)
+	var i Tag
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const getTags = `-- name: GetTags :many
+SELECT
+    "id", "name"
+FROM tags
+ORDER BY
+    name
+`
+
+func (q *Queries) GetTags(ctx context.Context) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, getTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrackedFlights = `-- name: GetTrackedFlights :many
+SELECT
+    transport_segments.id AS segment_id, transport_segments.trip_id, transport_segments.carrier, transport_segments.number, transport_segments.departure_place, transport_segments.departure_at, transport_segments.arrival_place, flight_statuses.departure_at AS estimated_departure_at, flight_statuses.gate
+FROM transport_segments
+JOIN trips ON trips.id = transport_segments.trip_id
+LEFT JOIN flight_statuses ON flight_statuses.segment_id = transport_segments.id
+WHERE
+    transport_segments.mode = 'flight'
+    AND transport_segments.number IS NOT NULL
+    AND transport_segments.departure_at <= $1
+    AND COALESCE(flight_statuses.departure_at, transport_segments.departure_at) >= $2
+    AND trips.status <> 'cancelled'
+ORDER BY
+    transport_segments.departure_at
+`
+
+type GetTrackedFlightsParams struct {
+	ToTime   pgtype.Timestamp
+	FromTime pgtype.Timestamp
+}
+
+type GetTrackedFlightsRow struct {
+	SegmentID            uuid.UUID
+	TripID               uuid.UUID
+	Carrier              string
+	Number               pgtype.Text
+	DeparturePlace       string
+	DepartureAt          pgtype.Timestamp
+	ArrivalPlace         string
+	EstimatedDepartureAt pgtype.Timestamp
+	Gate                 pgtype.Text
+}
+
+func (q *Queries) GetTrackedFlights(ctx context.Context, arg GetTrackedFlightsParams) ([]GetTrackedFlightsRow, error) {
+	rows, err := q.db.Query(ctx, getTrackedFlights, arg.ToTime, arg.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTrackedFlightsRow
+	for rows.Next() {
+		var i GetTrackedFlightsRow
+		if err := rows.Scan(
+			&i.SegmentID,
+			&i.TripID,
+			&i.Carrier,
+			&i.Number,
+			&i.DeparturePlace,
+			&i.DepartureAt,
+			&i.ArrivalPlace,
+			&i.EstimatedDepartureAt,
+			&i.Gate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
+FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Description,
+		&i.Status,
+		&i.RsvpDeadline,
+		&i.MaxGuests,
+		&i.MaxParticipants,
+		&i.PreTripReminderDays,
+		&i.Timezone,
+		&i.Version,
+		&i.CreatedAt,
+		&i.Search,
+		&i.Latitude,
+		&i.Longitude,
+		&i.PlaceName,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+    AND ($2::activity_category IS NULL OR category = $2)
+    AND occurs_at BETWEEN COALESCE($3, '-infinity'::timestamp) AND COALESCE($4, 'infinity'::timestamp)
+ORDER BY
+    occurs_at, position, id
+`
+
+type GetTripActivitiesParams struct {
+	TripID   uuid.UUID
+	Category NullActivityCategory
+	FromTime pgtype.Timestamp
+	ToTime   pgtype.Timestamp
+}
+
+func (q *Queries) GetTripActivities(ctx context.Context, arg GetTripActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities,
+		arg.TripID,
+		arg.Category,
+		arg.FromTime,
+		arg.ToTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.EndsAt,
+			&i.Address,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Category,
+			&i.Position,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Search,
+			&i.PlaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivitiesPage = `-- name: GetTripActivitiesPage :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+    AND (created_at, id) > ($2::timestamp, $3::uuid)
+ORDER BY
+    created_at, id
+LIMIT $4::int
+`
+
+type GetTripActivitiesPageParams struct {
+	TripID         uuid.UUID
+	AfterCreatedAt pgtype.Timestamp
+	AfterID        uuid.UUID
+	PageSize       int32
+}
+
+func (q *Queries) GetTripActivitiesPage(ctx context.Context, arg GetTripActivitiesPageParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivitiesPage,
+		arg.TripID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.EndsAt,
+			&i.Address,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Category,
+			&i.Position,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Search,
+			&i.PlaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityAttendeeCounts = `-- name: GetTripActivityAttendeeCounts :many
+SELECT
+    activity_attendees.activity_id, count(*) AS attendees
+FROM activity_attendees
+JOIN activities ON activities.id = activity_attendees.activity_id
+WHERE
+    activities.trip_id = $1 AND activities.deleted_at IS NULL AND activity_attendees.attending
+GROUP BY
+    activity_attendees.activity_id
+`
+
+type GetTripActivityAttendeeCountsRow struct {
+	ActivityID uuid.UUID
+	Attendees  int64
+}
+
+func (q *Queries) GetTripActivityAttendeeCounts(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityAttendeeCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivityAttendeeCounts, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripActivityAttendeeCountsRow
+	for rows.Next() {
+		var i GetTripActivityAttendeeCountsRow
+		if err := rows.Scan(&i.ActivityID, &i.Attendees); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityVoteCounts = `-- name: GetTripActivityVoteCounts :many
+SELECT
+    activity_votes.activity_id, count(*) AS votes
+FROM activity_votes
+JOIN activities ON activities.id = activity_votes.activity_id
+WHERE
+    activities.trip_id = $1 AND activities.deleted_at IS NULL
+GROUP BY
+    activity_votes.activity_id
+`
+
+type GetTripActivityVoteCountsRow struct {
+	ActivityID uuid.UUID
+	Votes      int64
+}
+
+func (q *Queries) GetTripActivityVoteCounts(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityVoteCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivityVoteCounts, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripActivityVoteCountsRow
+	for rows.Next() {
+		var i GetTripActivityVoteCountsRow
+		if err := rows.Scan(&i.ActivityID, &i.Votes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAggregate = `-- name: GetTripAggregate :one
+SELECT
+    trips."id", trips."destination", trips."owner_email", trips."owner_name", trips."starts_at", trips."ends_at", trips."description", trips."status", trips."rsvp_deadline", trips."max_guests", trips."max_participants", trips."pre_trip_reminder_days", trips."timezone", trips."version", trips."created_at", trips."search", trips."latitude", trips."longitude", trips."place_name",
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', p.id,
+            'TripID', p.trip_id,
+            'Email', p.email,
+            'IsConfirmed', p.is_confirmed,
+            'DeclinedAt', to_char(p.declined_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'DeclineReason', p.decline_reason,
+            'InvitedAt', to_char(p.invited_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Name', p.name,
+            'Phone', p.phone,
+            'AvatarUrl', p.avatar_url,
+            'RsvpRemindedAt', to_char(p.rsvp_reminded_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'NoResponseAt', to_char(p.no_response_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Guests', p.guests,
+            'Locale', p.locale,
+            'PreTripRemindedAt', to_char(p.pre_trip_reminded_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'EmailVerifiedAt', to_char(p.email_verified_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'CreatedAt', to_char(p.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+        ))
+        FROM participants AS p
+        WHERE p.trip_id = trips.id
+    ), '[]')::json AS participants,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', a.id,
+            'TripID', a.trip_id,
+            'Title', a.title,
+            'OccursAt', to_char(a.occurs_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'EndsAt', to_char(a.ends_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Address', a.address,
+            'Latitude', a.latitude,
+            'Longitude', a.longitude,
+            'PlaceName', a.place_name,
+            'Category', a.category,
+            'Position', a.position,
+            'CreatedAt', to_char(a.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+        ) ORDER BY a.occurs_at, a.position, a.id)
+        FROM activities AS a
+        WHERE a.trip_id = trips.id AND a.deleted_at IS NULL
+    ), '[]')::json AS activities,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', l.id,
+            'TripID', l.trip_id,
+            'Title', l.title,
+            'Url', l.url,
+            'Category', l.category,
+            'PreviewTitle', l.preview_title,
+            'PreviewDescription', l.preview_description,
+            'PreviewFaviconUrl', l.preview_favicon_url,
+            'PreviewImageUrl', l.preview_image_url,
+            'PreviewFetchedAt', to_char(l.preview_fetched_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Pinned', l.pinned,
+            'Position', l.position
+        ) ORDER BY l.category, l.position, l.title)
+        FROM links AS l
+        WHERE l.trip_id = trips.id AND l.deleted_at IS NULL
+    ), '[]')::json AS links
+FROM trips
+WHERE
+    trips.id = $1
+`
+
+type GetTripAggregateRow struct {
+	ID                  uuid.UUID
+	Destination         string
+	OwnerEmail          string
+	OwnerName           string
+	StartsAt            pgtype.Timestamp
+	EndsAt              pgtype.Timestamp
+	Description         string
+	Status              TripStatus
+	RsvpDeadline        pgtype.Timestamp
+	MaxGuests           int32
+	MaxParticipants     pgtype.Int4
+	PreTripReminderDays int32
+	Timezone            string
+	Version             int32
+	CreatedAt           pgtype.Timestamp
+	Search              interface{}
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	PlaceName           pgtype.Text
+	Participants        []byte
+	Activities          []byte
+	Links               []byte
+}
+
+func (q *Queries) GetTripAggregate(ctx context.Context, id uuid.UUID) (GetTripAggregateRow, error) {
+	row := q.db.QueryRow(ctx, getTripAggregate, id)
+	var i GetTripAggregateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Description,
+		&i.Status,
+		&i.RsvpDeadline,
+		&i.MaxGuests,
+		&i.MaxParticipants,
+		&i.PreTripReminderDays,
+		&i.Timezone,
+		&i.Version,
+		&i.CreatedAt,
+		&i.Search,
+		&i.Latitude,
+		&i.Longitude,
+		&i.PlaceName,
+		&i.Participants,
+		&i.Activities,
+		&i.Links,
+	)
+	return i, err
+}
+
+const getTripBookings = `-- name: GetTripBookings :many
+SELECT
+    "id", "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url", "created_at"
+FROM bookings
+WHERE
+    trip_id = $1
+ORDER BY
+    check_in_at, id
+`
+
+func (q *Queries) GetTripBookings(ctx context.Context, tripID uuid.UUID) ([]Booking, error) {
+	rows, err := q.db.Query(ctx, getTripBookings, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Type,
+			&i.Name,
+			&i.CheckInAt,
+			&i.CheckOutAt,
+			&i.Address,
+			&i.ConfirmationCode,
+			&i.Price,
+			&i.Currency,
+			&i.Url,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripDocuments = `-- name: GetTripDocuments :many
+SELECT
+    "id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM documents
+WHERE
+    trip_id = $1
+    AND (participant_id IS NULL OR participant_id = $2)
+ORDER BY
+    created_at, id
+`
+
+type GetTripDocumentsParams struct {
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+}
+
+func (q *Queries) GetTripDocuments(ctx context.Context, arg GetTripDocumentsParams) ([]Document, error) {
+	rows, err := q.db.Query(ctx, getTripDocuments, arg.TripID, arg.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Document
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.ParticipantID,
+			&i.Kind,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenseTotals = `-- name: GetTripExpenseTotals :many
+SELECT
+    "currency", "category", "payer_id", sum(amount)::bigint AS total
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY
+    currency, category, payer_id
+ORDER BY
+    currency, category, payer_id
+`
+
+type GetTripExpenseTotalsRow struct {
+	Currency string
+	Category ExpenseCategory
+	PayerID  uuid.UUID
+	Total    int64
+}
+
+func (q *Queries) GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]GetTripExpenseTotalsRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpenseTotals, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpenseTotalsRow
+	for rows.Next() {
+		var i GetTripExpenseTotalsRow
+		if err := rows.Scan(
+			&i.Currency,
+			&i.Category,
+			&i.PayerID,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenses = `-- name: GetTripExpenses :many
+SELECT
+    "id", "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at", "created_at"
+FROM expenses
+WHERE
+    trip_id = $1
+ORDER BY
+    spent_at, created_at
+`
+
+func (q *Queries) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]Expense, error) {
+	rows, err := q.db.Query(ctx, getTripExpenses, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.PayerID,
+			&i.ActivityID,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.Category,
+			&i.SpentAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripIDByJoinCode = `-- name: GetTripIDByJoinCode :one
+SELECT
+    "trip_id"
+FROM trip_join_codes
+WHERE
+    code = $1
+`
+
+func (q *Queries) GetTripIDByJoinCode(ctx context.Context, code string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getTripIDByJoinCode, code)
+	var trip_id uuid.UUID
+	err := row.Scan(&trip_id)
+	return trip_id, err
+}
+
+const getTripIDByShareSlug = `-- name: GetTripIDByShareSlug :one
+SELECT
+    "trip_id"
+FROM trip_shares
+WHERE
+    slug = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) GetTripIDByShareSlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getTripIDByShareSlug, slug)
+	var trip_id uuid.UUID
+	err := row.Scan(&trip_id)
+	return trip_id, err
+}
+
+const getTripJournalEntries = `-- name: GetTripJournalEntries :many
+SELECT
+    journal_entries.id, journal_entries.participant_id, participants.name, participants.email, journal_entries.day,
+    journal_entries.title, journal_entries.body, journal_entries.visibility, journal_entries.created_at,
+    journal_entries.updated_at
+FROM journal_entries
+JOIN participants ON participants.id = journal_entries.participant_id
+WHERE
+    journal_entries.trip_id = $1
+    AND (journal_entries.visibility = 'shared' OR journal_entries.participant_id = $2)
+ORDER BY
+    journal_entries.day, journal_entries.created_at, journal_entries.id
+`
+
+type GetTripJournalEntriesParams struct {
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+type GetTripJournalEntriesRow struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	Name          pgtype.Text
+	Email         string
+	Day           pgtype.Date
+	Title         pgtype.Text
+	Body          string
+	Visibility    JournalVisibility
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetTripJournalEntries(ctx context.Context, arg GetTripJournalEntriesParams) ([]GetTripJournalEntriesRow, error) {
+	rows, err := q.db.Query(ctx, getTripJournalEntries, arg.TripID, arg.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripJournalEntriesRow
+	for rows.Next() {
+		var i GetTripJournalEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Day,
+			&i.Title,
+			&i.Body,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLinkClickCounts = `-- name: GetTripLinkClickCounts :many
+SELECT
+    link_clicks.link_id, count(*) AS clicks
+FROM link_clicks
+JOIN links ON links.id = link_clicks.link_id
+WHERE
+    links.trip_id = $1 AND links.deleted_at IS NULL
+GROUP BY
+    link_clicks.link_id
+`
+
+type GetTripLinkClickCountsRow struct {
+	LinkID uuid.UUID
+	Clicks int64
+}
+
+func (q *Queries) GetTripLinkClickCounts(ctx context.Context, tripID uuid.UUID) ([]GetTripLinkClickCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTripLinkClickCounts, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripLinkClickCountsRow
+	for rows.Next() {
+		var i GetTripLinkClickCountsRow
+		if err := rows.Scan(&i.LinkID, &i.Clicks); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "category", "preview_title", "preview_description", "preview_favicon_url", "preview_image_url", "preview_fetched_at", "pinned", "position", "deleted_at"
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+ORDER BY
+    category, position, title
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.Category,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewFaviconUrl,
+			&i.PreviewImageUrl,
+			&i.PreviewFetchedAt,
+			&i.Pinned,
+			&i.Position,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripMessagesPage = `-- name: GetTripMessagesPage :many
+SELECT
+    messages.id, messages.participant_id, participants.name, participants.email, messages.body, messages.created_at
+FROM messages
+JOIN participants ON participants.id = messages.participant_id
+WHERE
+    messages.trip_id = $1
+    AND ($2::timestamp IS NULL
+        OR (messages.created_at, messages.id) < ($2::timestamp, $3::uuid))
+ORDER BY
+    messages.created_at DESC, messages.id DESC
+LIMIT $4::int
+`
+
+type GetTripMessagesPageParams struct {
+	TripID          uuid.UUID
+	BeforeCreatedAt pgtype.Timestamp
+	BeforeID        uuid.UUID
+	PageSize        int32
+}
+
+type GetTripMessagesPageRow struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+	Name          pgtype.Text
+	Email         string
+	Body          string
+	CreatedAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetTripMessagesPage(ctx context.Context, arg GetTripMessagesPageParams) ([]GetTripMessagesPageRow, error) {
+	rows, err := q.db.Query(ctx, getTripMessagesPage,
+		arg.TripID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripMessagesPageRow
+	for rows.Next() {
+		var i GetTripMessagesPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Body,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripOwners = `-- name: GetTripOwners :many
+SELECT
+    "trip_id", "email", "name", "created_at", "locale"
+FROM trip_owners
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at
+`
+
+func (q *Queries) GetTripOwners(ctx context.Context, tripID uuid.UUID) ([]TripOwner, error) {
+	rows, err := q.db.Query(ctx, getTripOwners, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripOwner
+	for rows.Next() {
+		var i TripOwner
+		if err := rows.Scan(
+			&i.TripID,
+			&i.Email,
+			&i.Name,
+			&i.CreatedAt,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripPackingItems = `-- name: GetTripPackingItems :many
+SELECT
+    "id", "trip_id", "name", "quantity", "assignee_id", "checked", "created_at"
+FROM packing_items
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at, id
+`
+
+func (q *Queries) GetTripPackingItems(ctx context.Context, tripID uuid.UUID) ([]PackingItem, error) {
+	rows, err := q.db.Query(ctx, getTripPackingItems, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PackingItem
+	for rows.Next() {
+		var i PackingItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.Quantity,
+			&i.AssigneeID,
+			&i.Checked,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantStats = `-- name: GetTripParticipantStats :one
+SELECT
+    count(*) AS invited,
+    count(*) FILTER (WHERE is_confirmed) AS confirmed,
+    count(*) FILTER (WHERE declined_at IS NOT NULL) AS declined,
+    count(*) FILTER (WHERE no_response_at IS NOT NULL) AS no_response,
+    COALESCE(sum(guests) FILTER (WHERE is_confirmed), 0)::bigint AS guests
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+type GetTripParticipantStatsRow struct {
+	Invited    int64
+	Confirmed  int64
+	Declined   int64
+	NoResponse int64
+	Guests     int64
+}
+
+func (q *Queries) GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (GetTripParticipantStatsRow, error) {
+	row := q.db.QueryRow(ctx, getTripParticipantStats, tripID)
+	var i GetTripParticipantStatsRow
+	err := row.Scan(
+		&i.Invited,
+		&i.Confirmed,
+		&i.Declined,
+		&i.NoResponse,
+		&i.Guests,
+	)
+	return i, err
+}
+
+const getTripPhotosPage = `-- name: GetTripPhotosPage :many
+SELECT
+    photos.id, photos.participant_id, participants.name, participants.email, photos.caption, photos.file_name,
+    photos.content_type, photos.size, photos.storage_key, photos.width, photos.height, photos.thumbnail_key,
+    photos.thumbnail_status, photos.created_at,
+    (SELECT count(*) FROM photo_comments WHERE photo_comments.photo_id = photos.id) AS comments
+FROM photos
+JOIN participants ON participants.id = photos.participant_id
+WHERE
+    photos.trip_id = $1
+    AND ($2::timestamp IS NULL
+        OR (photos.created_at, photos.id) < ($2::timestamp, $3::uuid))
+ORDER BY
+    photos.created_at DESC, photos.id DESC
+LIMIT $4::int
+`
+
+type GetTripPhotosPageParams struct {
+	TripID          uuid.UUID
+	BeforeCreatedAt pgtype.Timestamp
+	BeforeID        uuid.UUID
+	PageSize        int32
+}
+
+type GetTripPhotosPageRow struct {
+	ID              uuid.UUID
+	ParticipantID   uuid.UUID
+	Name            pgtype.Text
+	Email           string
+	Caption         pgtype.Text
+	FileName        string
+	ContentType     string
+	Size            int64
+	StorageKey      string
+	Width           pgtype.Int4
+	Height          pgtype.Int4
+	ThumbnailKey    pgtype.Text
+	ThumbnailStatus ThumbnailStatus
+	CreatedAt       pgtype.Timestamp
+	Comments        int64
+}
+
+func (q *Queries) GetTripPhotosPage(ctx context.Context, arg GetTripPhotosPageParams) ([]GetTripPhotosPageRow, error) {
+	rows, err := q.db.Query(ctx, getTripPhotosPage,
+		arg.TripID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripPhotosPageRow
+	for rows.Next() {
+		var i GetTripPhotosPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Caption,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.Width,
+			&i.Height,
+			&i.ThumbnailKey,
+			&i.ThumbnailStatus,
+			&i.CreatedAt,
+			&i.Comments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripPollOptions = `-- name: GetTripPollOptions :many
+SELECT
+    poll_options.id, poll_options.poll_id, poll_options.position, poll_options.destination,
+    poll_options.starts_at, poll_options.ends_at, count(poll_votes.participant_id) AS votes
+FROM poll_options
+JOIN polls ON polls.id = poll_options.poll_id
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE
+    polls.trip_id = $1
+GROUP BY
+    poll_options.id
+ORDER BY
+    poll_options.poll_id, poll_options.position
+`
+
+type GetTripPollOptionsRow struct {
+	ID          uuid.UUID
+	PollID      uuid.UUID
+	Position    int32
+	Destination pgtype.Text
+	StartsAt    pgtype.Timestamp
+	EndsAt      pgtype.Timestamp
+	Votes       int64
+}
+
+func (q *Queries) GetTripPollOptions(ctx context.Context, tripID uuid.UUID) ([]GetTripPollOptionsRow, error) {
+	rows, err := q.db.Query(ctx, getTripPollOptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripPollOptionsRow
+	for rows.Next() {
+		var i GetTripPollOptionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PollID,
+			&i.Position,
+			&i.Destination,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Votes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripPolls = `-- name: GetTripPolls :many
+SELECT
+    "id", "trip_id", "kind", "question", "applied_at", "created_at"
+FROM polls
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at
+`
+
+func (q *Queries) GetTripPolls(ctx context.Context, tripID uuid.UUID) ([]Poll, error) {
+	rows, err := q.db.Query(ctx, getTripPolls, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Poll
+	for rows.Next() {
+		var i Poll
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Kind,
+			&i.Question,
+			&i.AppliedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSettlementPayments = `-- name: GetTripSettlementPayments :many
+SELECT
+    "id", "trip_id", "from_participant_id", "to_participant_id", "amount", "currency", "paid_at"
+FROM settlement_payments
+WHERE
+    trip_id = $1
+ORDER BY
+    paid_at
+`
+
+func (q *Queries) GetTripSettlementPayments(ctx context.Context, tripID uuid.UUID) ([]SettlementPayment, error) {
+	rows, err := q.db.Query(ctx, getTripSettlementPayments, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SettlementPayment
+	for rows.Next() {
+		var i SettlementPayment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.FromParticipantID,
+			&i.ToParticipantID,
+			&i.Amount,
+			&i.Currency,
+			&i.PaidAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTags = `-- name: GetTripTags :many
+SELECT
+    tags.id, tags.name
+FROM tags
+JOIN trip_tags ON trip_tags.tag_id = tags.id
+WHERE
+    trip_tags.trip_id = $1
+ORDER BY
+    tags.name
+`
+
+func (q *Queries) GetTripTags(ctx context.Context, tripID uuid.UUID) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, getTripTags, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTasks = `-- name: GetTripTasks :many
+SELECT
+    "id", "trip_id", "title", "assignee_id", "due_at", "done", "reminded_at", "created_at"
+FROM tasks
+WHERE
+    trip_id = $1
+ORDER BY
+    done, due_at NULLS LAST, created_at, id
+`
+
+func (q *Queries) GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTripTasks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.AssigneeID,
+			&i.DueAt,
+			&i.Done,
+			&i.RemindedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTransportSegmentParticipants = `-- name: GetTripTransportSegmentParticipants :many
+SELECT
+    "segment_id", "trip_id", "participant_id"
+FROM transport_segment_participants
+WHERE
+    trip_id = $1
+ORDER BY
+    segment_id, participant_id
+`
+
+func (q *Queries) GetTripTransportSegmentParticipants(ctx context.Context, tripID uuid.UUID) ([]TransportSegmentParticipant, error) {
+	rows, err := q.db.Query(ctx, getTripTransportSegmentParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransportSegmentParticipant
+	for rows.Next() {
+		var i TransportSegmentParticipant
+		if err := rows.Scan(&i.SegmentID, &i.TripID, &i.ParticipantID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTransportSegments = `-- name: GetTripTransportSegments :many
+SELECT
+    "id", "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at", "created_at"
+FROM transport_segments
+WHERE
+    trip_id = $1
+ORDER BY
+    departure_at, id
+`
+
+func (q *Queries) GetTripTransportSegments(ctx context.Context, tripID uuid.UUID) ([]TransportSegment, error) {
+	rows, err := q.db.Query(ctx, getTripTransportSegments, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransportSegment
+	for rows.Next() {
+		var i TransportSegment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Mode,
+			&i.Carrier,
+			&i.Number,
+			&i.DeparturePlace,
+			&i.DepartureAt,
+			&i.ArrivalPlace,
+			&i.ArrivalAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripUndeliverableEmails = `-- name: GetTripUndeliverableEmails :many
+SELECT
+    undeliverable_emails.email, undeliverable_emails.issue
+FROM undeliverable_emails
+JOIN participants ON participants.email = undeliverable_emails.email
+WHERE
+    participants.trip_id = $1
+`
+
+type GetTripUndeliverableEmailsRow struct {
+	Email string
+	Issue EmailIssue
+}
+
+func (q *Queries) GetTripUndeliverableEmails(ctx context.Context, tripID uuid.UUID) ([]GetTripUndeliverableEmailsRow, error) {
+	rows, err := q.db.Query(ctx, getTripUndeliverableEmails, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripUndeliverableEmailsRow
+	for rows.Next() {
+		var i GetTripUndeliverableEmailsRow
+		if err := rows.Scan(&i.Email, &i.Issue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripWaitlist = `-- name: GetTripWaitlist :many
+SELECT
+    "id", "trip_id", "email", "created_at"
+FROM trip_waitlist
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at
+`
+
+func (q *Queries) GetTripWaitlist(ctx context.Context, tripID uuid.UUID) ([]TripWaitlist, error) {
+	rows, err := q.db.Query(ctx, getTripWaitlist, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TripWaitlist
+	for rows.Next() {
+		var i TripWaitlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripWebhooks = `-- name: GetTripWebhooks :many
+SELECT
+    "id", "trip_id", "url", "secret", "created_at"
+FROM webhooks
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at, id
+`
+
+func (q *Queries) GetTripWebhooks(ctx context.Context, tripID uuid.UUID) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, getTripWebhooks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Url,
+			&i.Secret,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT
+    "id", "trip_id", "url", "secret", "created_at"
+FROM webhooks
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetWebhook(ctx context.Context, id uuid.UUID) (Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhook, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Url,
+		&i.Secret,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT
+    webhook_deliveries.id, webhook_deliveries.event_id, domain_events.kind, domain_events.trip_id,
+    webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at,
+    webhook_deliveries.response_status, webhook_deliveries.last_error,
+    webhook_deliveries.created_at, webhook_deliveries.delivered_at
+FROM webhook_deliveries
+JOIN domain_events ON domain_events.id = webhook_deliveries.event_id
+WHERE
+    webhook_deliveries.webhook_id = $1
+ORDER BY
+    webhook_deliveries.created_at DESC, webhook_deliveries.id DESC
+LIMIT $2
+`
+
+type GetWebhookDeliveriesParams struct {
+	WebhookID uuid.UUID
+	Limit     int32
+}
+
+type GetWebhookDeliveriesRow struct {
+	ID             uuid.UUID
+	EventID        uuid.UUID
+	Kind           DomainEventKind
+	TripID         uuid.UUID
+	Status         WebhookDeliveryStatus
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamp
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	CreatedAt      pgtype.Timestamp
+	DeliveredAt    pgtype.Timestamp
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]GetWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, getWebhookDeliveries, arg.WebhookID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWebhookDeliveriesRow
+	for rows.Next() {
+		var i GetWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Kind,
+			&i.TripID,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertParticipant = `-- name: InsertParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+RETURNING "id"
+`
+
+type InsertParticipantParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) InsertParticipant(ctx context.Context, arg InsertParticipantParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertParticipant, arg.TripID, arg.Email)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertParticipantStatusChange = `-- name: InsertParticipantStatusChange :exec
+INSERT INTO participant_status_changes
+    ( "participant_id", "status", "reason" ) VALUES
+    ( $1, $2, $3 )
+`
+
+type InsertParticipantStatusChangeParams struct {
+	ParticipantID uuid.UUID
+	Status        ParticipantStatus
+	Reason        pgtype.Text
+}
+
+func (q *Queries) InsertParticipantStatusChange(ctx context.Context, arg InsertParticipantStatusChangeParams) error {
+	_, err := q.db.Exec(ctx, insertParticipantStatusChange, arg.ParticipantID, arg.Status, arg.Reason)
+	return err
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT
+INTO trips
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	Destination         string
+	OwnerEmail          string
+	OwnerName           string
+	StartsAt            pgtype.Timestamp
+	EndsAt              pgtype.Timestamp
+	Description         string
+	RsvpDeadline        pgtype.Timestamp
+	MaxGuests           int32
+	MaxParticipants     pgtype.Int4
+	PreTripReminderDays int32
+	Timezone            string
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Description,
+		arg.RsvpDeadline,
+		arg.MaxGuests,
+		arg.MaxParticipants,
+		arg.PreTripReminderDays,
+		arg.Timezone,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InviteParticipantsToTripParams struct {
+	TripID uuid.UUID
+	Email  string
+	Locale Locale
+}
+
+const isOptedOutOfNotification = `-- name: IsOptedOutOfNotification :one
+SELECT EXISTS (
+    SELECT 1 FROM notification_opt_outs
+    WHERE
+        participant_id = $1 AND kind = $2
+)
+`
+
+type IsOptedOutOfNotificationParams struct {
+	ParticipantID uuid.UUID
+	Kind          NotificationKind
+}
+
+func (q *Queries) IsOptedOutOfNotification(ctx context.Context, arg IsOptedOutOfNotificationParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isOptedOutOfNotification, arg.ParticipantID, arg.Kind)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isTripOwner = `-- name: IsTripOwner :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trip_owners
+    WHERE
+        trip_id = $1 AND email = $2
+)
+`
+
+type IsTripOwnerParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) IsTripOwner(ctx context.Context, arg IsTripOwnerParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTripOwner, arg.TripID, arg.Email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listTrips = `-- name: ListTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
+FROM trips
+WHERE
+    ($1::trip_status IS NULL OR status = $1)
+    AND ($2::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM trip_tags
+        JOIN tags ON tags.id = trip_tags.tag_id
+        WHERE
+            trip_tags.trip_id = trips.id AND tags.name = $2
+    ))
+    AND ($3::text IS NULL OR search @@ (websearch_to_tsquery('portuguese', $3) || websearch_to_tsquery('english', $3)))
+ORDER BY
+    starts_at
+`
+
+type ListTripsParams struct {
+	Status NullTripStatus
+	Tag    pgtype.Text
+	Query  pgtype.Text
+}
+
+func (q *Queries) ListTrips(ctx context.Context, arg ListTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTrips, arg.Status, arg.Tag, arg.Query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Description,
+			&i.Status,
+			&i.RsvpDeadline,
+			&i.MaxGuests,
+			&i.MaxParticipants,
+			&i.PreTripReminderDays,
+			&i.Timezone,
+			&i.Version,
+			&i.CreatedAt,
+			&i.Search,
+			&i.Latitude,
+			&i.Longitude,
+			&i.PlaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripsPage = `-- name: ListTripsPage :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
+FROM trips
+WHERE
+    (created_at, id) > ($1::timestamp, $2::uuid)
+    AND ($3::trip_status IS NULL OR status = $3)
+    AND ($4::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM trip_tags
+        JOIN tags ON tags.id = trip_tags.tag_id
+        WHERE
+            trip_tags.trip_id = trips.id AND tags.name = $4
+    ))
+    AND ($5::text IS NULL OR search @@ (websearch_to_tsquery('portuguese', $5) || websearch_to_tsquery('english', $5)))
+ORDER BY
+    created_at, id
+LIMIT $6::int
+`
+
+type ListTripsPageParams struct {
+	AfterCreatedAt pgtype.Timestamp
+	AfterID        uuid.UUID
+	Status         NullTripStatus
+	Tag            pgtype.Text
+	Query          pgtype.Text
+	PageSize       int32
+}
+
+func (q *Queries) ListTripsPage(ctx context.Context, arg ListTripsPageParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTripsPage,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Status,
+		arg.Tag,
+		arg.Query,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Description,
+			&i.Status,
+			&i.RsvpDeadline,
+			&i.MaxGuests,
+			&i.MaxParticipants,
+			&i.PreTripReminderDays,
+			&i.Timezone,
+			&i.Version,
+			&i.CreatedAt,
+			&i.Search,
+			&i.Latitude,
+			&i.Longitude,
+			&i.PlaceName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTripMaxParticipants = `-- name: LockTripMaxParticipants :one
+SELECT
+    "max_participants"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockTripMaxParticipants(ctx context.Context, id uuid.UUID) (pgtype.Int4, error) {
+	row := q.db.QueryRow(ctx, lockTripMaxParticipants, id)
+	var max_participants pgtype.Int4
+	err := row.Scan(&max_participants)
+	return max_participants, err
+}
+
+const markActivityReminderSent = `-- name: MarkActivityReminderSent :exec
+INSERT INTO activity_reminders
+    ( "activity_id", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("activity_id", "participant_id") DO NOTHING
+`
+
+type MarkActivityReminderSentParams struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) MarkActivityReminderSent(ctx context.Context, arg MarkActivityReminderSentParams) error {
+	_, err := q.db.Exec(ctx, markActivityReminderSent, arg.ActivityID, arg.ParticipantID)
+	return err
+}
+
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :exec
+UPDATE notifications
+SET
+    "read_at" = now()
+WHERE
+    participant_id = $1 AND read_at IS NULL
+`
+
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, participantID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markAllNotificationsRead, participantID)
+	return err
+}
+
+const markDailyDigestSent = `-- name: MarkDailyDigestSent :exec
+INSERT INTO daily_digests
+    ( "participant_id", "day" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("participant_id", "day") DO NOTHING
+`
+
+type MarkDailyDigestSentParams struct {
+	ParticipantID uuid.UUID
+	Day           pgtype.Date
+}
+
+func (q *Queries) MarkDailyDigestSent(ctx context.Context, arg MarkDailyDigestSentParams) error {
+	_, err := q.db.Exec(ctx, markDailyDigestSent, arg.ParticipantID, arg.Day)
+	return err
+}
+
+const markDomainEventFailed = `-- name: MarkDomainEventFailed :exec
+UPDATE domain_events
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    last_error = $3
+WHERE
+    id = $4
+`
+
+type MarkDomainEventFailedParams struct {
+	Status        DomainEventStatus
+	NextAttemptAt pgtype.Timestamp
+	LastError     pgtype.Text
+	ID            uuid.UUID
+}
+
+func (q *Queries) MarkDomainEventFailed(ctx context.Context, arg MarkDomainEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markDomainEventFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const markDomainEventPublished = `-- name: MarkDomainEventPublished :exec
+UPDATE domain_events
+SET
+    status = 'published',
+    attempts = attempts + 1,
+    published_at = $1
+WHERE
+    id = $2
+`
+
+type MarkDomainEventPublishedParams struct {
+	PublishedAt pgtype.Timestamp
+	ID          uuid.UUID
+}
+
+func (q *Queries) MarkDomainEventPublished(ctx context.Context, arg MarkDomainEventPublishedParams) error {
+	_, err := q.db.Exec(ctx, markDomainEventPublished, arg.PublishedAt, arg.ID)
+	return err
+}
+
+const markEmailFailed = `-- name: MarkEmailFailed :exec
+UPDATE email_outbox
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    last_error = $3
+WHERE
+    id = $4
+`
+
+type MarkEmailFailedParams struct {
+	Status        EmailStatus
+	NextAttemptAt pgtype.Timestamp
+	LastError     pgtype.Text
+	ID            uuid.UUID
+}
+
+func (q *Queries) MarkEmailFailed(ctx context.Context, arg MarkEmailFailedParams) error {
+	_, err := q.db.Exec(ctx, markEmailFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET
+    status = 'sent',
+    attempts = attempts + 1,
+    sent_at = $1
+WHERE
+    id = $2
+`
+
+type MarkEmailSentParams struct {
+	SentAt pgtype.Timestamp
+	ID     uuid.UUID
+}
+
+func (q *Queries) MarkEmailSent(ctx context.Context, arg MarkEmailSentParams) error {
+	_, err := q.db.Exec(ctx, markEmailSent, arg.SentAt, arg.ID)
+	return err
+}
+
+const markEmailUndeliverable = `-- name: MarkEmailUndeliverable :exec
+INSERT INTO undeliverable_emails
+    ( "email", "issue", "detail" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("email") DO UPDATE
+SET
+    "issue" = excluded.issue,
+    "detail" = excluded.detail,
+    "reported_at" = now()
+`
+
+type MarkEmailUndeliverableParams struct {
+	Email  string
+	Issue  EmailIssue
+	Detail pgtype.Text
+}
+
+func (q *Queries) MarkEmailUndeliverable(ctx context.Context, arg MarkEmailUndeliverableParams) error {
+	_, err := q.db.Exec(ctx, markEmailUndeliverable, arg.Email, arg.Issue, arg.Detail)
+	return err
+}
+
+const markMessagesRead = `-- name: MarkMessagesRead :exec
+INSERT INTO message_reads
+    ( "participant_id", "trip_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "read_at" = now()
+`
+
+type MarkMessagesReadParams struct {
+	ParticipantID uuid.UUID
+	TripID        uuid.UUID
+}
+
+func (q *Queries) MarkMessagesRead(ctx context.Context, arg MarkMessagesReadParams) error {
+	_, err := q.db.Exec(ctx, markMessagesRead, arg.ParticipantID, arg.TripID)
+	return err
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :execrows
+UPDATE notifications
+SET
+    "read_at" = COALESCE(read_at, now())
+WHERE
+    id = $1 AND participant_id = $2
+`
+
+type MarkNotificationReadParams struct {
+	ID            uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markNotificationRead, arg.ID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markParticipantEmailVerified = `-- name: MarkParticipantEmailVerified :exec
+UPDATE participants
+SET
+    "email_verified_at" = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkParticipantEmailVerified(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markParticipantEmailVerified, id)
+	return err
+}
+
+const markParticipantReinvited = `-- name: MarkParticipantReinvited :execrows
+UPDATE participants
+SET
+    "invited_at" = now()
+WHERE
+    id = $1 AND invited_at <= $2
+`
+
+type MarkParticipantReinvitedParams struct {
+	ID        uuid.UUID
+	InvitedAt pgtype.Timestamp
+}
+
+func (q *Queries) MarkParticipantReinvited(ctx context.Context, arg MarkParticipantReinvitedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markParticipantReinvited, arg.ID, arg.InvitedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markPollApplied = `-- name: MarkPollApplied :execrows
+UPDATE polls
+SET
+    "applied_at" = now()
+WHERE
+    id = $1 AND applied_at IS NULL
+`
+
+func (q *Queries) MarkPollApplied(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, markPollApplied, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markPreTripReminderSent = `-- name: MarkPreTripReminderSent :exec
+UPDATE participants
+SET
+    "pre_trip_reminded_at" = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkPreTripReminderSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markPreTripReminderSent, id)
+	return err
+}
+
+const markRSVPReminderSent = `-- name: MarkRSVPReminderSent :exec
+UPDATE participants
+SET
+    "rsvp_reminded_at" = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkRSVPReminderSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markRSVPReminderSent, id)
+	return err
+}
+
+const markTaskReminderSent = `-- name: MarkTaskReminderSent :exec
+UPDATE tasks
+SET
+    "reminded_at" = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkTaskReminderSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markTaskReminderSent, id)
+	return err
+}
+
+const markThumbnailFailed = `-- name: MarkThumbnailFailed :exec
+UPDATE photos
+SET
+    thumbnail_status = $1,
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_next_attempt_at = $2
+WHERE
+    id = $3
+`
+
+type MarkThumbnailFailedParams struct {
+	ThumbnailStatus        ThumbnailStatus
+	ThumbnailNextAttemptAt pgtype.Timestamp
+	ID                     uuid.UUID
+}
+
+func (q *Queries) MarkThumbnailFailed(ctx context.Context, arg MarkThumbnailFailedParams) error {
+	_, err := q.db.Exec(ctx, markThumbnailFailed, arg.ThumbnailStatus, arg.ThumbnailNextAttemptAt, arg.ID)
+	return err
+}
+
+const markThumbnailReady = `-- name: MarkThumbnailReady :exec
+UPDATE photos
+SET
+    thumbnail_status = 'ready',
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_key = $1,
+    width = $2,
+    height = $3
+WHERE
+    id = $4
+`
+
+type MarkThumbnailReadyParams struct {
+	ThumbnailKey pgtype.Text
+	Width        pgtype.Int4
+	Height       pgtype.Int4
+	ID           uuid.UUID
+}
+
+func (q *Queries) MarkThumbnailReady(ctx context.Context, arg MarkThumbnailReadyParams) error {
+	_, err := q.db.Exec(ctx, markThumbnailReady,
+		arg.ThumbnailKey,
+		arg.Width,
+		arg.Height,
+		arg.ID,
+	)
+	return err
+}
+
+const markWebhookDelivered = `-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    attempts = attempts + 1,
+    response_status = $1,
+    last_error = NULL,
+    delivered_at = $2
+WHERE
+    id = $3
+`
+
+type MarkWebhookDeliveredParams struct {
+	ResponseStatus pgtype.Int4
+	DeliveredAt    pgtype.Timestamp
+	ID             uuid.UUID
+}
+
+func (q *Queries) MarkWebhookDelivered(ctx context.Context, arg MarkWebhookDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDelivered, arg.ResponseStatus, arg.DeliveredAt, arg.ID)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    response_status = $3,
+    last_error = $4
+WHERE
+    id = $5
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	Status         WebhookDeliveryStatus
+	NextAttemptAt  pgtype.Timestamp
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	ID             uuid.UUID
+}
+
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed,
+		arg.Status,
+		arg.NextAttemptAt,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.ID,
+	)
+	return err
+}
+
+const optInToNotification = `-- name: OptInToNotification :exec
+DELETE FROM notification_opt_outs
+WHERE
+    participant_id = $1 AND kind = $2
+`
+
+type OptInToNotificationParams struct {
+	ParticipantID uuid.UUID
+	Kind          NotificationKind
+}
+
+func (q *Queries) OptInToNotification(ctx context.Context, arg OptInToNotificationParams) error {
+	_, err := q.db.Exec(ctx, optInToNotification, arg.ParticipantID, arg.Kind)
+	return err
+}
+
+const optOutOfNotification = `-- name: OptOutOfNotification :exec
+INSERT INTO notification_opt_outs
+    ( "participant_id", "kind" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("participant_id", "kind") DO NOTHING
+`
+
+type OptOutOfNotificationParams struct {
+	ParticipantID uuid.UUID
+	Kind          NotificationKind
+}
+
+func (q *Queries) OptOutOfNotification(ctx context.Context, arg OptOutOfNotificationParams) error {
+	_, err := q.db.Exec(ctx, optOutOfNotification, arg.ParticipantID, arg.Kind)
+	return err
+}
+
+const popTripWaitlist = `-- name: PopTripWaitlist :one
+DELETE FROM trip_waitlist
+WHERE
+    id = (
+        SELECT id FROM trip_waitlist AS next
+        WHERE next.trip_id = $1
+        ORDER BY next.created_at
+        LIMIT 1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "email"
+`
+
+func (q *Queries) PopTripWaitlist(ctx context.Context, tripID uuid.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, popTripWaitlist, tripID)
+	var email string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const recordDomainEvent = `-- name: RecordDomainEvent :exec
+INSERT INTO domain_events
+    ( "kind", "trip_id", "payload" ) VALUES
+    ( $1, $2, $3 )
+`
+
+type RecordDomainEventParams struct {
+	Kind    DomainEventKind
+	TripID  uuid.UUID
+	Payload []byte
+}
+
+func (q *Queries) RecordDomainEvent(ctx context.Context, arg RecordDomainEventParams) error {
+	_, err := q.db.Exec(ctx, recordDomainEvent, arg.Kind, arg.TripID, arg.Payload)
+	return err
+}
+
+const recordInvitationSent = `-- name: RecordInvitationSent :exec
+INSERT INTO participant_invitations
+    ( "participant_id" )
+SELECT
+    id
+FROM participants
+WHERE
+    trip_id = $1 AND email = $2
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "sent_at" = now()
+`
+
+type RecordInvitationSentParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) RecordInvitationSent(ctx context.Context, arg RecordInvitationSentParams) error {
+	_, err := q.db.Exec(ctx, recordInvitationSent, arg.TripID, arg.Email)
+	return err
+}
+
+const recordLinkClick = `-- name: RecordLinkClick :exec
+INSERT INTO link_clicks
+    ( "link_id" ) VALUES
+    ( $1 )
+`
+
+func (q *Queries) RecordLinkClick(ctx context.Context, linkID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, recordLinkClick, linkID)
+	return err
+}
+
+const removeTagFromTrip = `-- name: RemoveTagFromTrip :exec
+DELETE FROM trip_tags
+WHERE
+    trip_id = $1 AND tag_id = $2
+`
+
+type RemoveTagFromTripParams struct {
+	TripID uuid.UUID
+	TagID  uuid.UUID
+}
+
+func (q *Queries) RemoveTagFromTrip(ctx context.Context, arg RemoveTagFromTripParams) error {
+	_, err := q.db.Exec(ctx, removeTagFromTrip, arg.TripID, arg.TagID)
+	return err
+}
+
+const removeTripOwner = `-- name: RemoveTripOwner :exec
+DELETE FROM trip_owners
+WHERE
+    trip_id = $1 AND email = $2
+`
+
+type RemoveTripOwnerParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) RemoveTripOwner(ctx context.Context, arg RemoveTripOwnerParams) error {
+	_, err := q.db.Exec(ctx, removeTripOwner, arg.TripID, arg.Email)
+	return err
+}
+
+const resetRSVPReminders = `-- name: ResetRSVPReminders :exec
+UPDATE participants
+SET
+    "rsvp_reminded_at" = NULL,
+    "no_response_at" = NULL
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) ResetRSVPReminders(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, resetRSVPReminders, tripID)
+	return err
+}
+
+const restoreActivity = `-- name: RestoreActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+`
+
+type RestoreActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) RestoreActivity(ctx context.Context, arg RestoreActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreActivityComment = `-- name: RestoreActivityComment :execrows
+UPDATE activity_comments
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND activity_id = $2 AND participant_id = $3 AND deleted_at IS NOT NULL
+`
+
+type RestoreActivityCommentParams struct {
+	ID            uuid.UUID
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) RestoreActivityComment(ctx context.Context, arg RestoreActivityCommentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreActivityComment, arg.ID, arg.ActivityID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreTripLink = `-- name: RestoreTripLink :execrows
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+`
+
+type RestoreTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) RestoreTripLink(ctx context.Context, arg RestoreTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreTripLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeTripShares = `-- name: RevokeTripShares :exec
+UPDATE trip_shares
+SET
+    "revoked_at" = now()
+WHERE
+    trip_id = $1 AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeTripShares(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, revokeTripShares, tripID)
+	return err
+}
+
+const searchTrip = `-- name: SearchTrip :many
+SELECT
+    'activity'::text AS kind, activities.id, activities.title AS match
+FROM activities
+WHERE
+    activities.trip_id = $1 AND activities.deleted_at IS NULL
+    AND activities.search @@ (websearch_to_tsquery('portuguese', $2) || websearch_to_tsquery('english', $2))
+UNION ALL
+SELECT
+    'link'::text AS kind, links.id, links.title AS match
+FROM links
+WHERE
+    links.trip_id = $1 AND links.deleted_at IS NULL AND links.title ILIKE $3
+UNION ALL
+SELECT
+    'participant'::text AS kind, participants.id, participants.email AS match
+FROM participants
+WHERE
+    participants.trip_id = $1 AND participants.email ILIKE $3
+ORDER BY
+    kind, match
+LIMIT $4
+`
+
+type SearchTripParams struct {
+	TripID     uuid.UUID
+	Query      string
+	Pattern    string
+	MaxResults int32
+}
+
+type SearchTripRow struct {
+	Kind  string
+	ID    uuid.UUID
+	Match string
+}
+
+func (q *Queries) SearchTrip(ctx context.Context, arg SearchTripParams) ([]SearchTripRow, error) {
+	rows, err := q.db.Query(ctx, searchTrip,
+		arg.TripID,
+		arg.Query,
+		arg.Pattern,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchTripRow
+	for rows.Next() {
+		var i SearchTripRow
+		if err := rows.Scan(&i.Kind, &i.ID, &i.Match); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setLinkPreview = `-- name: SetLinkPreview :exec
+UPDATE links
+SET
+    "preview_title" = $1,
+    "preview_description" = $2,
+    "preview_favicon_url" = $3,
+    "preview_image_url" = $4,
+    "preview_fetched_at" = now()
+WHERE
+    id = $5
+`
+
+type SetLinkPreviewParams struct {
+	PreviewTitle       pgtype.Text
+	PreviewDescription pgtype.Text
+	PreviewFaviconUrl  pgtype.Text
+	PreviewImageUrl    pgtype.Text
+	ID                 uuid.UUID
+}
+
+func (q *Queries) SetLinkPreview(ctx context.Context, arg SetLinkPreviewParams) error {
+	_, err := q.db.Exec(ctx, setLinkPreview,
+		arg.PreviewTitle,
+		arg.PreviewDescription,
+		arg.PreviewFaviconUrl,
+		arg.PreviewImageUrl,
+		arg.ID,
+	)
+	return err
+}
+
+const setTripJoinCode = `-- name: SetTripJoinCode :exec
+INSERT INTO trip_join_codes
+    ( "trip_id", "code" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "code" = EXCLUDED.code,
+    "created_at" = now()
+`
+
+type SetTripJoinCodeParams struct {
+	TripID uuid.UUID
+	Code   string
+}
+
+func (q *Queries) SetTripJoinCode(ctx context.Context, arg SetTripJoinCodeParams) error {
+	_, err := q.db.Exec(ctx, setTripJoinCode, arg.TripID, arg.Code)
+	return err
+}
+
+const unconfirmParticipant = `-- name: UnconfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "guests" = 0
+WHERE
+    id = $1
+`
+
+func (q *Queries) UnconfirmParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, unconfirmParticipant, id)
+	return err
+}
+
+const unvoteActivity = `-- name: UnvoteActivity :execrows
+DELETE FROM activity_votes
+WHERE
+    activity_id = $1 AND participant_id = $2
+`
+
+type UnvoteActivityParams struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) UnvoteActivity(ctx context.Context, arg UnvoteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unvoteActivity, arg.ActivityID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateActivity = `-- name: UpdateActivity :exec
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "ends_at" = $3,
+    "address" = $4,
+    "latitude" = $5,
+    "longitude" = $6,
+    "category" = $7,
+    "place_name" = $8
+WHERE
+    id = $9 AND deleted_at IS NULL
+`
+
+type UpdateActivityParams struct {
+	Title     string
+	OccursAt  pgtype.Timestamp
+	EndsAt    pgtype.Timestamp
+	Address   pgtype.Text
+	Latitude  pgtype.Float8
+	Longitude pgtype.Float8
+	Category  ActivityCategory
+	PlaceName pgtype.Text
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) error {
+	_, err := q.db.Exec(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.EndsAt,
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Category,
+		arg.PlaceName,
+		arg.ID,
+	)
+	return err
+}
+
+const updateActivityPosition = `-- name: UpdateActivityPosition :execrows
+UPDATE activities
+SET
+    "position" = $1
+WHERE
+    id = $2 AND trip_id = $3 AND deleted_at IS NULL
+`
+
+type UpdateActivityPositionParams struct {
+	Position int32
+	ID       uuid.UUID
+	TripID   uuid.UUID
+}
+
+func (q *Queries) UpdateActivityPosition(ctx context.Context, arg UpdateActivityPositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivityPosition, arg.Position, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateBooking = `-- name: UpdateBooking :execrows
+UPDATE bookings
+SET
+    "type" = $1,
+    "name" = $2,
+    "check_in_at" = $3,
+    "check_out_at" = $4,
+    "address" = $5,
+    "confirmation_code" = $6,
+    "price" = $7,
+    "currency" = $8,
+    "url" = $9
+WHERE
+    id = $10 AND trip_id = $11
+`
+
+type UpdateBookingParams struct {
+	Type             BookingType
+	Name             string
+	CheckInAt        pgtype.Timestamp
+	CheckOutAt       pgtype.Timestamp
+	Address          pgtype.Text
+	ConfirmationCode pgtype.Text
+	Price            pgtype.Int8
+	Currency         pgtype.Text
+	Url              pgtype.Text
+	ID               uuid.UUID
+	TripID           uuid.UUID
+}
+
+func (q *Queries) UpdateBooking(ctx context.Context, arg UpdateBookingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateBooking,
+		arg.Type,
+		arg.Name,
+		arg.CheckInAt,
+		arg.CheckOutAt,
+		arg.Address,
+		arg.ConfirmationCode,
+		arg.Price,
+		arg.Currency,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateJournalEntry = `-- name: UpdateJournalEntry :execrows
+UPDATE journal_entries
+SET
+    "day" = $4,
+    "title" = $5,
+    "body" = $6,
+    "visibility" = $7,
+    "updated_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3
+`
+
+type UpdateJournalEntryParams struct {
+	ID            uuid.UUID
+	TripID        uuid.UUID
+	ParticipantID uuid.UUID
+	Day           pgtype.Date
+	Title         pgtype.Text
+	Body          string
+	Visibility    JournalVisibility
+}
+
+func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateJournalEntry,
+		arg.ID,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Day,
+		arg.Title,
+		arg.Body,
+		arg.Visibility,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateLinkPosition = `-- name: UpdateLinkPosition :execrows
+UPDATE links
+SET
+    "position" = $1
+WHERE
+    id = $2 AND trip_id = $3 AND deleted_at IS NULL
+`
+
+type UpdateLinkPositionParams struct {
+	Position int32
+	ID       uuid.UUID
+	TripID   uuid.UUID
+}
+
+func (q *Queries) UpdateLinkPosition(ctx context.Context, arg UpdateLinkPositionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLinkPosition, arg.Position, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePackingItem = `-- name: UpdatePackingItem :execrows
+UPDATE packing_items
+SET
+    "name" = $1,
+    "quantity" = $2,
+    "assignee_id" = $3,
+    "checked" = $4
+WHERE
+    id = $5 AND trip_id = $6
+`
+
+type UpdatePackingItemParams struct {
+	Name       string
+	Quantity   int32
+	AssigneeID pgtype.UUID
+	Checked    bool
+	ID         uuid.UUID
+	TripID     uuid.UUID
+}
+
+func (q *Queries) UpdatePackingItem(ctx context.Context, arg UpdatePackingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePackingItem,
+		arg.Name,
+		arg.Quantity,
+		arg.AssigneeID,
+		arg.Checked,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateParticipantProfile = `-- name: UpdateParticipantProfile :exec
+UPDATE participants
+SET
+    "name" = COALESCE($1, "name"),
+    "phone" = COALESCE($2, "phone"),
+    "avatar_url" = COALESCE($3, "avatar_url"),
+    "locale" = COALESCE($4, "locale")
+WHERE
+    id = $5
+`
+
+type UpdateParticipantProfileParams struct {
+	Name      pgtype.Text
+	Phone     pgtype.Text
+	AvatarUrl pgtype.Text
+	Locale    NullLocale
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateParticipantProfile(ctx context.Context, arg UpdateParticipantProfileParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantProfile,
+		arg.Name,
+		arg.Phone,
+		arg.AvatarUrl,
+		arg.Locale,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTag = `-- name: UpdateTag :exec
+UPDATE tags
+SET
+    "name" = $1
+WHERE
+    id = $2
+`
+
+type UpdateTagParams struct {
+	Name string
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateTag(ctx context.Context, arg UpdateTagParams) error {
+	_, err := q.db.Exec(ctx, updateTag, arg.Name, arg.ID)
+	return err
+}
+
+const updateTask = `-- name: UpdateTask :execrows
+UPDATE tasks
+SET
+    "title" = $1,
+    "assignee_id" = $2,
+    "due_at" = $3,
+    "done" = $4,
+    "reminded_at" = CASE
+        WHEN assignee_id IS DISTINCT FROM $2 OR due_at IS DISTINCT FROM $3 THEN NULL
+        ELSE reminded_at
+    END
+WHERE
+    id = $5 AND trip_id = $6
+`
+
+type UpdateTaskParams struct {
+	Title      string
+	AssigneeID pgtype.UUID
+	DueAt      pgtype.Timestamp
+	Done       bool
+	ID         uuid.UUID
+	TripID     uuid.UUID
+}
+
+func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTask,
+		arg.Title,
+		arg.AssigneeID,
+		arg.DueAt,
+		arg.Done,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTransportSegment = `-- name: UpdateTransportSegment :execrows
+UPDATE transport_segments
+SET
+    "mode" = $1,
+    "carrier" = $2,
+    "number" = $3,
+    "departure_place" = $4,
+    "departure_at" = $5,
+    "arrival_place" = $6,
+    "arrival_at" = $7
+WHERE
+    id = $8 AND trip_id = $9
+`
+
+type UpdateTransportSegmentParams struct {
+	Mode           TransportMode
+	Carrier        string
+	Number         pgtype.Text
+	DeparturePlace string
+	DepartureAt    pgtype.Timestamp
+	ArrivalPlace   string
+	ArrivalAt      pgtype.Timestamp
+	ID             uuid.UUID
+	TripID         uuid.UUID
+}
+
+func (q *Queries) UpdateTransportSegment(ctx context.Context, arg UpdateTransportSegmentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTransportSegment,
+		arg.Mode,
+		arg.Carrier,
+		arg.Number,
+		arg.DeparturePlace,
+		arg.DepartureAt,
+		arg.ArrivalPlace,
+		arg.ArrivalAt,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTrip = `-- name: UpdateTrip :execrows
+UPDATE trips
+SET 
+    "destination" = $1,
+    "ends_at" = $2,
+    "starts_at" = $3,
+    "description" = $4,
+    "version" = version + 1
+WHERE
+    id = $5 AND version = $6
+`
+
+type UpdateTripParams struct {
+	Destination string
+	EndsAt      pgtype.Timestamp
+	StartsAt    pgtype.Timestamp
+	Description string
+	ID          uuid.UUID
+	Version     int32
+}
+
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTrip,
+		arg.Destination,
+		arg.EndsAt,
+		arg.StartsAt,
+		arg.Description,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripExpense = `-- name: UpdateTripExpense :execrows
+UPDATE expenses
+SET
+    "payer_id" = $1,
+    "activity_id" = $2,
+    "description" = $3,
+    "amount" = $4,
+    "currency" = $5,
+    "category" = $6,
+    "spent_at" = $7
+WHERE
+    id = $8 AND trip_id = $9
+`
+
+type UpdateTripExpenseParams struct {
+	PayerID     uuid.UUID
+	ActivityID  pgtype.UUID
+	Description string
+	Amount      int64
+	Currency    string
+	Category    ExpenseCategory
+	SpentAt     pgtype.Timestamp
+	ID          uuid.UUID
+	TripID      uuid.UUID
+}
+
+func (q *Queries) UpdateTripExpense(ctx context.Context, arg UpdateTripExpenseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripExpense,
+		arg.PayerID,
+		arg.ActivityID,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.Category,
+		arg.SpentAt,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripLink = `-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "category" = $3
+WHERE
+    id = $4 AND trip_id = $5 AND deleted_at IS NULL
+`
+
+type UpdateTripLinkParams struct {
+	Title    string
+	Url      string
+	Category LinkCategory
+	ID       uuid.UUID
+	TripID   uuid.UUID
+}
+
+func (q *Queries) UpdateTripLink(ctx context.Context, arg UpdateTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripLink,
+		arg.Title,
+		arg.Url,
+		arg.Category,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripLinkPartial = `-- name: UpdateTripLinkPartial :execrows
+UPDATE links
+SET
+    "title" = COALESCE($1, "title"),
+    "url" = COALESCE($2, "url"),
+    "category" = COALESCE($3, "category"),
+    "pinned" = COALESCE($4, "pinned")
+WHERE
+    id = $5 AND trip_id = $6 AND deleted_at IS NULL
+`
+
+type UpdateTripLinkPartialParams struct {
+	Title    pgtype.Text
+	Url      pgtype.Text
+	Category NullLinkCategory
+	Pinned   pgtype.Bool
+	ID       uuid.UUID
+	TripID   uuid.UUID
+}
+
+func (q *Queries) UpdateTripLinkPartial(ctx context.Context, arg UpdateTripLinkPartialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripLinkPartial,
+		arg.Title,
+		arg.Url,
+		arg.Category,
+		arg.Pinned,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripPartial = `-- name: UpdateTripPartial :execrows
+UPDATE trips
+SET
+    "destination" = COALESCE($1, "destination"),
+    "ends_at" = COALESCE($2, "ends_at"),
+    "starts_at" = COALESCE($3, "starts_at"),
+    "description" = COALESCE($4, "description"),
+    "rsvp_deadline" = CASE WHEN $5::boolean THEN NULL ELSE COALESCE($6, "rsvp_deadline") END,
+    "max_guests" = COALESCE($7, "max_guests"),
+    "max_participants" = CASE WHEN $8::boolean THEN NULL ELSE COALESCE($9, "max_participants") END,
+    "pre_trip_reminder_days" = COALESCE($10, "pre_trip_reminder_days"),
+    "timezone" = COALESCE($11, "timezone"),
+    "version" = version + 1
+WHERE
+    id = $12 AND version = $13
+`
+
+type UpdateTripPartialParams struct {
+	Destination          pgtype.Text
+	EndsAt               pgtype.Timestamp
+	StartsAt             pgtype.Timestamp
+	Description          pgtype.Text
+	ClearRsvpDeadline    bool
+	RsvpDeadline         pgtype.Timestamp
+	MaxGuests            pgtype.Int4
+	ClearMaxParticipants bool
+	MaxParticipants      pgtype.Int4
+	PreTripReminderDays  pgtype.Int4
+	Timezone             pgtype.Text
+	ID                   uuid.UUID
+	Version              int32
+}
+
+func (q *Queries) UpdateTripPartial(ctx context.Context, arg UpdateTripPartialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripPartial,
+		arg.Destination,
+		arg.EndsAt,
+		arg.StartsAt,
+		arg.Description,
+		arg.ClearRsvpDeadline,
+		arg.RsvpDeadline,
+		arg.MaxGuests,
+		arg.ClearMaxParticipants,
+		arg.MaxParticipants,
+		arg.PreTripReminderDays,
+		arg.Timezone,
+		arg.ID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripPlace = `-- name: UpdateTripPlace :exec
+UPDATE trips
+SET
+    "latitude" = $1,
+    "longitude" = $2,
+    "place_name" = $3
+WHERE
+    id = $4
+`
+
+type UpdateTripPlaceParams struct {
+	Latitude  pgtype.Float8
+	Longitude pgtype.Float8
+	PlaceName pgtype.Text
+	ID        uuid.UUID
+}
+
+func (q *Queries) UpdateTripPlace(ctx context.Context, arg UpdateTripPlaceParams) error {
+	_, err := q.db.Exec(ctx, updateTripPlace,
+		arg.Latitude,
+		arg.Longitude,
+		arg.PlaceName,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTripStatus = `-- name: UpdateTripStatus :exec
+UPDATE trips
+SET
+    "status" = $1,
+    "version" = version + 1
+WHERE
+    id = $2
+`
+
+type UpdateTripStatusParams struct {
+	Status TripStatus
+	ID     uuid.UUID
+}
+
+func (q *Queries) UpdateTripStatus(ctx context.Context, arg UpdateTripStatusParams) error {
+	_, err := q.db.Exec(ctx, updateTripStatus, arg.Status, arg.ID)
+	return err
+}
+
+const updateTripStatusFrom = `-- name: UpdateTripStatusFrom :execrows
+UPDATE trips
+SET
+    "status" = $1,
+    "version" = version + 1
+WHERE
+    id = $2 AND status = $3
+`
+
+type UpdateTripStatusFromParams struct {
+	Status     TripStatus
+	ID         uuid.UUID
+	FromStatus TripStatus
+}
+
+func (q *Queries) UpdateTripStatusFrom(ctx context.Context, arg UpdateTripStatusFromParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripStatusFrom, arg.Status, arg.ID, arg.FromStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertActivityRSVP = `-- name: UpsertActivityRSVP :exec
+INSERT INTO activity_attendees
+    ( "activity_id", "participant_id", "attending" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("activity_id", "participant_id") DO UPDATE
+SET
+    "attending" = EXCLUDED.attending,
+    "updated_at" = now()
+`
+
+type UpsertActivityRSVPParams struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+	Attending     bool
+}
+
+func (q *Queries) UpsertActivityRSVP(ctx context.Context, arg UpsertActivityRSVPParams) error {
+	_, err := q.db.Exec(ctx, upsertActivityRSVP, arg.ActivityID, arg.ParticipantID, arg.Attending)
+	return err
+}
+
+const upsertFlightStatus = `-- name: UpsertFlightStatus :exec
+INSERT INTO flight_statuses
+    ( "segment_id", "departure_at", "gate" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (segment_id) DO UPDATE
+SET
+    "departure_at" = EXCLUDED.departure_at,
+    "gate" = EXCLUDED.gate,
+    "checked_at" = now()
+`
+
+type UpsertFlightStatusParams struct {
+	SegmentID   uuid.UUID
+	DepartureAt pgtype.Timestamp
+	Gate        pgtype.Text
+}
+
+func (q *Queries) UpsertFlightStatus(ctx context.Context, arg UpsertFlightStatusParams) error {
+	_, err := q.db.Exec(ctx, upsertFlightStatus, arg.SegmentID, arg.DepartureAt, arg.Gate)
+	return err
+}
+
+const voteActivity = `-- name: VoteActivity :exec
+INSERT INTO activity_votes
+    ( "activity_id", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("activity_id", "participant_id") DO NOTHING
+`
+
+type VoteActivityParams struct {
+	ActivityID    uuid.UUID
+	ParticipantID uuid.UUID
+}
+
+func (q *Queries) VoteActivity(ctx context.Context, arg VoteActivityParams) error {
+	_, err := q.db.Exec(ctx, voteActivity, arg.ActivityID, arg.ParticipantID)
+	return err
+}
+
+const votePoll = `-- name: VotePoll :exec
+INSERT INTO poll_votes
+    ( "poll_id", "participant_id", "option_id" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (poll_id, participant_id) DO UPDATE
+SET
+    "option_id" = EXCLUDED.option_id,
+    "voted_at" = now()
+`
+
+type VotePollParams struct {
+	PollID        uuid.UUID
+	ParticipantID uuid.UUID
+	OptionID      uuid.UUID
+}
+
+func (q *Queries) VotePoll(ctx context.Context, arg VotePollParams) error {
+	_, err := q.db.Exec(ctx, votePoll, arg.PollID, arg.ParticipantID, arg.OptionID)
+	return err
+}
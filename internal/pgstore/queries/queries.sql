@@ -2,85 +2,1695 @@
 -- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
 FROM trips
 WHERE
     id = $1;
 
--- name: UpdateTrip :exec
+-- name: GetTripAggregate :one
+SELECT
+    trips."id", trips."destination", trips."owner_email", trips."owner_name", trips."starts_at", trips."ends_at", trips."description", trips."status", trips."rsvp_deadline", trips."max_guests", trips."max_participants", trips."pre_trip_reminder_days", trips."timezone", trips."version", trips."created_at", trips."search", trips."latitude", trips."longitude", trips."place_name",
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', p.id,
+            'TripID', p.trip_id,
+            'Email', p.email,
+            'IsConfirmed', p.is_confirmed,
+            'DeclinedAt', to_char(p.declined_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'DeclineReason', p.decline_reason,
+            'InvitedAt', to_char(p.invited_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Name', p.name,
+            'Phone', p.phone,
+            'AvatarUrl', p.avatar_url,
+            'RsvpRemindedAt', to_char(p.rsvp_reminded_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'NoResponseAt', to_char(p.no_response_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Guests', p.guests,
+            'Locale', p.locale,
+            'PreTripRemindedAt', to_char(p.pre_trip_reminded_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'EmailVerifiedAt', to_char(p.email_verified_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'CreatedAt', to_char(p.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+        ))
+        FROM participants AS p
+        WHERE p.trip_id = trips.id
+    ), '[]')::json AS participants,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', a.id,
+            'TripID', a.trip_id,
+            'Title', a.title,
+            'OccursAt', to_char(a.occurs_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'EndsAt', to_char(a.ends_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Address', a.address,
+            'Latitude', a.latitude,
+            'Longitude', a.longitude,
+            'PlaceName', a.place_name,
+            'Category', a.category,
+            'Position', a.position,
+            'CreatedAt', to_char(a.created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+        ) ORDER BY a.occurs_at, a.position, a.id)
+        FROM activities AS a
+        WHERE a.trip_id = trips.id AND a.deleted_at IS NULL
+    ), '[]')::json AS activities,
+    COALESCE((
+        SELECT json_agg(json_build_object(
+            'ID', l.id,
+            'TripID', l.trip_id,
+            'Title', l.title,
+            'Url', l.url,
+            'Category', l.category,
+            'PreviewTitle', l.preview_title,
+            'PreviewDescription', l.preview_description,
+            'PreviewFaviconUrl', l.preview_favicon_url,
+            'PreviewImageUrl', l.preview_image_url,
+            'PreviewFetchedAt', to_char(l.preview_fetched_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'),
+            'Pinned', l.pinned,
+            'Position', l.position
+        ) ORDER BY l.category, l.position, l.title)
+        FROM links AS l
+        WHERE l.trip_id = trips.id AND l.deleted_at IS NULL
+    ), '[]')::json AS links
+FROM trips
+WHERE
+    trips.id = $1;
+
+-- name: UpdateTrip :execrows
 UPDATE trips
 SET 
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "description" = $4,
+    "version" = version + 1
 WHERE
-    id = $5;
+    id = $5 AND version = $6;
 
--- name: ConfirmTrip :exec
+-- name: UpdateTripPartial :execrows
 UPDATE trips
-SET 
-    "is_confirmed" = $1
+SET
+    "destination" = COALESCE(sqlc.narg(destination), "destination"),
+    "ends_at" = COALESCE(sqlc.narg(ends_at), "ends_at"),
+    "starts_at" = COALESCE(sqlc.narg(starts_at), "starts_at"),
+    "description" = COALESCE(sqlc.narg(description), "description"),
+    "rsvp_deadline" = CASE WHEN sqlc.arg(clear_rsvp_deadline)::boolean THEN NULL ELSE COALESCE(sqlc.narg(rsvp_deadline), "rsvp_deadline") END,
+    "max_guests" = COALESCE(sqlc.narg(max_guests), "max_guests"),
+    "max_participants" = CASE WHEN sqlc.arg(clear_max_participants)::boolean THEN NULL ELSE COALESCE(sqlc.narg(max_participants), "max_participants") END,
+    "pre_trip_reminder_days" = COALESCE(sqlc.narg(pre_trip_reminder_days), "pre_trip_reminder_days"),
+    "timezone" = COALESCE(sqlc.narg(timezone), "timezone"),
+    "version" = version + 1
+WHERE
+    id = sqlc.arg(id) AND version = sqlc.arg(version);
+
+-- name: UpdateTripStatus :exec
+UPDATE trips
+SET
+    "status" = $1,
+    "version" = version + 1
 WHERE
     id = $2;
 
+-- name: UpdateTripPlace :exec
+UPDATE trips
+SET
+    "latitude" = $1,
+    "longitude" = $2,
+    "place_name" = $3
+WHERE
+    id = $4;
+
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
 FROM participants
 WHERE
     id = $1;
 
 -- name: ConfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "guests" = $1,
+    "declined_at" = NULL,
+    "decline_reason" = NULL,
+    "no_response_at" = NULL
+WHERE
+    id = $2;
+
+-- name: GetParticipantByEmail :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
 FROM participants
+WHERE
+    trip_id = $1 AND email = $2;
+
+-- name: UpdateParticipantProfile :exec
+UPDATE participants
+SET
+    "name" = COALESCE(sqlc.narg(name), "name"),
+    "phone" = COALESCE(sqlc.narg(phone), "phone"),
+    "avatar_url" = COALESCE(sqlc.narg(avatar_url), "avatar_url"),
+    "locale" = COALESCE(sqlc.narg(locale), "locale")
+WHERE
+    id = sqlc.arg(id);
+
+-- name: MarkParticipantReinvited :execrows
+UPDATE participants
+SET
+    "invited_at" = now()
+WHERE
+    id = $1 AND invited_at <= $2;
+
+-- name: DeclineParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "declined_at" = now(),
+    "decline_reason" = $1
+WHERE
+    id = $2;
+
+-- name: UnconfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "guests" = 0
 WHERE
     id = $1;
 
+-- name: InsertParticipantStatusChange :exec
+INSERT INTO participant_status_changes
+    ( "participant_id", "status", "reason" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: GetParticipantStatusChanges :many
+SELECT
+    "id", "participant_id", "status", "reason", "created_at"
+FROM participant_status_changes
+WHERE
+    participant_id = $1
+ORDER BY
+    created_at, id;
 
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
 FROM participants
 WHERE
     trip_id = $1;
 
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 );
+    ( "trip_id", "email", "locale" ) VALUES
+    ( $1, $2, $3 );
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "place_name" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9 )
 RETURNING "id";
 
 -- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
 FROM activities
 WHERE
-    trip_id = $1;
+    trip_id = sqlc.arg(trip_id) AND deleted_at IS NULL
+    AND (sqlc.narg(category)::activity_category IS NULL OR category = sqlc.narg(category))
+    AND occurs_at BETWEEN COALESCE(sqlc.narg(from_time), '-infinity'::timestamp) AND COALESCE(sqlc.narg(to_time), 'infinity'::timestamp)
+ORDER BY
+    occurs_at, position, id;
+
+-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
+FROM activities
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL;
+
+-- name: UpdateActivity :exec
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "ends_at" = $3,
+    "address" = $4,
+    "latitude" = $5,
+    "longitude" = $6,
+    "category" = $7,
+    "place_name" = $8
+WHERE
+    id = $9 AND deleted_at IS NULL;
+
+-- name: DeleteActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL;
+
+-- name: RestoreActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL;
 
 -- name: CreateTripLink :one
 INSERT INTO links
-    ( "trip_id", "title", "url" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "url", "category", "pinned" ) VALUES
+    ( $1, $2, $3, $4, $5 )
 RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "category", "preview_title", "preview_description", "preview_favicon_url", "preview_image_url", "preview_fetched_at", "pinned", "position", "deleted_at"
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+ORDER BY
+    category, position, title;
+
+-- name: UpdateTripLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "category" = $3
+WHERE
+    id = $4 AND trip_id = $5 AND deleted_at IS NULL;
+
+-- name: UpdateTripLinkPartial :execrows
+UPDATE links
+SET
+    "title" = COALESCE(sqlc.narg(title), "title"),
+    "url" = COALESCE(sqlc.narg(url), "url"),
+    "category" = COALESCE(sqlc.narg(category), "category"),
+    "pinned" = COALESCE(sqlc.narg(pinned), "pinned")
+WHERE
+    id = sqlc.arg(id) AND trip_id = sqlc.arg(trip_id) AND deleted_at IS NULL;
+
+-- name: SetLinkPreview :exec
+UPDATE links
+SET
+    "preview_title" = $1,
+    "preview_description" = $2,
+    "preview_favicon_url" = $3,
+    "preview_image_url" = $4,
+    "preview_fetched_at" = now()
+WHERE
+    id = $5;
+
+-- name: UpdateLinkPosition :execrows
+UPDATE links
+SET
+    "position" = $1
+WHERE
+    id = $2 AND trip_id = $3 AND deleted_at IS NULL;
+
+-- name: GetLinkURL :one
+SELECT
+    "url"
+FROM links
+WHERE
+    id = $1 AND deleted_at IS NULL;
+
+-- name: RecordLinkClick :exec
+INSERT INTO link_clicks
+    ( "link_id" ) VALUES
+    ( $1 );
+
+-- name: GetTripLinkClickCounts :many
+SELECT
+    link_clicks.link_id, count(*) AS clicks
+FROM link_clicks
+JOIN links ON links.id = link_clicks.link_id
+WHERE
+    links.trip_id = $1 AND links.deleted_at IS NULL
+GROUP BY
+    link_clicks.link_id;
+
+-- name: DeleteTripLink :execrows
+UPDATE links
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL;
+
+-- name: RestoreTripLink :execrows
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL;
+
+-- name: CreateTripExpense :one
+INSERT INTO expenses
+    ( "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+RETURNING "id";
+
+-- name: GetTripExpenses :many
+SELECT
+    "id", "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at", "created_at"
+FROM expenses
+WHERE
+    trip_id = $1
+ORDER BY
+    spent_at, created_at;
+
+-- name: UpdateTripExpense :execrows
+UPDATE expenses
+SET
+    "payer_id" = $1,
+    "activity_id" = $2,
+    "description" = $3,
+    "amount" = $4,
+    "currency" = $5,
+    "category" = $6,
+    "spent_at" = $7
+WHERE
+    id = $8 AND trip_id = $9;
+
+-- name: DeleteTripExpense :execrows
+DELETE FROM expenses
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: GetTripExpenseTotals :many
+SELECT
+    "currency", "category", "payer_id", sum(amount)::bigint AS total
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY
+    currency, category, payer_id
+ORDER BY
+    currency, category, payer_id;
+
+-- name: CreateSettlementPayment :one
+INSERT INTO settlement_payments
+    ( "trip_id", "from_participant_id", "to_participant_id", "amount", "currency" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id";
+
+-- name: GetTripSettlementPayments :many
+SELECT
+    "id", "trip_id", "from_participant_id", "to_participant_id", "amount", "currency", "paid_at"
+FROM settlement_payments
+WHERE
+    trip_id = $1
+ORDER BY
+    paid_at;
+
+-- name: DeleteSettlementPayment :execrows
+DELETE FROM settlement_payments
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreatePoll :one
+INSERT INTO polls
+    ( "trip_id", "kind", "question" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: CreatePollOption :exec
+INSERT INTO poll_options
+    ( "poll_id", "position", "destination", "starts_at", "ends_at" ) VALUES
+    ( $1, $2, $3, $4, $5 );
+
+-- name: GetPoll :one
+SELECT
+    "id", "trip_id", "kind", "question", "applied_at", "created_at"
+FROM polls
+WHERE
+    id = $1;
+
+-- name: GetTripPolls :many
+SELECT
+    "id", "trip_id", "kind", "question", "applied_at", "created_at"
+FROM polls
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at;
+
+-- name: GetTripPollOptions :many
+SELECT
+    poll_options.id, poll_options.poll_id, poll_options.position, poll_options.destination,
+    poll_options.starts_at, poll_options.ends_at, count(poll_votes.participant_id) AS votes
+FROM poll_options
+JOIN polls ON polls.id = poll_options.poll_id
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE
+    polls.trip_id = $1
+GROUP BY
+    poll_options.id
+ORDER BY
+    poll_options.poll_id, poll_options.position;
+
+-- name: VotePoll :exec
+INSERT INTO poll_votes
+    ( "poll_id", "participant_id", "option_id" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (poll_id, participant_id) DO UPDATE
+SET
+    "option_id" = EXCLUDED.option_id,
+    "voted_at" = now();
+
+-- name: MarkPollApplied :execrows
+UPDATE polls
+SET
+    "applied_at" = now()
+WHERE
+    id = $1 AND applied_at IS NULL;
+
+-- name: CreateMessage :one
+INSERT INTO messages
+    ( "trip_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetTripMessagesPage :many
+SELECT
+    messages.id, messages.participant_id, participants.name, participants.email, messages.body, messages.created_at
+FROM messages
+JOIN participants ON participants.id = messages.participant_id
+WHERE
+    messages.trip_id = sqlc.arg(trip_id)
+    AND (sqlc.narg(before_created_at)::timestamp IS NULL
+        OR (messages.created_at, messages.id) < (sqlc.narg(before_created_at)::timestamp, sqlc.arg(before_id)::uuid))
+ORDER BY
+    messages.created_at DESC, messages.id DESC
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: MarkMessagesRead :exec
+INSERT INTO message_reads
+    ( "participant_id", "trip_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "read_at" = now();
+
+-- name: CountUnreadMessages :one
+SELECT
+    count(*)
+FROM messages
+WHERE
+    messages.trip_id = $1
+    AND messages.participant_id <> $2
+    AND messages.created_at > COALESCE((
+        SELECT read_at FROM message_reads WHERE message_reads.participant_id = $2
+    ), '-infinity');
+
+-- name: GetParticipantNotifications :many
+SELECT
+    "id", "participant_id", "trip_id", "event", "subject_id", "read_at", "created_at"
+FROM notifications
+WHERE
+    participant_id = sqlc.arg(participant_id)
+    AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY
+    created_at DESC, id DESC;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications
+SET
+    "read_at" = COALESCE(read_at, now())
+WHERE
+    id = $1 AND participant_id = $2;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications
+SET
+    "read_at" = now()
+WHERE
+    participant_id = $1 AND read_at IS NULL;
+
+-- name: CreateWebhook :one
+INSERT INTO webhooks
+    ( "trip_id", "url", "secret" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id", "trip_id", "url", "secret", "created_at";
+
+-- name: GetWebhook :one
+SELECT
+    "id", "trip_id", "url", "secret", "created_at"
+FROM webhooks
+WHERE
+    id = $1;
+
+-- name: GetTripWebhooks :many
+SELECT
+    "id", "trip_id", "url", "secret", "created_at"
+FROM webhooks
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at, id;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE
+    id = $1;
+
+-- name: GetWebhookDeliveries :many
+SELECT
+    webhook_deliveries.id, webhook_deliveries.event_id, domain_events.kind, domain_events.trip_id,
+    webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at,
+    webhook_deliveries.response_status, webhook_deliveries.last_error,
+    webhook_deliveries.created_at, webhook_deliveries.delivered_at
+FROM webhook_deliveries
+JOIN domain_events ON domain_events.id = webhook_deliveries.event_id
+WHERE
+    webhook_deliveries.webhook_id = $1
+ORDER BY
+    webhook_deliveries.created_at DESC, webhook_deliveries.id DESC
+LIMIT $2;
+
+-- name: CreatePackingItem :one
+INSERT INTO packing_items
+    ( "trip_id", "name", "quantity", "assignee_id" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetTripPackingItems :many
+SELECT
+    "id", "trip_id", "name", "quantity", "assignee_id", "checked", "created_at"
+FROM packing_items
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at, id;
+
+-- name: UpdatePackingItem :execrows
+UPDATE packing_items
+SET
+    "name" = $1,
+    "quantity" = $2,
+    "assignee_id" = $3,
+    "checked" = $4
+WHERE
+    id = $5 AND trip_id = $6;
+
+-- name: DeletePackingItem :execrows
+DELETE FROM packing_items
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreateTask :one
+INSERT INTO tasks
+    ( "trip_id", "title", "assignee_id", "due_at" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetTripTasks :many
+SELECT
+    "id", "trip_id", "title", "assignee_id", "due_at", "done", "reminded_at", "created_at"
+FROM tasks
+WHERE
+    trip_id = $1
+ORDER BY
+    done, due_at NULLS LAST, created_at, id;
+
+-- name: UpdateTask :execrows
+UPDATE tasks
+SET
+    "title" = $1,
+    "assignee_id" = $2,
+    "due_at" = $3,
+    "done" = $4,
+    "reminded_at" = CASE
+        WHEN assignee_id IS DISTINCT FROM $2 OR due_at IS DISTINCT FROM $3 THEN NULL
+        ELSE reminded_at
+    END
+WHERE
+    id = $5 AND trip_id = $6;
+
+-- name: DeleteTask :execrows
+DELETE FROM tasks
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreateBooking :one
+INSERT INTO bookings
+    ( "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10 )
+RETURNING "id";
+
+-- name: GetTripBookings :many
+SELECT
+    "id", "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url", "created_at"
+FROM bookings
+WHERE
+    trip_id = $1
+ORDER BY
+    check_in_at, id;
+
+-- name: UpdateBooking :execrows
+UPDATE bookings
+SET
+    "type" = $1,
+    "name" = $2,
+    "check_in_at" = $3,
+    "check_out_at" = $4,
+    "address" = $5,
+    "confirmation_code" = $6,
+    "price" = $7,
+    "currency" = $8,
+    "url" = $9
+WHERE
+    id = $10 AND trip_id = $11;
+
+-- name: DeleteBooking :execrows
+DELETE FROM bookings
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreateTransportSegment :one
+INSERT INTO transport_segments
+    ( "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+RETURNING "id";
+
+-- name: GetTripTransportSegments :many
+SELECT
+    "id", "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at", "created_at"
+FROM transport_segments
+WHERE
+    trip_id = $1
+ORDER BY
+    departure_at, id;
+
+-- name: UpdateTransportSegment :execrows
+UPDATE transport_segments
+SET
+    "mode" = $1,
+    "carrier" = $2,
+    "number" = $3,
+    "departure_place" = $4,
+    "departure_at" = $5,
+    "arrival_place" = $6,
+    "arrival_at" = $7
+WHERE
+    id = $8 AND trip_id = $9;
+
+-- name: DeleteTransportSegment :execrows
+DELETE FROM transport_segments
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: AddTransportSegmentParticipant :exec
+INSERT INTO transport_segment_participants
+    ( "segment_id", "trip_id", "participant_id" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: DeleteTransportSegmentParticipants :exec
+DELETE FROM transport_segment_participants
+WHERE
+    segment_id = $1;
+
+-- name: GetTripTransportSegmentParticipants :many
+SELECT
+    "segment_id", "trip_id", "participant_id"
+FROM transport_segment_participants
+WHERE
+    trip_id = $1
+ORDER BY
+    segment_id, participant_id;
+
+
+
+-- name: ListTrips :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
+FROM trips
+WHERE
+    (sqlc.narg(status)::trip_status IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(tag)::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM trip_tags
+        JOIN tags ON tags.id = trip_tags.tag_id
+        WHERE
+            trip_tags.trip_id = trips.id AND tags.name = sqlc.narg(tag)
+    ))
+    AND (sqlc.narg(query)::text IS NULL OR search @@ (websearch_to_tsquery('portuguese', sqlc.narg(query)) || websearch_to_tsquery('english', sqlc.narg(query))))
+ORDER BY
+    starts_at;
+
+-- name: CreateTag :one
+INSERT INTO tags
+    ( "name" ) VALUES
+    ( $1 )
+ON CONFLICT ("name") DO UPDATE SET "name" = EXCLUDED.name
+RETURNING "id";
+
+-- name: GetTag :one
+SELECT
+    "id", "name"
+FROM tags
+WHERE
+    id = $1;
+
+-- name: GetTags :many
+SELECT
+    "id", "name"
+FROM tags
+ORDER BY
+    name;
+
+-- name: UpdateTag :exec
+UPDATE tags
+SET
+    "name" = $1
+WHERE
+    id = $2;
+
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE
+    id = $1;
+
+-- name: AddTagToTrip :exec
+INSERT INTO trip_tags
+    ( "trip_id", "tag_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveTagFromTrip :exec
+DELETE FROM trip_tags
+WHERE
+    trip_id = $1 AND tag_id = $2;
+
+-- name: GetTripTags :many
+SELECT
+    tags.id, tags.name
+FROM tags
+JOIN trip_tags ON trip_tags.tag_id = tags.id
+WHERE
+    trip_tags.trip_id = $1
+ORDER BY
+    tags.name;
+
+-- name: CreateTripShare :exec
+INSERT INTO trip_shares
+    ( "trip_id", "slug" ) VALUES
+    ( $1, $2 );
+
+-- name: GetTripIDByShareSlug :one
+SELECT
+    "trip_id"
+FROM trip_shares
+WHERE
+    slug = $1 AND revoked_at IS NULL;
+
+-- name: RevokeTripShares :exec
+UPDATE trip_shares
+SET
+    "revoked_at" = now()
+WHERE
+    trip_id = $1 AND revoked_at IS NULL;
+
+-- name: SetTripJoinCode :exec
+INSERT INTO trip_join_codes
+    ( "trip_id", "code" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "code" = EXCLUDED.code,
+    "created_at" = now();
+
+-- name: GetTripIDByJoinCode :one
+SELECT
+    "trip_id"
+FROM trip_join_codes
+WHERE
+    code = $1;
+
+-- name: AddTripOwner :exec
+INSERT INTO trip_owners
+    ( "trip_id", "email", "name", "locale" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT DO NOTHING;
+
+-- name: GetTripOwners :many
+SELECT
+    "trip_id", "email", "name", "created_at", "locale"
+FROM trip_owners
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at;
+
+-- name: IsTripOwner :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trip_owners
+    WHERE
+        trip_id = $1 AND email = $2
+);
+
+-- name: RemoveTripOwner :exec
+DELETE FROM trip_owners
+WHERE
+    trip_id = $1 AND email = $2;
+
+-- name: GetActivityTripID :one
+SELECT
+    "trip_id"
+FROM activities
+WHERE
+    id = $1 AND deleted_at IS NULL;
+
+-- name: UpsertActivityRSVP :exec
+INSERT INTO activity_attendees
+    ( "activity_id", "participant_id", "attending" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("activity_id", "participant_id") DO UPDATE
+SET
+    "attending" = EXCLUDED.attending,
+    "updated_at" = now();
+
+-- name: GetTripActivityAttendeeCounts :many
+SELECT
+    activity_attendees.activity_id, count(*) AS attendees
+FROM activity_attendees
+JOIN activities ON activities.id = activity_attendees.activity_id
+WHERE
+    activities.trip_id = $1 AND activities.deleted_at IS NULL AND activity_attendees.attending
+GROUP BY
+    activity_attendees.activity_id;
+
+-- name: CreateActivityComment :one
+INSERT INTO activity_comments
+    ( "activity_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetActivityComments :many
+SELECT
+    activity_comments.id, activity_comments.participant_id, participants.email, activity_comments.body, activity_comments.created_at
+FROM activity_comments
+JOIN participants ON participants.id = activity_comments.participant_id
+WHERE
+    activity_comments.activity_id = $1 AND activity_comments.deleted_at IS NULL
+ORDER BY
+    activity_comments.created_at;
+
+-- name: DeleteActivityComment :execrows
+UPDATE activity_comments
+SET
+    "deleted_at" = now()
+WHERE
+    id = $1 AND activity_id = $2 AND participant_id = $3 AND deleted_at IS NULL;
+
+-- name: RestoreActivityComment :execrows
+UPDATE activity_comments
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND activity_id = $2 AND participant_id = $3 AND deleted_at IS NOT NULL;
+
+-- name: CreateActivityAttachment :one
+INSERT INTO activity_attachments
+    ( "activity_id", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id";
+
+-- name: GetActivityAttachments :many
+SELECT
+    "id", "activity_id", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM activity_attachments
+WHERE
+    activity_id = $1
+ORDER BY
+    created_at;
+
+-- name: UpdateActivityPosition :execrows
+UPDATE activities
+SET
+    "position" = $1
+WHERE
+    id = $2 AND trip_id = $3 AND deleted_at IS NULL;
+
+-- name: GetDueActivityReminders :many
+SELECT
+    activities.id AS activity_id, activities.title, activities.occurs_at, trips.destination, participants.id AS participant_id, participants.email, participants.name, participants.locale
+FROM activities
+JOIN trips ON trips.id = activities.trip_id
+JOIN participants ON participants.trip_id = activities.trip_id
+WHERE
+    activities.occurs_at > sqlc.arg(from_time) AND activities.occurs_at <= sqlc.arg(to_time)
+    AND activities.deleted_at IS NULL
+    AND trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'activity_reminder'
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM activity_reminders
+        WHERE activity_reminders.activity_id = activities.id AND activity_reminders.participant_id = participants.id
+    )
+ORDER BY
+    activities.occurs_at;
+
+-- name: MarkActivityReminderSent :exec
+INSERT INTO activity_reminders
+    ( "activity_id", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("activity_id", "participant_id") DO NOTHING;
+
+-- name: OptOutOfNotification :exec
+INSERT INTO notification_opt_outs
+    ( "participant_id", "kind" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("participant_id", "kind") DO NOTHING;
+
+-- name: OptInToNotification :exec
+DELETE FROM notification_opt_outs
+WHERE
+    participant_id = $1 AND kind = $2;
+
+-- name: GetNotificationOptOuts :many
+SELECT
+    "kind"
+FROM notification_opt_outs
+WHERE
+    participant_id = $1;
+
+-- name: IsOptedOutOfNotification :one
+SELECT EXISTS (
+    SELECT 1 FROM notification_opt_outs
+    WHERE
+        participant_id = $1 AND kind = $2
+);
+
+-- name: VoteActivity :exec
+INSERT INTO activity_votes
+    ( "activity_id", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("activity_id", "participant_id") DO NOTHING;
+
+-- name: UnvoteActivity :execrows
+DELETE FROM activity_votes
+WHERE
+    activity_id = $1 AND participant_id = $2;
+
+-- name: GetTripActivityVoteCounts :many
+SELECT
+    activity_votes.activity_id, count(*) AS votes
+FROM activity_votes
+JOIN activities ON activities.id = activity_votes.activity_id
+WHERE
+    activities.trip_id = $1 AND activities.deleted_at IS NULL
+GROUP BY
+    activity_votes.activity_id;
+
+-- name: SearchTrip :many
+SELECT
+    'activity'::text AS kind, activities.id, activities.title AS match
+FROM activities
+WHERE
+    activities.trip_id = sqlc.arg(trip_id) AND activities.deleted_at IS NULL
+    AND activities.search @@ (websearch_to_tsquery('portuguese', sqlc.arg(query)) || websearch_to_tsquery('english', sqlc.arg(query)))
+UNION ALL
+SELECT
+    'link'::text AS kind, links.id, links.title AS match
 FROM links
+WHERE
+    links.trip_id = sqlc.arg(trip_id) AND links.deleted_at IS NULL AND links.title ILIKE sqlc.arg(pattern)
+UNION ALL
+SELECT
+    'participant'::text AS kind, participants.id, participants.email AS match
+FROM participants
+WHERE
+    participants.trip_id = sqlc.arg(trip_id) AND participants.email ILIKE sqlc.arg(pattern)
+ORDER BY
+    kind, match
+LIMIT sqlc.arg(max_results);
+
+-- name: GetDueRSVPReminders :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.rsvp_deadline
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+JOIN participant_invitations ON participant_invitations.participant_id = participants.id
+WHERE
+    trips.rsvp_deadline > sqlc.arg(from_time) AND trips.rsvp_deadline <= sqlc.arg(to_time)
+    AND trips.status NOT IN ('draft', 'cancelled')
+    AND NOT participants.is_confirmed
+    AND participants.declined_at IS NULL
+    AND participants.rsvp_reminded_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'rsvp_reminder'
+    )
+ORDER BY
+    trips.rsvp_deadline;
+
+-- name: ResetRSVPReminders :exec
+UPDATE participants
+SET
+    "rsvp_reminded_at" = NULL,
+    "no_response_at" = NULL
+WHERE
+    trip_id = $1;
+
+-- name: MarkRSVPReminderSent :exec
+UPDATE participants
+SET
+    "rsvp_reminded_at" = now()
+WHERE
+    id = $1;
+
+-- name: GetDuePreTripReminders :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.starts_at
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE
+    trips.starts_at > sqlc.arg(now)
+    AND trips.starts_at <= sqlc.arg(now) + make_interval(days => trips.pre_trip_reminder_days)
+    AND trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND participants.pre_trip_reminded_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'pre_trip_reminder'
+    )
+ORDER BY
+    trips.starts_at;
+
+-- name: MarkPreTripReminderSent :exec
+UPDATE participants
+SET
+    "pre_trip_reminded_at" = now()
+WHERE
+    id = $1;
+
+-- name: GetDueDailyDigests :many
+SELECT
+    participants.id AS participant_id, participants.email, participants.name, participants.locale, trips.id AS trip_id, trips.destination, trips.timezone,
+    (sqlc.arg(now)::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date AS day
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE
+    trips.status <> 'cancelled'
+    AND participants.is_confirmed
+    AND (sqlc.arg(now)::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+        BETWEEN (trips.starts_at AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date AND (trips.ends_at AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+    AND extract(hour FROM sqlc.arg(now)::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone) >= sqlc.arg(send_hour)::int
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'daily_digest'
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM daily_digests
+        WHERE daily_digests.participant_id = participants.id
+            AND daily_digests.day = (sqlc.arg(now)::timestamp AT TIME ZONE 'UTC' AT TIME ZONE trips.timezone)::date
+    );
+
+-- name: MarkDailyDigestSent :exec
+INSERT INTO daily_digests
+    ( "participant_id", "day" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("participant_id", "day") DO NOTHING;
+
+-- name: GetDueTaskReminders :many
+SELECT
+    tasks.id AS task_id, tasks.title, tasks.due_at, trips.id AS trip_id, trips.destination, participants.id AS participant_id, participants.email, participants.name, participants.locale
+FROM tasks
+JOIN trips ON trips.id = tasks.trip_id
+JOIN participants ON participants.id = tasks.assignee_id
+WHERE
+    tasks.due_at <= sqlc.arg(now)
+    AND NOT tasks.done
+    AND tasks.reminded_at IS NULL
+    AND trips.status <> 'cancelled'
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'task_reminder'
+    )
+ORDER BY
+    tasks.due_at;
+
+-- name: MarkTaskReminderSent :exec
+UPDATE tasks
+SET
+    "reminded_at" = now()
+WHERE
+    id = $1;
+
+-- name: GetTrackedFlights :many
+SELECT
+    transport_segments.id AS segment_id, transport_segments.trip_id, transport_segments.carrier, transport_segments.number, transport_segments.departure_place, transport_segments.departure_at, transport_segments.arrival_place, flight_statuses.departure_at AS estimated_departure_at, flight_statuses.gate
+FROM transport_segments
+JOIN trips ON trips.id = transport_segments.trip_id
+LEFT JOIN flight_statuses ON flight_statuses.segment_id = transport_segments.id
+WHERE
+    transport_segments.mode = 'flight'
+    AND transport_segments.number IS NOT NULL
+    AND transport_segments.departure_at <= sqlc.arg(to_time)
+    AND COALESCE(flight_statuses.departure_at, transport_segments.departure_at) >= sqlc.arg(from_time)
+    AND trips.status <> 'cancelled'
+ORDER BY
+    transport_segments.departure_at;
+
+-- name: UpsertFlightStatus :exec
+INSERT INTO flight_statuses
+    ( "segment_id", "departure_at", "gate" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (segment_id) DO UPDATE
+SET
+    "departure_at" = EXCLUDED.departure_at,
+    "gate" = EXCLUDED.gate,
+    "checked_at" = now();
+
+-- name: DeleteFlightStatus :exec
+DELETE FROM flight_statuses
+WHERE
+    segment_id = $1;
+
+-- name: GetFlightUpdateRecipients :many
+SELECT
+    participants.id
+FROM transport_segment_participants
+JOIN participants ON participants.id = transport_segment_participants.participant_id
+WHERE
+    transport_segment_participants.segment_id = $1
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM notification_opt_outs
+        WHERE notification_opt_outs.participant_id = participants.id AND notification_opt_outs.kind = 'flight_update'
+    )
+ORDER BY
+    participants.id;
+
+-- name: CreateFlightUpdatedNotifications :exec
+INSERT INTO notifications (participant_id, trip_id, event, subject_id)
+SELECT
+    participants.id, participants.trip_id, 'flight_updated', transport_segment_participants.segment_id
+FROM transport_segment_participants
+JOIN participants ON participants.id = transport_segment_participants.participant_id
+WHERE
+    transport_segment_participants.segment_id = $1
+    AND participants.declined_at IS NULL;
+
+-- name: CreateDocument :one
+INSERT INTO documents
+    ( "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id";
+
+-- name: GetTripDocuments :many
+SELECT
+    "id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM documents
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND (participant_id IS NULL OR participant_id = sqlc.arg(participant_id))
+ORDER BY
+    created_at, id;
+
+-- name: GetDocument :one
+SELECT
+    "id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM documents
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: DeleteDocument :execrows
+DELETE FROM documents
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: CreatePhoto :one
+INSERT INTO photos
+    ( "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id";
+
+-- name: GetTripPhotosPage :many
+SELECT
+    photos.id, photos.participant_id, participants.name, participants.email, photos.caption, photos.file_name,
+    photos.content_type, photos.size, photos.storage_key, photos.width, photos.height, photos.thumbnail_key,
+    photos.thumbnail_status, photos.created_at,
+    (SELECT count(*) FROM photo_comments WHERE photo_comments.photo_id = photos.id) AS comments
+FROM photos
+JOIN participants ON participants.id = photos.participant_id
+WHERE
+    photos.trip_id = sqlc.arg(trip_id)
+    AND (sqlc.narg(before_created_at)::timestamp IS NULL
+        OR (photos.created_at, photos.id) < (sqlc.narg(before_created_at)::timestamp, sqlc.arg(before_id)::uuid))
+ORDER BY
+    photos.created_at DESC, photos.id DESC
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: GetPhoto :one
+SELECT
+    "id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "width", "height", "thumbnail_key", "thumbnail_status", "thumbnail_attempts", "thumbnail_next_attempt_at", "created_at"
+FROM photos
+WHERE
+    id = $1 AND trip_id = $2;
+
+-- name: DeletePhoto :one
+DELETE FROM photos
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3
+RETURNING "storage_key", "thumbnail_key";
+
+-- name: ClaimPendingThumbnails :many
+UPDATE photos
+SET
+    thumbnail_next_attempt_at = sqlc.arg('lease_until')
+WHERE
+    id IN (
+        SELECT id FROM photos AS due
+        WHERE due.thumbnail_status = 'pending' AND due.thumbnail_next_attempt_at <= sqlc.arg('now')
+        ORDER BY due.thumbnail_next_attempt_at
+        LIMIT sqlc.arg('limit')
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "width", "height", "thumbnail_key", "thumbnail_status", "thumbnail_attempts", "thumbnail_next_attempt_at", "created_at";
+
+-- name: MarkThumbnailReady :exec
+UPDATE photos
+SET
+    thumbnail_status = 'ready',
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_key = $1,
+    width = $2,
+    height = $3
+WHERE
+    id = $4;
+
+-- name: MarkThumbnailFailed :exec
+UPDATE photos
+SET
+    thumbnail_status = $1,
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_next_attempt_at = $2
+WHERE
+    id = $3;
+
+-- name: CreatePhotoComment :one
+INSERT INTO photo_comments
+    ( "photo_id", "participant_id", "body" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetPhotoComments :many
+SELECT
+    photo_comments.id, photo_comments.participant_id, participants.name, participants.email, photo_comments.body,
+    photo_comments.created_at
+FROM photo_comments
+JOIN participants ON participants.id = photo_comments.participant_id
+WHERE
+    photo_comments.photo_id = $1
+ORDER BY
+    photo_comments.created_at, photo_comments.id;
+
+-- name: DeletePhotoComment :execrows
+DELETE FROM photo_comments
+WHERE
+    id = $1 AND photo_id = $2 AND participant_id = $3;
+
+-- name: CreateJournalEntry :one
+INSERT INTO journal_entries
+    ( "trip_id", "participant_id", "day", "title", "body", "visibility" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: GetTripJournalEntries :many
+SELECT
+    journal_entries.id, journal_entries.participant_id, participants.name, participants.email, journal_entries.day,
+    journal_entries.title, journal_entries.body, journal_entries.visibility, journal_entries.created_at,
+    journal_entries.updated_at
+FROM journal_entries
+JOIN participants ON participants.id = journal_entries.participant_id
+WHERE
+    journal_entries.trip_id = sqlc.arg(trip_id)
+    AND (journal_entries.visibility = 'shared' OR journal_entries.participant_id = sqlc.arg(participant_id))
+ORDER BY
+    journal_entries.day, journal_entries.created_at, journal_entries.id;
+
+-- name: UpdateJournalEntry :execrows
+UPDATE journal_entries
+SET
+    "day" = $4,
+    "title" = $5,
+    "body" = $6,
+    "visibility" = $7,
+    "updated_at" = now()
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3;
+
+-- name: DeleteJournalEntry :execrows
+DELETE FROM journal_entries
+WHERE
+    id = $1 AND trip_id = $2 AND participant_id = $3;
+
+-- name: FlagNoResponseParticipants :execrows
+UPDATE participants
+SET
+    "no_response_at" = now()
+FROM trips, participant_invitations
+WHERE
+    trips.id = participants.trip_id
+    AND participant_invitations.participant_id = participants.id
+    AND trips.rsvp_deadline <= $1
+    AND trips.status NOT IN ('draft', 'cancelled')
+    AND NOT participants.is_confirmed
+    AND participants.declined_at IS NULL
+    AND participants.no_response_at IS NULL;
+
+-- name: GetTripParticipantStats :one
+SELECT
+    count(*) AS invited,
+    count(*) FILTER (WHERE is_confirmed) AS confirmed,
+    count(*) FILTER (WHERE declined_at IS NOT NULL) AS declined,
+    count(*) FILTER (WHERE no_response_at IS NOT NULL) AS no_response,
+    COALESCE(sum(guests) FILTER (WHERE is_confirmed), 0)::bigint AS guests
+FROM participants
 WHERE
     trip_id = $1;
 
+-- name: CountActiveTripParticipants :one
+SELECT
+    count(*)
+FROM participants
+WHERE
+    trip_id = $1 AND declined_at IS NULL;
+
+-- name: LockTripMaxParticipants :one
+SELECT
+    "max_participants"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: InsertParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
+-- name: AddToTripWaitlist :exec
+INSERT INTO trip_waitlist
+    ( "trip_id", "email" ) VALUES
+    ( $1, $2 )
+ON CONFLICT ("trip_id", "email") DO NOTHING;
+
+-- name: GetTripWaitlist :many
+SELECT
+    "id", "trip_id", "email", "created_at"
+FROM trip_waitlist
+WHERE
+    trip_id = $1
+ORDER BY
+    created_at;
+
+-- name: PopTripWaitlist :one
+DELETE FROM trip_waitlist
+WHERE
+    id = (
+        SELECT id FROM trip_waitlist AS next
+        WHERE next.trip_id = $1
+        ORDER BY next.created_at
+        LIMIT 1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "email";
+
+-- name: EnqueueEmail :exec
+INSERT INTO email_outbox
+    ( "kind", "payload" ) VALUES
+    ( $1, $2 );
+
+-- name: ClaimDueEmails :many
+UPDATE email_outbox
+SET
+    next_attempt_at = sqlc.arg('lease_until')
+WHERE
+    id IN (
+        SELECT id FROM email_outbox AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= sqlc.arg('now')
+        ORDER BY due.next_attempt_at
+        LIMIT sqlc.arg('limit')
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "sent_at";
+
+-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET
+    status = 'sent',
+    attempts = attempts + 1,
+    sent_at = $1
+WHERE
+    id = $2;
+
+-- name: MarkEmailFailed :exec
+UPDATE email_outbox
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    last_error = $3
+WHERE
+    id = $4;
+
+-- name: RecordDomainEvent :exec
+INSERT INTO domain_events
+    ( "kind", "trip_id", "payload" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: ClaimDueDomainEvents :many
+UPDATE domain_events
+SET
+    next_attempt_at = sqlc.arg('lease_until')
+WHERE
+    id IN (
+        SELECT id FROM domain_events AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= sqlc.arg('now')
+        ORDER BY due.occurred_at
+        LIMIT sqlc.arg('limit')
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id", "kind", "trip_id", "payload", "status", "attempts", "next_attempt_at", "last_error", "occurred_at", "published_at";
 
+-- name: MarkDomainEventPublished :exec
+UPDATE domain_events
+SET
+    status = 'published',
+    attempts = attempts + 1,
+    published_at = $1
+WHERE
+    id = $2;
+
+-- name: MarkDomainEventFailed :exec
+UPDATE domain_events
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    last_error = $3
+WHERE
+    id = $4;
+
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries
+    ( "webhook_id", "event_id" )
+SELECT
+    webhooks.id, sqlc.arg(event_id)
+FROM webhooks
+WHERE
+    webhooks.trip_id = sqlc.arg(trip_id)
+ON CONFLICT ("webhook_id", "event_id") DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET
+    next_attempt_at = sqlc.arg('lease_until')
+FROM webhooks, domain_events
+WHERE
+    webhook_deliveries.id IN (
+        SELECT id FROM webhook_deliveries AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= sqlc.arg('now')
+        ORDER BY due.next_attempt_at
+        LIMIT sqlc.arg('limit')
+        FOR UPDATE SKIP LOCKED
+    )
+    AND webhooks.id = webhook_deliveries.webhook_id
+    AND domain_events.id = webhook_deliveries.event_id
+RETURNING
+    webhook_deliveries.id, webhook_deliveries.attempts, webhooks.url, webhooks.secret,
+    domain_events.id AS event_id, domain_events.kind, domain_events.trip_id,
+    domain_events.payload, domain_events.occurred_at;
+
+-- name: MarkWebhookDelivered :exec
+UPDATE webhook_deliveries
+SET
+    status = 'delivered',
+    attempts = attempts + 1,
+    response_status = $1,
+    last_error = NULL,
+    delivered_at = $2
+WHERE
+    id = $3;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    status = $1,
+    attempts = attempts + 1,
+    next_attempt_at = $2,
+    response_status = $3,
+    last_error = $4
+WHERE
+    id = $5;
+
+-- name: MarkEmailUndeliverable :exec
+INSERT INTO undeliverable_emails
+    ( "email", "issue", "detail" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("email") DO UPDATE
+SET
+    "issue" = excluded.issue,
+    "detail" = excluded.detail,
+    "reported_at" = now();
+
+-- name: GetTripUndeliverableEmails :many
+SELECT
+    undeliverable_emails.email, undeliverable_emails.issue
+FROM undeliverable_emails
+JOIN participants ON participants.email = undeliverable_emails.email
+WHERE
+    participants.trip_id = $1;
+
+-- name: CreateEmailVerification :exec
+INSERT INTO email_verifications
+    ( "participant_id", "code", "expires_at" )
+SELECT
+    id, $3, $4
+FROM participants
+WHERE
+    trip_id = $1 AND email = $2
+ON CONFLICT ("participant_id") DO UPDATE
+SET
+    "code" = excluded.code,
+    "attempts" = 0,
+    "expires_at" = excluded.expires_at,
+    "created_at" = now();
+
+-- name: GetEmailVerification :one
+SELECT
+    "participant_id", "code", "attempts", "expires_at", "created_at"
+FROM email_verifications
+WHERE
+    participant_id = $1;
+
+-- name: ClaimEmailVerificationAttempt :one
+UPDATE email_verifications
+SET
+    "attempts" = attempts + 1
+WHERE
+    participant_id = sqlc.arg(participant_id) AND attempts < sqlc.arg(max_attempts)
+RETURNING "code", "expires_at";
+
+-- name: DeleteEmailVerification :exec
+DELETE FROM email_verifications
+WHERE
+    participant_id = $1;
+
+-- name: MarkParticipantEmailVerified :exec
+UPDATE participants
+SET
+    "email_verified_at" = now()
+WHERE
+    id = $1;
+
+-- name: UpdateTripStatusFrom :execrows
+UPDATE trips
+SET
+    "status" = sqlc.arg(status),
+    "version" = version + 1
+WHERE
+    id = sqlc.arg(id) AND status = sqlc.arg(from_status);
+
+-- name: RecordInvitationSent :exec
+INSERT INTO participant_invitations
+    ( "participant_id" )
+SELECT
+    id
+FROM participants
+WHERE
+    trip_id = $1 AND email = $2
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "sent_at" = now();
+
+-- name: GetParticipantsToInvite :many
+SELECT
+    participants.email
+FROM participants
+WHERE
+    participants.trip_id = $1
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM participant_invitations
+        WHERE participant_invitations.participant_id = participants.id
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM email_verifications
+        WHERE email_verifications.participant_id = participants.id
+    )
+ORDER BY
+    participants.invited_at;
+
+-- name: ListTripsPage :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "search", "latitude", "longitude", "place_name"
+FROM trips
+WHERE
+    (created_at, id) > (sqlc.arg(after_created_at)::timestamp, sqlc.arg(after_id)::uuid)
+    AND (sqlc.narg(status)::trip_status IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(tag)::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM trip_tags
+        JOIN tags ON tags.id = trip_tags.tag_id
+        WHERE
+            trip_tags.trip_id = trips.id AND tags.name = sqlc.narg(tag)
+    ))
+    AND (sqlc.narg(query)::text IS NULL OR search @@ (websearch_to_tsquery('portuguese', sqlc.narg(query)) || websearch_to_tsquery('english', sqlc.narg(query))))
+ORDER BY
+    created_at, id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: GetTripActivitiesPage :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "search", "place_name"
+FROM activities
+WHERE
+    trip_id = sqlc.arg(trip_id) AND deleted_at IS NULL
+    AND (created_at, id) > (sqlc.arg(after_created_at)::timestamp, sqlc.arg(after_id)::uuid)
+ORDER BY
+    created_at, id
+LIMIT sqlc.arg(page_size)::int;
+
+-- name: GetParticipantsPage :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"
+FROM participants
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND (created_at, id) > (sqlc.arg(after_created_at)::timestamp, sqlc.arg(after_id)::uuid)
+ORDER BY
+    created_at, id
+LIMIT sqlc.arg(page_size)::int;
@@ -0,0 +1,65 @@
+package pgstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// TripWithRelations is a trip along with its participants, activities and
+// links, as returned by GetTripWithRelations. Activities are sorted as by
+// GetTripActivities and links as by GetTripLinks; deleted ones are left out.
+type TripWithRelations struct {
+	Trip         Trip
+	Participants []Participant
+	Activities   []Activity
+	Links        []Link
+}
+
+// GetTripWithRelations loads a trip and its participants, activities and
+// links in a single round trip. The relations are aggregated to JSON by the
+// database, with keys named after the fields of the models.
+func (s *Store) GetTripWithRelations(ctx context.Context, id uuid.UUID) (TripWithRelations, error) {
+	row, err := read(ctx, s, func(q *Queries) (GetTripAggregateRow, error) { return q.GetTripAggregate(ctx, id) })
+	if err != nil {
+		return TripWithRelations{}, err
+	}
+
+	t := TripWithRelations{
+		Trip: Trip{
+			ID:                  row.ID,
+			Destination:         row.Destination,
+			OwnerEmail:          row.OwnerEmail,
+			OwnerName:           row.OwnerName,
+			StartsAt:            row.StartsAt,
+			EndsAt:              row.EndsAt,
+			Description:         row.Description,
+			Status:              row.Status,
+			RsvpDeadline:        row.RsvpDeadline,
+			MaxGuests:           row.MaxGuests,
+			MaxParticipants:     row.MaxParticipants,
+			PreTripReminderDays: row.PreTripReminderDays,
+			Timezone:            row.Timezone,
+			Version:             row.Version,
+			CreatedAt:           row.CreatedAt,
+			Search:              row.Search,
+			Latitude:            row.Latitude,
+			Longitude:           row.Longitude,
+			PlaceName:           row.PlaceName,
+		},
+	}
+
+	if err := json.Unmarshal(row.Participants, &t.Participants); err != nil {
+		return TripWithRelations{}, fmt.Errorf("failed to decode participants: %w", err)
+	}
+	if err := json.Unmarshal(row.Activities, &t.Activities); err != nil {
+		return TripWithRelations{}, fmt.Errorf("failed to decode activities: %w", err)
+	}
+	if err := json.Unmarshal(row.Links, &t.Links); err != nil {
+		return TripWithRelations{}, fmt.Errorf("failed to decode links: %w", err)
+	}
+
+	return t, nil
+}
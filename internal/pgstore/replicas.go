@@ -0,0 +1,92 @@
+package pgstore
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Store runs the queries on the primary database, except the read-only ones
+// serving most requests (GetTrip, GetParticipants, GetTripActivities,
+// GetTripLinks and GetTripWithRelations), which are spread over the replicas
+// in turn. Transactions
+// always run on the primary.
+//
+// A replica may lag behind the primary, so a read failing on a replica, not
+// finding a row that was just written included, is retried on the primary.
+type Store struct {
+	*Queries
+	replicas []*Queries
+	next     atomic.Uint64
+}
+
+// NewStore returns a Store writing to primary and reading from replicas. With
+// no replicas every query runs on primary. Queries running longer than
+// timeout are canceled, a zero timeout letting them run, and those failing on
+// a transient error are retried as told by retries. The queries are recorded
+// in metrics unless it is nil.
+func NewStore(timeout time.Duration, metrics *QueryMetrics, retries Retries, primary DBTX, replicas ...DBTX) *Store {
+	wrap := func(db DBTX) DBTX {
+		if timeout > 0 {
+			db = WithTimeout(db, timeout)
+		}
+		// Each attempt has its own timeout.
+		if retries.Attempts > 1 {
+			db = WithRetries(db, retries)
+		}
+		// Outside of the timeout, for the canceled queries to be recorded, and
+		// of the retries, for a query to be recorded once however many times
+		// it ran.
+		if metrics != nil {
+			db = WithMetrics(db, metrics)
+		}
+		return db
+	}
+
+	s := &Store{Queries: New(wrap(primary))}
+	for _, replica := range replicas {
+		s.replicas = append(s.replicas, New(wrap(replica)))
+	}
+
+	return s
+}
+
+// replica returns the queries of the next replica, or of the primary when
+// there are no replicas.
+func (s *Store) replica() *Queries {
+	if len(s.replicas) == 0 {
+		return s.Queries
+	}
+
+	return s.replicas[(s.next.Add(1)-1)%uint64(len(s.replicas))]
+}
+
+// read runs query on a replica, then on the primary if it fails.
+func read[T any](ctx context.Context, s *Store, query func(*Queries) (T, error)) (T, error) {
+	q := s.replica()
+
+	v, err := query(q)
+	if err == nil || q == s.Queries || ctx.Err() != nil {
+		return v, err
+	}
+
+	return query(s.Queries)
+}
+
+func (s *Store) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	return read(ctx, s, func(q *Queries) (Trip, error) { return q.GetTrip(ctx, id) })
+}
+
+func (s *Store) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	return read(ctx, s, func(q *Queries) ([]Participant, error) { return q.GetParticipants(ctx, tripID) })
+}
+
+func (s *Store) GetTripActivities(ctx context.Context, arg GetTripActivitiesParams) ([]Activity, error) {
+	return read(ctx, s, func(q *Queries) ([]Activity, error) { return q.GetTripActivities(ctx, arg) })
+}
+
+func (s *Store) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	return read(ctx, s, func(q *Queries) ([]Link, error) { return q.GetTripLinks(ctx, tripID) })
+}
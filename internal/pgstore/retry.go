@@ -0,0 +1,213 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Retries is how the queries failing on a transient error, such as a
+// connection reset or a replica failing over, are retried.
+type Retries struct {
+	// Attempts is how many times a query runs at most, one or less never
+	// retrying it.
+	Attempts int
+	// Backoff is the longest wait before the first retry, doubled on each
+	// retry. The actual wait is picked at random up to it, for the queries
+	// failing together not to be retried together.
+	Backoff time.Duration
+}
+
+// The SQLSTATE codes of the errors worth retrying a query on. The statement
+// failing on them was rolled back, so a write is retried too.
+var transientCodes = map[string]bool{
+	"40001": true, // serialization_failure, as on a conflict with recovery on a replica
+	"40P01": true, // deadlock_detected
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// retryable reports whether the query that failed with err may be run again.
+// A read is retried on the connection errors too, a write only when it was
+// never sent, as it may have been applied otherwise. The queries timing out
+// or canceled are not retried.
+func retryable(err error, read bool) bool {
+	if err == nil || isUnavailable(err) {
+		return false
+	}
+
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 is the connection exceptions.
+		return transientCodes[pgErr.Code] || (read && strings.HasPrefix(pgErr.Code, "08"))
+	}
+
+	return read && isConnectionError(err)
+}
+
+// isConnectionError reports whether err is the connection to the database
+// failing, such as being reset.
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	var connectErr *pgconn.ConnectError
+	return errors.As(err, &netErr) || errors.As(err, &connectErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isRead reports whether the sqlc query only reads.
+func isRead(sql string) bool {
+	if strings.HasPrefix(sql, "-- name: ") {
+		_, sql, _ = strings.Cut(sql, "\n")
+	}
+
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "SELECT")
+}
+
+// wait sleeps before the retry following attempt, returning false if ctx is
+// done meanwhile.
+func (r Retries) wait(ctx context.Context, attempt int) bool {
+	backoff := r.Backoff << (attempt - 1)
+	if backoff <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(rand.N(backoff) + 1)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryDB retries the queries run on db failing on a transient error.
+type retryDB struct {
+	db      DBTX
+	retries Retries
+}
+
+// WithRetries returns db retrying the queries failing on a transient error.
+// The rows of a query are only retried when the first of them fails to be
+// read, none having been returned yet. COPY is never retried, its source
+// being read once.
+func WithRetries(db DBTX, retries Retries) DBTX {
+	return retryDB{db, retries}
+}
+
+func (r retryDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	read := isRead(sql)
+
+	for attempt := 1; ; attempt++ {
+		tag, err := r.db.Exec(ctx, sql, args...)
+		if attempt >= r.retries.Attempts || !retryable(err, read) || !r.retries.wait(ctx, attempt) {
+			return tag, err
+		}
+	}
+}
+
+func (r retryDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	read := isRead(sql)
+
+	for attempt := 1; ; attempt++ {
+		rows, err := r.db.Query(ctx, sql, args...)
+		if err == nil {
+			return &retryRows{Rows: rows, db: r, ctx: ctx, sql: sql, args: args, read: read, attempt: attempt}, nil
+		}
+		if attempt >= r.retries.Attempts || !retryable(err, read) || !r.retries.wait(ctx, attempt) {
+			return nil, err
+		}
+	}
+}
+
+func (r retryDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return retryRow{r, ctx, sql, args}
+}
+
+func (r retryDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return r.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+// retryRows runs its query again when reading the first row fails on a
+// transient error.
+type retryRows struct {
+	pgx.Rows
+	db      retryDB
+	ctx     context.Context
+	sql     string
+	args    []any
+	read    bool
+	attempt int
+	started bool
+	err     error
+}
+
+func (r *retryRows) Next() bool {
+	if r.started {
+		return r.Rows.Next()
+	}
+
+	for {
+		if r.Rows.Next() {
+			r.started = true
+			return true
+		}
+
+		err := r.Rows.Err()
+		if r.attempt >= r.db.retries.Attempts || !retryable(err, r.read) || !r.db.retries.wait(r.ctx, r.attempt) {
+			r.started = true
+			return false
+		}
+
+		r.Rows.Close()
+
+		rows, err := r.db.db.Query(r.ctx, r.sql, r.args...)
+		r.attempt++
+		if err != nil {
+			r.err = err
+			r.started = true
+			return false
+		}
+
+		r.Rows = rows
+	}
+}
+
+func (r *retryRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return r.Rows.Err()
+}
+
+// retryRow runs its query again when scanning it fails on a transient error.
+type retryRow struct {
+	db   retryDB
+	ctx  context.Context
+	sql  string
+	args []any
+}
+
+func (r retryRow) Scan(dest ...any) error {
+	read := isRead(r.sql)
+
+	for attempt := 1; ; attempt++ {
+		err := r.db.db.QueryRow(r.ctx, r.sql, r.args...).Scan(dest...)
+		if attempt >= r.db.retries.Attempts || !retryable(err, read) || !r.db.retries.wait(r.ctx, attempt) {
+			return err
+		}
+	}
+}
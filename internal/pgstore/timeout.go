@@ -0,0 +1,153 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// timeoutDB cancels the queries running longer than timeout, so a stuck query
+// does not hold its caller forever.
+type timeoutDB struct {
+	db      DBTX
+	timeout time.Duration
+}
+
+// WithTimeout returns db cancelling every query that runs longer than timeout.
+// The rows of a query must be read and closed within the timeout too. Unlike
+// Tracer, it also records the queries that timed out waiting for a connection.
+func WithTimeout(db DBTX, timeout time.Duration) DBTX {
+	return timeoutDB{db, timeout}
+}
+
+func (t timeoutDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	tag, err := t.db.Exec(ctx, sql, args...)
+	markUnavailable(ctx, err)
+
+	return tag, err
+}
+
+func (t timeoutDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+
+	rows, err := t.db.Query(ctx, sql, args...)
+	if err != nil {
+		markUnavailable(ctx, err)
+		cancel()
+		return nil, err
+	}
+
+	return timeoutRows{rows, cancel}, nil
+}
+
+func (t timeoutDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	return timeoutRow{ctx, t.db.QueryRow(ctx, sql, args...), cancel}
+}
+
+func (t timeoutDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	n, err := t.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+	markUnavailable(ctx, err)
+
+	return n, err
+}
+
+// timeoutRows releases the timeout of its query once closed.
+type timeoutRows struct {
+	pgx.Rows
+	cancel context.CancelFunc
+}
+
+func (r timeoutRows) Close() {
+	r.Rows.Close()
+	r.cancel()
+}
+
+// timeoutRow releases the timeout of its query once scanned.
+type timeoutRow struct {
+	ctx    context.Context
+	row    pgx.Row
+	cancel context.CancelFunc
+}
+
+func (r timeoutRow) Scan(dest ...any) error {
+	defer r.cancel()
+
+	err := r.row.Scan(dest...)
+	markUnavailable(r.ctx, err)
+
+	return err
+}
+
+type unavailableKey struct{}
+
+// TrackUnavailable returns a context recording whether a query run with it
+// timed out or was canceled, as reported by Unavailable. The queries must run
+// on a pool traced by Tracer or through WithTimeout.
+func TrackUnavailable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unavailableKey{}, new(atomic.Bool))
+}
+
+// Unavailable reports whether a query run with ctx, a context returned by
+// TrackUnavailable, timed out or was canceled.
+func Unavailable(ctx context.Context) bool {
+	unavailable, ok := ctx.Value(unavailableKey{}).(*atomic.Bool)
+	return ok && unavailable.Load()
+}
+
+// Tracer records on the contexts returned by TrackUnavailable the queries
+// that timed out or were canceled, transactions included.
+type Tracer struct{}
+
+func (Tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return ctx
+}
+
+func (Tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	markUnavailable(ctx, data.Err)
+}
+
+func (Tracer) TraceCopyFromStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceCopyFromStartData) context.Context {
+	return ctx
+}
+
+func (Tracer) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	markUnavailable(ctx, data.Err)
+}
+
+func markUnavailable(ctx context.Context, err error) {
+	if !isUnavailable(err) {
+		return
+	}
+
+	if unavailable, ok := ctx.Value(unavailableKey{}).(*atomic.Bool); ok {
+		unavailable.Store(true)
+	}
+}
+
+// queryCanceled is the SQLSTATE of the queries canceled by the server, on
+// statement_timeout among others.
+const queryCanceled = "57014"
+
+func isUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) || pgconn.Timeout(err) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == queryCanceled
+}
@@ -2,14 +2,30 @@ package pgstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"travel-api/internal/api/spec"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrActivityNotInTrip is returned by ReorderActivitiesTx when one of the IDs
+// is not an activity of the trip.
+var ErrActivityNotInTrip = errors.New("pgstore: activity does not belong to the trip")
+
+// ErrLinkNotInTrip is returned by ReorderLinksTx when one of the IDs is not a
+// link of the trip.
+var ErrLinkNotInTrip = errors.New("pgstore: link does not belong to the trip")
+
+// defaultPreTripReminderDays is how many days before the trip the pre-trip
+// reminder is sent when the trip does not say otherwise, matching the column
+// default.
+const defaultPreTripReminderDays = 2
+
 func (q *Queries) CreateTripTx(
 	ctx context.Context,
 	pool *pgxpool.Pool,
@@ -24,33 +40,1202 @@ func (q *Queries) CreateTripTx(
 
 	qtx := q.WithTx(tx)
 
+	var description string
+	if params.Description != nil {
+		description = *params.Description
+	}
+
+	var rsvpDeadline pgtype.Timestamp
+	if params.RsvpDeadline != nil {
+		rsvpDeadline = pgtype.Timestamp{Valid: true, Time: *params.RsvpDeadline}
+	}
+
+	var maxGuests int32
+	if params.MaxGuests != nil {
+		maxGuests = int32(*params.MaxGuests)
+	}
+
+	var maxParticipants pgtype.Int4
+	if params.MaxParticipants != nil {
+		maxParticipants = pgtype.Int4{Valid: true, Int32: int32(*params.MaxParticipants)}
+	}
+
+	preTripReminderDays := int32(defaultPreTripReminderDays)
+	if params.PreTripReminderDays != nil {
+		preTripReminderDays = int32(*params.PreTripReminderDays)
+	}
+
+	timezone := "UTC"
+	if params.Timezone != nil {
+		timezone = *params.Timezone
+	}
+
 	tripID, err := qtx.InsertTrip(ctx, InsertTripParams{
-		Destination: params.Destination,
-		OwnerEmail:  string(params.OwnerEmail),
-		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Destination:         params.Destination,
+		OwnerEmail:          string(params.OwnerEmail),
+		OwnerName:           params.OwnerName,
+		StartsAt:            pgtype.Timestamp{Valid: true, Time: params.StartsAt},
+		EndsAt:              pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Description:         description,
+		RsvpDeadline:        rsvpDeadline,
+		MaxGuests:           maxGuests,
+		MaxParticipants:     maxParticipants,
+		PreTripReminderDays: preTripReminderDays,
+		Timezone:            timezone,
 	})
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
 	}
 
-	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
+	// The invitations are written in the language of the owner.
+	locale := LocalePtBR
+	if params.OwnerLocale != nil && *params.OwnerLocale != spec.UnknownLocale {
+		locale = Locale(params.OwnerLocale.ToValue())
+	}
+
+	if err := qtx.AddTripOwner(ctx, AddTripOwnerParams{
+		TripID: tripID,
+		Email:  strings.ToLower(string(params.OwnerEmail)),
+		Name:   params.OwnerName,
+		Locale: locale,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to add trip owner for CreateTrip: %w", err)
+	}
+
+	participants := make([]InviteParticipantsToTripParams, 0, len(params.EmailsToInvite))
+	seen := make(map[string]bool, len(params.EmailsToInvite))
 
-	for i, emailToInvite := range params.EmailsToInvite {
-		participants[i] = InviteParticipantsToTripParams{
+	for _, emailToInvite := range params.EmailsToInvite {
+		email := strings.ToLower(string(emailToInvite))
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		participants = append(participants, InviteParticipantsToTripParams{
 			TripID: tripID,
-			Email:  string(emailToInvite),
+			Email:  email,
+			Locale: locale,
+		})
+	}
+
+	// Invitations beyond the trip capacity go to the waitlist.
+	if maxParticipants.Valid && len(participants) > int(maxParticipants.Int32) {
+		for _, p := range participants[maxParticipants.Int32:] {
+			if err := qtx.AddToTripWaitlist(ctx, AddToTripWaitlistParams{
+				TripID: tripID,
+				Email:  p.Email,
+			}); err != nil {
+				return uuid.UUID{}, fmt.Errorf("pgstore: failed to add to waitlist for CreateTrip: %w", err)
+			}
 		}
+		participants = participants[:maxParticipants.Int32]
 	}
 
 	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to invite participants for CreateTrip: %w", err)
 	}
 
+	if err := qtx.enqueueEmail(ctx, EmailKindConfirmTrip, ConfirmTripEmail{TripID: tripID}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to enqueue confirmation email for CreateTrip: %w", err)
+	}
+
+	if err := qtx.recordEvent(ctx, DomainEventKindTripCreated, tripID, TripCreatedEvent{
+		TripID:      tripID,
+		Destination: params.Destination,
+		OwnerEmail:  strings.ToLower(string(params.OwnerEmail)),
+		StartsAt:    params.StartsAt,
+		EndsAt:      params.EndsAt,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to record event for CreateTrip: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateTrip: %w", err)
 	}
 
 	return tripID, nil
 }
+
+func (q *Queries) CreateActivitiesTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	params []CreateActivityParams,
+) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for CreateActivities: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	activityIDs := make([]uuid.UUID, len(params))
+
+	for i, p := range params {
+		activityID, err := qtx.CreateActivity(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to insert activity for CreateActivities: %w", err)
+		}
+		activityIDs[i] = activityID
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for CreateActivities: %w", err)
+	}
+
+	return activityIDs, nil
+}
+
+func (q *Queries) ReorderActivitiesTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	tripID uuid.UUID,
+	activityIDs []uuid.UUID,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ReorderActivities: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	for i, activityID := range activityIDs {
+		updated, err := qtx.UpdateActivityPosition(ctx, UpdateActivityPositionParams{
+			Position: int32(i),
+			ID:       activityID,
+			TripID:   tripID,
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to update activity position for ReorderActivities: %w", err)
+		}
+		if updated == 0 {
+			return fmt.Errorf("%w: %s", ErrActivityNotInTrip, activityID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ReorderActivities: %w", err)
+	}
+
+	return nil
+}
+
+func (q *Queries) ReorderLinksTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	tripID uuid.UUID,
+	linkIDs []uuid.UUID,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ReorderLinks: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	for i, linkID := range linkIDs {
+		updated, err := qtx.UpdateLinkPosition(ctx, UpdateLinkPositionParams{
+			Position: int32(i),
+			ID:       linkID,
+			TripID:   tripID,
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to update link position for ReorderLinks: %w", err)
+		}
+		if updated == 0 {
+			return fmt.Errorf("%w: %s", ErrLinkNotInTrip, linkID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ReorderLinks: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmParticipantTx confirms a participant and records the change in the
+// participant status history.
+func (q *Queries) ConfirmParticipantTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	params ConfirmParticipantParams,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ConfirmParticipant: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.ConfirmParticipant(ctx, params); err != nil {
+		return fmt.Errorf("pgstore: failed to confirm participant for ConfirmParticipant: %w", err)
+	}
+
+	if err := qtx.InsertParticipantStatusChange(ctx, InsertParticipantStatusChangeParams{
+		ParticipantID: params.ID,
+		Status:        ParticipantStatusConfirmed,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record status change for ConfirmParticipant: %w", err)
+	}
+
+	participant, err := qtx.GetParticipant(ctx, params.ID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participant for ConfirmParticipant: %w", err)
+	}
+
+	if err := qtx.recordEvent(ctx, DomainEventKindParticipantConfirmed, participant.TripID, ParticipantConfirmedEvent{
+		TripID: participant.TripID,
+		Email:  participant.Email,
+		Guests: participant.Guests,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record event for ConfirmParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ConfirmParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// UnconfirmParticipantTx takes back the confirmation of a participant, who
+// goes back to not having answered the invitation, records the reason in the
+// participant status history and lets the trip owners know by email.
+func (q *Queries) UnconfirmParticipantTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	participantID uuid.UUID,
+	reason string,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UnconfirmParticipant: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.UnconfirmParticipant(ctx, participantID); err != nil {
+		return fmt.Errorf("pgstore: failed to unconfirm participant for UnconfirmParticipant: %w", err)
+	}
+
+	if err := qtx.InsertParticipantStatusChange(ctx, InsertParticipantStatusChangeParams{
+		ParticipantID: participantID,
+		Status:        ParticipantStatusUnconfirmed,
+		Reason:        pgtype.Text{Valid: true, String: reason},
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record status change for UnconfirmParticipant: %w", err)
+	}
+
+	if err := qtx.enqueueEmail(ctx, EmailKindUnconfirmation, UnconfirmationEmail{
+		ParticipantID: participantID,
+		Reason:        reason,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue owners email for UnconfirmParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for UnconfirmParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// DeclineParticipantTx declines the invitation of a participant of the trip
+// and, when the trip has a maximum number of participants and a seat is now
+// free, promotes the first person of the waitlist to participant and invites
+// them.
+func (q *Queries) DeclineParticipantTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	tripID uuid.UUID,
+	params DeclineParticipantParams,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for DeclineParticipant: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.DeclineParticipant(ctx, params); err != nil {
+		return fmt.Errorf("pgstore: failed to decline participant for DeclineParticipant: %w", err)
+	}
+
+	if err := qtx.InsertParticipantStatusChange(ctx, InsertParticipantStatusChangeParams{
+		ParticipantID: params.ID,
+		Status:        ParticipantStatusDeclined,
+		Reason:        params.DeclineReason,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to record status change for DeclineParticipant: %w", err)
+	}
+
+	if err := qtx.promoteFromWaitlist(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to promote from waitlist for DeclineParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for DeclineParticipant: %w", err)
+	}
+
+	return nil
+}
+
+// promoteFromWaitlist turns the oldest waitlist entries of the trip into
+// participants, and invites them, while the trip is below its maximum number
+// of participants, or all of them when it no longer has one. The trip is
+// locked until the transaction ends.
+func (q *Queries) promoteFromWaitlist(ctx context.Context, tripID uuid.UUID) error {
+	maxParticipants, err := q.LockTripMaxParticipants(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	active, err := q.CountActiveTripParticipants(ctx, tripID)
+	if err != nil {
+		return err
+	}
+
+	for ; !maxParticipants.Valid || active < int64(maxParticipants.Int32); active++ {
+		email, err := q.PopTripWaitlist(ctx, tripID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		if _, err := q.InsertParticipant(ctx, InsertParticipantParams{
+			TripID: tripID,
+			Email:  email,
+		}); err != nil {
+			return err
+		}
+
+		if err := q.enqueueInvitation(ctx, InvitationEmail{TripID: tripID, Email: email}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfirmTripTx moves the trip from status from to status to and invites the
+// participants who have not been sent their invitation yet. It returns false,
+// changing nothing, when the trip is no longer in status from.
+func (q *Queries) ConfirmTripTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	tripID uuid.UUID,
+	from TripStatus,
+	to TripStatus,
+) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin tx for ConfirmTrip: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.UpdateTripStatusFrom(ctx, UpdateTripStatusFromParams{
+		Status:     to,
+		ID:         tripID,
+		FromStatus: from,
+	})
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to update trip status for ConfirmTrip: %w", err)
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	if err := qtx.recordEvent(ctx, DomainEventKindTripConfirmed, tripID, TripConfirmedEvent{
+		TripID: tripID,
+		Status: to,
+	}); err != nil {
+		return false, fmt.Errorf("pgstore: failed to record event for ConfirmTrip: %w", err)
+	}
+
+	// Participants waiting on their email verification are invited once they
+	// verify it.
+	emails, err := qtx.GetParticipantsToInvite(ctx, tripID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to get participants to invite for ConfirmTrip: %w", err)
+	}
+
+	for _, email := range emails {
+		if err := qtx.enqueueInvitation(ctx, InvitationEmail{TripID: tripID, Email: email}); err != nil {
+			return false, fmt.Errorf("pgstore: failed to enqueue invitation for ConfirmTrip: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit tx for ConfirmTrip: %w", err)
+	}
+
+	return true, nil
+}
+
+// InviteParticipantsTx adds the participants to the trip and sends them the
+// invitation. Participants with a verification are sent its code instead, and
+// get the invitation once they confirm their address. The trip is locked while
+// its seats are counted, and the participants beyond its maximum go to its
+// waitlist instead. It returns the emails added to the waitlist.
+func (q *Queries) InviteParticipantsTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	tripID uuid.UUID,
+	participants []InviteParticipantsToTripParams,
+	verifications []CreateEmailVerificationParams,
+) ([]string, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for InviteParticipants: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	maxParticipants, err := qtx.LockTripMaxParticipants(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock trip for InviteParticipants: %w", err)
+	}
+
+	var waitlisted []string
+	if maxParticipants.Valid {
+		active, err := qtx.CountActiveTripParticipants(ctx, tripID)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to count participants for InviteParticipants: %w", err)
+		}
+
+		seats := max(int(maxParticipants.Int32)-int(active), 0)
+		if len(participants) > seats {
+			for _, p := range participants[seats:] {
+				if err := qtx.AddToTripWaitlist(ctx, AddToTripWaitlistParams{
+					TripID: tripID,
+					Email:  p.Email,
+				}); err != nil {
+					return nil, fmt.Errorf("pgstore: failed to add to waitlist for InviteParticipants: %w", err)
+				}
+				waitlisted = append(waitlisted, p.Email)
+			}
+			participants = participants[:seats]
+		}
+	}
+
+	if len(participants) > 0 {
+		if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to invite participants for InviteParticipants: %w", err)
+		}
+	}
+
+	invited := make(map[string]bool, len(participants))
+	for _, p := range participants {
+		invited[p.Email] = true
+	}
+
+	verifying := make(map[string]bool, len(verifications))
+	for _, v := range verifications {
+		if !invited[v.Email] {
+			continue
+		}
+
+		if err := qtx.CreateEmailVerification(ctx, v); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to create email verification for InviteParticipants: %w", err)
+		}
+
+		if err := qtx.enqueueEmail(ctx, EmailKindEmailVerification, EmailVerificationEmail{TripID: v.TripID, Email: v.Email}); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to enqueue email verification for InviteParticipants: %w", err)
+		}
+
+		verifying[v.Email] = true
+	}
+
+	for _, p := range participants {
+		if verifying[p.Email] {
+			continue
+		}
+
+		if err := qtx.enqueueInvitation(ctx, InvitationEmail{TripID: p.TripID, Email: p.Email}); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to enqueue invitation for InviteParticipants: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for InviteParticipants: %w", err)
+	}
+
+	return waitlisted, nil
+}
+
+// JoinTripTx adds a participant to the trip and sends them the invitation.
+// The trip is locked while its seats are counted, and the participant goes to
+// its waitlist instead when it is full. It returns whether they were added to
+// the waitlist.
+func (q *Queries) JoinTripTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	params InsertParticipantParams,
+) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin tx for JoinTrip: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	maxParticipants, err := qtx.LockTripMaxParticipants(ctx, params.TripID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to lock trip for JoinTrip: %w", err)
+	}
+
+	if maxParticipants.Valid {
+		active, err := qtx.CountActiveTripParticipants(ctx, params.TripID)
+		if err != nil {
+			return false, fmt.Errorf("pgstore: failed to count participants for JoinTrip: %w", err)
+		}
+
+		if active >= int64(maxParticipants.Int32) {
+			if err := qtx.AddToTripWaitlist(ctx, AddToTripWaitlistParams{
+				TripID: params.TripID,
+				Email:  params.Email,
+			}); err != nil {
+				return false, fmt.Errorf("pgstore: failed to add to waitlist for JoinTrip: %w", err)
+			}
+
+			if err := tx.Commit(ctx); err != nil {
+				return false, fmt.Errorf("pgstore: failed to commit tx for JoinTrip: %w", err)
+			}
+
+			return true, nil
+		}
+	}
+
+	if _, err := qtx.InsertParticipant(ctx, params); err != nil {
+		return false, fmt.Errorf("pgstore: failed to insert participant for JoinTrip: %w", err)
+	}
+
+	if err := qtx.enqueueInvitation(ctx, InvitationEmail{TripID: params.TripID, Email: params.Email}); err != nil {
+		return false, fmt.Errorf("pgstore: failed to enqueue invitation for JoinTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit tx for JoinTrip: %w", err)
+	}
+
+	return false, nil
+}
+
+// ReinviteParticipantTx sends the invitation again unless the participant was
+// invited after params.InvitedAt. It returns the number of participants
+// reinvited, 0 or 1.
+func (q *Queries) ReinviteParticipantTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	params MarkParticipantReinvitedParams,
+	invitation InvitationEmail,
+) (int64, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for ReinviteParticipant: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.MarkParticipantReinvited(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to mark participant reinvited for ReinviteParticipant: %w", err)
+	}
+	if updated == 0 {
+		return 0, nil
+	}
+
+	if err := qtx.enqueueInvitation(ctx, invitation); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to enqueue invitation for ReinviteParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for ReinviteParticipant: %w", err)
+	}
+
+	return updated, nil
+}
+
+// ResendEmailVerificationTx sends a new verification code unless the
+// participant was invited after params.InvitedAt, replacing the previous code.
+// It returns the number of participants sent a code, 0 or 1.
+func (q *Queries) ResendEmailVerificationTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	params MarkParticipantReinvitedParams,
+	verification CreateEmailVerificationParams,
+) (int64, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for ResendEmailVerification: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.MarkParticipantReinvited(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to mark participant reinvited for ResendEmailVerification: %w", err)
+	}
+	if updated == 0 {
+		return 0, nil
+	}
+
+	if err := qtx.CreateEmailVerification(ctx, verification); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to create email verification for ResendEmailVerification: %w", err)
+	}
+
+	if err := qtx.enqueueEmail(ctx, EmailKindEmailVerification, EmailVerificationEmail{
+		TripID: verification.TripID,
+		Email:  verification.Email,
+	}); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to enqueue email verification for ResendEmailVerification: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for ResendEmailVerification: %w", err)
+	}
+
+	return updated, nil
+}
+
+// VerifyParticipantEmailTx marks the address of the participant as verified
+// and sends them the invitation held back until then.
+func (q *Queries) VerifyParticipantEmailTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	participantID uuid.UUID,
+	invitation InvitationEmail,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for VerifyParticipantEmail: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.MarkParticipantEmailVerified(ctx, participantID); err != nil {
+		return fmt.Errorf("pgstore: failed to mark email verified for VerifyParticipantEmail: %w", err)
+	}
+
+	if err := qtx.DeleteEmailVerification(ctx, participantID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete email verification for VerifyParticipantEmail: %w", err)
+	}
+
+	if err := qtx.enqueueInvitation(ctx, invitation); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue invitation for VerifyParticipantEmail: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for VerifyParticipantEmail: %w", err)
+	}
+
+	return nil
+}
+
+// QueueActivityReminderTx sends an activity reminder and records it, so it is
+// sent only once.
+func (q *Queries) QueueActivityReminderTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	reminder GetDueActivityRemindersRow,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueueActivityReminder: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.enqueueEmail(ctx, EmailKindActivityReminder, reminder); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue reminder for QueueActivityReminder: %w", err)
+	}
+
+	if err := qtx.MarkActivityReminderSent(ctx, MarkActivityReminderSentParams{
+		ActivityID:    reminder.ActivityID,
+		ParticipantID: reminder.ParticipantID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to mark reminder sent for QueueActivityReminder: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueueActivityReminder: %w", err)
+	}
+
+	return nil
+}
+
+// QueueRSVPReminderTx sends an RSVP reminder and records it, so it is sent
+// only once.
+func (q *Queries) QueueRSVPReminderTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	reminder GetDueRSVPRemindersRow,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueueRSVPReminder: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.enqueueEmail(ctx, EmailKindRsvpReminder, reminder); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue reminder for QueueRSVPReminder: %w", err)
+	}
+
+	if err := qtx.MarkRSVPReminderSent(ctx, reminder.ParticipantID); err != nil {
+		return fmt.Errorf("pgstore: failed to mark reminder sent for QueueRSVPReminder: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueueRSVPReminder: %w", err)
+	}
+
+	return nil
+}
+
+// QueuePreTripReminderTx sends a pre-trip reminder and records it, so it is
+// sent only once.
+func (q *Queries) QueuePreTripReminderTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	reminder GetDuePreTripRemindersRow,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueuePreTripReminder: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.enqueueEmail(ctx, EmailKindPreTripReminder, reminder); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue reminder for QueuePreTripReminder: %w", err)
+	}
+
+	if err := qtx.MarkPreTripReminderSent(ctx, reminder.ParticipantID); err != nil {
+		return fmt.Errorf("pgstore: failed to mark reminder sent for QueuePreTripReminder: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueuePreTripReminder: %w", err)
+	}
+
+	return nil
+}
+
+// QueueTaskReminderTx nudges the assignee of an overdue task and records it,
+// so they are nudged only once until the task changes.
+func (q *Queries) QueueTaskReminderTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	reminder GetDueTaskRemindersRow,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueueTaskReminder: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.enqueueEmail(ctx, EmailKindTaskReminder, reminder); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue reminder for QueueTaskReminder: %w", err)
+	}
+
+	if err := qtx.MarkTaskReminderSent(ctx, reminder.TaskID); err != nil {
+		return fmt.Errorf("pgstore: failed to mark reminder sent for QueueTaskReminder: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueueTaskReminder: %w", err)
+	}
+
+	return nil
+}
+
+// QueueFlightUpdateTx records the new status of a flight and notifies its
+// passengers of the change, in the notifications feed and by email unless they
+// opted out.
+func (q *Queries) QueueFlightUpdateTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	update FlightUpdate,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueueFlightUpdate: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.UpsertFlightStatus(ctx, UpsertFlightStatusParams{
+		SegmentID:   update.SegmentID,
+		DepartureAt: pgtype.Timestamp{Valid: true, Time: update.DepartureAt},
+		Gate:        pgtype.Text{Valid: update.Gate != "", String: update.Gate},
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to upsert flight status for QueueFlightUpdate: %w", err)
+	}
+
+	if err := qtx.CreateFlightUpdatedNotifications(ctx, update.SegmentID); err != nil {
+		return fmt.Errorf("pgstore: failed to create notifications for QueueFlightUpdate: %w", err)
+	}
+
+	recipients, err := qtx.GetFlightUpdateRecipients(ctx, update.SegmentID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get recipients for QueueFlightUpdate: %w", err)
+	}
+
+	for _, participantID := range recipients {
+		if err := qtx.enqueueEmail(ctx, EmailKindFlightUpdate, FlightUpdateEmail{
+			ParticipantID: participantID,
+			FlightUpdate:  update,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to enqueue email for QueueFlightUpdate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueueFlightUpdate: %w", err)
+	}
+
+	return nil
+}
+
+// QueueDailyDigestTx sends the digest of a trip day and records it, so it is
+// sent only once a day.
+func (q *Queries) QueueDailyDigestTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	digest GetDueDailyDigestsRow,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for QueueDailyDigest: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if err := qtx.enqueueEmail(ctx, EmailKindDailyDigest, digest); err != nil {
+		return fmt.Errorf("pgstore: failed to enqueue digest for QueueDailyDigest: %w", err)
+	}
+
+	if err := qtx.MarkDailyDigestSent(ctx, MarkDailyDigestSentParams{
+		ParticipantID: digest.ParticipantID,
+		Day:           digest.Day,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to mark digest sent for QueueDailyDigest: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for QueueDailyDigest: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateNotificationPreferencesTx turns each kind of notification in enabled
+// on or off for the participant. Kinds left out are not changed.
+func (q *Queries) UpdateNotificationPreferencesTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	participantID uuid.UUID,
+	enabled map[NotificationKind]bool,
+) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateNotificationPreferences: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	for kind, on := range enabled {
+		if on {
+			err = qtx.OptInToNotification(ctx, OptInToNotificationParams{ParticipantID: participantID, Kind: kind})
+		} else {
+			err = qtx.OptOutOfNotification(ctx, OptOutOfNotificationParams{ParticipantID: participantID, Kind: kind})
+		}
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to update %s preference for UpdateNotificationPreferences: %w", kind, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for UpdateNotificationPreferences: %w", err)
+	}
+
+	return nil
+}
+
+// CreatePollTx creates the poll with its options, in order, and emails the
+// participants who did not decline the trip the links to vote on it.
+func (q *Queries) CreatePollTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	arg CreatePollParams,
+	options []CreatePollOptionParams,
+) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreatePoll: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	pollID, err := qtx.CreatePoll(ctx, arg)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert poll for CreatePoll: %w", err)
+	}
+
+	for i, option := range options {
+		option.PollID = pollID
+		option.Position = int32(i)
+		if err := qtx.CreatePollOption(ctx, option); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert poll option for CreatePoll: %w", err)
+		}
+	}
+
+	participants, err := qtx.GetParticipants(ctx, arg.TripID)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to get participants for CreatePoll: %w", err)
+	}
+
+	for _, participant := range participants {
+		if participant.DeclinedAt.Valid {
+			continue
+		}
+		if err := qtx.enqueueEmail(ctx, EmailKindPollInvitation, PollInvitationEmail{
+			PollID:        pollID,
+			ParticipantID: participant.ID,
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to enqueue poll invitation for CreatePoll: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreatePoll: %w", err)
+	}
+
+	return pollID, nil
+}
+
+// UpdateTripPartialTx updates the fields of the trip set in update. Setting the
+// RSVP deadline clears the reminders sent and the participants flagged as not
+// responding against the old one, so they are reminded and flagged against
+// the new one, and raising or removing the maximum number of participants
+// promotes people from the waitlist. It returns 0, changing nothing, when the
+// trip is no longer at the version of update.
+func (q *Queries) UpdateTripPartialTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	update UpdateTripPartialParams,
+) (int64, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for UpdateTripPartial: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.UpdateTripPartial(ctx, update)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to update trip for UpdateTripPartial: %w", err)
+	}
+	if updated == 0 {
+		return 0, nil
+	}
+
+	if update.RsvpDeadline.Valid {
+		if err := qtx.ResetRSVPReminders(ctx, update.ID); err != nil {
+			return 0, fmt.Errorf("pgstore: failed to reset rsvp reminders for UpdateTripPartial: %w", err)
+		}
+	}
+
+	// A larger trip takes people from its waitlist.
+	if update.MaxParticipants.Valid || update.ClearMaxParticipants {
+		if err := qtx.promoteFromWaitlist(ctx, update.ID); err != nil {
+			return 0, fmt.Errorf("pgstore: failed to promote from waitlist for UpdateTripPartial: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for UpdateTripPartial: %w", err)
+	}
+
+	return updated, nil
+}
+
+// ApplyPollTx updates the trip with the winning option of the poll and closes
+// the poll. It returns false, changing nothing, when the trip is no longer at
+// the version of update or the poll was already applied.
+func (q *Queries) ApplyPollTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	pollID uuid.UUID,
+	update UpdateTripPartialParams,
+) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin tx for ApplyPoll: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	applied, err := qtx.MarkPollApplied(ctx, pollID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to mark poll applied for ApplyPoll: %w", err)
+	}
+	if applied == 0 {
+		return false, nil
+	}
+
+	updated, err := qtx.UpdateTripPartial(ctx, update)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to update trip for ApplyPoll: %w", err)
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit tx for ApplyPoll: %w", err)
+	}
+
+	return true, nil
+}
+
+// CreateTransportSegmentTx creates the segment along with the participants
+// taking it.
+func (q *Queries) CreateTransportSegmentTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	arg CreateTransportSegmentParams,
+	participantIDs []uuid.UUID,
+) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateTransportSegment: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	segmentID, err := qtx.CreateTransportSegment(ctx, arg)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert segment for CreateTransportSegment: %w", err)
+	}
+
+	for _, participantID := range participantIDs {
+		if err := qtx.AddTransportSegmentParticipant(ctx, AddTransportSegmentParticipantParams{
+			SegmentID:     segmentID,
+			TripID:        arg.TripID,
+			ParticipantID: participantID,
+		}); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert segment participant for CreateTransportSegment: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateTransportSegment: %w", err)
+	}
+
+	return segmentID, nil
+}
+
+// UpdateTransportSegmentTx updates the segment and replaces the participants
+// taking it. It returns the number of segments updated, 0 or 1.
+func (q *Queries) UpdateTransportSegmentTx(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	arg UpdateTransportSegmentParams,
+	participantIDs []uuid.UUID,
+) (int64, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for UpdateTransportSegment: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	updated, err := qtx.UpdateTransportSegment(ctx, arg)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to update segment for UpdateTransportSegment: %w", err)
+	}
+	if updated == 0 {
+		return 0, nil
+	}
+
+	if err := qtx.DeleteTransportSegmentParticipants(ctx, arg.ID); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to delete segment participants for UpdateTransportSegment: %w", err)
+	}
+
+	for _, participantID := range participantIDs {
+		if err := qtx.AddTransportSegmentParticipant(ctx, AddTransportSegmentParticipantParams{
+			SegmentID:     arg.ID,
+			TripID:        arg.TripID,
+			ParticipantID: participantID,
+		}); err != nil {
+			return 0, fmt.Errorf("pgstore: failed to insert segment participant for UpdateTransportSegment: %w", err)
+		}
+	}
+
+	// The status of the flight may no longer be the one of the segment, the
+	// next check starts over from its schedule.
+	if err := qtx.DeleteFlightStatus(ctx, arg.ID); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to delete flight status for UpdateTransportSegment: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for UpdateTransportSegment: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,44 @@
+package places
+
+import (
+	"context"
+	"fmt"
+)
+
+// Kind is what a place is visited for.
+type Kind string
+
+const (
+	Attraction Kind = "attraction"
+	Restaurant Kind = "restaurant"
+)
+
+// ParseKind returns the kind named s.
+func ParseKind(s string) (Kind, error) {
+	switch kind := Kind(s); kind {
+	case Attraction, Restaurant:
+		return kind, nil
+	}
+	return "", fmt.Errorf("places: invalid kind %q", s)
+}
+
+// Place is a point of interest near the destination of a trip.
+type Place struct {
+	// ID is the identifier of the place at the provider, stable across
+	// lookups.
+	ID        string
+	Name      string
+	Kind      Kind
+	Address   string
+	Latitude  float64
+	Longitude float64
+	// Rating is the average rating of the place, from 1 to 5, or 0 when it
+	// has none.
+	Rating float64
+}
+
+// Provider finds the points of interest around a place, the most relevant
+// first. An empty kind asks for places of every kind.
+type Provider interface {
+	Nearby(ctx context.Context, latitude, longitude float64, kind Kind) ([]Place, error)
+}
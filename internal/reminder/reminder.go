@@ -0,0 +1,190 @@
+package reminder
+
+import (
+	"context"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// digestHour is the hour of the day, in the trip timezone, from which the
+// daily digest is sent.
+const digestHour = 7
+
+type store interface {
+	GetDueActivityReminders(context.Context, pgstore.GetDueActivityRemindersParams) ([]pgstore.GetDueActivityRemindersRow, error)
+	QueueActivityReminderTx(context.Context, *pgxpool.Pool, pgstore.GetDueActivityRemindersRow) error
+	GetDueRSVPReminders(context.Context, pgstore.GetDueRSVPRemindersParams) ([]pgstore.GetDueRSVPRemindersRow, error)
+	QueueRSVPReminderTx(context.Context, *pgxpool.Pool, pgstore.GetDueRSVPRemindersRow) error
+	GetDuePreTripReminders(context.Context, pgtype.Timestamp) ([]pgstore.GetDuePreTripRemindersRow, error)
+	QueuePreTripReminderTx(context.Context, *pgxpool.Pool, pgstore.GetDuePreTripRemindersRow) error
+	GetDueDailyDigests(context.Context, pgstore.GetDueDailyDigestsParams) ([]pgstore.GetDueDailyDigestsRow, error)
+	QueueDailyDigestTx(context.Context, *pgxpool.Pool, pgstore.GetDueDailyDigestsRow) error
+	GetDueTaskReminders(context.Context, pgtype.Timestamp) ([]pgstore.GetDueTaskRemindersRow, error)
+	QueueTaskReminderTx(context.Context, *pgxpool.Pool, pgstore.GetDueTaskRemindersRow) error
+	FlagNoResponseParticipants(context.Context, pgtype.Timestamp) (int64, error)
+}
+
+// Scheduler periodically runs the background jobs that keep participants
+// informed:
+//   - confirmed participants are e-mailed about activities starting within
+//     lead, unless they opted out;
+//   - participants that did not answer their invitation are e-mailed rsvpLead
+//     before the trip RSVP deadline and flagged as "no response" once it
+//     passes;
+//   - confirmed participants are e-mailed the day-one agenda and the links of
+//     the trip as many days before it starts as the trip asks for;
+//   - during the trip, confirmed participants are e-mailed the activities of
+//     the day every morning, in the trip timezone, unless they opted out;
+//   - the assignees of overdue tasks are nudged to do them, unless they opted
+//     out, once per task until its due date or assignee change.
+//
+// Every reminder is recorded so it is sent only once, the emails themselves
+// going through the outbox.
+type Scheduler struct {
+	store    store
+	pool     *pgxpool.Pool
+	logger   *zap.Logger
+	lead     time.Duration
+	rsvpLead time.Duration
+	interval time.Duration
+}
+
+func NewScheduler(pool *pgxpool.Pool, logger *zap.Logger, lead, rsvpLead, interval time.Duration) Scheduler {
+	return Scheduler{pgstore.New(pool), pool, logger, lead, rsvpLead, interval}
+}
+
+// Run runs the jobs every interval until ctx is done.
+func (s Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now().UTC()
+		s.sendActivityReminders(ctx, now)
+		s.sendRSVPReminders(ctx, now)
+		s.sendPreTripReminders(ctx, now)
+		s.sendDailyDigests(ctx, now)
+		s.sendTaskReminders(ctx, now)
+		s.flagNoResponses(ctx, now)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s Scheduler) sendActivityReminders(ctx context.Context, now time.Time) {
+	reminders, err := s.store.GetDueActivityReminders(ctx, pgstore.GetDueActivityRemindersParams{
+		FromTime: pgtype.Timestamp{Valid: true, Time: now},
+		ToTime:   pgtype.Timestamp{Valid: true, Time: now.Add(s.lead)},
+	})
+	if err != nil {
+		s.logger.Error("failed to get due activity reminders", zap.Error(err))
+		return
+	}
+
+	for _, reminder := range reminders {
+		if err := s.store.QueueActivityReminderTx(ctx, s.pool, reminder); err != nil {
+			s.logger.Error("failed to queue activity reminder",
+				zap.Error(err),
+				zap.String("activity_id", reminder.ActivityID.String()),
+				zap.String("participant_id", reminder.ParticipantID.String()),
+			)
+		}
+	}
+}
+
+func (s Scheduler) sendRSVPReminders(ctx context.Context, now time.Time) {
+	reminders, err := s.store.GetDueRSVPReminders(ctx, pgstore.GetDueRSVPRemindersParams{
+		FromTime: pgtype.Timestamp{Valid: true, Time: now},
+		ToTime:   pgtype.Timestamp{Valid: true, Time: now.Add(s.rsvpLead)},
+	})
+	if err != nil {
+		s.logger.Error("failed to get due rsvp reminders", zap.Error(err))
+		return
+	}
+
+	for _, reminder := range reminders {
+		if err := s.store.QueueRSVPReminderTx(ctx, s.pool, reminder); err != nil {
+			s.logger.Error("failed to queue rsvp reminder",
+				zap.Error(err),
+				zap.String("participant_id", reminder.ParticipantID.String()),
+			)
+		}
+	}
+}
+
+func (s Scheduler) sendPreTripReminders(ctx context.Context, now time.Time) {
+	reminders, err := s.store.GetDuePreTripReminders(ctx, pgtype.Timestamp{Valid: true, Time: now})
+	if err != nil {
+		s.logger.Error("failed to get due pre-trip reminders", zap.Error(err))
+		return
+	}
+
+	for _, reminder := range reminders {
+		if err := s.store.QueuePreTripReminderTx(ctx, s.pool, reminder); err != nil {
+			s.logger.Error("failed to queue pre-trip reminder",
+				zap.Error(err),
+				zap.String("trip_id", reminder.TripID.String()),
+				zap.String("participant_id", reminder.ParticipantID.String()),
+			)
+		}
+	}
+}
+
+func (s Scheduler) sendDailyDigests(ctx context.Context, now time.Time) {
+	digests, err := s.store.GetDueDailyDigests(ctx, pgstore.GetDueDailyDigestsParams{
+		Now:      pgtype.Timestamp{Valid: true, Time: now},
+		SendHour: digestHour,
+	})
+	if err != nil {
+		s.logger.Error("failed to get due daily digests", zap.Error(err))
+		return
+	}
+
+	for _, digest := range digests {
+		if err := s.store.QueueDailyDigestTx(ctx, s.pool, digest); err != nil {
+			s.logger.Error("failed to queue daily digest",
+				zap.Error(err),
+				zap.String("trip_id", digest.TripID.String()),
+				zap.String("participant_id", digest.ParticipantID.String()),
+			)
+		}
+	}
+}
+
+func (s Scheduler) sendTaskReminders(ctx context.Context, now time.Time) {
+	reminders, err := s.store.GetDueTaskReminders(ctx, pgtype.Timestamp{Valid: true, Time: now})
+	if err != nil {
+		s.logger.Error("failed to get due task reminders", zap.Error(err))
+		return
+	}
+
+	for _, reminder := range reminders {
+		if err := s.store.QueueTaskReminderTx(ctx, s.pool, reminder); err != nil {
+			s.logger.Error("failed to queue task reminder",
+				zap.Error(err),
+				zap.String("task_id", reminder.TaskID.String()),
+				zap.String("participant_id", reminder.ParticipantID.String()),
+			)
+		}
+	}
+}
+
+func (s Scheduler) flagNoResponses(ctx context.Context, now time.Time) {
+	flagged, err := s.store.FlagNoResponseParticipants(ctx, pgtype.Timestamp{Valid: true, Time: now})
+	if err != nil {
+		s.logger.Error("failed to flag participants without response", zap.Error(err))
+		return
+	}
+
+	if flagged > 0 {
+		s.logger.Info("flagged participants without response", zap.Int64("participants", flagged))
+	}
+}
@@ -0,0 +1,193 @@
+package seed
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+	"travel-api/internal/pgstore"
+)
+
+type destination struct {
+	city     string
+	country  string
+	timezone string
+	lat, lon float64
+}
+
+var destinations = []destination{
+	{"Rio de Janeiro", "Brasil", "America/Sao_Paulo", -22.9068, -43.1729},
+	{"Florianópolis", "Brasil", "America/Sao_Paulo", -27.5949, -48.5482},
+	{"Salvador", "Brasil", "America/Bahia", -12.9777, -38.5016},
+	{"Gramado", "Brasil", "America/Sao_Paulo", -29.3787, -50.8741},
+	{"Buenos Aires", "Argentina", "America/Argentina/Buenos_Aires", -34.6037, -58.3816},
+	{"Santiago", "Chile", "America/Santiago", -33.4489, -70.6693},
+	{"Cusco", "Peru", "America/Lima", -13.5320, -71.9675},
+	{"Cartagena", "Colombia", "America/Bogota", 10.3910, -75.4794},
+	{"Cidade do México", "México", "America/Mexico_City", 19.4326, -99.1332},
+	{"Nova York", "Estados Unidos", "America/New_York", 40.7128, -74.0060},
+	{"Lisboa", "Portugal", "Europe/Lisbon", 38.7223, -9.1393},
+	{"Porto", "Portugal", "Europe/Lisbon", 41.1579, -8.6291},
+	{"Barcelona", "Espanha", "Europe/Madrid", 41.3874, 2.1686},
+	{"Paris", "França", "Europe/Paris", 48.8566, 2.3522},
+	{"Roma", "Itália", "Europe/Rome", 41.9028, 12.4964},
+	{"Amsterdã", "Holanda", "Europe/Amsterdam", 52.3676, 4.9041},
+	{"Berlim", "Alemanha", "Europe/Berlin", 52.5200, 13.4050},
+	{"Tóquio", "Japão", "Asia/Tokyo", 35.6762, 139.6503},
+	{"Bangkok", "Tailândia", "Asia/Bangkok", 13.7563, 100.5018},
+	{"Cidade do Cabo", "África do Sul", "Africa/Johannesburg", -33.9249, 18.4241},
+}
+
+var firstNames = []string{
+	"Ana", "Bruno", "Camila", "Diego", "Eduarda", "Felipe", "Gabriela", "Henrique",
+	"Isabela", "João", "Larissa", "Lucas", "Mariana", "Mateus", "Natália", "Pedro",
+	"Rafaela", "Rodrigo", "Sofia", "Thiago", "Valentina", "Vinícius", "Beatriz", "Gustavo",
+}
+
+var lastNames = []string{
+	"Almeida", "Barbosa", "Cardoso", "Costa", "Ferreira", "Gomes", "Lima", "Martins",
+	"Melo", "Oliveira", "Pereira", "Ribeiro", "Rocha", "Santos", "Silva", "Souza",
+}
+
+var emailDomains = []string{"gmail.com", "outlook.com", "yahoo.com.br", "proton.me", "example.com"}
+
+var activityTitles = map[pgstore.ActivityCategory][]string{
+	pgstore.ActivityCategoryFood: {
+		"Café da manhã na padaria", "Almoço no mercado central", "Jantar de boas-vindas",
+		"Aula de culinária local", "Degustação de vinhos", "Tour gastronômico",
+	},
+	pgstore.ActivityCategoryTransport: {
+		"Voo de ida", "Voo de volta", "Traslado do aeroporto", "Aluguel de carro",
+		"Trem para a cidade vizinha", "Passeio de barco",
+	},
+	pgstore.ActivityCategorySightseeing: {
+		"Visita ao centro histórico", "Museu de arte", "Trilha até o mirante",
+		"Pôr do sol na praia", "Tour a pé guiado", "Passeio de bicicleta",
+	},
+	pgstore.ActivityCategoryLodging: {
+		"Check-in no hotel", "Check-out do hotel", "Check-in na pousada",
+	},
+	pgstore.ActivityCategoryOther: {
+		"Compras de lembranças", "Dia livre", "Show ao vivo", "Reunião do grupo",
+	},
+}
+
+var activityCategories = []pgstore.ActivityCategory{
+	pgstore.ActivityCategoryFood,
+	pgstore.ActivityCategoryTransport,
+	pgstore.ActivityCategorySightseeing,
+	pgstore.ActivityCategoryLodging,
+	pgstore.ActivityCategoryOther,
+}
+
+type link struct {
+	title    string
+	host     string
+	category pgstore.LinkCategory
+}
+
+var links = []link{
+	{"Reserva do hotel", "booking.com", pgstore.LinkCategoryLodging},
+	{"Reserva do apartamento", "airbnb.com", pgstore.LinkCategoryLodging},
+	{"Passagens aéreas", "latam.com", pgstore.LinkCategoryTransport},
+	{"Aluguel do carro", "localiza.com", pgstore.LinkCategoryTransport},
+	{"Ingressos do museu", "tickets.example.com", pgstore.LinkCategoryTickets},
+	{"Ingressos do show", "eventim.com.br", pgstore.LinkCategoryTickets},
+	{"Seguro viagem", "seguro.example.com", pgstore.LinkCategoryDocs},
+	{"Roteiro compartilhado", "docs.google.com", pgstore.LinkCategoryDocs},
+	{"Mapa dos restaurantes", "maps.google.com", pgstore.LinkCategoryOther},
+}
+
+var locales = []pgstore.Locale{pgstore.LocalePtBR, pgstore.LocalePtBR, pgstore.LocaleEn, pgstore.LocaleEs}
+
+// faker makes up realistic looking data, always the same for the same seed.
+type faker struct {
+	rand *rand.Rand
+	// n numbers the emails so they stay unique across trips.
+	n int
+}
+
+func newFaker(seed uint64) *faker {
+	return &faker{rand: rand.New(rand.NewPCG(seed, seed))}
+}
+
+func pick[T any](f *faker, items []T) T {
+	return items[f.rand.IntN(len(items))]
+}
+
+func (f *faker) name() string {
+	return pick(f, firstNames) + " " + pick(f, lastNames)
+}
+
+// email returns a unique address made from name.
+func (f *faker) email(name string) string {
+	f.n++
+	user := strings.ToLower(strings.ReplaceAll(name, " ", "."))
+	return fmt.Sprintf("%s.%d@%s", ascii(user), f.n, pick(f, emailDomains))
+}
+
+func (f *faker) destination() destination {
+	return pick(f, destinations)
+}
+
+func (f *faker) locale() pgstore.Locale {
+	return pick(f, locales)
+}
+
+// tripDates returns the start and end of a trip of 2 to 14 days, starting
+// between two months ago and six months from now, so there are past, ongoing
+// and upcoming trips.
+func (f *faker) tripDates(now time.Time) (time.Time, time.Time) {
+	startsAt := now.AddDate(0, 0, f.rand.IntN(240)-60).Truncate(24 * time.Hour).Add(9 * time.Hour)
+	endsAt := startsAt.AddDate(0, 0, 2+f.rand.IntN(13))
+	return startsAt, endsAt
+}
+
+func (f *faker) tripStatus(startsAt, endsAt, now time.Time) pgstore.TripStatus {
+	switch {
+	case f.rand.IntN(10) == 0:
+		return pgstore.TripStatusCancelled
+	case endsAt.Before(now):
+		return pgstore.TripStatusCompleted
+	case startsAt.Before(now):
+		return pgstore.TripStatusOngoing
+	case f.rand.IntN(3) == 0:
+		return pgstore.TripStatusDraft
+	default:
+		return pgstore.TripStatusConfirmed
+	}
+}
+
+// activityAt returns when an activity takes place during the trip, at a
+// round hour between 8h and 21h.
+func (f *faker) activityAt(startsAt, endsAt time.Time) (time.Time, time.Time) {
+	days := int(endsAt.Sub(startsAt).Hours() / 24)
+	day := startsAt.Truncate(24*time.Hour).AddDate(0, 0, f.rand.IntN(days+1))
+	occursAt := day.Add(time.Duration(8+f.rand.IntN(14)) * time.Hour)
+	return occursAt, occursAt.Add(time.Duration(1+f.rand.IntN(3)) * time.Hour)
+}
+
+func (f *faker) activity() (string, pgstore.ActivityCategory) {
+	category := pick(f, activityCategories)
+	return pick(f, activityTitles[category]), category
+}
+
+// near returns a coordinate within about a kilometer of d.
+func (f *faker) near(d destination) (float64, float64) {
+	return d.lat + (f.rand.Float64()-0.5)/50, d.lon + (f.rand.Float64()-0.5)/50
+}
+
+func (f *faker) link() (string, string, pgstore.LinkCategory) {
+	l := pick(f, links)
+	return l.title, fmt.Sprintf("https://%s/%x", l.host, f.rand.Uint32()), l.category
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "ã", "a", "â", "a", "é", "e", "ê", "e", "í", "i",
+	"ó", "o", "õ", "o", "ô", "o", "ú", "u", "ç", "c",
+)
+
+// ascii drops the accents of the names, which are not valid in emails.
+func ascii(s string) string {
+	return accents.Replace(s)
+}
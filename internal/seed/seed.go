@@ -0,0 +1,184 @@
+// Package seed fills the database with made up trips, for demos, local
+// development and load testing.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Config is how much data Run makes up.
+type Config struct {
+	Trips               int
+	ParticipantsPerTrip int
+	ActivitiesPerTrip   int
+	LinksPerTrip        int
+	// Seed makes the generated data reproducible, the same seed making up the
+	// same trips.
+	Seed uint64
+}
+
+// Counts is how many rows Run inserted.
+type Counts struct {
+	Trips        int
+	Participants int
+	Activities   int
+	Links        int
+}
+
+// Run inserts cfg.Trips trips, each in its own transaction, with their owner,
+// participants, activities and links. No email is sent for the made up trips.
+func Run(ctx context.Context, pool *pgxpool.Pool, cfg Config) (Counts, error) {
+	f := newFaker(cfg.Seed)
+	now := time.Now().UTC()
+
+	var counts Counts
+	for range cfg.Trips {
+		tripCounts, err := seedTrip(ctx, pool, f, cfg, now)
+		if err != nil {
+			return counts, err
+		}
+
+		counts.Trips++
+		counts.Participants += tripCounts.Participants
+		counts.Activities += tripCounts.Activities
+		counts.Links += tripCounts.Links
+	}
+
+	return counts, nil
+}
+
+func seedTrip(ctx context.Context, pool *pgxpool.Pool, f *faker, cfg Config, now time.Time) (Counts, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return Counts{}, fmt.Errorf("seed: failed to begin tx: %w", err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := pgstore.New(tx)
+
+	dest := f.destination()
+	ownerName := f.name()
+	ownerEmail := f.email(ownerName)
+	locale := f.locale()
+	startsAt, endsAt := f.tripDates(now)
+
+	tripID, err := q.InsertTrip(ctx, pgstore.InsertTripParams{
+		Destination:         dest.city + ", " + dest.country,
+		OwnerEmail:          ownerEmail,
+		OwnerName:           ownerName,
+		StartsAt:            pgtype.Timestamp{Valid: true, Time: startsAt},
+		EndsAt:              pgtype.Timestamp{Valid: true, Time: endsAt},
+		Description:         fmt.Sprintf("Viagem de %s para %s.", ownerName, dest.city),
+		RsvpDeadline:        pgtype.Timestamp{Valid: true, Time: startsAt.AddDate(0, 0, -7)},
+		PreTripReminderDays: 2,
+		Timezone:            dest.timezone,
+	})
+	if err != nil {
+		return Counts{}, fmt.Errorf("seed: failed to insert trip: %w", err)
+	}
+
+	if err := q.AddTripOwner(ctx, pgstore.AddTripOwnerParams{
+		TripID: tripID,
+		Email:  ownerEmail,
+		Name:   ownerName,
+		Locale: locale,
+	}); err != nil {
+		return Counts{}, fmt.Errorf("seed: failed to add trip owner: %w", err)
+	}
+
+	status := f.tripStatus(startsAt, endsAt, now)
+	if status != pgstore.TripStatusDraft {
+		if err := q.UpdateTripStatus(ctx, pgstore.UpdateTripStatusParams{Status: status, ID: tripID}); err != nil {
+			return Counts{}, fmt.Errorf("seed: failed to update trip status: %w", err)
+		}
+	}
+
+	participants := make([]pgstore.InviteParticipantsToTripParams, 0, cfg.ParticipantsPerTrip)
+	for range cfg.ParticipantsPerTrip {
+		participants = append(participants, pgstore.InviteParticipantsToTripParams{
+			TripID: tripID,
+			Email:  f.email(f.name()),
+			Locale: locale,
+		})
+	}
+
+	if _, err := q.InviteParticipantsToTrip(ctx, participants); err != nil {
+		return Counts{}, fmt.Errorf("seed: failed to invite participants: %w", err)
+	}
+
+	// The participants of trips past the draft were invited, and most of them
+	// answered.
+	if status != pgstore.TripStatusDraft {
+		invited, err := q.GetParticipants(ctx, tripID)
+		if err != nil {
+			return Counts{}, fmt.Errorf("seed: failed to get participants: %w", err)
+		}
+
+		for _, p := range invited {
+			if err := q.RecordInvitationSent(ctx, pgstore.RecordInvitationSentParams{TripID: tripID, Email: p.Email}); err != nil {
+				return Counts{}, fmt.Errorf("seed: failed to record invitation: %w", err)
+			}
+
+			if f.rand.IntN(4) == 0 {
+				continue
+			}
+
+			if err := q.ConfirmParticipant(ctx, pgstore.ConfirmParticipantParams{
+				Guests: int32(f.rand.IntN(2)),
+				ID:     p.ID,
+			}); err != nil {
+				return Counts{}, fmt.Errorf("seed: failed to confirm participant: %w", err)
+			}
+		}
+	}
+
+	for range cfg.ActivitiesPerTrip {
+		title, category := f.activity()
+		occursAt, activityEndsAt := f.activityAt(startsAt, endsAt)
+		lat, lon := f.near(dest)
+
+		if _, err := q.CreateActivity(ctx, pgstore.CreateActivityParams{
+			TripID:    tripID,
+			Title:     title,
+			OccursAt:  pgtype.Timestamp{Valid: true, Time: occursAt},
+			EndsAt:    pgtype.Timestamp{Valid: true, Time: activityEndsAt},
+			Address:   pgtype.Text{Valid: true, String: dest.city},
+			Latitude:  pgtype.Float8{Valid: true, Float64: lat},
+			Longitude: pgtype.Float8{Valid: true, Float64: lon},
+			Category:  category,
+		}); err != nil {
+			return Counts{}, fmt.Errorf("seed: failed to create activity: %w", err)
+		}
+	}
+
+	for i := range cfg.LinksPerTrip {
+		title, url, category := f.link()
+
+		if _, err := q.CreateTripLink(ctx, pgstore.CreateTripLinkParams{
+			TripID:   tripID,
+			Title:    title,
+			Url:      url,
+			Category: category,
+			Pinned:   i == 0,
+		}); err != nil {
+			return Counts{}, fmt.Errorf("seed: failed to create link: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Counts{}, fmt.Errorf("seed: failed to commit tx: %w", err)
+	}
+
+	return Counts{
+		Participants: cfg.ParticipantsPerTrip,
+		Activities:   cfg.ActivitiesPerTrip,
+		Links:        cfg.LinksPerTrip,
+	}, nil
+}
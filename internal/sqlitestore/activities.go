@@ -0,0 +1,385 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const activityColumns = `"id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "position", "created_at", "deleted_at", "place_name"`
+
+func scanActivity(row scanner) (pgstore.Activity, error) {
+	var i pgstore.Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.EndsAt,
+		&i.Address,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Category,
+		&i.Position,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.PlaceName,
+	)
+	return i, err
+}
+
+const createActivity = `
+INSERT INTO activities
+    ( "id", "trip_id", "title", "occurs_at", "ends_at", "address", "latitude", "longitude", "category", "place_name" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+func createActivityWith(ctx context.Context, q querier, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, q, createActivity,
+		id,
+		arg.TripID,
+		arg.Title,
+		timestamp(arg.OccursAt),
+		timestamp(arg.EndsAt),
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Category,
+		arg.PlaceName,
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+func (s *Store) CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	return createActivityWith(ctx, s.db, arg)
+}
+
+func (s *Store) CreateActivitiesTx(ctx context.Context, _ *pgxpool.Pool, params []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	activityIDs := make([]uuid.UUID, len(params))
+
+	err := s.inTx(ctx, "CreateActivities", func(tx *sql.Tx) error {
+		for i, p := range params {
+			activityID, err := createActivityWith(ctx, tx, p)
+			if err != nil {
+				return fmt.Errorf("sqlitestore: failed to insert activity for CreateActivities: %w", err)
+			}
+			activityIDs[i] = activityID
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return activityIDs, nil
+}
+
+const updateActivityPosition = `
+UPDATE activities
+SET
+    "position" = ?
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) ReorderActivitiesTx(ctx context.Context, _ *pgxpool.Pool, tripID uuid.UUID, activityIDs []uuid.UUID) error {
+	return s.inTx(ctx, "ReorderActivities", func(tx *sql.Tx) error {
+		for i, activityID := range activityIDs {
+			updated, err := exec(ctx, tx, updateActivityPosition, int32(i), activityID, tripID)
+			if err != nil {
+				return fmt.Errorf("sqlitestore: failed to update activity position for ReorderActivities: %w", err)
+			}
+			if updated == 0 {
+				return fmt.Errorf("%w: %s", pgstore.ErrActivityNotInTrip, activityID)
+			}
+		}
+
+		return nil
+	})
+}
+
+const getTripActivities = `
+SELECT
+    ` + activityColumns + `
+FROM activities
+WHERE
+    trip_id = ?1 AND deleted_at IS NULL
+    AND (?2 IS NULL OR category = ?2)
+    AND (?3 IS NULL OR occurs_at >= ?3)
+    AND (?4 IS NULL OR occurs_at <= ?4)
+ORDER BY
+    occurs_at, position, id
+`
+
+func (s *Store) GetTripActivities(ctx context.Context, arg pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error) {
+	return queryAll(ctx, s.db, scanActivity, getTripActivities,
+		arg.TripID,
+		arg.Category,
+		timestamp(arg.FromTime),
+		timestamp(arg.ToTime),
+	)
+}
+
+const getActivity = `
+SELECT
+    ` + activityColumns + `
+FROM activities
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) GetActivity(ctx context.Context, arg pgstore.GetActivityParams) (pgstore.Activity, error) {
+	activity, err := scanActivity(s.db.QueryRowContext(ctx, getActivity, arg.ID, arg.TripID))
+	return activity, pgError(err)
+}
+
+const updateActivity = `
+UPDATE activities
+SET
+    "title" = ?,
+    "occurs_at" = ?,
+    "ends_at" = ?,
+    "address" = ?,
+    "latitude" = ?,
+    "longitude" = ?,
+    "category" = ?,
+    "place_name" = ?
+WHERE
+    id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) UpdateActivity(ctx context.Context, arg pgstore.UpdateActivityParams) error {
+	_, err := exec(ctx, s.db, updateActivity,
+		arg.Title,
+		timestamp(arg.OccursAt),
+		timestamp(arg.EndsAt),
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Category,
+		arg.PlaceName,
+		arg.ID,
+	)
+	return err
+}
+
+const getActivityTripID = `
+SELECT
+    "trip_id"
+FROM activities
+WHERE
+    id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) GetActivityTripID(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	var tripID uuid.UUID
+	err := queryRow(ctx, s.db, getActivityTripID, []any{id}, &tripID)
+	return tripID, err
+}
+
+const deleteActivity = `
+UPDATE activities
+SET
+    "deleted_at" = ?
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) DeleteActivity(ctx context.Context, arg pgstore.DeleteActivityParams) (int64, error) {
+	return exec(ctx, s.db, deleteActivity, now(), arg.ID, arg.TripID)
+}
+
+const restoreActivity = `
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NOT NULL
+`
+
+func (s *Store) RestoreActivity(ctx context.Context, arg pgstore.RestoreActivityParams) (int64, error) {
+	return exec(ctx, s.db, restoreActivity, arg.ID, arg.TripID)
+}
+
+const upsertActivityRSVP = `
+INSERT INTO activity_attendees
+    ( "activity_id", "participant_id", "attending" ) VALUES
+    ( ?, ?, ? )
+ON CONFLICT ("activity_id", "participant_id") DO UPDATE
+SET
+    "attending" = excluded.attending,
+    "updated_at" = excluded.updated_at
+`
+
+func (s *Store) UpsertActivityRSVP(ctx context.Context, arg pgstore.UpsertActivityRSVPParams) error {
+	_, err := exec(ctx, s.db, upsertActivityRSVP, arg.ActivityID, arg.ParticipantID, arg.Attending)
+	return err
+}
+
+const getTripActivityAttendeeCounts = `
+SELECT
+    activity_attendees.activity_id, count(*) AS attendees
+FROM activity_attendees
+JOIN activities ON activities.id = activity_attendees.activity_id
+WHERE
+    activities.trip_id = ? AND activities.deleted_at IS NULL AND activity_attendees.attending
+GROUP BY
+    activity_attendees.activity_id
+`
+
+func (s *Store) GetTripActivityAttendeeCounts(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityAttendeeCountsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripActivityAttendeeCountsRow, error) {
+		var i pgstore.GetTripActivityAttendeeCountsRow
+		err := row.Scan(&i.ActivityID, &i.Attendees)
+		return i, err
+	}, getTripActivityAttendeeCounts, tripID)
+}
+
+const voteActivity = `
+INSERT INTO activity_votes
+    ( "activity_id", "participant_id" ) VALUES
+    ( ?, ? )
+ON CONFLICT ("activity_id", "participant_id") DO NOTHING
+`
+
+func (s *Store) VoteActivity(ctx context.Context, arg pgstore.VoteActivityParams) error {
+	_, err := exec(ctx, s.db, voteActivity, arg.ActivityID, arg.ParticipantID)
+	return err
+}
+
+const unvoteActivity = `
+DELETE FROM activity_votes
+WHERE
+    activity_id = ? AND participant_id = ?
+`
+
+func (s *Store) UnvoteActivity(ctx context.Context, arg pgstore.UnvoteActivityParams) (int64, error) {
+	return exec(ctx, s.db, unvoteActivity, arg.ActivityID, arg.ParticipantID)
+}
+
+const getTripActivityVoteCounts = `
+SELECT
+    activity_votes.activity_id, count(*) AS votes
+FROM activity_votes
+JOIN activities ON activities.id = activity_votes.activity_id
+WHERE
+    activities.trip_id = ? AND activities.deleted_at IS NULL
+GROUP BY
+    activity_votes.activity_id
+`
+
+func (s *Store) GetTripActivityVoteCounts(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityVoteCountsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripActivityVoteCountsRow, error) {
+		var i pgstore.GetTripActivityVoteCountsRow
+		err := row.Scan(&i.ActivityID, &i.Votes)
+		return i, err
+	}, getTripActivityVoteCounts, tripID)
+}
+
+const createActivityComment = `
+INSERT INTO activity_comments
+    ( "id", "activity_id", "participant_id", "body" ) VALUES
+    ( ?, ?, ?, ? )
+`
+
+func (s *Store) CreateActivityComment(ctx context.Context, arg pgstore.CreateActivityCommentParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createActivityComment, id, arg.ActivityID, arg.ParticipantID, arg.Body); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getActivityComments = `
+SELECT
+    activity_comments.id, activity_comments.participant_id, participants.email, activity_comments.body, activity_comments.created_at
+FROM activity_comments
+JOIN participants ON participants.id = activity_comments.participant_id
+WHERE
+    activity_comments.activity_id = ? AND activity_comments.deleted_at IS NULL
+ORDER BY
+    activity_comments.created_at
+`
+
+func (s *Store) GetActivityComments(ctx context.Context, activityID uuid.UUID) ([]pgstore.GetActivityCommentsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetActivityCommentsRow, error) {
+		var i pgstore.GetActivityCommentsRow
+		err := row.Scan(&i.ID, &i.ParticipantID, &i.Email, &i.Body, &i.CreatedAt)
+		return i, err
+	}, getActivityComments, activityID)
+}
+
+const deleteActivityComment = `
+UPDATE activity_comments
+SET
+    "deleted_at" = ?
+WHERE
+    id = ? AND activity_id = ? AND participant_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) DeleteActivityComment(ctx context.Context, arg pgstore.DeleteActivityCommentParams) (int64, error) {
+	return exec(ctx, s.db, deleteActivityComment, now(), arg.ID, arg.ActivityID, arg.ParticipantID)
+}
+
+const restoreActivityComment = `
+UPDATE activity_comments
+SET
+    "deleted_at" = NULL
+WHERE
+    id = ? AND activity_id = ? AND participant_id = ? AND deleted_at IS NOT NULL
+`
+
+func (s *Store) RestoreActivityComment(ctx context.Context, arg pgstore.RestoreActivityCommentParams) (int64, error) {
+	return exec(ctx, s.db, restoreActivityComment, arg.ID, arg.ActivityID, arg.ParticipantID)
+}
+
+const createActivityAttachment = `
+INSERT INTO activity_attachments
+    ( "id", "activity_id", "file_name", "content_type", "size", "storage_key" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateActivityAttachment(ctx context.Context, arg pgstore.CreateActivityAttachmentParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createActivityAttachment,
+		id,
+		arg.ActivityID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getActivityAttachments = `
+SELECT
+    "id", "activity_id", "file_name", "content_type", "size", "storage_key", "created_at"
+FROM activity_attachments
+WHERE
+    activity_id = ?
+ORDER BY
+    created_at
+`
+
+func (s *Store) GetActivityAttachments(ctx context.Context, activityID uuid.UUID) ([]pgstore.ActivityAttachment, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.ActivityAttachment, error) {
+		var i pgstore.ActivityAttachment
+		err := row.Scan(&i.ID, &i.ActivityID, &i.FileName, &i.ContentType, &i.Size, &i.StorageKey, &i.CreatedAt)
+		return i, err
+	}, getActivityAttachments, activityID)
+}
@@ -0,0 +1,110 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const createBooking = `
+INSERT INTO bookings
+    ( "id", "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateBooking(ctx context.Context, arg pgstore.CreateBookingParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createBooking,
+		id,
+		arg.TripID,
+		arg.Type,
+		arg.Name,
+		timestamp(arg.CheckInAt),
+		timestamp(arg.CheckOutAt),
+		arg.Address,
+		arg.ConfirmationCode,
+		arg.Price,
+		arg.Currency,
+		arg.Url,
+		now(),
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripBookings = `
+SELECT
+    "id", "trip_id", "type", "name", "check_in_at", "check_out_at", "address", "confirmation_code", "price", "currency", "url", "created_at"
+FROM bookings
+WHERE
+    trip_id = ?
+ORDER BY
+    check_in_at, id
+`
+
+func (s *Store) GetTripBookings(ctx context.Context, tripID uuid.UUID) ([]pgstore.Booking, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.Booking, error) {
+		var i pgstore.Booking
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Type,
+			&i.Name,
+			&i.CheckInAt,
+			&i.CheckOutAt,
+			&i.Address,
+			&i.ConfirmationCode,
+			&i.Price,
+			&i.Currency,
+			&i.Url,
+			&i.CreatedAt,
+		)
+		return i, err
+	}, getTripBookings, tripID)
+}
+
+const updateBooking = `
+UPDATE bookings
+SET
+    "type" = ?,
+    "name" = ?,
+    "check_in_at" = ?,
+    "check_out_at" = ?,
+    "address" = ?,
+    "confirmation_code" = ?,
+    "price" = ?,
+    "currency" = ?,
+    "url" = ?
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) UpdateBooking(ctx context.Context, arg pgstore.UpdateBookingParams) (int64, error) {
+	return exec(ctx, s.db, updateBooking,
+		arg.Type,
+		arg.Name,
+		timestamp(arg.CheckInAt),
+		timestamp(arg.CheckOutAt),
+		arg.Address,
+		arg.ConfirmationCode,
+		arg.Price,
+		arg.Currency,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+	)
+}
+
+const deleteBooking = `
+DELETE FROM bookings
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteBooking(ctx context.Context, arg pgstore.DeleteBookingParams) (int64, error) {
+	return exec(ctx, s.db, deleteBooking, arg.ID, arg.TripID)
+}
@@ -0,0 +1,90 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const documentColumns = `"id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at"`
+
+func scanDocument(row scanner) (pgstore.Document, error) {
+	var i pgstore.Document
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.Kind,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createDocument = `
+INSERT INTO documents
+    ( "id", "trip_id", "participant_id", "kind", "file_name", "content_type", "size", "storage_key", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateDocument(ctx context.Context, arg pgstore.CreateDocumentParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createDocument,
+		id,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Kind,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+		now(),
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripDocuments = `
+SELECT
+    ` + documentColumns + `
+FROM documents
+WHERE
+    trip_id = ?
+    AND (participant_id IS NULL OR participant_id = ?)
+ORDER BY
+    created_at, id
+`
+
+func (s *Store) GetTripDocuments(ctx context.Context, arg pgstore.GetTripDocumentsParams) ([]pgstore.Document, error) {
+	return queryAll(ctx, s.db, scanDocument, getTripDocuments, arg.TripID, arg.ParticipantID)
+}
+
+const getDocument = `
+SELECT
+    ` + documentColumns + `
+FROM documents
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) GetDocument(ctx context.Context, arg pgstore.GetDocumentParams) (pgstore.Document, error) {
+	document, err := scanDocument(s.db.QueryRowContext(ctx, getDocument, arg.ID, arg.TripID))
+	return document, pgError(err)
+}
+
+const deleteDocument = `
+DELETE FROM documents
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteDocument(ctx context.Context, arg pgstore.DeleteDocumentParams) (int64, error) {
+	return exec(ctx, s.db, deleteDocument, arg.ID, arg.TripID)
+}
@@ -0,0 +1,182 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const createTripExpense = `
+INSERT INTO expenses
+    ( "id", "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateTripExpense(ctx context.Context, arg pgstore.CreateTripExpenseParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createTripExpense,
+		id,
+		arg.TripID,
+		arg.PayerID,
+		arg.ActivityID,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.Category,
+		timestamp(arg.SpentAt),
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripExpenses = `
+SELECT
+    "id", "trip_id", "payer_id", "activity_id", "description", "amount", "currency", "category", "spent_at", "created_at"
+FROM expenses
+WHERE
+    trip_id = ?
+ORDER BY
+    spent_at, created_at
+`
+
+func (s *Store) GetTripExpenses(ctx context.Context, tripID uuid.UUID) ([]pgstore.Expense, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.Expense, error) {
+		var i pgstore.Expense
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.PayerID,
+			&i.ActivityID,
+			&i.Description,
+			&i.Amount,
+			&i.Currency,
+			&i.Category,
+			&i.SpentAt,
+			&i.CreatedAt,
+		)
+		return i, err
+	}, getTripExpenses, tripID)
+}
+
+const updateTripExpense = `
+UPDATE expenses
+SET
+    "payer_id" = ?,
+    "activity_id" = ?,
+    "description" = ?,
+    "amount" = ?,
+    "currency" = ?,
+    "category" = ?,
+    "spent_at" = ?
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) UpdateTripExpense(ctx context.Context, arg pgstore.UpdateTripExpenseParams) (int64, error) {
+	return exec(ctx, s.db, updateTripExpense,
+		arg.PayerID,
+		arg.ActivityID,
+		arg.Description,
+		arg.Amount,
+		arg.Currency,
+		arg.Category,
+		timestamp(arg.SpentAt),
+		arg.ID,
+		arg.TripID,
+	)
+}
+
+const deleteTripExpense = `
+DELETE FROM expenses
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteTripExpense(ctx context.Context, arg pgstore.DeleteTripExpenseParams) (int64, error) {
+	return exec(ctx, s.db, deleteTripExpense, arg.ID, arg.TripID)
+}
+
+// The categories are sorted as text rather than in the order of the
+// expense_category enum of Postgres, which only changes the order of the
+// totals.
+const getTripExpenseTotals = `
+SELECT
+    "currency", "category", "payer_id", sum(amount) AS total
+FROM expenses
+WHERE
+    trip_id = ?
+GROUP BY
+    currency, category, payer_id
+ORDER BY
+    currency, category, payer_id
+`
+
+func (s *Store) GetTripExpenseTotals(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseTotalsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripExpenseTotalsRow, error) {
+		var i pgstore.GetTripExpenseTotalsRow
+		err := row.Scan(&i.Currency, &i.Category, &i.PayerID, &i.Total)
+		return i, err
+	}, getTripExpenseTotals, tripID)
+}
+
+const createSettlementPayment = `
+INSERT INTO settlement_payments
+    ( "id", "trip_id", "from_participant_id", "to_participant_id", "amount", "currency" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateSettlementPayment(ctx context.Context, arg pgstore.CreateSettlementPaymentParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createSettlementPayment,
+		id,
+		arg.TripID,
+		arg.FromParticipantID,
+		arg.ToParticipantID,
+		arg.Amount,
+		arg.Currency,
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripSettlementPayments = `
+SELECT
+    "id", "trip_id", "from_participant_id", "to_participant_id", "amount", "currency", "paid_at"
+FROM settlement_payments
+WHERE
+    trip_id = ?
+ORDER BY
+    paid_at
+`
+
+func (s *Store) GetTripSettlementPayments(ctx context.Context, tripID uuid.UUID) ([]pgstore.SettlementPayment, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.SettlementPayment, error) {
+		var i pgstore.SettlementPayment
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.FromParticipantID,
+			&i.ToParticipantID,
+			&i.Amount,
+			&i.Currency,
+			&i.PaidAt,
+		)
+		return i, err
+	}, getTripSettlementPayments, tripID)
+}
+
+const deleteSettlementPayment = `
+DELETE FROM settlement_payments
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteSettlementPayment(ctx context.Context, arg pgstore.DeleteSettlementPaymentParams) (int64, error) {
+	return exec(ctx, s.db, deleteSettlementPayment, arg.ID, arg.TripID)
+}
@@ -0,0 +1,101 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const createJournalEntry = `
+INSERT INTO journal_entries
+    ( "id", "trip_id", "participant_id", "day", "title", "body", "visibility", "created_at", "updated_at" ) VALUES
+    ( ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?8 )
+`
+
+func (s *Store) CreateJournalEntry(ctx context.Context, arg pgstore.CreateJournalEntryParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createJournalEntry,
+		id,
+		arg.TripID,
+		arg.ParticipantID,
+		date(arg.Day),
+		arg.Title,
+		arg.Body,
+		arg.Visibility,
+		now(),
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripJournalEntries = `
+SELECT
+    journal_entries.id, journal_entries.participant_id, participants.name, participants.email, journal_entries.day,
+    journal_entries.title, journal_entries.body, journal_entries.visibility, journal_entries.created_at,
+    journal_entries.updated_at
+FROM journal_entries
+JOIN participants ON participants.id = journal_entries.participant_id
+WHERE
+    journal_entries.trip_id = ?1
+    AND (journal_entries.visibility = 'shared' OR journal_entries.participant_id = ?2)
+ORDER BY
+    journal_entries.day, journal_entries.created_at, journal_entries.id
+`
+
+func (s *Store) GetTripJournalEntries(ctx context.Context, arg pgstore.GetTripJournalEntriesParams) ([]pgstore.GetTripJournalEntriesRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripJournalEntriesRow, error) {
+		var i pgstore.GetTripJournalEntriesRow
+		err := row.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Day,
+			&i.Title,
+			&i.Body,
+			&i.Visibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		)
+		return i, err
+	}, getTripJournalEntries, arg.TripID, arg.ParticipantID)
+}
+
+const updateJournalEntry = `
+UPDATE journal_entries
+SET
+    "day" = ?4,
+    "title" = ?5,
+    "body" = ?6,
+    "visibility" = ?7,
+    "updated_at" = ?8
+WHERE
+    id = ?1 AND trip_id = ?2 AND participant_id = ?3
+`
+
+func (s *Store) UpdateJournalEntry(ctx context.Context, arg pgstore.UpdateJournalEntryParams) (int64, error) {
+	return exec(ctx, s.db, updateJournalEntry,
+		arg.ID,
+		arg.TripID,
+		arg.ParticipantID,
+		date(arg.Day),
+		arg.Title,
+		arg.Body,
+		arg.Visibility,
+		now(),
+	)
+}
+
+const deleteJournalEntry = `
+DELETE FROM journal_entries
+WHERE
+    id = ? AND trip_id = ? AND participant_id = ?
+`
+
+func (s *Store) DeleteJournalEntry(ctx context.Context, arg pgstore.DeleteJournalEntryParams) (int64, error) {
+	return exec(ctx, s.db, deleteJournalEntry, arg.ID, arg.TripID, arg.ParticipantID)
+}
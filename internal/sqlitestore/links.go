@@ -0,0 +1,204 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const createTripLink = `
+INSERT INTO links
+    ( "id", "trip_id", "title", "url", "category", "pinned" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createTripLink, id, arg.TripID, arg.Title, arg.Url, arg.Category, arg.Pinned); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripLinks = `
+SELECT
+    "id", "trip_id", "title", "url", "category", "preview_title", "preview_description", "preview_favicon_url", "preview_image_url", "preview_fetched_at", "pinned", "position", "deleted_at"
+FROM links
+WHERE
+    trip_id = ? AND deleted_at IS NULL
+ORDER BY
+    category, position, title
+`
+
+func (s *Store) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.Link, error) {
+		var i pgstore.Link
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.Category,
+			&i.PreviewTitle,
+			&i.PreviewDescription,
+			&i.PreviewFaviconUrl,
+			&i.PreviewImageUrl,
+			&i.PreviewFetchedAt,
+			&i.Pinned,
+			&i.Position,
+			&i.DeletedAt,
+		)
+		return i, err
+	}, getTripLinks, tripID)
+}
+
+const updateTripLink = `
+UPDATE links
+SET
+    "title" = ?,
+    "url" = ?,
+    "category" = ?
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) UpdateTripLink(ctx context.Context, arg pgstore.UpdateTripLinkParams) (int64, error) {
+	return exec(ctx, s.db, updateTripLink, arg.Title, arg.Url, arg.Category, arg.ID, arg.TripID)
+}
+
+const updateTripLinkPartial = `
+UPDATE links
+SET
+    "title" = COALESCE(?, "title"),
+    "url" = COALESCE(?, "url"),
+    "category" = COALESCE(?, "category"),
+    "pinned" = COALESCE(?, "pinned")
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) UpdateTripLinkPartial(ctx context.Context, arg pgstore.UpdateTripLinkPartialParams) (int64, error) {
+	return exec(ctx, s.db, updateTripLinkPartial, arg.Title, arg.Url, arg.Category, arg.Pinned, arg.ID, arg.TripID)
+}
+
+const deleteTripLink = `
+UPDATE links
+SET
+    "deleted_at" = ?
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) DeleteTripLink(ctx context.Context, arg pgstore.DeleteTripLinkParams) (int64, error) {
+	return exec(ctx, s.db, deleteTripLink, now(), arg.ID, arg.TripID)
+}
+
+const restoreTripLink = `
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NOT NULL
+`
+
+func (s *Store) RestoreTripLink(ctx context.Context, arg pgstore.RestoreTripLinkParams) (int64, error) {
+	return exec(ctx, s.db, restoreTripLink, arg.ID, arg.TripID)
+}
+
+const getLinkURL = `
+SELECT
+    "url"
+FROM links
+WHERE
+    id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) GetLinkURL(ctx context.Context, id uuid.UUID) (string, error) {
+	var url string
+	err := queryRow(ctx, s.db, getLinkURL, []any{id}, &url)
+	return url, err
+}
+
+const recordLinkClick = `
+INSERT INTO link_clicks
+    ( "id", "link_id" ) VALUES
+    ( ?, ? )
+`
+
+func (s *Store) RecordLinkClick(ctx context.Context, linkID uuid.UUID) error {
+	_, err := exec(ctx, s.db, recordLinkClick, uuid.New(), linkID)
+	return err
+}
+
+const getTripLinkClickCounts = `
+SELECT
+    link_clicks.link_id, count(*) AS clicks
+FROM link_clicks
+JOIN links ON links.id = link_clicks.link_id
+WHERE
+    links.trip_id = ? AND links.deleted_at IS NULL
+GROUP BY
+    link_clicks.link_id
+`
+
+func (s *Store) GetTripLinkClickCounts(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripLinkClickCountsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripLinkClickCountsRow, error) {
+		var i pgstore.GetTripLinkClickCountsRow
+		err := row.Scan(&i.LinkID, &i.Clicks)
+		return i, err
+	}, getTripLinkClickCounts, tripID)
+}
+
+const setLinkPreview = `
+UPDATE links
+SET
+    "preview_title" = ?,
+    "preview_description" = ?,
+    "preview_favicon_url" = ?,
+    "preview_image_url" = ?,
+    "preview_fetched_at" = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) SetLinkPreview(ctx context.Context, arg pgstore.SetLinkPreviewParams) error {
+	_, err := exec(ctx, s.db, setLinkPreview,
+		arg.PreviewTitle,
+		arg.PreviewDescription,
+		arg.PreviewFaviconUrl,
+		arg.PreviewImageUrl,
+		now(),
+		arg.ID,
+	)
+	return err
+}
+
+const updateLinkPosition = `
+UPDATE links
+SET
+    "position" = ?
+WHERE
+    id = ? AND trip_id = ? AND deleted_at IS NULL
+`
+
+func (s *Store) ReorderLinksTx(ctx context.Context, _ *pgxpool.Pool, tripID uuid.UUID, linkIDs []uuid.UUID) error {
+	return s.inTx(ctx, "ReorderLinks", func(tx *sql.Tx) error {
+		for i, linkID := range linkIDs {
+			updated, err := exec(ctx, tx, updateLinkPosition, int32(i), linkID, tripID)
+			if err != nil {
+				return fmt.Errorf("sqlitestore: failed to update link position for ReorderLinks: %w", err)
+			}
+			if updated == 0 {
+				return fmt.Errorf("%w: %s", pgstore.ErrLinkNotInTrip, linkID)
+			}
+		}
+
+		return nil
+	})
+}
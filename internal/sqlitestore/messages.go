@@ -0,0 +1,92 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMessage = `
+INSERT INTO messages
+    ( "id", "trip_id", "participant_id", "body", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateMessage(ctx context.Context, arg pgstore.CreateMessageParams) (uuid.UUID, error) {
+	id := uuid.New()
+	// created_at is written to the microsecond, which the cursors of the
+	// pages keep.
+	if _, err := exec(ctx, s.db, createMessage, id, arg.TripID, arg.ParticipantID, arg.Body, now()); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripMessagesPage = `
+SELECT
+    messages.id, messages.participant_id, participants.name, participants.email, messages.body, messages.created_at
+FROM messages
+JOIN participants ON participants.id = messages.participant_id
+WHERE
+    messages.trip_id = ?1
+    AND (?2 IS NULL OR (messages.created_at, messages.id) < (?2, ?3))
+ORDER BY
+    messages.created_at DESC, messages.id DESC
+LIMIT ?4
+`
+
+// TripMessagesPage lists up to size messages of the trip after the cursor,
+// newest first.
+func (s *Store) TripMessagesPage(ctx context.Context, tripID uuid.UUID, after pgstore.Cursor, size int32) (pgstore.Page[pgstore.GetTripMessagesPageRow], error) {
+	// The zero cursor starts from the newest message.
+	before := pgtype.Timestamp{Valid: after != pgstore.Cursor{}, Time: after.CreatedAt}
+
+	messages, err := queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripMessagesPageRow, error) {
+		var i pgstore.GetTripMessagesPageRow
+		err := row.Scan(&i.ID, &i.ParticipantID, &i.Name, &i.Email, &i.Body, &i.CreatedAt)
+		return i, err
+	}, getTripMessagesPage, tripID, timestamp(before), after.ID, size+1)
+	if err != nil {
+		return pgstore.Page[pgstore.GetTripMessagesPageRow]{}, err
+	}
+
+	return pgstore.NewPage(messages, size, func(m pgstore.GetTripMessagesPageRow) pgstore.Cursor {
+		return pgstore.Cursor{CreatedAt: m.CreatedAt.Time, ID: m.ID}
+	}), nil
+}
+
+const markMessagesRead = `
+INSERT INTO message_reads
+    ( "participant_id", "trip_id", "read_at" ) VALUES
+    ( ?, ?, ? )
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "read_at" = excluded.read_at
+`
+
+func (s *Store) MarkMessagesRead(ctx context.Context, arg pgstore.MarkMessagesReadParams) error {
+	_, err := exec(ctx, s.db, markMessagesRead, arg.ParticipantID, arg.TripID, now())
+	return err
+}
+
+const countUnreadMessages = `
+SELECT
+    count(*)
+FROM messages
+WHERE
+    messages.trip_id = ?1
+    AND messages.participant_id <> ?2
+    AND messages.created_at > COALESCE((
+        SELECT read_at FROM message_reads WHERE message_reads.participant_id = ?2
+    ), '')
+`
+
+func (s *Store) CountUnreadMessages(ctx context.Context, arg pgstore.CountUnreadMessagesParams) (int64, error) {
+	var count int64
+	err := queryRow(ctx, s.db, countUnreadMessages, []any{arg.TripID, arg.ParticipantID}, &count)
+	return count, err
+}
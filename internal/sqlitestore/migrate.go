@@ -0,0 +1,124 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFiles are the migrations of the SQLite schema, in the tern format
+// of the Postgres ones.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationSeparator splits a migration into its up and down statements.
+const migrationSeparator = "---- create above / drop below ----"
+
+// Migration is one of the embedded migrations.
+type Migration struct {
+	Version int32
+	Name    string
+	up      string
+}
+
+// Migrations returns the embedded migrations in the order they are applied.
+func Migrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("sqlitestore: failed to read migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("sqlitestore: migration %s has no version prefix", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(prefix, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("sqlitestore: migration %s has an invalid version: %w", entry.Name(), err)
+		}
+
+		sql, err := fs.ReadFile(migrationFiles, path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("sqlitestore: failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		up, _, _ := strings.Cut(string(sql), migrationSeparator)
+		migrations = append(migrations, Migration{
+			Version: int32(version),
+			Name:    strings.TrimSuffix(entry.Name(), ".sql"),
+			up:      up,
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i, m := range migrations {
+		if m.Version != int32(i+1) {
+			return nil, fmt.Errorf("sqlitestore: migration %s is out of sequence, expected version %d", m.Name, i+1)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Migrate applies the embedded migrations the database is missing, each in
+// its own transaction, and returns the ones it applied. The version is kept in
+// a schema_version table, like tern does.
+func (s *Store) Migrate(ctx context.Context) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var current int32
+	if err := s.inTx(ctx, "Migrate", func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			CREATE TABLE IF NOT EXISTS schema_version (version integer NOT NULL);
+			INSERT INTO schema_version (version)
+			SELECT 0 WHERE NOT EXISTS (SELECT 1 FROM schema_version);
+		`); err != nil {
+			return fmt.Errorf("sqlitestore: failed to create schema_version: %w", err)
+		}
+
+		if err := tx.QueryRowContext(ctx, "SELECT version FROM schema_version").Scan(&current); err != nil {
+			return fmt.Errorf("sqlitestore: failed to get schema version: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if int(current) > len(migrations) {
+		return nil, fmt.Errorf("sqlitestore: database is at version %d, newer than the %d known migrations", current, len(migrations))
+	}
+
+	applied := migrations[current:]
+	for _, m := range applied {
+		if err := s.inTx(ctx, "migration "+m.Name, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, m.up); err != nil {
+				return fmt.Errorf("sqlitestore: failed to apply migration %s: %w", m.Name, err)
+			}
+
+			if _, err := tx.ExecContext(ctx, "UPDATE schema_version SET version = ?", m.Version); err != nil {
+				return fmt.Errorf("sqlitestore: failed to set schema version for migration %s: %w", m.Name, err)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return applied, nil
+}
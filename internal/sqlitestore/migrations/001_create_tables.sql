@@ -0,0 +1,247 @@
+-- Write your migrate up statements here
+-- The schema of the Postgres migrations up to 045, less the tables of the
+-- background jobs that only run against Postgres. UUIDs are generated by the
+-- store, timestamps are stored as text in UTC to the microsecond, which sorts
+-- them in time order, and the enums are checked against their values.
+CREATE TABLE trips (
+    "id" text PRIMARY KEY NOT NULL,
+    "destination" text NOT NULL,
+    "owner_email" text NOT NULL,
+    "owner_name" text NOT NULL,
+    "starts_at" timestamp NOT NULL,
+    "ends_at" timestamp NOT NULL,
+    "description" text NOT NULL DEFAULT '',
+    "status" text NOT NULL DEFAULT 'draft'
+        CHECK ("status" IN ('draft', 'confirmed', 'ongoing', 'completed', 'cancelled')),
+    "rsvp_deadline" timestamp,
+    "max_guests" integer NOT NULL DEFAULT 0,
+    "max_participants" integer,
+    "pre_trip_reminder_days" integer NOT NULL DEFAULT 2,
+    "timezone" text NOT NULL DEFAULT 'UTC',
+    "version" integer NOT NULL DEFAULT 1,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT trips_max_guests_check CHECK ("max_guests" >= 0),
+    CONSTRAINT trips_max_participants_check CHECK ("max_participants" > 0)
+);
+
+CREATE INDEX trips_created_at_id_idx ON trips (created_at, id);
+
+CREATE TABLE trip_owners (
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "email" text NOT NULL,
+    "name" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "locale" text NOT NULL DEFAULT 'pt-BR' CHECK ("locale" IN ('pt-BR', 'en', 'es')),
+    PRIMARY KEY (trip_id, email)
+);
+
+CREATE TABLE tags (
+    "id" text PRIMARY KEY NOT NULL,
+    "name" text NOT NULL UNIQUE
+);
+
+CREATE TABLE trip_tags (
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "tag_id" text NOT NULL REFERENCES tags (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (trip_id, tag_id)
+);
+
+CREATE TABLE trip_shares (
+    "slug" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "revoked_at" timestamp
+);
+
+CREATE TABLE trip_join_codes (
+    "code" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL UNIQUE REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE participants (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "email" text NOT NULL,
+    "is_confirmed" boolean NOT NULL DEFAULT FALSE,
+    "declined_at" timestamp,
+    "decline_reason" text,
+    "invited_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "name" text,
+    "phone" text,
+    "avatar_url" text,
+    "rsvp_reminded_at" timestamp,
+    "no_response_at" timestamp,
+    "guests" integer NOT NULL DEFAULT 0,
+    "locale" text NOT NULL DEFAULT 'pt-BR' CHECK ("locale" IN ('pt-BR', 'en', 'es')),
+    "pre_trip_reminded_at" timestamp,
+    "email_verified_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT participants_trip_id_email_key UNIQUE (trip_id, email),
+    CONSTRAINT participants_guests_check CHECK ("guests" >= 0)
+);
+
+CREATE INDEX participants_trip_id_created_at_id_idx ON participants (trip_id, created_at, id);
+
+CREATE TABLE participant_status_changes (
+    "id" text PRIMARY KEY NOT NULL,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "status" text NOT NULL CHECK ("status" IN ('confirmed', 'declined', 'unconfirmed')),
+    "reason" text,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE participant_invitations (
+    "participant_id" text PRIMARY KEY NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "sent_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE email_verifications (
+    "participant_id" text PRIMARY KEY NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "code" text NOT NULL,
+    "attempts" integer NOT NULL DEFAULT 0,
+    "expires_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE notification_opt_outs (
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL
+        CHECK ("kind" IN ('activity_reminder', 'rsvp_reminder', 'pre_trip_reminder', 'daily_digest')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (participant_id, kind)
+);
+
+CREATE TABLE undeliverable_emails (
+    "email" text PRIMARY KEY NOT NULL,
+    "issue" text NOT NULL CHECK ("issue" IN ('bounce', 'complaint')),
+    "detail" text,
+    "reported_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE trip_waitlist (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "email" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    UNIQUE (trip_id, email)
+);
+
+CREATE TABLE activities (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "title" text NOT NULL,
+    "occurs_at" timestamp NOT NULL,
+    "ends_at" timestamp,
+    "address" text,
+    "latitude" real,
+    "longitude" real,
+    "category" text NOT NULL DEFAULT 'other'
+        CHECK ("category" IN ('food', 'transport', 'sightseeing', 'lodging', 'other')),
+    "position" integer NOT NULL DEFAULT 0,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "deleted_at" timestamp,
+    CONSTRAINT activities_ends_after_start CHECK ("ends_at" IS NULL OR "ends_at" > "occurs_at"),
+    CONSTRAINT activities_latitude_range CHECK ("latitude" BETWEEN -90 AND 90),
+    CONSTRAINT activities_longitude_range CHECK ("longitude" BETWEEN -180 AND 180)
+);
+
+CREATE INDEX activities_trip_id_created_at_id_idx ON activities (trip_id, created_at, id);
+
+CREATE TABLE activity_attendees (
+    "activity_id" text NOT NULL REFERENCES activities (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "attending" boolean NOT NULL,
+    "updated_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (activity_id, participant_id)
+);
+
+CREATE TABLE activity_votes (
+    "activity_id" text NOT NULL REFERENCES activities (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (activity_id, participant_id)
+);
+
+CREATE TABLE activity_comments (
+    "id" text PRIMARY KEY NOT NULL,
+    "activity_id" text NOT NULL REFERENCES activities (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "body" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "deleted_at" timestamp
+);
+
+CREATE TABLE activity_attachments (
+    "id" text PRIMARY KEY NOT NULL,
+    "activity_id" text NOT NULL REFERENCES activities (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "file_name" text NOT NULL,
+    "content_type" text NOT NULL,
+    "size" integer NOT NULL,
+    "storage_key" text NOT NULL UNIQUE,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE links (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "title" text NOT NULL,
+    "url" text NOT NULL,
+    "category" text NOT NULL DEFAULT 'other'
+        CHECK ("category" IN ('lodging', 'transport', 'tickets', 'docs', 'other')),
+    "preview_title" text,
+    "preview_description" text,
+    "preview_favicon_url" text,
+    "preview_image_url" text,
+    "preview_fetched_at" timestamp,
+    "pinned" boolean NOT NULL DEFAULT FALSE,
+    "position" integer NOT NULL DEFAULT 0,
+    "deleted_at" timestamp
+);
+
+CREATE TABLE link_clicks (
+    "id" text PRIMARY KEY NOT NULL,
+    "link_id" text NOT NULL REFERENCES links (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "clicked_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE TABLE email_outbox (
+    "id" text PRIMARY KEY NOT NULL,
+    "kind" text NOT NULL CHECK ("kind" IN (
+        'confirm_trip', 'invitation', 'unconfirmation', 'activity_reminder', 'rsvp_reminder',
+        'pre_trip_reminder', 'daily_digest', 'email_verification'
+    )),
+    "payload" blob NOT NULL,
+    "status" text NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'sent', 'dead')),
+    "attempts" integer NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "last_error" text,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "sent_at" timestamp
+);
+
+CREATE INDEX email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_outbox;
+DROP TABLE IF EXISTS link_clicks;
+DROP TABLE IF EXISTS links;
+DROP TABLE IF EXISTS activity_attachments;
+DROP TABLE IF EXISTS activity_comments;
+DROP TABLE IF EXISTS activity_votes;
+DROP TABLE IF EXISTS activity_attendees;
+DROP TABLE IF EXISTS activities;
+DROP TABLE IF EXISTS trip_waitlist;
+DROP TABLE IF EXISTS undeliverable_emails;
+DROP TABLE IF EXISTS notification_opt_outs;
+DROP TABLE IF EXISTS email_verifications;
+DROP TABLE IF EXISTS participant_invitations;
+DROP TABLE IF EXISTS participant_status_changes;
+DROP TABLE IF EXISTS participants;
+DROP TABLE IF EXISTS trip_join_codes;
+DROP TABLE IF EXISTS trip_shares;
+DROP TABLE IF EXISTS trip_tags;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS trip_owners;
+DROP TABLE IF EXISTS trips;
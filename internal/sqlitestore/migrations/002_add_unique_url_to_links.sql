@@ -0,0 +1,8 @@
+-- Write your migrate up statements here
+-- The Postgres migration 046.
+CREATE UNIQUE INDEX links_trip_id_url_key ON links (trip_id, url)
+    WHERE deleted_at IS NULL;
+---- create above / drop below ----
+DROP INDEX IF EXISTS links_trip_id_url_key;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,35 @@
+-- Write your migrate up statements here
+-- The Postgres migration 047. SQLite cannot set a single column of a
+-- composite foreign key to NULL, so deleting an activity keeps its expenses
+-- from being deleted instead, activities only being soft deleted anyway.
+CREATE UNIQUE INDEX participants_id_trip_id_key ON participants (id, trip_id);
+
+CREATE UNIQUE INDEX activities_id_trip_id_key ON activities (id, trip_id);
+
+CREATE TABLE expenses (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "payer_id" text NOT NULL,
+    "activity_id" text,
+    "description" text NOT NULL,
+    "amount" integer NOT NULL,
+    "currency" text NOT NULL,
+    "category" text NOT NULL DEFAULT 'other'
+        CHECK ("category" IN ('food', 'transport', 'lodging', 'tickets', 'shopping', 'other')),
+    "spent_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT expenses_amount_check CHECK ("amount" > 0),
+    CONSTRAINT expenses_currency_check CHECK ("currency" GLOB '[A-Z][A-Z][A-Z]'),
+    FOREIGN KEY (payer_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (activity_id, trip_id) REFERENCES activities (id, trip_id) ON UPDATE CASCADE
+);
+
+CREATE INDEX expenses_trip_id_spent_at_idx ON expenses (trip_id, spent_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS expenses;
+
+DROP INDEX IF EXISTS activities_id_trip_id_key;
+
+DROP INDEX IF EXISTS participants_id_trip_id_key;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
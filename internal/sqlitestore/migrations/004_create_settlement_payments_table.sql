@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+-- The Postgres migration 048.
+CREATE TABLE settlement_payments (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "from_participant_id" text NOT NULL,
+    "to_participant_id" text NOT NULL,
+    "amount" integer NOT NULL,
+    "currency" text NOT NULL,
+    "paid_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT settlement_payments_amount_check CHECK ("amount" > 0),
+    CONSTRAINT settlement_payments_currency_check CHECK ("currency" GLOB '[A-Z][A-Z][A-Z]'),
+    CONSTRAINT settlement_payments_distinct_participants CHECK ("from_participant_id" <> "to_participant_id"),
+    FOREIGN KEY (from_participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (to_participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX settlement_payments_trip_id_paid_at_idx ON settlement_payments (trip_id, paid_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS settlement_payments;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
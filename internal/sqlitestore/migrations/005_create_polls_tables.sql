@@ -0,0 +1,66 @@
+-- Write your migrate up statements here
+-- The Postgres migration 049. SQLite cannot change a check constraint, so
+-- email_outbox is rebuilt to accept the poll_invitation emails.
+CREATE TABLE polls (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL CHECK ("kind" IN ('dates', 'destination')),
+    "question" text NOT NULL,
+    "applied_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE INDEX polls_trip_id_idx ON polls (trip_id);
+
+CREATE TABLE poll_options (
+    "id" text PRIMARY KEY NOT NULL,
+    "poll_id" text NOT NULL REFERENCES polls (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "position" integer NOT NULL,
+    "destination" text,
+    "starts_at" timestamp,
+    "ends_at" timestamp,
+    CONSTRAINT poll_options_candidate_check CHECK (
+        ("destination" IS NOT NULL AND "starts_at" IS NULL AND "ends_at" IS NULL)
+        OR ("destination" IS NULL AND "starts_at" IS NOT NULL AND "ends_at" > "starts_at")
+    )
+);
+
+CREATE UNIQUE INDEX poll_options_id_poll_id_key ON poll_options (id, poll_id);
+
+CREATE TABLE poll_votes (
+    "poll_id" text NOT NULL,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "option_id" text NOT NULL,
+    "voted_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (poll_id, participant_id),
+    FOREIGN KEY (option_id, poll_id) REFERENCES poll_options (id, poll_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE email_outbox_new (
+    "id" text PRIMARY KEY NOT NULL,
+    "kind" text NOT NULL CHECK ("kind" IN (
+        'confirm_trip', 'invitation', 'unconfirmation', 'activity_reminder', 'rsvp_reminder',
+        'pre_trip_reminder', 'daily_digest', 'email_verification', 'poll_invitation'
+    )),
+    "payload" blob NOT NULL,
+    "status" text NOT NULL DEFAULT 'pending' CHECK ("status" IN ('pending', 'sent', 'dead')),
+    "attempts" integer NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "last_error" text,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "sent_at" timestamp
+);
+
+INSERT INTO email_outbox_new SELECT * FROM email_outbox;
+
+DROP TABLE email_outbox;
+
+ALTER TABLE email_outbox_new RENAME TO email_outbox;
+
+CREATE INDEX email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+---- create above / drop below ----
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
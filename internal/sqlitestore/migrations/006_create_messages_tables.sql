@@ -0,0 +1,24 @@
+-- Write your migrate up statements here
+-- The Postgres migration 050, without its trigger notifying the changes.
+CREATE TABLE messages (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL,
+    "body" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    FOREIGN KEY (participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX messages_trip_id_created_at_id_idx ON messages (trip_id, created_at, id);
+
+CREATE TABLE message_reads (
+    "participant_id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL,
+    "read_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    FOREIGN KEY (participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+---- create above / drop below ----
+DROP TABLE IF EXISTS message_reads;
+DROP TABLE IF EXISTS messages;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
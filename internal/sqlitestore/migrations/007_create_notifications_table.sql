@@ -0,0 +1,65 @@
+-- Write your migrate up statements here
+-- The Postgres migration 051. SQLite has no functions to share between the
+-- triggers nor gen_random_uuid, so each trigger notifies the participants
+-- itself, making up a version 4 uuid from random bytes for every row.
+CREATE TABLE notifications (
+    "id" text PRIMARY KEY NOT NULL,
+    "participant_id" text NOT NULL,
+    "trip_id" text NOT NULL,
+    "event" text NOT NULL CHECK ("event" IN ('activity_created', 'participant_confirmed', 'trip_updated')),
+    "subject_id" text NOT NULL,
+    "read_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    FOREIGN KEY (participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX notifications_participant_id_created_at_idx ON notifications (participant_id, created_at);
+
+CREATE TRIGGER activities_notify_participants
+    AFTER INSERT ON activities
+BEGIN
+    INSERT INTO notifications ("id", "participant_id", "trip_id", "event", "subject_id")
+    SELECT
+        lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-'
+            || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6))),
+        participants.id, participants.trip_id, 'activity_created', NEW.id
+    FROM participants
+    WHERE
+        participants.trip_id = NEW.trip_id AND participants.declined_at IS NULL;
+END;
+
+CREATE TRIGGER participants_notify_participants
+    AFTER UPDATE OF is_confirmed ON participants
+    WHEN NEW.is_confirmed AND NOT OLD.is_confirmed
+BEGIN
+    INSERT INTO notifications ("id", "participant_id", "trip_id", "event", "subject_id")
+    SELECT
+        lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-'
+            || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6))),
+        participants.id, participants.trip_id, 'participant_confirmed', NEW.id
+    FROM participants
+    WHERE
+        participants.trip_id = NEW.trip_id AND participants.declined_at IS NULL AND participants.id <> NEW.id;
+END;
+
+CREATE TRIGGER trips_notify_participants
+    AFTER UPDATE OF destination, starts_at, ends_at, description ON trips
+    WHEN (OLD.destination, OLD.starts_at, OLD.ends_at, OLD.description)
+        IS NOT (NEW.destination, NEW.starts_at, NEW.ends_at, NEW.description)
+BEGIN
+    INSERT INTO notifications ("id", "participant_id", "trip_id", "event", "subject_id")
+    SELECT
+        lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-'
+            || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6))),
+        participants.id, participants.trip_id, 'trip_updated', NEW.id
+    FROM participants
+    WHERE
+        participants.trip_id = NEW.id AND participants.declined_at IS NULL;
+END;
+---- create above / drop below ----
+DROP TRIGGER IF EXISTS trips_notify_participants;
+DROP TRIGGER IF EXISTS participants_notify_participants;
+DROP TRIGGER IF EXISTS activities_notify_participants;
+DROP TABLE IF EXISTS notifications;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
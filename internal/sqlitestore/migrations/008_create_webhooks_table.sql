@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+-- The webhooks of the Postgres migration 053. Without the domain events, none
+-- is delivered and it has no delivery table.
+CREATE TABLE webhooks (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "owner_email" text,
+    "url" text NOT NULL,
+    "secret" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT webhooks_scope CHECK ((trip_id IS NULL) <> (owner_email IS NULL))
+);
+
+CREATE INDEX webhooks_trip_id_idx ON webhooks (trip_id);
+
+CREATE INDEX webhooks_owner_email_idx ON webhooks (owner_email);
+---- create above / drop below ----
+DROP TABLE IF EXISTS webhooks;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
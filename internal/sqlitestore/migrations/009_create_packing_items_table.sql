@@ -0,0 +1,21 @@
+-- Write your migrate up statements here
+-- The Postgres migration 054. SQLite cannot set a single column of a
+-- composite foreign key to NULL, so the assignee is kept from being deleted
+-- instead, participants only being deleted along with their trip anyway.
+CREATE TABLE packing_items (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "name" text NOT NULL,
+    "quantity" integer NOT NULL DEFAULT 1,
+    "assignee_id" text,
+    "checked" boolean NOT NULL DEFAULT false,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT packing_items_quantity_check CHECK ("quantity" > 0),
+    FOREIGN KEY (assignee_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE
+);
+
+CREATE INDEX packing_items_trip_id_created_at_idx ON packing_items (trip_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS packing_items;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,35 @@
+-- Write your migrate up statements here
+-- The Postgres migration 055. The task reminders are sent by a job that only
+-- runs against Postgres, so the outbox is left without their kind, but the
+-- participants may still opt out of them.
+CREATE TABLE tasks (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "title" text NOT NULL,
+    "assignee_id" text,
+    "due_at" timestamp,
+    "done" boolean NOT NULL DEFAULT false,
+    "reminded_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    FOREIGN KEY (assignee_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE
+);
+
+CREATE INDEX tasks_trip_id_idx ON tasks (trip_id);
+
+CREATE TABLE notification_opt_outs_new (
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL
+        CHECK ("kind" IN ('activity_reminder', 'rsvp_reminder', 'pre_trip_reminder', 'daily_digest', 'task_reminder')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (participant_id, kind)
+);
+
+INSERT INTO notification_opt_outs_new SELECT * FROM notification_opt_outs;
+
+DROP TABLE notification_opt_outs;
+
+ALTER TABLE notification_opt_outs_new RENAME TO notification_opt_outs;
+---- create above / drop below ----
+DROP TABLE IF EXISTS tasks;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,27 @@
+-- Write your migrate up statements here
+-- The Postgres migration 056.
+CREATE TABLE bookings (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "type" text NOT NULL DEFAULT 'lodging'
+        CHECK ("type" IN ('lodging')),
+    "name" text NOT NULL,
+    "check_in_at" timestamp NOT NULL,
+    "check_out_at" timestamp NOT NULL,
+    "address" text,
+    "confirmation_code" text,
+    "price" integer,
+    "currency" text,
+    "url" text,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT bookings_check_out_at_check CHECK ("check_out_at" > "check_in_at"),
+    CONSTRAINT bookings_price_check CHECK ("price" > 0),
+    CONSTRAINT bookings_currency_check CHECK ("currency" GLOB '[A-Z][A-Z][A-Z]'),
+    CONSTRAINT bookings_price_currency_check CHECK (("price" IS NULL) = ("currency" IS NULL))
+);
+
+CREATE INDEX bookings_trip_id_check_in_at_idx ON bookings (trip_id, check_in_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS bookings;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+-- The Postgres migration 057.
+CREATE TABLE transport_segments (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "mode" text NOT NULL
+        CHECK ("mode" IN ('flight', 'train', 'bus')),
+    "carrier" text NOT NULL,
+    "number" text,
+    "departure_place" text NOT NULL,
+    "departure_at" timestamp NOT NULL,
+    "arrival_place" text NOT NULL,
+    "arrival_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT transport_segments_id_trip_id_key UNIQUE (id, trip_id),
+    CONSTRAINT transport_segments_arrival_at_check CHECK ("arrival_at" > "departure_at")
+);
+
+CREATE INDEX transport_segments_trip_id_departure_at_idx ON transport_segments (trip_id, departure_at);
+
+CREATE TABLE transport_segment_participants (
+    "segment_id" text NOT NULL,
+    "trip_id" text NOT NULL,
+    "participant_id" text NOT NULL,
+    PRIMARY KEY (segment_id, participant_id),
+    FOREIGN KEY (segment_id, trip_id) REFERENCES transport_segments (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (participant_id, trip_id) REFERENCES participants (id, trip_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX transport_segment_participants_trip_id_idx ON transport_segment_participants (trip_id);
+---- create above / drop below ----
+DROP TABLE IF EXISTS transport_segment_participants;
+
+DROP TABLE IF EXISTS transport_segments;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
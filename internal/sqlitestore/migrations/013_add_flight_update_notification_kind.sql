@@ -0,0 +1,33 @@
+-- Write your migrate up statements here
+-- The Postgres migration 058. The flights are tracked by a job that only runs
+-- against Postgres, so neither their statuses nor their emails are kept, but
+-- the participants may still opt out of them.
+CREATE TABLE notification_opt_outs_new (
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL
+        CHECK ("kind" IN ('activity_reminder', 'rsvp_reminder', 'pre_trip_reminder', 'daily_digest', 'task_reminder', 'flight_update')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (participant_id, kind)
+);
+
+INSERT INTO notification_opt_outs_new SELECT * FROM notification_opt_outs;
+
+DROP TABLE notification_opt_outs;
+
+ALTER TABLE notification_opt_outs_new RENAME TO notification_opt_outs;
+---- create above / drop below ----
+CREATE TABLE notification_opt_outs_old (
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL
+        CHECK ("kind" IN ('activity_reminder', 'rsvp_reminder', 'pre_trip_reminder', 'daily_digest', 'task_reminder')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    PRIMARY KEY (participant_id, kind)
+);
+
+INSERT INTO notification_opt_outs_old SELECT * FROM notification_opt_outs WHERE "kind" <> 'flight_update';
+
+DROP TABLE notification_opt_outs;
+
+ALTER TABLE notification_opt_outs_old RENAME TO notification_opt_outs;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
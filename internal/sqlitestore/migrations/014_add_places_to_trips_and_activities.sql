@@ -0,0 +1,19 @@
+-- Write your migrate up statements here
+-- The Postgres migration 059.
+ALTER TABLE trips ADD COLUMN "latitude" real CHECK ("latitude" BETWEEN -90 AND 90);
+
+ALTER TABLE trips ADD COLUMN "longitude" real CHECK ("longitude" BETWEEN -180 AND 180);
+
+ALTER TABLE trips ADD COLUMN "place_name" text;
+
+ALTER TABLE activities ADD COLUMN "place_name" text;
+---- create above / drop below ----
+ALTER TABLE activities DROP COLUMN "place_name";
+
+ALTER TABLE trips DROP COLUMN "place_name";
+
+ALTER TABLE trips DROP COLUMN "longitude";
+
+ALTER TABLE trips DROP COLUMN "latitude";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
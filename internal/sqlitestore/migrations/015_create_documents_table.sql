@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+-- The Postgres migration 060.
+CREATE TABLE documents (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "kind" text NOT NULL DEFAULT 'other'
+        CHECK ("kind" IN ('ticket', 'passport', 'visa', 'insurance', 'other')),
+    "file_name" text NOT NULL,
+    "content_type" text NOT NULL,
+    "size" integer NOT NULL CHECK ("size" > 0),
+    "storage_key" text NOT NULL UNIQUE,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE INDEX documents_trip_id_created_at_idx ON documents (trip_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS documents;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,40 @@
+-- Write your migrate up statements here
+-- The Postgres migration 061.
+CREATE TABLE photos (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "caption" text,
+    "file_name" text NOT NULL,
+    "content_type" text NOT NULL,
+    "size" integer NOT NULL CHECK ("size" > 0),
+    "storage_key" text NOT NULL UNIQUE,
+    "width" integer,
+    "height" integer,
+    "thumbnail_key" text,
+    "thumbnail_status" text NOT NULL DEFAULT 'pending'
+        CHECK ("thumbnail_status" IN ('pending', 'ready', 'failed')),
+    "thumbnail_attempts" integer NOT NULL DEFAULT 0,
+    "thumbnail_next_attempt_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE INDEX photos_trip_id_created_at_idx ON photos (trip_id, created_at DESC, id DESC);
+
+CREATE INDEX photos_pending_thumbnails_idx ON photos (thumbnail_next_attempt_at) WHERE thumbnail_status = 'pending';
+
+CREATE TABLE photo_comments (
+    "id" text PRIMARY KEY NOT NULL,
+    "photo_id" text NOT NULL REFERENCES photos (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "body" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE INDEX photo_comments_photo_id_created_at_idx ON photo_comments (photo_id, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS photo_comments;
+
+DROP TABLE IF EXISTS photos;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,20 @@
+-- Write your migrate up statements here
+-- The Postgres migration 062.
+CREATE TABLE journal_entries (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "participant_id" text NOT NULL REFERENCES participants (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "day" text NOT NULL,
+    "title" text,
+    "body" text NOT NULL,
+    "visibility" text NOT NULL DEFAULT 'shared'
+        CHECK ("visibility" IN ('shared', 'private')),
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    "updated_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+CREATE INDEX journal_entries_trip_id_day_idx ON journal_entries (trip_id, day, created_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS journal_entries;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
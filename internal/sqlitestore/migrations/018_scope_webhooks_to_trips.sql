@@ -0,0 +1,39 @@
+-- Write your migrate up statements here
+-- The Postgres migration 063. A webhook is always one of a trip.
+CREATE TABLE webhooks_new (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text NOT NULL REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "url" text NOT NULL,
+    "secret" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now'))
+);
+
+INSERT INTO webhooks_new SELECT "id", "trip_id", "url", "secret", "created_at" FROM webhooks WHERE "trip_id" IS NOT NULL;
+
+DROP TABLE webhooks;
+
+ALTER TABLE webhooks_new RENAME TO webhooks;
+
+CREATE INDEX webhooks_trip_id_idx ON webhooks (trip_id);
+---- create above / drop below ----
+CREATE TABLE webhooks_old (
+    "id" text PRIMARY KEY NOT NULL,
+    "trip_id" text REFERENCES trips (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    "owner_email" text,
+    "url" text NOT NULL,
+    "secret" text NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f000', 'now')),
+    CONSTRAINT webhooks_scope CHECK ((trip_id IS NULL) <> (owner_email IS NULL))
+);
+
+INSERT INTO webhooks_old ("id", "trip_id", "url", "secret", "created_at") SELECT "id", "trip_id", "url", "secret", "created_at" FROM webhooks;
+
+DROP TABLE webhooks;
+
+ALTER TABLE webhooks_old RENAME TO webhooks;
+
+CREATE INDEX webhooks_trip_id_idx ON webhooks (trip_id);
+
+CREATE INDEX webhooks_owner_email_idx ON webhooks (owner_email);
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
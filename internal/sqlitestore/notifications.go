@@ -0,0 +1,53 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const getParticipantNotifications = `
+SELECT
+    "id", "participant_id", "trip_id", "event", "subject_id", "read_at", "created_at"
+FROM notifications
+WHERE
+    participant_id = ?1
+    AND (NOT ?2 OR read_at IS NULL)
+ORDER BY
+    created_at DESC, id DESC
+`
+
+func (s *Store) GetParticipantNotifications(ctx context.Context, arg pgstore.GetParticipantNotificationsParams) ([]pgstore.Notification, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.Notification, error) {
+		var i pgstore.Notification
+		err := row.Scan(&i.ID, &i.ParticipantID, &i.TripID, &i.Event, &i.SubjectID, &i.ReadAt, &i.CreatedAt)
+		return i, err
+	}, getParticipantNotifications, arg.ParticipantID, arg.UnreadOnly)
+}
+
+const markNotificationRead = `
+UPDATE notifications
+SET
+    "read_at" = COALESCE(read_at, ?)
+WHERE
+    id = ? AND participant_id = ?
+`
+
+func (s *Store) MarkNotificationRead(ctx context.Context, arg pgstore.MarkNotificationReadParams) (int64, error) {
+	return exec(ctx, s.db, markNotificationRead, now(), arg.ID, arg.ParticipantID)
+}
+
+const markAllNotificationsRead = `
+UPDATE notifications
+SET
+    "read_at" = ?
+WHERE
+    participant_id = ? AND read_at IS NULL
+`
+
+func (s *Store) MarkAllNotificationsRead(ctx context.Context, participantID uuid.UUID) error {
+	_, err := exec(ctx, s.db, markAllNotificationsRead, now(), participantID)
+	return err
+}
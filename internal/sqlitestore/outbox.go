@@ -0,0 +1,118 @@
+package sqlitestore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const outboxColumns = `"id", "kind", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "sent_at"`
+
+// A single statement holds the write lock of the whole database, no other
+// instance can claim the same emails meanwhile.
+const claimDueEmails = `
+UPDATE email_outbox
+SET
+    next_attempt_at = ?
+WHERE
+    id IN (
+        SELECT id FROM email_outbox AS due
+        WHERE due.status = 'pending' AND due.next_attempt_at <= ?
+        ORDER BY due.next_attempt_at
+        LIMIT ?
+    )
+RETURNING ` + outboxColumns
+
+func (s *Store) ClaimDueEmails(ctx context.Context, arg pgstore.ClaimDueEmailsParams) ([]pgstore.EmailOutbox, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.EmailOutbox, error) {
+		var i pgstore.EmailOutbox
+		err := row.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.SentAt,
+		)
+		return i, err
+	}, claimDueEmails, timestamp(arg.LeaseUntil), timestamp(arg.Now), arg.Limit)
+}
+
+const markEmailSent = `
+UPDATE email_outbox
+SET
+    status = 'sent',
+    attempts = attempts + 1,
+    sent_at = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) MarkEmailSent(ctx context.Context, arg pgstore.MarkEmailSentParams) error {
+	_, err := exec(ctx, s.db, markEmailSent, timestamp(arg.SentAt), arg.ID)
+	return err
+}
+
+const markEmailFailed = `
+UPDATE email_outbox
+SET
+    status = ?,
+    attempts = attempts + 1,
+    next_attempt_at = ?,
+    last_error = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) MarkEmailFailed(ctx context.Context, arg pgstore.MarkEmailFailedParams) error {
+	_, err := exec(ctx, s.db, markEmailFailed, arg.Status, timestamp(arg.NextAttemptAt), arg.LastError, arg.ID)
+	return err
+}
+
+const insertEmail = `
+INSERT INTO email_outbox
+    ( "id", "kind", "payload" ) VALUES
+    ( ?, ?, ? )
+`
+
+// enqueueEmail writes an email to the outbox. Called with a transaction, the
+// email is only sent if the transaction commits.
+func enqueueEmail(ctx context.Context, q querier, kind pgstore.EmailKind, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s email: %w", kind, err)
+	}
+
+	_, err = exec(ctx, q, insertEmail, uuid.New(), kind, b)
+	return err
+}
+
+const recordInvitationSent = `
+INSERT INTO participant_invitations
+    ( "participant_id" )
+SELECT
+    id
+FROM participants
+WHERE
+    trip_id = ? AND email = ?
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "sent_at" = excluded.sent_at
+`
+
+// enqueueInvitation writes the invitation of a participant to the outbox and
+// records it, so confirming the trip does not invite them again.
+func enqueueInvitation(ctx context.Context, q querier, invitation pgstore.InvitationEmail) error {
+	if _, err := exec(ctx, q, recordInvitationSent, invitation.TripID, invitation.Email); err != nil {
+		return fmt.Errorf("failed to record invitation: %w", err)
+	}
+
+	return enqueueEmail(ctx, q, pgstore.EmailKindInvitation, invitation)
+}
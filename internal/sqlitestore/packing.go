@@ -0,0 +1,83 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const createPackingItem = `
+INSERT INTO packing_items
+    ( "id", "trip_id", "name", "quantity", "assignee_id", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreatePackingItem(ctx context.Context, arg pgstore.CreatePackingItemParams) (uuid.UUID, error) {
+	id := uuid.New()
+	// created_at is written to the microsecond, which orders the items.
+	if _, err := exec(ctx, s.db, createPackingItem, id, arg.TripID, arg.Name, arg.Quantity, arg.AssigneeID, now()); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripPackingItems = `
+SELECT
+    "id", "trip_id", "name", "quantity", "assignee_id", "checked", "created_at"
+FROM packing_items
+WHERE
+    trip_id = ?
+ORDER BY
+    created_at, id
+`
+
+func (s *Store) GetTripPackingItems(ctx context.Context, tripID uuid.UUID) ([]pgstore.PackingItem, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.PackingItem, error) {
+		var i pgstore.PackingItem
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.Quantity,
+			&i.AssigneeID,
+			&i.Checked,
+			&i.CreatedAt,
+		)
+		return i, err
+	}, getTripPackingItems, tripID)
+}
+
+const updatePackingItem = `
+UPDATE packing_items
+SET
+    "name" = ?,
+    "quantity" = ?,
+    "assignee_id" = ?,
+    "checked" = ?
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) UpdatePackingItem(ctx context.Context, arg pgstore.UpdatePackingItemParams) (int64, error) {
+	return exec(ctx, s.db, updatePackingItem,
+		arg.Name,
+		arg.Quantity,
+		arg.AssigneeID,
+		arg.Checked,
+		arg.ID,
+		arg.TripID,
+	)
+}
+
+const deletePackingItem = `
+DELETE FROM packing_items
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeletePackingItem(ctx context.Context, arg pgstore.DeletePackingItemParams) (int64, error) {
+	return exec(ctx, s.db, deletePackingItem, arg.ID, arg.TripID)
+}
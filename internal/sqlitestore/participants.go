@@ -0,0 +1,740 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const participantColumns = `"id", "trip_id", "email", "is_confirmed", "declined_at", "decline_reason", "invited_at", "name", "phone", "avatar_url", "rsvp_reminded_at", "no_response_at", "guests", "locale", "pre_trip_reminded_at", "email_verified_at", "created_at"`
+
+func scanParticipant(row scanner) (pgstore.Participant, error) {
+	var i pgstore.Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.DeclinedAt,
+		&i.DeclineReason,
+		&i.InvitedAt,
+		&i.Name,
+		&i.Phone,
+		&i.AvatarUrl,
+		&i.RsvpRemindedAt,
+		&i.NoResponseAt,
+		&i.Guests,
+		&i.Locale,
+		&i.PreTripRemindedAt,
+		&i.EmailVerifiedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getParticipant = `
+SELECT
+    ` + participantColumns + `
+FROM participants
+WHERE
+    id = ?
+`
+
+func (s *Store) GetParticipant(ctx context.Context, id uuid.UUID) (pgstore.Participant, error) {
+	participant, err := scanParticipant(s.db.QueryRowContext(ctx, getParticipant, id))
+	return participant, pgError(err)
+}
+
+const getParticipantByEmail = `
+SELECT
+    ` + participantColumns + `
+FROM participants
+WHERE
+    trip_id = ? AND email = ?
+`
+
+func (s *Store) GetParticipantByEmail(ctx context.Context, arg pgstore.GetParticipantByEmailParams) (pgstore.Participant, error) {
+	participant, err := scanParticipant(s.db.QueryRowContext(ctx, getParticipantByEmail, arg.TripID, arg.Email))
+	return participant, pgError(err)
+}
+
+const getParticipants = `
+SELECT
+    ` + participantColumns + `
+FROM participants
+WHERE
+    trip_id = ?
+`
+
+func (s *Store) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	return queryAll(ctx, s.db, scanParticipant, getParticipants, tripID)
+}
+
+const insertParticipant = `
+INSERT INTO participants
+    ( "id", "trip_id", "email" ) VALUES
+    ( ?, ?, ? )
+`
+
+// JoinTripTx adds a participant to the trip and sends them the invitation.
+// The participant goes to the waitlist of the trip instead when it is full.
+// It returns whether they were added to the waitlist.
+func (s *Store) JoinTripTx(ctx context.Context, _ *pgxpool.Pool, params pgstore.InsertParticipantParams) (bool, error) {
+	var waitlisted bool
+	err := s.inTx(ctx, "JoinTrip", func(tx *sql.Tx) error {
+		trip, err := getTripWith(ctx, tx, params.TripID)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to get trip for JoinTrip: %w", err)
+		}
+
+		if trip.MaxParticipants.Valid {
+			var active int64
+			if err := queryRow(ctx, tx, countActiveTripParticipants, []any{params.TripID}, &active); err != nil {
+				return fmt.Errorf("sqlitestore: failed to count participants for JoinTrip: %w", err)
+			}
+
+			if active >= int64(trip.MaxParticipants.Int32) {
+				if _, err := exec(ctx, tx, addToTripWaitlist, uuid.New(), params.TripID, params.Email); err != nil {
+					return fmt.Errorf("sqlitestore: failed to add to waitlist for JoinTrip: %w", err)
+				}
+				waitlisted = true
+				return nil
+			}
+		}
+
+		if _, err := exec(ctx, tx, insertParticipant, uuid.New(), params.TripID, params.Email); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert participant for JoinTrip: %w", err)
+		}
+
+		if err := enqueueInvitation(ctx, tx, pgstore.InvitationEmail{TripID: params.TripID, Email: params.Email}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue invitation for JoinTrip: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return waitlisted, nil
+}
+
+const inviteParticipantToTrip = `
+INSERT INTO participants
+    ( "id", "trip_id", "email", "locale" ) VALUES
+    ( ?, ?, ?, ? )
+`
+
+func inviteParticipantsToTrip(ctx context.Context, q querier, participants []pgstore.InviteParticipantsToTripParams) error {
+	for _, p := range participants {
+		if _, err := exec(ctx, q, inviteParticipantToTrip, uuid.New(), p.TripID, p.Email, p.Locale); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const upsertEmailVerification = `
+INSERT INTO email_verifications
+    ( "participant_id", "code", "expires_at" )
+SELECT
+    id, ?3, ?4
+FROM participants
+WHERE
+    trip_id = ?1 AND email = ?2
+ON CONFLICT ("participant_id") DO UPDATE
+SET
+    "code" = excluded.code,
+    "attempts" = 0,
+    "expires_at" = excluded.expires_at,
+    "created_at" = excluded.created_at
+`
+
+func createEmailVerification(ctx context.Context, q querier, v pgstore.CreateEmailVerificationParams) error {
+	_, err := exec(ctx, q, upsertEmailVerification, v.TripID, v.Email, v.Code, timestamp(v.ExpiresAt))
+	return err
+}
+
+// InviteParticipantsTx adds the participants to the trip and sends them the
+// invitation. Participants with a verification are sent its code instead, and
+// get the invitation once they confirm their address. The participants beyond
+// the maximum of the trip go to its waitlist instead. It returns the emails
+// added to the waitlist.
+func (s *Store) InviteParticipantsTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	tripID uuid.UUID,
+	participants []pgstore.InviteParticipantsToTripParams,
+	verifications []pgstore.CreateEmailVerificationParams,
+) ([]string, error) {
+	var waitlisted []string
+	err := s.inTx(ctx, "InviteParticipants", func(tx *sql.Tx) error {
+		trip, err := getTripWith(ctx, tx, tripID)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to get trip for InviteParticipants: %w", err)
+		}
+
+		if trip.MaxParticipants.Valid {
+			var active int64
+			if err := queryRow(ctx, tx, countActiveTripParticipants, []any{tripID}, &active); err != nil {
+				return fmt.Errorf("sqlitestore: failed to count participants for InviteParticipants: %w", err)
+			}
+
+			seats := max(int(trip.MaxParticipants.Int32)-int(active), 0)
+			if len(participants) > seats {
+				for _, p := range participants[seats:] {
+					if _, err := exec(ctx, tx, addToTripWaitlist, uuid.New(), tripID, p.Email); err != nil {
+						return fmt.Errorf("sqlitestore: failed to add to waitlist for InviteParticipants: %w", err)
+					}
+					waitlisted = append(waitlisted, p.Email)
+				}
+				participants = participants[:seats]
+			}
+		}
+
+		if err := inviteParticipantsToTrip(ctx, tx, participants); err != nil {
+			return fmt.Errorf("sqlitestore: failed to invite participants for InviteParticipants: %w", err)
+		}
+
+		invited := make(map[string]bool, len(participants))
+		for _, p := range participants {
+			invited[p.Email] = true
+		}
+
+		verifying := make(map[string]bool, len(verifications))
+		for _, v := range verifications {
+			if !invited[v.Email] {
+				continue
+			}
+
+			if err := createEmailVerification(ctx, tx, v); err != nil {
+				return fmt.Errorf("sqlitestore: failed to create email verification for InviteParticipants: %w", err)
+			}
+
+			if err := enqueueEmail(ctx, tx, pgstore.EmailKindEmailVerification, pgstore.EmailVerificationEmail{TripID: v.TripID, Email: v.Email}); err != nil {
+				return fmt.Errorf("sqlitestore: failed to enqueue email verification for InviteParticipants: %w", err)
+			}
+
+			verifying[v.Email] = true
+		}
+
+		for _, p := range participants {
+			if verifying[p.Email] {
+				continue
+			}
+
+			if err := enqueueInvitation(ctx, tx, pgstore.InvitationEmail{TripID: p.TripID, Email: p.Email}); err != nil {
+				return fmt.Errorf("sqlitestore: failed to enqueue invitation for InviteParticipants: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return waitlisted, err
+}
+
+const insertParticipantStatusChange = `
+INSERT INTO participant_status_changes
+    ( "id", "participant_id", "status", "reason" ) VALUES
+    ( ?, ?, ?, ? )
+`
+
+func recordStatusChange(ctx context.Context, q querier, participantID uuid.UUID, status pgstore.ParticipantStatus, reason pgtype.Text) error {
+	_, err := exec(ctx, q, insertParticipantStatusChange, uuid.New(), participantID, status, reason)
+	return err
+}
+
+const confirmParticipant = `
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "guests" = ?,
+    "declined_at" = NULL,
+    "decline_reason" = NULL,
+    "no_response_at" = NULL
+WHERE
+    id = ?
+`
+
+// ConfirmParticipantTx confirms a participant and records the change in the
+// participant status history.
+func (s *Store) ConfirmParticipantTx(ctx context.Context, _ *pgxpool.Pool, params pgstore.ConfirmParticipantParams) error {
+	return s.inTx(ctx, "ConfirmParticipant", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, confirmParticipant, params.Guests, params.ID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to confirm participant for ConfirmParticipant: %w", err)
+		}
+
+		if err := recordStatusChange(ctx, tx, params.ID, pgstore.ParticipantStatusConfirmed, pgtype.Text{}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to record status change for ConfirmParticipant: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const unconfirmParticipant = `
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "guests" = 0
+WHERE
+    id = ?
+`
+
+// UnconfirmParticipantTx takes back the confirmation of a participant, who
+// goes back to not having answered the invitation, records the reason in the
+// participant status history and lets the trip owners know by email.
+func (s *Store) UnconfirmParticipantTx(ctx context.Context, _ *pgxpool.Pool, participantID uuid.UUID, reason string) error {
+	return s.inTx(ctx, "UnconfirmParticipant", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, unconfirmParticipant, participantID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to unconfirm participant for UnconfirmParticipant: %w", err)
+		}
+
+		if err := recordStatusChange(ctx, tx, participantID, pgstore.ParticipantStatusUnconfirmed, pgtype.Text{Valid: true, String: reason}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to record status change for UnconfirmParticipant: %w", err)
+		}
+
+		if err := enqueueEmail(ctx, tx, pgstore.EmailKindUnconfirmation, pgstore.UnconfirmationEmail{
+			ParticipantID: participantID,
+			Reason:        reason,
+		}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue owners email for UnconfirmParticipant: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const getParticipantStatusChanges = `
+SELECT
+    "id", "participant_id", "status", "reason", "created_at"
+FROM participant_status_changes
+WHERE
+    participant_id = ?
+ORDER BY
+    created_at, id
+`
+
+func (s *Store) GetParticipantStatusChanges(ctx context.Context, participantID uuid.UUID) ([]pgstore.ParticipantStatusChange, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.ParticipantStatusChange, error) {
+		var i pgstore.ParticipantStatusChange
+		err := row.Scan(&i.ID, &i.ParticipantID, &i.Status, &i.Reason, &i.CreatedAt)
+		return i, err
+	}, getParticipantStatusChanges, participantID)
+}
+
+const declineParticipant = `
+UPDATE participants
+SET
+    "is_confirmed" = false,
+    "declined_at" = ?,
+    "decline_reason" = ?
+WHERE
+    id = ?
+`
+
+// DeclineParticipantTx declines the invitation of a participant of the trip
+// and, when the trip has a maximum number of participants and a seat is now
+// free, promotes the first person of the waitlist to participant and invites
+// them.
+func (s *Store) DeclineParticipantTx(ctx context.Context, _ *pgxpool.Pool, tripID uuid.UUID, params pgstore.DeclineParticipantParams) error {
+	return s.inTx(ctx, "DeclineParticipant", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, declineParticipant, now(), params.DeclineReason, params.ID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to decline participant for DeclineParticipant: %w", err)
+		}
+
+		if err := recordStatusChange(ctx, tx, params.ID, pgstore.ParticipantStatusDeclined, params.DeclineReason); err != nil {
+			return fmt.Errorf("sqlitestore: failed to record status change for DeclineParticipant: %w", err)
+		}
+
+		if err := promoteFromWaitlist(ctx, tx, tripID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to promote from waitlist for DeclineParticipant: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const popTripWaitlist = `
+DELETE FROM trip_waitlist
+WHERE
+    id = (
+        SELECT id FROM trip_waitlist AS next
+        WHERE next.trip_id = ?
+        ORDER BY next.created_at
+        LIMIT 1
+    )
+RETURNING "email"
+`
+
+// promoteFromWaitlist turns the oldest waitlist entries of the trip into
+// participants, and invites them, while the trip is below its maximum number
+// of participants, or all of them when it no longer has one.
+func promoteFromWaitlist(ctx context.Context, tx *sql.Tx, tripID uuid.UUID) error {
+	trip, err := getTripWith(ctx, tx, tripID)
+	if err != nil {
+		return err
+	}
+
+	var active int64
+	if err := queryRow(ctx, tx, countActiveTripParticipants, []any{tripID}, &active); err != nil {
+		return err
+	}
+
+	for ; !trip.MaxParticipants.Valid || active < int64(trip.MaxParticipants.Int32); active++ {
+		var email string
+		if err := queryRow(ctx, tx, popTripWaitlist, []any{tripID}, &email); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		if _, err := exec(ctx, tx, insertParticipant, uuid.New(), tripID, email); err != nil {
+			return err
+		}
+
+		if err := enqueueInvitation(ctx, tx, pgstore.InvitationEmail{TripID: tripID, Email: email}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const updateParticipantProfile = `
+UPDATE participants
+SET
+    "name" = COALESCE(?, "name"),
+    "phone" = COALESCE(?, "phone"),
+    "avatar_url" = COALESCE(?, "avatar_url"),
+    "locale" = COALESCE(?, "locale")
+WHERE
+    id = ?
+`
+
+func (s *Store) UpdateParticipantProfile(ctx context.Context, arg pgstore.UpdateParticipantProfileParams) error {
+	_, err := exec(ctx, s.db, updateParticipantProfile, arg.Name, arg.Phone, arg.AvatarUrl, arg.Locale, arg.ID)
+	return err
+}
+
+const markParticipantReinvited = `
+UPDATE participants
+SET
+    "invited_at" = ?
+WHERE
+    id = ? AND invited_at <= ?
+`
+
+func markReinvited(ctx context.Context, q querier, params pgstore.MarkParticipantReinvitedParams) (int64, error) {
+	return exec(ctx, q, markParticipantReinvited, now(), params.ID, timestamp(params.InvitedAt))
+}
+
+// ReinviteParticipantTx sends the invitation again unless the participant was
+// invited after params.InvitedAt. It returns the number of participants
+// reinvited, 0 or 1.
+func (s *Store) ReinviteParticipantTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	params pgstore.MarkParticipantReinvitedParams,
+	invitation pgstore.InvitationEmail,
+) (int64, error) {
+	var updated int64
+	err := s.inTx(ctx, "ReinviteParticipant", func(tx *sql.Tx) error {
+		var err error
+		updated, err = markReinvited(ctx, tx, params)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to mark participant reinvited for ReinviteParticipant: %w", err)
+		}
+		if updated == 0 {
+			return nil
+		}
+
+		if err := enqueueInvitation(ctx, tx, invitation); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue invitation for ReinviteParticipant: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+// ResendEmailVerificationTx sends a new verification code unless the
+// participant was invited after params.InvitedAt, replacing the previous code.
+// It returns the number of participants sent a code, 0 or 1.
+func (s *Store) ResendEmailVerificationTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	params pgstore.MarkParticipantReinvitedParams,
+	verification pgstore.CreateEmailVerificationParams,
+) (int64, error) {
+	var updated int64
+	err := s.inTx(ctx, "ResendEmailVerification", func(tx *sql.Tx) error {
+		var err error
+		updated, err = markReinvited(ctx, tx, params)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to mark participant reinvited for ResendEmailVerification: %w", err)
+		}
+		if updated == 0 {
+			return nil
+		}
+
+		if err := createEmailVerification(ctx, tx, verification); err != nil {
+			return fmt.Errorf("sqlitestore: failed to create email verification for ResendEmailVerification: %w", err)
+		}
+
+		if err := enqueueEmail(ctx, tx, pgstore.EmailKindEmailVerification, pgstore.EmailVerificationEmail{
+			TripID: verification.TripID,
+			Email:  verification.Email,
+		}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue email verification for ResendEmailVerification: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+const getEmailVerification = `
+SELECT
+    "participant_id", "code", "attempts", "expires_at", "created_at"
+FROM email_verifications
+WHERE
+    participant_id = ?
+`
+
+func (s *Store) GetEmailVerification(ctx context.Context, participantID uuid.UUID) (pgstore.EmailVerification, error) {
+	var i pgstore.EmailVerification
+	err := queryRow(ctx, s.db, getEmailVerification, []any{participantID},
+		&i.ParticipantID,
+		&i.Code,
+		&i.Attempts,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const claimEmailVerificationAttempt = `
+UPDATE email_verifications
+SET
+    "attempts" = attempts + 1
+WHERE
+    participant_id = ? AND attempts < ?
+RETURNING "code", "expires_at"
+`
+
+func (s *Store) ClaimEmailVerificationAttempt(ctx context.Context, arg pgstore.ClaimEmailVerificationAttemptParams) (pgstore.ClaimEmailVerificationAttemptRow, error) {
+	var i pgstore.ClaimEmailVerificationAttemptRow
+	err := queryRow(ctx, s.db, claimEmailVerificationAttempt, []any{arg.ParticipantID, arg.MaxAttempts}, &i.Code, &i.ExpiresAt)
+	return i, err
+}
+
+const markParticipantEmailVerified = `
+UPDATE participants
+SET
+    "email_verified_at" = ?
+WHERE
+    id = ?
+`
+
+const deleteEmailVerification = `
+DELETE FROM email_verifications
+WHERE
+    participant_id = ?
+`
+
+// VerifyParticipantEmailTx marks the address of the participant as verified
+// and sends them the invitation held back until then.
+func (s *Store) VerifyParticipantEmailTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	participantID uuid.UUID,
+	invitation pgstore.InvitationEmail,
+) error {
+	return s.inTx(ctx, "VerifyParticipantEmail", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, markParticipantEmailVerified, now(), participantID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to mark email verified for VerifyParticipantEmail: %w", err)
+		}
+
+		if _, err := exec(ctx, tx, deleteEmailVerification, participantID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to delete email verification for VerifyParticipantEmail: %w", err)
+		}
+
+		if err := enqueueInvitation(ctx, tx, invitation); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue invitation for VerifyParticipantEmail: %w", err)
+		}
+
+		return nil
+	})
+}
+
+const getNotificationOptOuts = `
+SELECT
+    "kind"
+FROM notification_opt_outs
+WHERE
+    participant_id = ?
+`
+
+func (s *Store) GetNotificationOptOuts(ctx context.Context, participantID uuid.UUID) ([]pgstore.NotificationKind, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.NotificationKind, error) {
+		var kind pgstore.NotificationKind
+		err := row.Scan(&kind)
+		return kind, err
+	}, getNotificationOptOuts, participantID)
+}
+
+const isOptedOutOfNotification = `
+SELECT EXISTS (
+    SELECT 1 FROM notification_opt_outs
+    WHERE
+        participant_id = ? AND kind = ?
+)
+`
+
+func (s *Store) IsOptedOutOfNotification(ctx context.Context, arg pgstore.IsOptedOutOfNotificationParams) (bool, error) {
+	var exists bool
+	err := queryRow(ctx, s.db, isOptedOutOfNotification, []any{arg.ParticipantID, arg.Kind}, &exists)
+	return exists, err
+}
+
+const optInToNotification = `
+DELETE FROM notification_opt_outs
+WHERE
+    participant_id = ? AND kind = ?
+`
+
+const optOutOfNotification = `
+INSERT INTO notification_opt_outs
+    ( "participant_id", "kind" ) VALUES
+    ( ?, ? )
+ON CONFLICT ("participant_id", "kind") DO NOTHING
+`
+
+// UpdateNotificationPreferencesTx turns each kind of notification in enabled
+// on or off for the participant. Kinds left out are not changed.
+func (s *Store) UpdateNotificationPreferencesTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	participantID uuid.UUID,
+	enabled map[pgstore.NotificationKind]bool,
+) error {
+	return s.inTx(ctx, "UpdateNotificationPreferences", func(tx *sql.Tx) error {
+		for kind, on := range enabled {
+			query := optOutOfNotification
+			if on {
+				query = optInToNotification
+			}
+
+			if _, err := exec(ctx, tx, query, participantID, kind); err != nil {
+				return fmt.Errorf("sqlitestore: failed to update %s preference for UpdateNotificationPreferences: %w", kind, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+const getTripUndeliverableEmails = `
+SELECT
+    undeliverable_emails.email, undeliverable_emails.issue
+FROM undeliverable_emails
+JOIN participants ON participants.email = undeliverable_emails.email
+WHERE
+    participants.trip_id = ?
+`
+
+func (s *Store) GetTripUndeliverableEmails(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripUndeliverableEmailsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripUndeliverableEmailsRow, error) {
+		var i pgstore.GetTripUndeliverableEmailsRow
+		err := row.Scan(&i.Email, &i.Issue)
+		return i, err
+	}, getTripUndeliverableEmails, tripID)
+}
+
+const getTripParticipantStats = `
+SELECT
+    count(*) AS invited,
+    count(*) FILTER (WHERE is_confirmed) AS confirmed,
+    count(*) FILTER (WHERE declined_at IS NOT NULL) AS declined,
+    count(*) FILTER (WHERE no_response_at IS NOT NULL) AS no_response,
+    COALESCE(sum(guests) FILTER (WHERE is_confirmed), 0) AS guests
+FROM participants
+WHERE
+    trip_id = ?
+`
+
+func (s *Store) GetTripParticipantStats(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripParticipantStatsRow, error) {
+	var i pgstore.GetTripParticipantStatsRow
+	err := queryRow(ctx, s.db, getTripParticipantStats, []any{tripID},
+		&i.Invited,
+		&i.Confirmed,
+		&i.Declined,
+		&i.NoResponse,
+		&i.Guests,
+	)
+	return i, err
+}
+
+const addToTripWaitlist = `
+INSERT INTO trip_waitlist
+    ( "id", "trip_id", "email" ) VALUES
+    ( ?, ?, ? )
+ON CONFLICT ("trip_id", "email") DO NOTHING
+`
+
+func (s *Store) AddToTripWaitlist(ctx context.Context, arg pgstore.AddToTripWaitlistParams) error {
+	_, err := exec(ctx, s.db, addToTripWaitlist, uuid.New(), arg.TripID, arg.Email)
+	return err
+}
+
+const getTripWaitlist = `
+SELECT
+    "id", "trip_id", "email", "created_at"
+FROM trip_waitlist
+WHERE
+    trip_id = ?
+ORDER BY
+    created_at
+`
+
+func (s *Store) GetTripWaitlist(ctx context.Context, tripID uuid.UUID) ([]pgstore.TripWaitlist, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.TripWaitlist, error) {
+		var i pgstore.TripWaitlist
+		err := row.Scan(&i.ID, &i.TripID, &i.Email, &i.CreatedAt)
+		return i, err
+	}, getTripWaitlist, tripID)
+}
+
+const countActiveTripParticipants = `
+SELECT
+    count(*)
+FROM participants
+WHERE
+    trip_id = ? AND declined_at IS NULL
+`
+
+func (s *Store) CountActiveTripParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	var count int64
+	err := queryRow(ctx, s.db, countActiveTripParticipants, []any{tripID}, &count)
+	return count, err
+}
@@ -0,0 +1,233 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const photoColumns = `"id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "width", "height", "thumbnail_key", "thumbnail_status", "thumbnail_attempts", "thumbnail_next_attempt_at", "created_at"`
+
+func scanPhoto(row scanner) (pgstore.Photo, error) {
+	var i pgstore.Photo
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.Caption,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.Width,
+		&i.Height,
+		&i.ThumbnailKey,
+		&i.ThumbnailStatus,
+		&i.ThumbnailAttempts,
+		&i.ThumbnailNextAttemptAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPhoto = `
+INSERT INTO photos
+    ( "id", "trip_id", "participant_id", "caption", "file_name", "content_type", "size", "storage_key", "thumbnail_next_attempt_at", "created_at" ) VALUES
+    ( ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?9 )
+`
+
+func (s *Store) CreatePhoto(ctx context.Context, arg pgstore.CreatePhotoParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createPhoto,
+		id,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Caption,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.StorageKey,
+		now(),
+	); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripPhotosPage = `
+SELECT
+    photos.id, photos.participant_id, participants.name, participants.email, photos.caption, photos.file_name,
+    photos.content_type, photos.size, photos.storage_key, photos.width, photos.height, photos.thumbnail_key,
+    photos.thumbnail_status, photos.created_at,
+    (SELECT count(*) FROM photo_comments WHERE photo_comments.photo_id = photos.id) AS comments
+FROM photos
+JOIN participants ON participants.id = photos.participant_id
+WHERE
+    photos.trip_id = ?1
+    AND (?2 IS NULL OR (photos.created_at, photos.id) < (?2, ?3))
+ORDER BY
+    photos.created_at DESC, photos.id DESC
+LIMIT ?4
+`
+
+// TripPhotosPage lists up to size photos of the trip after the cursor, newest
+// first.
+func (s *Store) TripPhotosPage(ctx context.Context, tripID uuid.UUID, after pgstore.Cursor, size int32) (pgstore.Page[pgstore.GetTripPhotosPageRow], error) {
+	// The zero cursor starts from the newest photo.
+	before := pgtype.Timestamp{Valid: after != pgstore.Cursor{}, Time: after.CreatedAt}
+
+	photos, err := queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripPhotosPageRow, error) {
+		var i pgstore.GetTripPhotosPageRow
+		err := row.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.Email,
+			&i.Caption,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.Width,
+			&i.Height,
+			&i.ThumbnailKey,
+			&i.ThumbnailStatus,
+			&i.CreatedAt,
+			&i.Comments,
+		)
+		return i, err
+	}, getTripPhotosPage, tripID, timestamp(before), after.ID, size+1)
+	if err != nil {
+		return pgstore.Page[pgstore.GetTripPhotosPageRow]{}, err
+	}
+
+	return pgstore.NewPage(photos, size, func(p pgstore.GetTripPhotosPageRow) pgstore.Cursor {
+		return pgstore.Cursor{CreatedAt: p.CreatedAt.Time, ID: p.ID}
+	}), nil
+}
+
+const getPhoto = `
+SELECT
+    ` + photoColumns + `
+FROM photos
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) GetPhoto(ctx context.Context, arg pgstore.GetPhotoParams) (pgstore.Photo, error) {
+	photo, err := scanPhoto(s.db.QueryRowContext(ctx, getPhoto, arg.ID, arg.TripID))
+	return photo, pgError(err)
+}
+
+const deletePhoto = `
+DELETE FROM photos
+WHERE
+    id = ? AND trip_id = ? AND participant_id = ?
+RETURNING "storage_key", "thumbnail_key"
+`
+
+func (s *Store) DeletePhoto(ctx context.Context, arg pgstore.DeletePhotoParams) (pgstore.DeletePhotoRow, error) {
+	var i pgstore.DeletePhotoRow
+	err := queryRow(ctx, s.db, deletePhoto, []any{arg.ID, arg.TripID, arg.ParticipantID}, &i.StorageKey, &i.ThumbnailKey)
+	return i, err
+}
+
+// A single statement holds the write lock of the whole database, no other
+// instance can claim the same photos meanwhile.
+const claimPendingThumbnails = `
+UPDATE photos
+SET
+    thumbnail_next_attempt_at = ?
+WHERE
+    id IN (
+        SELECT id FROM photos AS due
+        WHERE due.thumbnail_status = 'pending' AND due.thumbnail_next_attempt_at <= ?
+        ORDER BY due.thumbnail_next_attempt_at
+        LIMIT ?
+    )
+RETURNING ` + photoColumns
+
+func (s *Store) ClaimPendingThumbnails(ctx context.Context, arg pgstore.ClaimPendingThumbnailsParams) ([]pgstore.Photo, error) {
+	return queryAll(ctx, s.db, scanPhoto, claimPendingThumbnails, timestamp(arg.LeaseUntil), timestamp(arg.Now), arg.Limit)
+}
+
+const markThumbnailReady = `
+UPDATE photos
+SET
+    thumbnail_status = 'ready',
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_key = ?,
+    width = ?,
+    height = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) MarkThumbnailReady(ctx context.Context, arg pgstore.MarkThumbnailReadyParams) error {
+	_, err := exec(ctx, s.db, markThumbnailReady, arg.ThumbnailKey, arg.Width, arg.Height, arg.ID)
+	return err
+}
+
+const markThumbnailFailed = `
+UPDATE photos
+SET
+    thumbnail_status = ?,
+    thumbnail_attempts = thumbnail_attempts + 1,
+    thumbnail_next_attempt_at = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) MarkThumbnailFailed(ctx context.Context, arg pgstore.MarkThumbnailFailedParams) error {
+	_, err := exec(ctx, s.db, markThumbnailFailed, arg.ThumbnailStatus, timestamp(arg.ThumbnailNextAttemptAt), arg.ID)
+	return err
+}
+
+const createPhotoComment = `
+INSERT INTO photo_comments
+    ( "id", "photo_id", "participant_id", "body", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreatePhotoComment(ctx context.Context, arg pgstore.CreatePhotoCommentParams) (uuid.UUID, error) {
+	id := uuid.New()
+	if _, err := exec(ctx, s.db, createPhotoComment, id, arg.PhotoID, arg.ParticipantID, arg.Body, now()); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getPhotoComments = `
+SELECT
+    photo_comments.id, photo_comments.participant_id, participants.name, participants.email, photo_comments.body,
+    photo_comments.created_at
+FROM photo_comments
+JOIN participants ON participants.id = photo_comments.participant_id
+WHERE
+    photo_comments.photo_id = ?
+ORDER BY
+    photo_comments.created_at, photo_comments.id
+`
+
+func (s *Store) GetPhotoComments(ctx context.Context, photoID uuid.UUID) ([]pgstore.GetPhotoCommentsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetPhotoCommentsRow, error) {
+		var i pgstore.GetPhotoCommentsRow
+		err := row.Scan(&i.ID, &i.ParticipantID, &i.Name, &i.Email, &i.Body, &i.CreatedAt)
+		return i, err
+	}, getPhotoComments, photoID)
+}
+
+const deletePhotoComment = `
+DELETE FROM photo_comments
+WHERE
+    id = ? AND photo_id = ? AND participant_id = ?
+`
+
+func (s *Store) DeletePhotoComment(ctx context.Context, arg pgstore.DeletePhotoCommentParams) (int64, error) {
+	return exec(ctx, s.db, deletePhotoComment, arg.ID, arg.PhotoID, arg.ParticipantID)
+}
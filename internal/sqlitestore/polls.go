@@ -0,0 +1,206 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const createPoll = `
+INSERT INTO polls
+    ( "id", "trip_id", "kind", "question" ) VALUES
+    ( ?, ?, ?, ? )
+`
+
+const createPollOption = `
+INSERT INTO poll_options
+    ( "id", "poll_id", "position", "destination", "starts_at", "ends_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+// CreatePollTx creates the poll with its options, in order, and emails the
+// participants who did not decline the trip the links to vote on it.
+func (s *Store) CreatePollTx(ctx context.Context, _ *pgxpool.Pool, arg pgstore.CreatePollParams, options []pgstore.CreatePollOptionParams) (uuid.UUID, error) {
+	pollID := uuid.New()
+
+	err := s.inTx(ctx, "CreatePoll", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, createPoll, pollID, arg.TripID, arg.Kind, arg.Question); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert poll for CreatePoll: %w", err)
+		}
+
+		for i, option := range options {
+			if _, err := exec(ctx, tx, createPollOption,
+				uuid.New(),
+				pollID,
+				int32(i),
+				option.Destination,
+				timestamp(option.StartsAt),
+				timestamp(option.EndsAt),
+			); err != nil {
+				return fmt.Errorf("sqlitestore: failed to insert poll option for CreatePoll: %w", err)
+			}
+		}
+
+		participants, err := queryAll(ctx, tx, scanParticipant, getParticipants, arg.TripID)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to get participants for CreatePoll: %w", err)
+		}
+
+		for _, participant := range participants {
+			if participant.DeclinedAt.Valid {
+				continue
+			}
+			if err := enqueueEmail(ctx, tx, pgstore.EmailKindPollInvitation, pgstore.PollInvitationEmail{
+				PollID:        pollID,
+				ParticipantID: participant.ID,
+			}); err != nil {
+				return fmt.Errorf("sqlitestore: failed to enqueue poll invitation for CreatePoll: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return pollID, nil
+}
+
+const pollColumns = `"id", "trip_id", "kind", "question", "applied_at", "created_at"`
+
+func scanPoll(row scanner) (pgstore.Poll, error) {
+	var i pgstore.Poll
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Kind,
+		&i.Question,
+		&i.AppliedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPoll = `
+SELECT
+    ` + pollColumns + `
+FROM polls
+WHERE
+    id = ?
+`
+
+func (s *Store) GetPoll(ctx context.Context, id uuid.UUID) (pgstore.Poll, error) {
+	poll, err := scanPoll(s.db.QueryRowContext(ctx, getPoll, id))
+	return poll, pgError(err)
+}
+
+const getTripPolls = `
+SELECT
+    ` + pollColumns + `
+FROM polls
+WHERE
+    trip_id = ?
+ORDER BY
+    created_at
+`
+
+func (s *Store) GetTripPolls(ctx context.Context, tripID uuid.UUID) ([]pgstore.Poll, error) {
+	return queryAll(ctx, s.db, scanPoll, getTripPolls, tripID)
+}
+
+const getTripPollOptions = `
+SELECT
+    poll_options.id, poll_options.poll_id, poll_options.position, poll_options.destination,
+    poll_options.starts_at, poll_options.ends_at, count(poll_votes.participant_id) AS votes
+FROM poll_options
+JOIN polls ON polls.id = poll_options.poll_id
+LEFT JOIN poll_votes ON poll_votes.option_id = poll_options.id
+WHERE
+    polls.trip_id = ?
+GROUP BY
+    poll_options.id
+ORDER BY
+    poll_options.poll_id, poll_options.position
+`
+
+func (s *Store) GetTripPollOptions(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripPollOptionsRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.GetTripPollOptionsRow, error) {
+		var i pgstore.GetTripPollOptionsRow
+		err := row.Scan(
+			&i.ID,
+			&i.PollID,
+			&i.Position,
+			&i.Destination,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Votes,
+		)
+		return i, err
+	}, getTripPollOptions, tripID)
+}
+
+const votePoll = `
+INSERT INTO poll_votes
+    ( "poll_id", "participant_id", "option_id" ) VALUES
+    ( ?, ?, ? )
+ON CONFLICT (poll_id, participant_id) DO UPDATE
+SET
+    "option_id" = excluded.option_id,
+    "voted_at" = strftime('%Y-%m-%d %H:%M:%f000', 'now')
+`
+
+func (s *Store) VotePoll(ctx context.Context, arg pgstore.VotePollParams) error {
+	_, err := exec(ctx, s.db, votePoll, arg.PollID, arg.ParticipantID, arg.OptionID)
+	return err
+}
+
+// errTripChanged rolls ApplyPollTx back when the trip is no longer at the
+// version it was read in.
+var errTripChanged = errors.New("sqlitestore: trip changed")
+
+const markPollApplied = `
+UPDATE polls
+SET
+    "applied_at" = strftime('%Y-%m-%d %H:%M:%f000', 'now')
+WHERE
+    id = ? AND applied_at IS NULL
+`
+
+// ApplyPollTx updates the trip with the winning option of the poll and closes
+// the poll. It returns false, changing nothing, when the trip is no longer at
+// the version of update or the poll was already applied.
+func (s *Store) ApplyPollTx(ctx context.Context, _ *pgxpool.Pool, pollID uuid.UUID, update pgstore.UpdateTripPartialParams) (bool, error) {
+	var applied bool
+	err := s.inTx(ctx, "ApplyPoll", func(tx *sql.Tx) error {
+		marked, err := exec(ctx, tx, markPollApplied, pollID)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to mark poll applied for ApplyPoll: %w", err)
+		}
+		if marked == 0 {
+			return nil
+		}
+
+		updated, err := updateTripPartialWith(ctx, tx, update)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to update trip for ApplyPoll: %w", err)
+		}
+		if updated == 0 {
+			return errTripChanged
+		}
+
+		applied = true
+		return nil
+	})
+	if err != nil && !errors.Is(err, errTripChanged) {
+		return false, err
+	}
+
+	return applied, nil
+}
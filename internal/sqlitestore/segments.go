@@ -0,0 +1,186 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const createTransportSegment = `
+INSERT INTO transport_segments
+    ( "id", "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+const addTransportSegmentParticipant = `
+INSERT INTO transport_segment_participants
+    ( "segment_id", "trip_id", "participant_id" ) VALUES
+    ( ?, ?, ? )
+`
+
+// CreateTransportSegmentTx creates the segment along with the participants
+// taking it.
+func (s *Store) CreateTransportSegmentTx(ctx context.Context, _ *pgxpool.Pool, arg pgstore.CreateTransportSegmentParams, participantIDs []uuid.UUID) (uuid.UUID, error) {
+	segmentID := uuid.New()
+
+	err := s.inTx(ctx, "CreateTransportSegment", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, createTransportSegment,
+			segmentID,
+			arg.TripID,
+			arg.Mode,
+			arg.Carrier,
+			arg.Number,
+			arg.DeparturePlace,
+			timestamp(arg.DepartureAt),
+			arg.ArrivalPlace,
+			timestamp(arg.ArrivalAt),
+			now(),
+		); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert segment for CreateTransportSegment: %w", err)
+		}
+
+		if err := addTransportSegmentParticipants(ctx, tx, segmentID, arg.TripID, participantIDs); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert segment participant for CreateTransportSegment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return segmentID, nil
+}
+
+const getTripTransportSegments = `
+SELECT
+    "id", "trip_id", "mode", "carrier", "number", "departure_place", "departure_at", "arrival_place", "arrival_at", "created_at"
+FROM transport_segments
+WHERE
+    trip_id = ?
+ORDER BY
+    departure_at, id
+`
+
+func (s *Store) GetTripTransportSegments(ctx context.Context, tripID uuid.UUID) ([]pgstore.TransportSegment, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.TransportSegment, error) {
+		var i pgstore.TransportSegment
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Mode,
+			&i.Carrier,
+			&i.Number,
+			&i.DeparturePlace,
+			&i.DepartureAt,
+			&i.ArrivalPlace,
+			&i.ArrivalAt,
+			&i.CreatedAt,
+		)
+		return i, err
+	}, getTripTransportSegments, tripID)
+}
+
+const getTripTransportSegmentParticipants = `
+SELECT
+    "segment_id", "trip_id", "participant_id"
+FROM transport_segment_participants
+WHERE
+    trip_id = ?
+ORDER BY
+    segment_id, participant_id
+`
+
+func (s *Store) GetTripTransportSegmentParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.TransportSegmentParticipant, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.TransportSegmentParticipant, error) {
+		var i pgstore.TransportSegmentParticipant
+		err := row.Scan(&i.SegmentID, &i.TripID, &i.ParticipantID)
+		return i, err
+	}, getTripTransportSegmentParticipants, tripID)
+}
+
+const updateTransportSegment = `
+UPDATE transport_segments
+SET
+    "mode" = ?,
+    "carrier" = ?,
+    "number" = ?,
+    "departure_place" = ?,
+    "departure_at" = ?,
+    "arrival_place" = ?,
+    "arrival_at" = ?
+WHERE
+    id = ? AND trip_id = ?
+`
+
+const deleteTransportSegmentParticipants = `
+DELETE FROM transport_segment_participants
+WHERE
+    segment_id = ?
+`
+
+// UpdateTransportSegmentTx updates the segment and replaces the participants
+// taking it. It returns the number of segments updated, 0 or 1.
+func (s *Store) UpdateTransportSegmentTx(ctx context.Context, _ *pgxpool.Pool, arg pgstore.UpdateTransportSegmentParams, participantIDs []uuid.UUID) (int64, error) {
+	var updated int64
+
+	err := s.inTx(ctx, "UpdateTransportSegment", func(tx *sql.Tx) error {
+		var err error
+		updated, err = exec(ctx, tx, updateTransportSegment,
+			arg.Mode,
+			arg.Carrier,
+			arg.Number,
+			arg.DeparturePlace,
+			timestamp(arg.DepartureAt),
+			arg.ArrivalPlace,
+			timestamp(arg.ArrivalAt),
+			arg.ID,
+			arg.TripID,
+		)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to update segment for UpdateTransportSegment: %w", err)
+		}
+		if updated == 0 {
+			return nil
+		}
+
+		if _, err := exec(ctx, tx, deleteTransportSegmentParticipants, arg.ID); err != nil {
+			return fmt.Errorf("sqlitestore: failed to delete segment participants for UpdateTransportSegment: %w", err)
+		}
+
+		if err := addTransportSegmentParticipants(ctx, tx, arg.ID, arg.TripID, participantIDs); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert segment participant for UpdateTransportSegment: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return updated, nil
+}
+
+const deleteTransportSegment = `
+DELETE FROM transport_segments
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteTransportSegment(ctx context.Context, arg pgstore.DeleteTransportSegmentParams) (int64, error) {
+	return exec(ctx, s.db, deleteTransportSegment, arg.ID, arg.TripID)
+}
+
+func addTransportSegmentParticipants(ctx context.Context, tx *sql.Tx, segmentID, tripID uuid.UUID, participantIDs []uuid.UUID) error {
+	for _, participantID := range participantIDs {
+		if _, err := exec(ctx, tx, addTransportSegmentParticipant, segmentID, tripID, participantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
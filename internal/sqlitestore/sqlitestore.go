@@ -0,0 +1,268 @@
+// Package sqlitestore keeps the trips in SQLite, behind the same methods the
+// API calls on pgstore, for self-hosting the API on a single small machine
+// without Postgres.
+//
+// It answers like pgstore does: pgx.ErrNoRows for missing rows and a
+// *pgconn.PgError for the unique, foreign key and check constraints of the
+// schema. Its emails are written to its own outbox, for mailer.Outbox to send.
+// The other background jobs, the reminders, the daily digests, the domain
+// events and the streamed changes, only run against Postgres.
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName is the SQLite driver with the functions the queries use
+// registered on every connection.
+const driverName = "sqlite3_travel"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			return conn.RegisterFunc("websearch_match", websearchMatch, true)
+		},
+	})
+}
+
+// timeFormat is how timestamps are stored, which sorts them in time order
+// when compared as text. The SQL defaults of the schema write the same format.
+const timeFormat = "2006-01-02 15:04:05.000000"
+
+// Store is the SQLite store.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens the database file at path, creating it when missing. Migrate
+// creates its tables.
+func Open(path string) (*Store, error) {
+	params := url.Values{}
+	params.Set("_foreign_keys", "on")
+	params.Set("_journal_mode", "WAL")
+	params.Set("_busy_timeout", "5000")
+	// Transactions take the write lock when they begin, rather than on their
+	// first write, where they would fail if another one wrote meanwhile.
+	params.Set("_txlock", "immediate")
+
+	db, err := sql.Open(driverName, "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("sqlitestore: failed to open %s: %w", path, err)
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("sqlitestore: failed to open %s: %w", path, err)
+	}
+
+	return &Store{db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// querier runs the queries, either on the database or in a transaction.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// inTx runs fn in a transaction, committed when fn returns nil. name is the
+// method the errors are reported for.
+func (s *Store) inTx(ctx context.Context, name string, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqlitestore: failed to begin tx for %s: %w", name, pgError(err))
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlitestore: failed to commit tx for %s: %w", name, pgError(err))
+	}
+
+	return nil
+}
+
+// exec runs a statement and returns the number of rows it changed.
+func exec(ctx context.Context, q querier, query string, args ...any) (int64, error) {
+	res, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, pgError(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, pgError(err)
+	}
+
+	return n, nil
+}
+
+// queryRow scans the single row of a query into dest.
+func queryRow(ctx context.Context, q querier, query string, args []any, dest ...any) error {
+	return pgError(q.QueryRowContext(ctx, query, args...).Scan(dest...))
+}
+
+// queryAll returns the rows of a query, each read by scan.
+func queryAll[T any](ctx context.Context, q querier, scan func(scanner) (T, error), query string, args ...any) ([]T, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, pgError(err)
+	}
+
+	defer rows.Close()
+
+	var items []T
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, pgError(err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, pgError(err)
+	}
+
+	return items, nil
+}
+
+// timestamp converts t to its stored format. Like the timestamp columns of
+// Postgres, its time zone is dropped and its wall clock kept.
+func timestamp(t pgtype.Timestamp) any {
+	if !t.Valid {
+		return nil
+	}
+
+	return t.Time.Format(timeFormat)
+}
+
+// date converts d to its stored format, the ISO 8601 date Postgres writes.
+func date(d pgtype.Date) any {
+	if !d.Valid {
+		return nil
+	}
+
+	return d.Time.Format(time.DateOnly)
+}
+
+// now returns the current time in its stored format.
+func now() string {
+	return time.Now().UTC().Format(timeFormat)
+}
+
+// uniqueConstraints are the Postgres names of the unique constraints, by the
+// columns SQLite reports them by.
+var uniqueConstraints = map[string]string{
+	"trip_owners.trip_id, trip_owners.email":     "trip_owners_pkey",
+	"tags.name":                                  "tags_name_key",
+	"trip_tags.trip_id, trip_tags.tag_id":        "trip_tags_pkey",
+	"trip_shares.slug":                           "trip_shares_pkey",
+	"trip_join_codes.code":                       "trip_join_codes_pkey",
+	"trip_join_codes.trip_id":                    "trip_join_codes_trip_id_key",
+	"participants.trip_id, participants.email":   "participants_trip_id_email_key",
+	"trip_waitlist.trip_id, trip_waitlist.email": "trip_waitlist_trip_id_email_key",
+	"links.trip_id, links.url":                   "links_trip_id_url_key",
+	"activity_attachments.storage_key":           "activity_attachments_storage_key_key",
+	"documents.storage_key":                      "documents_storage_key_key",
+	"photos.storage_key":                         "photos_storage_key_key",
+}
+
+// pgError translates the errors of SQLite to the ones pgx returns, which the
+// callers check: pgx.ErrNoRows and the *pgconn.PgError of the constraint
+// violations.
+func pgError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return pgx.ErrNoRows
+	}
+
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.Code != sqlite3.ErrConstraint {
+		return err
+	}
+
+	var code string
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		code = "23505"
+	case sqlite3.ErrConstraintForeignKey:
+		code = "23503"
+	case sqlite3.ErrConstraintCheck:
+		code = "23514"
+	case sqlite3.ErrConstraintNotNull:
+		code = "23502"
+	default:
+		return err
+	}
+
+	// The check constraints are reported by name, the others by column.
+	_, constraint, _ := strings.Cut(sqliteErr.Error(), "constraint failed: ")
+	if name, ok := uniqueConstraints[constraint]; ok {
+		constraint = name
+	}
+
+	return &pgconn.PgError{
+		Severity:       "ERROR",
+		Code:           code,
+		Message:        sqliteErr.Error(),
+		ConstraintName: constraint,
+	}
+}
+
+// websearchMatch tells whether text contains every word of the websearch
+// query, ignoring case, and none of its words starting with -. It stands in
+// for the full-text search of Postgres, without its stemming: a word matches
+// the words of text it starts.
+func websearchMatch(query, text string) bool {
+	words := strings.Fields(strings.ToLower(text))
+	contains := func(term string) bool {
+		for _, word := range words {
+			if strings.HasPrefix(word, term) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, term := range strings.Fields(strings.ToLower(strings.ReplaceAll(query, `"`, " "))) {
+		if term == "or" {
+			continue
+		}
+
+		if excluded, ok := strings.CutPrefix(term, "-"); ok {
+			if excluded != "" && contains(excluded) {
+				return false
+			}
+			continue
+		}
+
+		if !contains(term) {
+			return false
+		}
+	}
+
+	return true
+}
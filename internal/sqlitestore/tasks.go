@@ -0,0 +1,89 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const createTask = `
+INSERT INTO tasks
+    ( "id", "trip_id", "title", "assignee_id", "due_at", "created_at" ) VALUES
+    ( ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateTask(ctx context.Context, arg pgstore.CreateTaskParams) (uuid.UUID, error) {
+	id := uuid.New()
+	// created_at is written to the microsecond, which orders the tasks due
+	// at the same time.
+	if _, err := exec(ctx, s.db, createTask, id, arg.TripID, arg.Title, arg.AssigneeID, timestamp(arg.DueAt), now()); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+const getTripTasks = `
+SELECT
+    "id", "trip_id", "title", "assignee_id", "due_at", "done", "reminded_at", "created_at"
+FROM tasks
+WHERE
+    trip_id = ?
+ORDER BY
+    done, due_at IS NULL, due_at, created_at, id
+`
+
+func (s *Store) GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Task, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.Task, error) {
+		var i pgstore.Task
+		err := row.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.AssigneeID,
+			&i.DueAt,
+			&i.Done,
+			&i.RemindedAt,
+			&i.CreatedAt,
+		)
+		return i, err
+	}, getTripTasks, tripID)
+}
+
+const updateTask = `
+UPDATE tasks
+SET
+    "title" = ?1,
+    "assignee_id" = ?2,
+    "due_at" = ?3,
+    "done" = ?4,
+    "reminded_at" = CASE
+        WHEN assignee_id IS NOT ?2 OR due_at IS NOT ?3 THEN NULL
+        ELSE reminded_at
+    END
+WHERE
+    id = ?5 AND trip_id = ?6
+`
+
+func (s *Store) UpdateTask(ctx context.Context, arg pgstore.UpdateTaskParams) (int64, error) {
+	return exec(ctx, s.db, updateTask,
+		arg.Title,
+		arg.AssigneeID,
+		timestamp(arg.DueAt),
+		arg.Done,
+		arg.ID,
+		arg.TripID,
+	)
+}
+
+const deleteTask = `
+DELETE FROM tasks
+WHERE
+    id = ? AND trip_id = ?
+`
+
+func (s *Store) DeleteTask(ctx context.Context, arg pgstore.DeleteTaskParams) (int64, error) {
+	return exec(ctx, s.db, deleteTask, arg.ID, arg.TripID)
+}
@@ -0,0 +1,707 @@
+package sqlitestore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"travel-api/internal/api/spec"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// defaultPreTripReminderDays is how many days before the trip the pre-trip
+// reminder is sent when the trip does not say otherwise, matching the column
+// default.
+const defaultPreTripReminderDays = 2
+
+const tripColumns = `"id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "status", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone", "version", "created_at", "latitude", "longitude", "place_name"`
+
+func scanTrip(row scanner) (pgstore.Trip, error) {
+	var i pgstore.Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Description,
+		&i.Status,
+		&i.RsvpDeadline,
+		&i.MaxGuests,
+		&i.MaxParticipants,
+		&i.PreTripReminderDays,
+		&i.Timezone,
+		&i.Version,
+		&i.CreatedAt,
+		&i.Latitude,
+		&i.Longitude,
+		&i.PlaceName,
+	)
+	return i, err
+}
+
+const insertTrip = `
+INSERT INTO trips
+    ( "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "description", "rsvp_deadline", "max_guests", "max_participants", "pre_trip_reminder_days", "timezone" ) VALUES
+    ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
+`
+
+func (s *Store) CreateTripTx(ctx context.Context, _ *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
+	var description string
+	if params.Description != nil {
+		description = *params.Description
+	}
+
+	var rsvpDeadline pgtype.Timestamp
+	if params.RsvpDeadline != nil {
+		rsvpDeadline = pgtype.Timestamp{Valid: true, Time: *params.RsvpDeadline}
+	}
+
+	var maxGuests int32
+	if params.MaxGuests != nil {
+		maxGuests = int32(*params.MaxGuests)
+	}
+
+	var maxParticipants pgtype.Int4
+	if params.MaxParticipants != nil {
+		maxParticipants = pgtype.Int4{Valid: true, Int32: int32(*params.MaxParticipants)}
+	}
+
+	preTripReminderDays := int32(defaultPreTripReminderDays)
+	if params.PreTripReminderDays != nil {
+		preTripReminderDays = int32(*params.PreTripReminderDays)
+	}
+
+	timezone := "UTC"
+	if params.Timezone != nil {
+		timezone = *params.Timezone
+	}
+
+	// The invitations are written in the language of the owner.
+	locale := pgstore.LocalePtBR
+	if params.OwnerLocale != nil && *params.OwnerLocale != spec.UnknownLocale {
+		locale = pgstore.Locale(params.OwnerLocale.ToValue())
+	}
+
+	tripID := uuid.New()
+	err := s.inTx(ctx, "CreateTrip", func(tx *sql.Tx) error {
+		if _, err := exec(ctx, tx, insertTrip,
+			tripID,
+			params.Destination,
+			string(params.OwnerEmail),
+			params.OwnerName,
+			timestamp(pgtype.Timestamp{Valid: true, Time: params.StartsAt}),
+			timestamp(pgtype.Timestamp{Valid: true, Time: params.EndsAt}),
+			description,
+			timestamp(rsvpDeadline),
+			maxGuests,
+			maxParticipants,
+			preTripReminderDays,
+			timezone,
+		); err != nil {
+			return fmt.Errorf("sqlitestore: failed to insert trip for CreateTrip: %w", err)
+		}
+
+		if _, err := exec(ctx, tx, addTripOwner,
+			tripID,
+			strings.ToLower(string(params.OwnerEmail)),
+			params.OwnerName,
+			locale,
+		); err != nil {
+			return fmt.Errorf("sqlitestore: failed to add trip owner for CreateTrip: %w", err)
+		}
+
+		participants := make([]pgstore.InviteParticipantsToTripParams, 0, len(params.EmailsToInvite))
+		seen := make(map[string]bool, len(params.EmailsToInvite))
+
+		for _, emailToInvite := range params.EmailsToInvite {
+			email := strings.ToLower(string(emailToInvite))
+			if seen[email] {
+				continue
+			}
+			seen[email] = true
+
+			participants = append(participants, pgstore.InviteParticipantsToTripParams{
+				TripID: tripID,
+				Email:  email,
+				Locale: locale,
+			})
+		}
+
+		// Invitations beyond the trip capacity go to the waitlist.
+		if maxParticipants.Valid && len(participants) > int(maxParticipants.Int32) {
+			for _, p := range participants[maxParticipants.Int32:] {
+				if _, err := exec(ctx, tx, addToTripWaitlist, uuid.New(), tripID, p.Email); err != nil {
+					return fmt.Errorf("sqlitestore: failed to add to waitlist for CreateTrip: %w", err)
+				}
+			}
+			participants = participants[:maxParticipants.Int32]
+		}
+
+		if err := inviteParticipantsToTrip(ctx, tx, participants); err != nil {
+			return fmt.Errorf("sqlitestore: failed to invite participants for CreateTrip: %w", err)
+		}
+
+		if err := enqueueEmail(ctx, tx, pgstore.EmailKindConfirmTrip, pgstore.ConfirmTripEmail{TripID: tripID}); err != nil {
+			return fmt.Errorf("sqlitestore: failed to enqueue confirmation email for CreateTrip: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return tripID, nil
+}
+
+const getTrip = `
+SELECT
+    ` + tripColumns + `
+FROM trips
+WHERE
+    id = ?
+`
+
+func (s *Store) GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	return getTripWith(ctx, s.db, id)
+}
+
+func getTripWith(ctx context.Context, q querier, id uuid.UUID) (pgstore.Trip, error) {
+	trip, err := scanTrip(q.QueryRowContext(ctx, getTrip, id))
+	return trip, pgError(err)
+}
+
+// GetTripWithRelations runs a query per relation, the database being in the
+// same process there is no round trip to save.
+func (s *Store) GetTripWithRelations(ctx context.Context, id uuid.UUID) (pgstore.TripWithRelations, error) {
+	trip, err := s.GetTrip(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	participants, err := s.GetParticipants(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	activities, err := s.GetTripActivities(ctx, pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	links, err := s.GetTripLinks(ctx, id)
+	if err != nil {
+		return pgstore.TripWithRelations{}, err
+	}
+
+	return pgstore.TripWithRelations{
+		Trip:         trip,
+		Participants: participants,
+		Activities:   activities,
+		Links:        links,
+	}, nil
+}
+
+const updateTrip = `
+UPDATE trips
+SET
+    "destination" = ?,
+    "ends_at" = ?,
+    "starts_at" = ?,
+    "description" = ?,
+    "version" = version + 1
+WHERE
+    id = ? AND version = ?
+`
+
+func (s *Store) UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) (int64, error) {
+	return exec(ctx, s.db, updateTrip,
+		arg.Destination,
+		timestamp(arg.EndsAt),
+		timestamp(arg.StartsAt),
+		arg.Description,
+		arg.ID,
+		arg.Version,
+	)
+}
+
+const updateTripPartial = `
+UPDATE trips
+SET
+    "destination" = COALESCE(?, "destination"),
+    "ends_at" = COALESCE(?, "ends_at"),
+    "starts_at" = COALESCE(?, "starts_at"),
+    "description" = COALESCE(?, "description"),
+    "rsvp_deadline" = CASE WHEN ? THEN NULL ELSE COALESCE(?, "rsvp_deadline") END,
+    "max_guests" = COALESCE(?, "max_guests"),
+    "max_participants" = CASE WHEN ? THEN NULL ELSE COALESCE(?, "max_participants") END,
+    "pre_trip_reminder_days" = COALESCE(?, "pre_trip_reminder_days"),
+    "timezone" = COALESCE(?, "timezone"),
+    "version" = version + 1
+WHERE
+    id = ? AND version = ?
+`
+
+func (s *Store) UpdateTripPartial(ctx context.Context, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	return updateTripPartialWith(ctx, s.db, arg)
+}
+
+const resetRSVPReminders = `
+UPDATE participants
+SET
+    "rsvp_reminded_at" = NULL,
+    "no_response_at" = NULL
+WHERE
+    trip_id = ?
+`
+
+// UpdateTripPartialTx updates the fields of the trip set in arg, clearing the
+// RSVP reminders of its participants when it sets the deadline and promoting
+// people from the waitlist when it sets or clears the maximum number of
+// participants.
+func (s *Store) UpdateTripPartialTx(ctx context.Context, _ *pgxpool.Pool, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	var updated int64
+	err := s.inTx(ctx, "UpdateTripPartial", func(tx *sql.Tx) error {
+		var err error
+		updated, err = updateTripPartialWith(ctx, tx, arg)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to update trip for UpdateTripPartial: %w", err)
+		}
+		if updated == 0 {
+			return nil
+		}
+
+		if arg.RsvpDeadline.Valid {
+			if _, err := exec(ctx, tx, resetRSVPReminders, arg.ID); err != nil {
+				return fmt.Errorf("sqlitestore: failed to reset rsvp reminders for UpdateTripPartial: %w", err)
+			}
+		}
+
+		if arg.MaxParticipants.Valid || arg.ClearMaxParticipants {
+			if err := promoteFromWaitlist(ctx, tx, arg.ID); err != nil {
+				return fmt.Errorf("sqlitestore: failed to promote from waitlist for UpdateTripPartial: %w", err)
+			}
+		}
+		return nil
+	})
+
+	return updated, err
+}
+
+func updateTripPartialWith(ctx context.Context, q querier, arg pgstore.UpdateTripPartialParams) (int64, error) {
+	return exec(ctx, q, updateTripPartial,
+		arg.Destination,
+		timestamp(arg.EndsAt),
+		timestamp(arg.StartsAt),
+		arg.Description,
+		arg.ClearRsvpDeadline,
+		timestamp(arg.RsvpDeadline),
+		arg.MaxGuests,
+		arg.ClearMaxParticipants,
+		arg.MaxParticipants,
+		arg.PreTripReminderDays,
+		arg.Timezone,
+		arg.ID,
+		arg.Version,
+	)
+}
+
+const updateTripStatus = `
+UPDATE trips
+SET
+    "status" = ?,
+    "version" = version + 1
+WHERE
+    id = ?
+`
+
+func (s *Store) UpdateTripStatus(ctx context.Context, arg pgstore.UpdateTripStatusParams) error {
+	_, err := exec(ctx, s.db, updateTripStatus, arg.Status, arg.ID)
+	return err
+}
+
+const updateTripPlace = `
+UPDATE trips
+SET
+    "latitude" = ?,
+    "longitude" = ?,
+    "place_name" = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) UpdateTripPlace(ctx context.Context, arg pgstore.UpdateTripPlaceParams) error {
+	_, err := exec(ctx, s.db, updateTripPlace, arg.Latitude, arg.Longitude, arg.PlaceName, arg.ID)
+	return err
+}
+
+const updateTripStatusFrom = `
+UPDATE trips
+SET
+    "status" = ?,
+    "version" = version + 1
+WHERE
+    id = ? AND status = ?
+`
+
+const getParticipantsToInvite = `
+SELECT
+    participants.email
+FROM participants
+WHERE
+    participants.trip_id = ?
+    AND participants.declined_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM participant_invitations
+        WHERE participant_invitations.participant_id = participants.id
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM email_verifications
+        WHERE email_verifications.participant_id = participants.id
+    )
+ORDER BY
+    participants.invited_at
+`
+
+// ConfirmTripTx moves the trip from status from to status to and invites the
+// participants who have not been sent their invitation yet. It returns false,
+// changing nothing, when the trip is no longer in status from.
+func (s *Store) ConfirmTripTx(
+	ctx context.Context,
+	_ *pgxpool.Pool,
+	tripID uuid.UUID,
+	from pgstore.TripStatus,
+	to pgstore.TripStatus,
+) (bool, error) {
+	var updated int64
+	err := s.inTx(ctx, "ConfirmTrip", func(tx *sql.Tx) error {
+		var err error
+		updated, err = exec(ctx, tx, updateTripStatusFrom, to, tripID, from)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to update trip status for ConfirmTrip: %w", err)
+		}
+		if updated == 0 {
+			return nil
+		}
+
+		// Participants waiting on their email verification are invited once
+		// they verify it.
+		emails, err := queryAll(ctx, tx, scanString, getParticipantsToInvite, tripID)
+		if err != nil {
+			return fmt.Errorf("sqlitestore: failed to get participants to invite for ConfirmTrip: %w", err)
+		}
+
+		for _, email := range emails {
+			if err := enqueueInvitation(ctx, tx, pgstore.InvitationEmail{TripID: tripID, Email: email}); err != nil {
+				return fmt.Errorf("sqlitestore: failed to enqueue invitation for ConfirmTrip: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+const listTrips = `
+SELECT
+    ` + tripColumns + `
+FROM trips
+WHERE
+    (?1 IS NULL OR status = ?1)
+    AND (?2 IS NULL OR EXISTS (
+        SELECT 1
+        FROM trip_tags
+        JOIN tags ON tags.id = trip_tags.tag_id
+        WHERE
+            trip_tags.trip_id = trips.id AND tags.name = ?2
+    ))
+    AND (?3 IS NULL OR websearch_match(?3, "destination" || ' ' || "description"))
+ORDER BY
+    starts_at
+`
+
+func (s *Store) ListTrips(ctx context.Context, arg pgstore.ListTripsParams) ([]pgstore.Trip, error) {
+	return queryAll(ctx, s.db, scanTrip, listTrips, arg.Status, arg.Tag, arg.Query)
+}
+
+const searchTrip = `
+SELECT
+    'activity' AS kind, activities.id, activities.title AS match
+FROM activities
+WHERE
+    activities.trip_id = ?1 AND activities.deleted_at IS NULL
+    AND websearch_match(?2, activities.title || ' ' || COALESCE(activities.address, ''))
+UNION ALL
+SELECT
+    'link' AS kind, links.id, links.title AS match
+FROM links
+WHERE
+    links.trip_id = ?1 AND links.deleted_at IS NULL AND links.title LIKE ?3 ESCAPE '\'
+UNION ALL
+SELECT
+    'participant' AS kind, participants.id, participants.email AS match
+FROM participants
+WHERE
+    participants.trip_id = ?1 AND participants.email LIKE ?3 ESCAPE '\'
+ORDER BY
+    kind, match
+LIMIT ?4
+`
+
+// SearchTrip matches the query against the activities, like the full-text
+// search of Postgres would, and the pattern against the links and the
+// participants.
+func (s *Store) SearchTrip(ctx context.Context, arg pgstore.SearchTripParams) ([]pgstore.SearchTripRow, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.SearchTripRow, error) {
+		var i pgstore.SearchTripRow
+		err := row.Scan(&i.Kind, &i.ID, &i.Match)
+		return i, err
+	}, searchTrip, arg.TripID, arg.Query, arg.Pattern, arg.MaxResults)
+}
+
+const createTag = `
+INSERT INTO tags
+    ( "id", "name" ) VALUES
+    ( ?, ? )
+ON CONFLICT ("name") DO UPDATE SET "name" = excluded.name
+RETURNING "id"
+`
+
+func (s *Store) CreateTag(ctx context.Context, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := queryRow(ctx, s.db, createTag, []any{uuid.New(), name}, &id)
+	return id, err
+}
+
+const getTag = `
+SELECT
+    "id", "name"
+FROM tags
+WHERE
+    id = ?
+`
+
+func (s *Store) GetTag(ctx context.Context, id uuid.UUID) (pgstore.Tag, error) {
+	var i pgstore.Tag
+	err := queryRow(ctx, s.db, getTag, []any{id}, &i.ID, &i.Name)
+	return i, err
+}
+
+const getTags = `
+SELECT
+    "id", "name"
+FROM tags
+ORDER BY
+    name
+`
+
+func (s *Store) GetTags(ctx context.Context) ([]pgstore.Tag, error) {
+	return queryAll(ctx, s.db, scanTag, getTags)
+}
+
+const updateTag = `
+UPDATE tags
+SET
+    "name" = ?
+WHERE
+    id = ?
+`
+
+func (s *Store) UpdateTag(ctx context.Context, arg pgstore.UpdateTagParams) error {
+	_, err := exec(ctx, s.db, updateTag, arg.Name, arg.ID)
+	return err
+}
+
+const deleteTag = `
+DELETE FROM tags
+WHERE
+    id = ?
+`
+
+func (s *Store) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	_, err := exec(ctx, s.db, deleteTag, id)
+	return err
+}
+
+const addTagToTrip = `
+INSERT INTO trip_tags
+    ( "trip_id", "tag_id" ) VALUES
+    ( ?, ? )
+ON CONFLICT DO NOTHING
+`
+
+func (s *Store) AddTagToTrip(ctx context.Context, arg pgstore.AddTagToTripParams) error {
+	_, err := exec(ctx, s.db, addTagToTrip, arg.TripID, arg.TagID)
+	return err
+}
+
+const removeTagFromTrip = `
+DELETE FROM trip_tags
+WHERE
+    trip_id = ? AND tag_id = ?
+`
+
+func (s *Store) RemoveTagFromTrip(ctx context.Context, arg pgstore.RemoveTagFromTripParams) error {
+	_, err := exec(ctx, s.db, removeTagFromTrip, arg.TripID, arg.TagID)
+	return err
+}
+
+const getTripTags = `
+SELECT
+    tags.id, tags.name
+FROM tags
+JOIN trip_tags ON trip_tags.tag_id = tags.id
+WHERE
+    trip_tags.trip_id = ?
+ORDER BY
+    tags.name
+`
+
+func (s *Store) GetTripTags(ctx context.Context, tripID uuid.UUID) ([]pgstore.Tag, error) {
+	return queryAll(ctx, s.db, scanTag, getTripTags, tripID)
+}
+
+func scanTag(row scanner) (pgstore.Tag, error) {
+	var i pgstore.Tag
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const createTripShare = `
+INSERT INTO trip_shares
+    ( "trip_id", "slug" ) VALUES
+    ( ?, ? )
+`
+
+func (s *Store) CreateTripShare(ctx context.Context, arg pgstore.CreateTripShareParams) error {
+	_, err := exec(ctx, s.db, createTripShare, arg.TripID, arg.Slug)
+	return err
+}
+
+const getTripIDByShareSlug = `
+SELECT
+    "trip_id"
+FROM trip_shares
+WHERE
+    slug = ? AND revoked_at IS NULL
+`
+
+func (s *Store) GetTripIDByShareSlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	var tripID uuid.UUID
+	err := queryRow(ctx, s.db, getTripIDByShareSlug, []any{slug}, &tripID)
+	return tripID, err
+}
+
+const revokeTripShares = `
+UPDATE trip_shares
+SET
+    "revoked_at" = ?
+WHERE
+    trip_id = ? AND revoked_at IS NULL
+`
+
+func (s *Store) RevokeTripShares(ctx context.Context, tripID uuid.UUID) error {
+	_, err := exec(ctx, s.db, revokeTripShares, now(), tripID)
+	return err
+}
+
+const setTripJoinCode = `
+INSERT INTO trip_join_codes
+    ( "trip_id", "code" ) VALUES
+    ( ?, ? )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "code" = excluded.code,
+    "created_at" = excluded.created_at
+`
+
+func (s *Store) SetTripJoinCode(ctx context.Context, arg pgstore.SetTripJoinCodeParams) error {
+	_, err := exec(ctx, s.db, setTripJoinCode, arg.TripID, arg.Code)
+	return err
+}
+
+const getTripIDByJoinCode = `
+SELECT
+    "trip_id"
+FROM trip_join_codes
+WHERE
+    code = ?
+`
+
+func (s *Store) GetTripIDByJoinCode(ctx context.Context, code string) (uuid.UUID, error) {
+	var tripID uuid.UUID
+	err := queryRow(ctx, s.db, getTripIDByJoinCode, []any{code}, &tripID)
+	return tripID, err
+}
+
+const addTripOwner = `
+INSERT INTO trip_owners
+    ( "trip_id", "email", "name", "locale" ) VALUES
+    ( ?, ?, ?, ? )
+ON CONFLICT DO NOTHING
+`
+
+func (s *Store) AddTripOwner(ctx context.Context, arg pgstore.AddTripOwnerParams) error {
+	_, err := exec(ctx, s.db, addTripOwner, arg.TripID, arg.Email, arg.Name, arg.Locale)
+	return err
+}
+
+const getTripOwners = `
+SELECT
+    "trip_id", "email", "name", "created_at", "locale"
+FROM trip_owners
+WHERE
+    trip_id = ?
+ORDER BY
+    created_at
+`
+
+func (s *Store) GetTripOwners(ctx context.Context, tripID uuid.UUID) ([]pgstore.TripOwner, error) {
+	return queryAll(ctx, s.db, func(row scanner) (pgstore.TripOwner, error) {
+		var i pgstore.TripOwner
+		err := row.Scan(&i.TripID, &i.Email, &i.Name, &i.CreatedAt, &i.Locale)
+		return i, err
+	}, getTripOwners, tripID)
+}
+
+const isTripOwner = `
+SELECT EXISTS (
+    SELECT 1
+    FROM trip_owners
+    WHERE
+        trip_id = ? AND email = ?
+)
+`
+
+func (s *Store) IsTripOwner(ctx context.Context, arg pgstore.IsTripOwnerParams) (bool, error) {
+	var exists bool
+	err := queryRow(ctx, s.db, isTripOwner, []any{arg.TripID, arg.Email}, &exists)
+	return exists, err
+}
+
+const removeTripOwner = `
+DELETE FROM trip_owners
+WHERE
+    trip_id = ? AND email = ?
+`
+
+func (s *Store) RemoveTripOwner(ctx context.Context, arg pgstore.RemoveTripOwnerParams) error {
+	_, err := exec(ctx, s.db, removeTripOwner, arg.TripID, arg.Email)
+	return err
+}
+
+func scanString(row scanner) (string, error) {
+	var s string
+	err := row.Scan(&s)
+	return s, err
+}
@@ -0,0 +1,76 @@
+package sqlitestore
+
+import (
+	"context"
+
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+)
+
+const webhookColumns = `"id", "trip_id", "url", "secret", "created_at"`
+
+// webhookDest returns the fields of i in the order of webhookColumns.
+func webhookDest(i *pgstore.Webhook) []any {
+	return []any{&i.ID, &i.TripID, &i.Url, &i.Secret, &i.CreatedAt}
+}
+
+func scanWebhook(row scanner) (pgstore.Webhook, error) {
+	var i pgstore.Webhook
+	err := row.Scan(webhookDest(&i)...)
+	return i, err
+}
+
+const createWebhook = `
+INSERT INTO webhooks
+    ( "id", "trip_id", "url", "secret" ) VALUES
+    ( ?, ?, ?, ? )
+RETURNING ` + webhookColumns
+
+func (s *Store) CreateWebhook(ctx context.Context, arg pgstore.CreateWebhookParams) (pgstore.Webhook, error) {
+	var i pgstore.Webhook
+	err := queryRow(ctx, s.db, createWebhook, []any{uuid.New(), arg.TripID, arg.Url, arg.Secret}, webhookDest(&i)...)
+	return i, err
+}
+
+const getWebhook = `
+SELECT ` + webhookColumns + `
+FROM webhooks
+WHERE
+    id = ?
+`
+
+func (s *Store) GetWebhook(ctx context.Context, id uuid.UUID) (pgstore.Webhook, error) {
+	var i pgstore.Webhook
+	err := queryRow(ctx, s.db, getWebhook, []any{id}, webhookDest(&i)...)
+	return i, err
+}
+
+const getTripWebhooks = `
+SELECT ` + webhookColumns + `
+FROM webhooks
+WHERE
+    trip_id = ?
+ORDER BY
+    created_at, id
+`
+
+func (s *Store) GetTripWebhooks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Webhook, error) {
+	return queryAll(ctx, s.db, scanWebhook, getTripWebhooks, tripID)
+}
+
+const deleteWebhook = `
+DELETE FROM webhooks
+WHERE
+    id = ?
+`
+
+func (s *Store) DeleteWebhook(ctx context.Context, id uuid.UUID) (int64, error) {
+	return exec(ctx, s.db, deleteWebhook, id)
+}
+
+// GetWebhookDeliveries returns no delivery: the store records no domain
+// events to deliver.
+func (s *Store) GetWebhookDeliveries(_ context.Context, _ pgstore.GetWebhookDeliveriesParams) ([]pgstore.GetWebhookDeliveriesRow, error) {
+	return nil, nil
+}
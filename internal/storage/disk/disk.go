@@ -0,0 +1,166 @@
+package disk
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Disk stores blobs on the local file system and hands out download URLs
+// signed with an HMAC, which are served by Handler.
+type Disk struct {
+	dir     string
+	baseURL string
+	secret  []byte
+}
+
+// NewDisk creates the storage directory if needed. baseURL is the public URL
+// Handler is mounted on, e.g. "http://localhost:8080/files".
+func NewDisk(dir, baseURL string, secret []byte) (Disk, error) {
+	if len(secret) == 0 {
+		return Disk{}, fmt.Errorf("disk: signing secret must not be empty")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return Disk{}, fmt.Errorf("disk: failed to create storage dir: %w", err)
+	}
+
+	return Disk{dir, strings.TrimSuffix(baseURL, "/"), secret}, nil
+}
+
+// Put writes everything read from r under key.
+func (d Disk) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("disk: failed to create dir for Put: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("disk: failed to create file for Put: %w", err)
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return fmt.Errorf("disk: failed to write file for Put: %w", err)
+	}
+
+	// Close flushes the file, so a failure means it was not fully written.
+	if err := f.Close(); err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("disk: failed to close file for Put: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the blob under key, which the caller closes.
+func (d Disk) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("disk: failed to open file for Get: %w", err)
+	}
+
+	return f, nil
+}
+
+// Delete removes key. Deleting a missing key is not an error.
+func (d Disk) Delete(ctx context.Context, key string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("disk: failed to remove file for Delete: %w", err)
+	}
+
+	return nil
+}
+
+// SignedURL returns a URL to download key that stops working after ttl.
+func (d Disk) SignedURL(key string, ttl time.Duration) (string, time.Time, error) {
+	if _, err := d.path(key); err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("signature", d.sign(key, expires))
+
+	return d.baseURL + "/" + key + "?" + q.Encode(), expiresAt, nil
+}
+
+// Handler serves the files behind the URLs produced by SignedURL. It must be
+// mounted with the prefix of baseURL stripped from the request path. The files
+// are uploaded by the users, so they are served as downloads the browser must
+// not sniff, never rendered as a page of the API origin.
+func (d Disk) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		expires := r.URL.Query().Get("expires")
+
+		unix, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().After(time.Unix(unix, 0)) {
+			http.Error(w, "link expirado", http.StatusForbidden)
+			return
+		}
+
+		signature := r.URL.Query().Get("signature")
+		if !hmac.Equal([]byte(signature), []byte(d.sign(key, expires))) {
+			http.Error(w, "assinatura inválida", http.StatusForbidden)
+			return
+		}
+
+		path, err := d.path(key)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Disposition", "attachment")
+		http.ServeFile(w, r, path)
+	})
+}
+
+func (d Disk) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, d.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path resolves key inside the storage dir, refusing keys that would escape
+// it.
+func (d Disk) path(key string) (string, error) {
+	path := filepath.Join(d.dir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(path, filepath.Clean(d.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("disk: invalid key %q", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,244 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxURLTTL is the longest a presigned URL may stay valid for.
+const maxURLTTL = 7 * 24 * time.Hour
+
+// Config holds the bucket the blobs are kept in and the credentials it is
+// reached with. Endpoint is the URL of the S3 API, such as
+// https://s3.us-east-1.amazonaws.com, or http://localhost:9000 for MinIO, the
+// bucket being addressed by path. SessionToken is only set for temporary
+// credentials.
+type Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// S3 stores blobs in a bucket of Amazon S3 or of a compatible storage, such as
+// MinIO, and hands out presigned download URLs.
+type S3 struct {
+	client   *http.Client
+	cfg      Config
+	endpoint *url.URL
+}
+
+func NewS3(cfg Config) (S3, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return S3{}, fmt.Errorf("s3: invalid endpoint %q", cfg.Endpoint)
+	}
+
+	if cfg.Region == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return S3{}, fmt.Errorf("s3: region, bucket and credentials must not be empty")
+	}
+
+	return S3{&http.Client{Timeout: time.Minute}, cfg, endpoint}, nil
+}
+
+// Put writes everything read from r under key. The blob is read in memory
+// first, S3 needing its length and hash up front, so the callers cap its
+// size.
+func (s S3) Put(ctx context.Context, key string, r io.Reader) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("s3: failed to read blob for Put: %w", err)
+	}
+
+	return s.do(ctx, http.MethodPut, key, body, http.StatusOK)
+}
+
+// Get downloads the blob under key, whose body the caller closes.
+func (s S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("s3: failed to create GET request: %w", err)
+	}
+	s.sign(req, key, nil, time.Now().UTC())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3: failed to GET object: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		return nil, fmt.Errorf("s3: unexpected status %d for GET: %s", res.StatusCode, msg)
+	}
+
+	return res.Body, nil
+}
+
+// Delete removes key. Deleting a missing key is not an error, S3 answering
+// it the same way.
+func (s S3) Delete(ctx context.Context, key string) error {
+	return s.do(ctx, http.MethodDelete, key, nil, http.StatusNoContent)
+}
+
+func (s S3) do(ctx context.Context, method, key string, body []byte, want int) error {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("s3: failed to create %s request: %w", method, err)
+	}
+	s.sign(req, key, body, time.Now().UTC())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: failed to %s object: %w", method, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != want && res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		return fmt.Errorf("s3: unexpected status %d for %s: %s", res.StatusCode, method, msg)
+	}
+
+	return nil
+}
+
+// SignedURL returns a presigned URL to download key that stops working after
+// ttl, at most seven days.
+func (s S3) SignedURL(key string, ttl time.Duration) (string, time.Time, error) {
+	if key == "" {
+		return "", time.Time{}, fmt.Errorf("s3: invalid key %q", key)
+	}
+	if ttl > maxURLTTL {
+		ttl = maxURLTTL
+	}
+
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := s.scope(now)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", s.cfg.AccessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	if s.cfg.SessionToken != "" {
+		query.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+
+	canonicalQuery := canonicalQuery(query)
+	canonicalRequest := fmt.Sprintf("%s\n%s\n%s\nhost:%s\n\nhost\nUNSIGNED-PAYLOAD",
+		http.MethodGet, s.objectPath(key), canonicalQuery, s.endpoint.Host)
+
+	signature := s.signature(now, amzDate, scope, canonicalRequest)
+
+	return s.objectURL(key) + "?" + canonicalQuery + "&X-Amz-Signature=" + signature, now.Add(ttl), nil
+}
+
+// sign adds the AWS Signature Version 4 headers to req.
+func (s S3) sign(req *http.Request, key string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	payloadHash := sha256.Sum256(body)
+	payload := hex.EncodeToString(payloadHash[:])
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payload)
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n",
+		s.endpoint.Host, payload, amzDate)
+	if s.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += "x-amz-security-token:" + s.cfg.SessionToken + "\n"
+	}
+
+	canonicalRequest := fmt.Sprintf("%s\n%s\n\n%s\n%s\n%s",
+		req.Method, s.objectPath(key), canonicalHeaders, signedHeaders, payload)
+
+	scope := s.scope(now)
+	signature := s.signature(now, amzDate, scope, canonicalRequest)
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func (s S3) scope(now time.Time) string {
+	return fmt.Sprintf("%s/%s/s3/aws4_request", now.Format("20060102"), s.cfg.Region)
+}
+
+func (s S3) signature(now time.Time, amzDate, scope, canonicalRequest string) string {
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := fmt.Sprintf("AWS4-HMAC-SHA256\n%s\n%s\n%s",
+		amzDate, scope, hex.EncodeToString(requestHash[:]))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// objectPath is the path of key in the bucket, each segment escaped once as
+// S3 expects it.
+func (s S3) objectPath(key string) string {
+	segments := strings.Split(s.cfg.Bucket+"/"+key, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return s.endpoint.Path + "/" + strings.Join(segments, "/")
+}
+
+func (s S3) objectURL(key string) string {
+	return s.endpoint.Scheme + "://" + s.endpoint.Host + s.objectPath(key)
+}
+
+// canonicalQuery encodes query sorted by name, escaped the way the signature
+// expects it rather than the way url.Values does.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]string, len(names))
+	for i, name := range names {
+		params[i] = escape(name) + "=" + escape(query.Get(name))
+	}
+	return strings.Join(params, "&")
+}
+
+// escape percent-encodes every byte but the unreserved characters of RFC 3986.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,196 @@
+package thumbnail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+	"travel-api/internal/pgstore"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	// batch is how many photos are claimed at once.
+	batch = 10
+	// lease hides a claimed photo from other workers while its thumbnail is
+	// made. If the worker dies, the photo is retried once the lease expires.
+	lease = 5 * time.Minute
+	// maxAttempts is how many times a thumbnail is tried before the photo is
+	// left without one.
+	maxAttempts = 5
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+	// generateTimeout bounds the making of a thumbnail, well within lease so
+	// the photo is not claimed again meanwhile.
+	generateTimeout = 2 * time.Minute
+)
+
+// blobStore reads the photos and keeps their thumbnails.
+type blobStore interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Put(ctx context.Context, key string, r io.Reader) error
+}
+
+type store interface {
+	ClaimPendingThumbnails(context.Context, pgstore.ClaimPendingThumbnailsParams) ([]pgstore.Photo, error)
+	MarkThumbnailReady(context.Context, pgstore.MarkThumbnailReadyParams) error
+	MarkThumbnailFailed(context.Context, pgstore.MarkThumbnailFailedParams) error
+}
+
+// Generator makes the thumbnails of the photos uploaded to the galleries, and
+// records the size of the photos along. Failures are retried with exponential
+// backoff, the photos that are not a supported image right away failing.
+type Generator struct {
+	store    store
+	blobs    blobStore
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewGenerator(pool *pgxpool.Pool, logger *zap.Logger, blobs blobStore, interval time.Duration) Generator {
+	return Generator{pgstore.New(pool), blobs, logger, interval}
+}
+
+// NewGeneratorWithStore returns a Generator making the thumbnails of the
+// photos of s rather than of Postgres.
+func NewGeneratorWithStore(s store, logger *zap.Logger, blobs blobStore, interval time.Duration) Generator {
+	return Generator{s, blobs, logger, interval}
+}
+
+// Run makes the pending thumbnails every interval until ctx is done.
+func (g Generator) Run(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		g.generateDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// generateDue makes the pending thumbnails, batch after batch, until none is
+// left.
+func (g Generator) generateDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		if g.generateBatch(ctx) < batch {
+			return
+		}
+	}
+}
+
+// generateBatch makes the thumbnails of a batch of photos and returns how
+// many were claimed.
+func (g Generator) generateBatch(ctx context.Context) int {
+	now := time.Now().UTC()
+	photos, err := g.store.ClaimPendingThumbnails(ctx, pgstore.ClaimPendingThumbnailsParams{
+		LeaseUntil: pgtype.Timestamp{Valid: true, Time: now.Add(lease)},
+		Now:        pgtype.Timestamp{Valid: true, Time: now},
+		Limit:      batch,
+	})
+	if err != nil {
+		g.logger.Error("failed to claim pending thumbnails", zap.Error(err))
+		return 0
+	}
+
+	for _, photo := range photos {
+		key := thumbnailKey(photo.StorageKey)
+
+		width, height, err := g.generate(ctx, photo.StorageKey, key)
+		if err != nil {
+			g.fail(ctx, photo, err)
+			continue
+		}
+
+		if err := g.store.MarkThumbnailReady(ctx, pgstore.MarkThumbnailReadyParams{
+			ThumbnailKey: pgtype.Text{Valid: true, String: key},
+			Width:        pgtype.Int4{Valid: true, Int32: int32(width)},
+			Height:       pgtype.Int4{Valid: true, Int32: int32(height)},
+			ID:           photo.ID,
+		}); err != nil {
+			g.logger.Error("failed to mark thumbnail as ready",
+				zap.Error(err),
+				zap.String("photo_id", photo.ID.String()),
+			)
+		}
+	}
+
+	return len(photos)
+}
+
+// thumbnailKey is where the thumbnail of the photo stored under key is kept.
+func thumbnailKey(key string) string {
+	return path.Join(path.Dir(key), "thumbnails", path.Base(key))
+}
+
+// generate makes the thumbnail of the photo under key, stores it under
+// thumbKey and returns the size of the photo.
+func (g Generator) generate(ctx context.Context, key, thumbKey string) (width, height int, err error) {
+	ctx, cancel := context.WithTimeout(ctx, generateTimeout)
+	defer cancel()
+
+	photo, err := g.blobs.Get(ctx, key)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer photo.Close()
+
+	thumb, width, height, err := Make(photo)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := g.blobs.Put(ctx, thumbKey, bytes.NewReader(thumb)); err != nil {
+		return 0, 0, fmt.Errorf("thumbnail: failed to store: %w", err)
+	}
+
+	return width, height, nil
+}
+
+// fail schedules the next attempt of the thumbnail of photo, or gives up on
+// it once it reaches maxAttempts or the photo is not a supported image.
+func (g Generator) fail(ctx context.Context, photo pgstore.Photo, genErr error) {
+	attempts := photo.ThumbnailAttempts + 1
+	status := pgstore.ThumbnailStatusPending
+	if attempts >= maxAttempts || errors.Is(genErr, ErrUnsupported) {
+		status = pgstore.ThumbnailStatusFailed
+	}
+
+	g.logger.Error("failed to make thumbnail",
+		zap.Error(genErr),
+		zap.String("photo_id", photo.ID.String()),
+		zap.Int32("attempts", attempts),
+		zap.String("status", string(status)),
+	)
+
+	if err := g.store.MarkThumbnailFailed(ctx, pgstore.MarkThumbnailFailedParams{
+		ThumbnailStatus:        status,
+		ThumbnailNextAttemptAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(backoff(attempts))},
+		ID:                     photo.ID,
+	}); err != nil {
+		g.logger.Error("failed to mark thumbnail as failed",
+			zap.Error(err),
+			zap.String("photo_id", photo.ID.String()),
+		)
+	}
+}
+
+// backoff doubles the wait between attempts, starting at baseBackoff and
+// capped at maxBackoff.
+func backoff(attempts int32) time.Duration {
+	d := baseBackoff
+	for i := int32(1); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
@@ -0,0 +1,101 @@
+// Package thumbnail makes the thumbnails of the photos of the trip galleries,
+// in a background job claiming the photos uploaded since it last ran.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+
+	// The photos are JPEG or PNG images.
+	_ "image/png"
+)
+
+const (
+	// Size is the longest side of a thumbnail, in pixels.
+	Size = 320
+	// maxPixels caps the size of the images decoded, for a crafted header not
+	// to make the job allocate gigabytes.
+	maxPixels = 50_000_000
+	quality   = 80
+)
+
+// ErrUnsupported is returned for files that are not an image Make decodes,
+// or too large an image. Trying them again is pointless.
+var ErrUnsupported = errors.New("thumbnail: unsupported image")
+
+// Make decodes the JPEG or PNG image read from r and encodes as a JPEG a copy
+// of it fitting in a Size square, keeping its aspect ratio. Smaller images are
+// only reencoded. It also returns the size of the original image.
+func Make(r io.Reader) (thumb []byte, width, height int, err error) {
+	// The header read to check the size is decoded again with the rest.
+	var header bytes.Buffer
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, &header))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: %w", ErrUnsupported, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, 0, 0, fmt.Errorf("%w: %dx%d pixels", ErrUnsupported, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(io.MultiReader(&header, r))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: %w", ErrUnsupported, err)
+	}
+
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, scale(img, Size), &jpeg.Options{Quality: quality}); err != nil {
+		return nil, 0, 0, fmt.Errorf("thumbnail: failed to encode: %w", err)
+	}
+
+	return out.Bytes(), cfg.Width, cfg.Height, nil
+}
+
+// scale shrinks src to fit in a size square, each pixel averaging the ones of
+// src it covers.
+func scale(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	tw, th := w, h
+	switch {
+	case w <= size && h <= size:
+	case w >= h:
+		tw, th = size, max(1, h*size/w)
+	default:
+		tw, th = max(1, w*size/h), size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0 := bounds.Min.Y + y*h/th
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*h/th)
+
+		for x := 0; x < tw; x++ {
+			x0 := bounds.Min.X + x*w/tw
+			x1 := max(x0+1, bounds.Min.X+(x+1)*w/tw)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(cr), g+uint64(cg), b+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+
+	return dst
+}
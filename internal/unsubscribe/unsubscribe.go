@@ -0,0 +1,260 @@
+package unsubscribe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"travel-api/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type store interface {
+	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	OptOutOfNotification(context.Context, pgstore.OptOutOfNotificationParams) error
+}
+
+// Links hands out the unsubscribe URLs of the notification emails, signed
+// with an HMAC so a participant can only be opted out from their own emails,
+// and serves them with Handler. The URLs do not expire.
+type Links struct {
+	store   store
+	logger  *zap.Logger
+	baseURL string
+	secret  []byte
+}
+
+// NewLinks returns the Links of the Handler mounted on baseURL, e.g.
+// "http://localhost:8080/unsubscribe".
+func NewLinks(pool *pgxpool.Pool, logger *zap.Logger, baseURL string, secret []byte) (Links, error) {
+	if len(secret) == 0 {
+		return Links{}, fmt.Errorf("unsubscribe: signing secret must not be empty")
+	}
+
+	return Links{pgstore.New(pool), logger, baseURL, secret}, nil
+}
+
+// URL returns the link opting the participant out of the kind of
+// notification.
+func (l Links) URL(participantID uuid.UUID, kind pgstore.NotificationKind) string {
+	q := url.Values{}
+	q.Set("participant", participantID.String())
+	q.Set("kind", string(kind))
+	q.Set("signature", l.sign(participantID.String(), string(kind)))
+
+	return l.baseURL + "?" + q.Encode()
+}
+
+// Handler serves the URLs produced by URL. GET is the link clicked in the
+// email and answers with a page asking to confirm, since mail scanners and
+// link previews open links too. POST unsubscribes: the page posts its form
+// and is answered with another page, while email clients send the one-click
+// unsubscribe of RFC 8058, List-Unsubscribe=One-Click, and get no content.
+func (l Links) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "método não permitido", http.StatusMethodNotAllowed)
+			return
+		}
+
+		participant := r.URL.Query().Get("participant")
+		kind := pgstore.NotificationKind(r.URL.Query().Get("kind"))
+		signature := r.URL.Query().Get("signature")
+
+		if !hmac.Equal([]byte(signature), []byte(l.sign(participant, string(kind)))) {
+			http.Error(w, "assinatura inválida", http.StatusForbidden)
+			return
+		}
+
+		id, err := uuid.Parse(participant)
+		if err != nil {
+			http.Error(w, "uuid inválido", http.StatusBadRequest)
+			return
+		}
+
+		p, err := l.store.GetParticipant(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				http.Error(w, "participante não encontrado", http.StatusNotFound)
+				return
+			}
+			l.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participant))
+			http.Error(w, "Algo deu errado, tente novamente", http.StatusInternalServerError)
+			return
+		}
+
+		text, ok := pages[p.Locale]
+		if !ok {
+			text = pages[pgstore.LocalePtBR]
+		}
+
+		data := pageData{
+			Lang:    string(p.Locale),
+			Title:   text.confirmTitle,
+			Message: text.confirmKinds[kind],
+			// The form posts to this same URL, signature included.
+			Action: "?" + r.URL.RawQuery,
+			Button: text.button,
+		}
+
+		if r.Method == http.MethodPost {
+			if err := l.store.OptOutOfNotification(r.Context(), pgstore.OptOutOfNotificationParams{
+				ParticipantID: id,
+				Kind:          kind,
+			}); err != nil {
+				l.logger.Error("failed to opt out of notification", zap.Error(err), zap.String("participant_id", participant))
+				http.Error(w, "Algo deu errado, tente novamente", http.StatusInternalServerError)
+				return
+			}
+
+			if r.PostFormValue("List-Unsubscribe") == "One-Click" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			data = pageData{
+				Lang:    string(p.Locale),
+				Title:   text.title,
+				Message: text.kinds[kind],
+				Hint:    text.hint,
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := page.Execute(w, data); err != nil {
+			l.logger.Error("failed to render unsubscribe page", zap.Error(err))
+		}
+	})
+}
+
+func (l Links) sign(participant, kind string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(participant + "\n" + kind))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// pageText is the pages shown before and after unsubscribing, in the
+// language of the participant.
+type pageText struct {
+	confirmTitle string
+	button       string
+	confirmKinds map[pgstore.NotificationKind]string
+	title        string
+	hint         string
+	kinds        map[pgstore.NotificationKind]string
+}
+
+var pages = map[pgstore.Locale]pageText{
+	pgstore.LocalePtBR: {
+		confirmTitle: "Cancelar inscrição",
+		button:       "Cancelar inscrição",
+		confirmKinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "Deseja deixar de receber lembretes de atividades?",
+			pgstore.NotificationKindRsvpReminder:     "Deseja deixar de receber lembretes para responder convites?",
+			pgstore.NotificationKindPreTripReminder:  "Deseja deixar de receber lembretes antes da viagem?",
+			pgstore.NotificationKindDailyDigest:      "Deseja deixar de receber a programação do dia?",
+			pgstore.NotificationKindTaskReminder:     "Deseja deixar de receber lembretes de tarefas atrasadas?",
+			pgstore.NotificationKindFlightUpdate:     "Deseja deixar de receber avisos de atrasos e mudanças de portão dos voos?",
+		},
+		title: "Inscrição cancelada",
+		hint:  "Você pode voltar a receber estes emails nas preferências da viagem.",
+		kinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "Você não receberá mais lembretes de atividades.",
+			pgstore.NotificationKindRsvpReminder:     "Você não receberá mais lembretes para responder convites.",
+			pgstore.NotificationKindPreTripReminder:  "Você não receberá mais lembretes antes da viagem.",
+			pgstore.NotificationKindDailyDigest:      "Você não receberá mais a programação do dia.",
+			pgstore.NotificationKindTaskReminder:     "Você não receberá mais lembretes de tarefas atrasadas.",
+			pgstore.NotificationKindFlightUpdate:     "Você não receberá mais avisos de atrasos e mudanças de portão dos voos.",
+		},
+	},
+	pgstore.LocaleEn: {
+		confirmTitle: "Unsubscribe",
+		button:       "Unsubscribe",
+		confirmKinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "Stop getting activity reminders?",
+			pgstore.NotificationKindRsvpReminder:     "Stop getting reminders to answer invitations?",
+			pgstore.NotificationKindPreTripReminder:  "Stop getting reminders before the trip?",
+			pgstore.NotificationKindDailyDigest:      "Stop getting the daily agenda?",
+			pgstore.NotificationKindTaskReminder:     "Stop getting reminders of overdue tasks?",
+			pgstore.NotificationKindFlightUpdate:     "Stop getting flight delay and gate change alerts?",
+		},
+		title: "Unsubscribed",
+		hint:  "You can get these emails again from the trip preferences.",
+		kinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "You will no longer get activity reminders.",
+			pgstore.NotificationKindRsvpReminder:     "You will no longer get reminders to answer invitations.",
+			pgstore.NotificationKindPreTripReminder:  "You will no longer get reminders before the trip.",
+			pgstore.NotificationKindDailyDigest:      "You will no longer get the daily agenda.",
+			pgstore.NotificationKindTaskReminder:     "You will no longer get reminders of overdue tasks.",
+			pgstore.NotificationKindFlightUpdate:     "You will no longer get flight delay and gate change alerts.",
+		},
+	},
+	pgstore.LocaleEs: {
+		confirmTitle: "Cancelar suscripción",
+		button:       "Cancelar suscripción",
+		confirmKinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "¿Quieres dejar de recibir recordatorios de actividades?",
+			pgstore.NotificationKindRsvpReminder:     "¿Quieres dejar de recibir recordatorios para responder invitaciones?",
+			pgstore.NotificationKindPreTripReminder:  "¿Quieres dejar de recibir recordatorios antes del viaje?",
+			pgstore.NotificationKindDailyDigest:      "¿Quieres dejar de recibir la agenda del día?",
+			pgstore.NotificationKindTaskReminder:     "¿Quieres dejar de recibir recordatorios de tareas atrasadas?",
+			pgstore.NotificationKindFlightUpdate:     "¿Quieres dejar de recibir avisos de retrasos y cambios de puerta de los vuelos?",
+		},
+		title: "Suscripción cancelada",
+		hint:  "Puedes volver a recibir estos correos en las preferencias del viaje.",
+		kinds: map[pgstore.NotificationKind]string{
+			pgstore.NotificationKindActivityReminder: "Ya no recibirás recordatorios de actividades.",
+			pgstore.NotificationKindRsvpReminder:     "Ya no recibirás recordatorios para responder invitaciones.",
+			pgstore.NotificationKindPreTripReminder:  "Ya no recibirás recordatorios antes del viaje.",
+			pgstore.NotificationKindDailyDigest:      "Ya no recibirás la agenda del día.",
+			pgstore.NotificationKindTaskReminder:     "Ya no recibirás recordatorios de tareas atrasadas.",
+			pgstore.NotificationKindFlightUpdate:     "Ya no recibirás avisos de retrasos y cambios de puerta de los vuelos.",
+		},
+	},
+}
+
+// pageData fills page. With an Action, the page asks to confirm with a form
+// posted there; without it, the page tells the unsubscribe is done.
+type pageData struct {
+	Lang    string
+	Title   string
+	Message string
+	Hint    string
+	Action  string
+	Button  string
+}
+
+var page = template.Must(template.New("page").Parse(strings.TrimSpace(`
+<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}}</title>
+</head>
+<body style="margin:0;padding:24px;background-color:#09090b;font-family:Helvetica,Arial,sans-serif;color:#d4d4d8;">
+  <div style="max-width:560px;margin:0 auto;padding:32px;background-color:#18181b;border-radius:12px;font-size:16px;line-height:24px;">
+    <h1 style="margin:0 0 16px;font-size:20px;color:#fafafa;">{{.Title}}</h1>
+    <p style="margin:0 0 16px;">{{.Message}}</p>
+    {{- if .Action}}
+    <form method="post" action="{{.Action}}" style="margin:0;">
+      <button type="submit" style="padding:12px 20px;border:0;border-radius:8px;background-color:#bef264;font-weight:bold;font-size:16px;color:#1a2e05;cursor:pointer;">{{.Button}}</button>
+    </form>
+    {{- else}}
+    <p style="margin:0;font-size:14px;color:#a1a1aa;">{{.Hint}}</p>
+    {{- end}}
+  </div>
+</body>
+</html>
+`)))
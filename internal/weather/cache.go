@@ -0,0 +1,68 @@
+package weather
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cacheKey struct {
+	place    string
+	from, to string
+}
+
+type cacheEntry struct {
+	days      []Day
+	expiresAt time.Time
+}
+
+// Cache keeps the forecasts of a Provider in memory for ttl, forecasts being
+// updated a few times a day at most while trips are looked at many times.
+// Failures are not kept, the next lookup asking the provider again.
+type Cache struct {
+	provider Provider
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+func NewCache(provider Provider, ttl time.Duration) *Cache {
+	return &Cache{
+		provider: provider,
+		ttl:      ttl,
+		entries:  make(map[cacheKey]cacheEntry),
+	}
+}
+
+func (c *Cache) Forecast(ctx context.Context, place string, from, to time.Time) ([]Day, error) {
+	key := cacheKey{place, from.Format(time.DateOnly), to.Format(time.DateOnly)}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && now.Before(entry.expiresAt) {
+		return entry.days, nil
+	}
+
+	days, err := c.provider.Forecast(ctx, place, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The expired entries are dropped along the way, for the trips that are
+	// over not to be kept forever.
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{days, now.Add(c.ttl)}
+
+	return days, nil
+}
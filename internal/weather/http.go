@@ -0,0 +1,99 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// maxResponseSize caps the forecast read, two weeks being a few kilobytes.
+const maxResponseSize = 1 << 20
+
+// HTTP gets the forecasts from a JSON API, as
+//
+//	GET <url>?place=Lisboa&from=2024-07-01&to=2024-07-05
+//	Authorization: Bearer <key>
+//
+// answered with {"days": [{"date": "2024-07-01", "condition": "rain",
+// "min_temperature": 12.5, "max_temperature": 20, "precipitation_probability":
+// 80}]}. Providers with another API are put behind a small adapter speaking
+// it.
+type HTTP struct {
+	url    string
+	key    string
+	client *http.Client
+}
+
+func NewHTTP(url, key string, timeout time.Duration) HTTP {
+	return HTTP{url, key, &http.Client{Timeout: timeout}}
+}
+
+type httpForecast struct {
+	Days []struct {
+		Date                     string    `json:"date"`
+		Condition                Condition `json:"condition"`
+		MinTemperature           float64   `json:"min_temperature"`
+		MaxTemperature           float64   `json:"max_temperature"`
+		PrecipitationProbability int       `json:"precipitation_probability"`
+	} `json:"days"`
+}
+
+func (h HTTP) Forecast(ctx context.Context, place string, from, to time.Time) ([]Day, error) {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return nil, fmt.Errorf("weather: invalid provider url: %w", err)
+	}
+
+	query := u.Query()
+	query.Set("place", place)
+	query.Set("from", from.Format(time.DateOnly))
+	query.Set("to", to.Format(time.DateOnly))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("weather: failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if h.key != "" {
+		req.Header.Set("Authorization", "Bearer "+h.key)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("weather: failed to get forecast: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("weather: unexpected status %d", res.StatusCode)
+	}
+
+	var body httpForecast
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("weather: failed to decode forecast: %w", err)
+	}
+
+	days := make([]Day, len(body.Days))
+	for i, day := range body.Days {
+		date, err := time.Parse(time.DateOnly, day.Date)
+		if err != nil {
+			return nil, fmt.Errorf("weather: invalid forecast date %q: %w", day.Date, err)
+		}
+
+		days[i] = Day{
+			Date:                     date,
+			Condition:                day.Condition,
+			MinTemperature:           day.MinTemperature,
+			MaxTemperature:           day.MaxTemperature,
+			PrecipitationProbability: day.PrecipitationProbability,
+		}
+	}
+
+	return days, nil
+}
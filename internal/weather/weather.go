@@ -0,0 +1,40 @@
+package weather
+
+import (
+	"context"
+	"time"
+)
+
+// Condition is the overall weather of a day.
+type Condition string
+
+const (
+	Clear        Condition = "clear"
+	PartlyCloudy Condition = "partly_cloudy"
+	Cloudy       Condition = "cloudy"
+	Fog          Condition = "fog"
+	Rain         Condition = "rain"
+	Snow         Condition = "snow"
+	Storm        Condition = "storm"
+)
+
+// Day is the forecast of a day at a place.
+type Day struct {
+	// Date is the day, at midnight UTC.
+	Date      time.Time
+	Condition Condition
+	// The temperatures are in degrees Celsius.
+	MinTemperature float64
+	MaxTemperature float64
+	// PrecipitationProbability is the chance of rain or snow, from 0 to
+	// 100.
+	PrecipitationProbability int
+}
+
+// Provider forecasts the weather of a place, such as the destination of a
+// trip, from and to being the first and last days, in the timezone of the
+// place. The days past the horizon of the provider are left out, so the
+// forecast may be shorter than asked for, or empty.
+type Provider interface {
+	Forecast(ctx context.Context, place string, from, to time.Time) ([]Day, error)
+}